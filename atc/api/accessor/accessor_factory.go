@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
@@ -20,15 +22,19 @@ type AccessFactory interface {
 }
 
 type accessFactory struct {
-	publicKey      *rsa.PublicKey
-	rolesActionMap map[string]string
+	publicKey       *rsa.PublicKey
+	serviceAccounts db.ServiceAccountRepository
+	rolesActionMap  map[string]string
 }
 
-func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
+// NewAccessFactory creates accesses for requests bearing either a JWT signed
+// with the given key, or the API token of a service account.
+func NewAccessFactory(key *rsa.PublicKey, serviceAccounts db.ServiceAccountRepository) AccessFactory {
 
 	factory := accessFactory{
-		publicKey:      key,
-		rolesActionMap: map[string]string{},
+		publicKey:       key,
+		serviceAccounts: serviceAccounts,
+		rolesActionMap:  map[string]string{},
 	}
 
 	// Copy rolesActionMap
@@ -50,6 +56,10 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 		return &access{&jwt.Token{}, action, a}
 	}
 
+	if token.IsAPIToken(header[7:]) {
+		return a.serviceAccountAccess(header[7:], action)
+	}
+
 	token, err := jwt.Parse(header[7:], a.validate)
 	if err != nil {
 		return &access{&jwt.Token{}, action, a}
@@ -58,6 +68,48 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 	return &access{token, action, a}
 }
 
+// serviceAccountAccess grants the service account its role on its team. If
+// the API token is limited to certain actions, any other action is only
+// granted the access of a user without any teams.
+func (a *accessFactory) serviceAccountAccess(apiToken string, action string) Access {
+	if a.serviceAccounts == nil {
+		return &access{&jwt.Token{}, action, a}
+	}
+
+	found, exists, err := a.serviceAccounts.UseToken(token.HashAPIToken(apiToken))
+	if err != nil || !exists {
+		return &access{&jwt.Token{}, action, a}
+	}
+
+	teams := map[string][]string{
+		found.TeamName: {found.Role},
+	}
+
+	if len(found.Actions) > 0 && !containsAction(found.Actions, action) {
+		teams = map[string][]string{}
+	}
+
+	return &access{&jwt.Token{
+		Valid: true,
+		Claims: jwt.MapClaims{
+			"sub":       "service-account:" + found.TeamName + "/" + found.ServiceAccount,
+			"user_id":   found.ServiceAccount,
+			"user_name": "service-account:" + found.TeamName + "/" + found.ServiceAccount,
+			"teams":     teams,
+		},
+	}, action, a}
+}
+
+func containsAction(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *accessFactory) validate(token *jwt.Token) (interface{}, error) {
 
 	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"crypto/rand"
 	"crypto/rsa"
+	"errors"
 	"fmt"
 	"github.com/dgrijalva/jwt-go"
 	"net/http"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/skymarshal/token"
 )
 
 var _ = Describe("AccessorFactory", func() {
@@ -20,6 +23,8 @@ var _ = Describe("AccessorFactory", func() {
 	var access accessor.Access
 	var key *rsa.PrivateKey
 	var req *http.Request
+	var fakeServiceAccounts *dbfakes.FakeServiceAccountRepository
+	var action string
 
 	Describe("Create", func() {
 		BeforeEach(func() {
@@ -31,13 +36,16 @@ var _ = Describe("AccessorFactory", func() {
 
 			publicKey := &key.PublicKey
 			//publicKey = rsa.GenerateKey(random, bits)
-			accessorFactory = accessor.NewAccessFactory(publicKey)
+			fakeServiceAccounts = new(dbfakes.FakeServiceAccountRepository)
+			accessorFactory = accessor.NewAccessFactory(publicKey, fakeServiceAccounts)
 
 			req, err = http.NewRequest("GET", "localhost:8080", nil)
 			Expect(err).NotTo(HaveOccurred())
+
+			action = "some-action"
 		})
 		JustBeforeEach(func() {
-			access = accessorFactory.Create(req, "some-action")
+			access = accessorFactory.Create(req, action)
 		})
 
 		Context("when request has jwt token set", func() {
@@ -78,6 +86,107 @@ var _ = Describe("AccessorFactory", func() {
 			})
 		})
 
+		Context("when request has the api token of a service account", func() {
+			var apiToken string
+
+			BeforeEach(func() {
+				var err error
+				apiToken, _, err = token.GenerateAPIToken()
+				Expect(err).NotTo(HaveOccurred())
+
+				req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", apiToken))
+
+				action = atc.CreateJobBuild
+			})
+
+			Context("when the token is found", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.UseTokenReturns(atc.APIToken{
+						TeamName:       "some-team",
+						ServiceAccount: "some-bot",
+						Role:           "member",
+					}, true, nil)
+				})
+
+				It("looks the token up by its hash", func() {
+					Expect(fakeServiceAccounts.UseTokenCallCount()).To(Equal(1))
+					Expect(fakeServiceAccounts.UseTokenArgsForCall(0)).To(Equal(token.HashAPIToken(apiToken)))
+				})
+
+				It("grants the service account its role on its team", func() {
+					Expect(access.IsAuthenticated()).To(BeTrue())
+					Expect(access.IsAuthorized("some-team")).To(BeTrue())
+					Expect(access.IsAuthorized("some-other-team")).To(BeFalse())
+					Expect(access.IsAdmin()).To(BeFalse())
+					Expect(access.TeamRoles()).To(Equal(map[string][]string{"some-team": {"member"}}))
+					Expect(access.UserName()).To(Equal("service-account:some-team/some-bot"))
+				})
+
+				Context("when the action requires a higher role", func() {
+					BeforeEach(func() {
+						action = atc.DestroyTeam
+					})
+
+					It("is not authorized", func() {
+						Expect(access.IsAuthorized("some-team")).To(BeFalse())
+					})
+				})
+			})
+
+			Context("when the token is limited to other actions", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.UseTokenReturns(atc.APIToken{
+						TeamName:       "some-team",
+						ServiceAccount: "some-bot",
+						Role:           "member",
+						Actions:        []string{atc.GetBuild},
+					}, true, nil)
+				})
+
+				It("is authenticated without any teams", func() {
+					Expect(access.IsAuthenticated()).To(BeTrue())
+					Expect(access.IsAuthorized("some-team")).To(BeFalse())
+					Expect(access.TeamNames()).To(BeEmpty())
+				})
+			})
+
+			Context("when the token is limited to the action", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.UseTokenReturns(atc.APIToken{
+						TeamName:       "some-team",
+						ServiceAccount: "some-bot",
+						Role:           "member",
+						Actions:        []string{atc.CreateJobBuild},
+					}, true, nil)
+				})
+
+				It("is authorized", func() {
+					Expect(access.IsAuthorized("some-team")).To(BeTrue())
+				})
+			})
+
+			Context("when the token is not found", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.UseTokenReturns(atc.APIToken{}, false, nil)
+				})
+
+				It("is not authenticated", func() {
+					Expect(access.HasToken()).To(BeTrue())
+					Expect(access.IsAuthenticated()).To(BeFalse())
+				})
+			})
+
+			Context("when looking up the token fails", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.UseTokenReturns(atc.APIToken{}, false, errors.New("nope"))
+				})
+
+				It("is not authenticated", func() {
+					Expect(access.IsAuthenticated()).To(BeFalse())
+				})
+			})
+		})
+
 		Context("when request does not have valid jwt token set", func() {
 			BeforeEach(func() {
 				req.Header.Add("Authorization", "blah-token")
@@ -94,7 +203,7 @@ var _ = Describe("AccessorFactory", func() {
 		)
 
 		BeforeEach(func() {
-			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{})
+			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{}, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -37,7 +37,7 @@ var _ = Describe("Accessor", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		publicKey := &key.PublicKey
-		accessorFactory = accessor.NewAccessFactory(publicKey)
+		accessorFactory = accessor.NewAccessFactory(publicKey, nil)
 
 	})
 
@@ -504,6 +504,26 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.AbortBuild, atc.AbortBuild, "pipeline-operator", true),
 		Entry("viewer :: "+atc.AbortBuild, atc.AbortBuild, "viewer", false),
 
+		Entry("owner :: "+atc.ListBuildStepApprovals, atc.ListBuildStepApprovals, "owner", true),
+		Entry("member :: "+atc.ListBuildStepApprovals, atc.ListBuildStepApprovals, "member", true),
+		Entry("pipeline-operator :: "+atc.ListBuildStepApprovals, atc.ListBuildStepApprovals, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListBuildStepApprovals, atc.ListBuildStepApprovals, "viewer", true),
+
+		Entry("owner :: "+atc.GetBuildTestResults, atc.GetBuildTestResults, "owner", true),
+		Entry("member :: "+atc.GetBuildTestResults, atc.GetBuildTestResults, "member", true),
+		Entry("pipeline-operator :: "+atc.GetBuildTestResults, atc.GetBuildTestResults, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetBuildTestResults, atc.GetBuildTestResults, "viewer", true),
+
+		Entry("owner :: "+atc.ApproveBuildStep, atc.ApproveBuildStep, "owner", true),
+		Entry("member :: "+atc.ApproveBuildStep, atc.ApproveBuildStep, "member", true),
+		Entry("pipeline-operator :: "+atc.ApproveBuildStep, atc.ApproveBuildStep, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ApproveBuildStep, atc.ApproveBuildStep, "viewer", true),
+
+		Entry("owner :: "+atc.RejectBuildStep, atc.RejectBuildStep, "owner", true),
+		Entry("member :: "+atc.RejectBuildStep, atc.RejectBuildStep, "member", true),
+		Entry("pipeline-operator :: "+atc.RejectBuildStep, atc.RejectBuildStep, "pipeline-operator", true),
+		Entry("viewer :: "+atc.RejectBuildStep, atc.RejectBuildStep, "viewer", true),
+
 		Entry("owner :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "owner", true),
 		Entry("member :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "member", true),
 		Entry("pipeline-operator :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "pipeline-operator", true),
@@ -544,6 +564,16 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.ListJobInputs, atc.ListJobInputs, "pipeline-operator", true),
 		Entry("viewer :: "+atc.ListJobInputs, atc.ListJobInputs, "viewer", true),
 
+		Entry("owner :: "+atc.GetJobSchedulingExplanation, atc.GetJobSchedulingExplanation, "owner", true),
+		Entry("member :: "+atc.GetJobSchedulingExplanation, atc.GetJobSchedulingExplanation, "member", true),
+		Entry("pipeline-operator :: "+atc.GetJobSchedulingExplanation, atc.GetJobSchedulingExplanation, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetJobSchedulingExplanation, atc.GetJobSchedulingExplanation, "viewer", true),
+
+		Entry("owner :: "+atc.GetJobTestHistory, atc.GetJobTestHistory, "owner", true),
+		Entry("member :: "+atc.GetJobTestHistory, atc.GetJobTestHistory, "member", true),
+		Entry("pipeline-operator :: "+atc.GetJobTestHistory, atc.GetJobTestHistory, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetJobTestHistory, atc.GetJobTestHistory, "viewer", true),
+
 		Entry("owner :: "+atc.GetJobBuild, atc.GetJobBuild, "owner", true),
 		Entry("member :: "+atc.GetJobBuild, atc.GetJobBuild, "member", true),
 		Entry("pipeline-operator :: "+atc.GetJobBuild, atc.GetJobBuild, "pipeline-operator", true),
@@ -848,6 +878,76 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "pipeline-operator", true),
 		Entry("viewer :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.SearchBuildLogs, atc.SearchBuildLogs, "owner", true),
+		Entry("member :: "+atc.SearchBuildLogs, atc.SearchBuildLogs, "member", true),
+		Entry("pipeline-operator :: "+atc.SearchBuildLogs, atc.SearchBuildLogs, "pipeline-operator", true),
+		Entry("viewer :: "+atc.SearchBuildLogs, atc.SearchBuildLogs, "viewer", true),
+
+		Entry("owner :: "+atc.ListTeamAuditEvents, atc.ListTeamAuditEvents, "owner", true),
+		Entry("member :: "+atc.ListTeamAuditEvents, atc.ListTeamAuditEvents, "member", false),
+		Entry("pipeline-operator :: "+atc.ListTeamAuditEvents, atc.ListTeamAuditEvents, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ListTeamAuditEvents, atc.ListTeamAuditEvents, "viewer", false),
+
+		Entry("owner :: "+atc.ListStepTemplates, atc.ListStepTemplates, "owner", true),
+		Entry("member :: "+atc.ListStepTemplates, atc.ListStepTemplates, "member", true),
+		Entry("pipeline-operator :: "+atc.ListStepTemplates, atc.ListStepTemplates, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListStepTemplates, atc.ListStepTemplates, "viewer", true),
+
+		Entry("owner :: "+atc.GetStepTemplate, atc.GetStepTemplate, "owner", true),
+		Entry("member :: "+atc.GetStepTemplate, atc.GetStepTemplate, "member", true),
+		Entry("pipeline-operator :: "+atc.GetStepTemplate, atc.GetStepTemplate, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetStepTemplate, atc.GetStepTemplate, "viewer", true),
+
+		Entry("owner :: "+atc.SaveStepTemplate, atc.SaveStepTemplate, "owner", true),
+		Entry("member :: "+atc.SaveStepTemplate, atc.SaveStepTemplate, "member", true),
+		Entry("pipeline-operator :: "+atc.SaveStepTemplate, atc.SaveStepTemplate, "pipeline-operator", false),
+		Entry("viewer :: "+atc.SaveStepTemplate, atc.SaveStepTemplate, "viewer", false),
+
+		Entry("owner :: "+atc.DestroyStepTemplate, atc.DestroyStepTemplate, "owner", true),
+		Entry("member :: "+atc.DestroyStepTemplate, atc.DestroyStepTemplate, "member", true),
+		Entry("pipeline-operator :: "+atc.DestroyStepTemplate, atc.DestroyStepTemplate, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DestroyStepTemplate, atc.DestroyStepTemplate, "viewer", false),
+
+		Entry("owner :: "+atc.ListNotifications, atc.ListNotifications, "owner", true),
+		Entry("member :: "+atc.ListNotifications, atc.ListNotifications, "member", true),
+		Entry("pipeline-operator :: "+atc.ListNotifications, atc.ListNotifications, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ListNotifications, atc.ListNotifications, "viewer", false),
+
+		Entry("owner :: "+atc.SaveNotification, atc.SaveNotification, "owner", true),
+		Entry("member :: "+atc.SaveNotification, atc.SaveNotification, "member", true),
+		Entry("pipeline-operator :: "+atc.SaveNotification, atc.SaveNotification, "pipeline-operator", false),
+		Entry("viewer :: "+atc.SaveNotification, atc.SaveNotification, "viewer", false),
+
+		Entry("owner :: "+atc.DestroyNotification, atc.DestroyNotification, "owner", true),
+		Entry("member :: "+atc.DestroyNotification, atc.DestroyNotification, "member", true),
+		Entry("pipeline-operator :: "+atc.DestroyNotification, atc.DestroyNotification, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DestroyNotification, atc.DestroyNotification, "viewer", false),
+
+		Entry("owner :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "owner", true),
+		Entry("member :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "member", true),
+		Entry("pipeline-operator :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ListNotificationDeliveries, atc.ListNotificationDeliveries, "viewer", false),
+
+		Entry("owner :: "+atc.ListAPITokens, atc.ListAPITokens, "owner", true),
+		Entry("member :: "+atc.ListAPITokens, atc.ListAPITokens, "member", false),
+		Entry("pipeline-operator :: "+atc.ListAPITokens, atc.ListAPITokens, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ListAPITokens, atc.ListAPITokens, "viewer", false),
+
+		Entry("owner :: "+atc.CreateAPIToken, atc.CreateAPIToken, "owner", true),
+		Entry("member :: "+atc.CreateAPIToken, atc.CreateAPIToken, "member", false),
+		Entry("pipeline-operator :: "+atc.CreateAPIToken, atc.CreateAPIToken, "pipeline-operator", false),
+		Entry("viewer :: "+atc.CreateAPIToken, atc.CreateAPIToken, "viewer", false),
+
+		Entry("owner :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "owner", true),
+		Entry("member :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "member", false),
+		Entry("pipeline-operator :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "pipeline-operator", false),
+		Entry("viewer :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "viewer", false),
+
+		Entry("owner :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "owner", true),
+		Entry("member :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "member", false),
+		Entry("pipeline-operator :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DestroyServiceAccount, atc.DestroyServiceAccount, "viewer", false),
+
 		Entry("owner :: "+atc.CreateArtifact, atc.CreateArtifact, "owner", true),
 		Entry("member :: "+atc.CreateArtifact, atc.CreateArtifact, "member", true),
 		Entry("pipeline-operator :: "+atc.CreateArtifact, atc.CreateArtifact, "pipeline-operator", false),
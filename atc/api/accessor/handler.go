@@ -1,7 +1,10 @@
 package accessor
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"net"
 	"net/http"
 
 	"github.com/concourse/concourse/atc/auditor"
@@ -33,8 +36,57 @@ func (h accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	acc := h.accessFactory.Create(r, h.action)
 	ctx := context.WithValue(r.Context(), "accessor", acc)
 
-	h.auditor.Audit(h.action, acc.UserName(), r)
-	h.handler.ServeHTTP(w, r.WithContext(ctx))
+	// audit the request before handling it, as event streams and hijacked
+	// containers may not be responded to for a long time, if ever
+	event := h.auditor.Audit(h.action, acc.UserName(), r)
+
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	h.handler.ServeHTTP(recorder, r.WithContext(ctx))
+
+	h.auditor.Responded(event, recorder.status)
+}
+
+// statusRecorder remembers the status a request was responded to with, so
+// that it can be audited along with the request.
+type statusRecorder struct {
+	http.ResponseWriter
+
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(p []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(p)
+}
+
+// Flush is needed for streaming build events.
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack is needed for intercepting containers.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not support hijacking")
+	}
+
+	r.status = http.StatusSwitchingProtocols
+	r.wroteHeader = true
+
+	return hijacker.Hijack()
 }
 
 func GetAccessor(r *http.Request) Access {
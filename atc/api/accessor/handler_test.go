@@ -2,7 +2,9 @@ package accessor_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
@@ -20,7 +22,9 @@ var _ = Describe("Handler", func() {
 		access             accessor.Access
 		fakeAccess         *accessorfakes.FakeAccess
 		accessorHandler    http.Handler
+		fakeAuditor        *auditorfakes.FakeAuditor
 		req                *http.Request
+		recorder           *httptest.ResponseRecorder
 	)
 	BeforeEach(func() {
 		accessorFactory = new(accessorfakes.FakeAccessFactory)
@@ -29,6 +33,8 @@ var _ = Describe("Handler", func() {
 			innerHandlerCalled = true
 
 			access = r.Context().Value("accessor").(accessor.Access)
+
+			w.WriteHeader(http.StatusTeapot)
 		})
 
 		var err error
@@ -37,23 +43,58 @@ var _ = Describe("Handler", func() {
 	})
 
 	JustBeforeEach(func() {
-		accessorHandler.ServeHTTP(nil, req)
+		recorder = httptest.NewRecorder()
+		accessorHandler.ServeHTTP(recorder, req)
 	})
 
 	Describe("Accessor Handler", func() {
 		BeforeEach(func() {
-			accessorHandler = accessor.NewHandler(dummyHandler, accessorFactory, "some-action", new(auditorfakes.FakeAuditor))
+			fakeAuditor = new(auditorfakes.FakeAuditor)
+			fakeAuditor.AuditReturns(atc.AuditEvent{ID: 42, Action: "some-action"})
+			accessorHandler = accessor.NewHandler(dummyHandler, accessorFactory, "some-action", fakeAuditor)
 		})
 
 		Context("when access factory return valid access object", func() {
 			BeforeEach(func() {
 				fakeAccess = new(accessorfakes.FakeAccess)
+				fakeAccess.UserNameReturns("some-user")
 				accessorFactory.CreateReturns(fakeAccess)
 			})
 			It("calls the inner handler", func() {
 				Expect(innerHandlerCalled).To(BeTrue())
 				Expect(access).To(Equal(fakeAccess))
 			})
+
+			It("audits the request before handling it", func() {
+				Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+				action, userName, r := fakeAuditor.AuditArgsForCall(0)
+				Expect(action).To(Equal("some-action"))
+				Expect(userName).To(Equal("some-user"))
+				Expect(r).To(Equal(req))
+			})
+
+			It("records the status the request was responded to with", func() {
+				Expect(fakeAuditor.RespondedCallCount()).To(Equal(1))
+				event, status := fakeAuditor.RespondedArgsForCall(0)
+				Expect(event).To(Equal(atc.AuditEvent{ID: 42, Action: "some-action"}))
+				Expect(status).To(Equal(http.StatusTeapot))
+			})
+
+			Context("while the request is being handled", func() {
+				var auditedCalls, respondedCalls int
+
+				BeforeEach(func() {
+					accessorHandler = accessor.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						auditedCalls = fakeAuditor.AuditCallCount()
+						respondedCalls = fakeAuditor.RespondedCallCount()
+					}), accessorFactory, "some-action", fakeAuditor)
+				})
+
+				It("has already audited the request", func() {
+					Expect(auditedCalls).To(Equal(1))
+					Expect(respondedCalls).To(BeZero())
+				})
+			})
 		})
 	})
 })
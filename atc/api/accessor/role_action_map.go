@@ -23,6 +23,10 @@ var requiredRoles = map[string]string{
 	atc.BuildResources:                "viewer",
 	atc.AbortBuild:                    "pipeline-operator",
 	atc.GetBuildPreparation:           "viewer",
+	atc.ListBuildStepApprovals:        "viewer",
+	atc.ApproveBuildStep:              "viewer",
+	atc.RejectBuildStep:               "viewer",
+	atc.GetBuildTestResults:           "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "pipeline-operator",
 	atc.RerunJobBuild:                 "pipeline-operator",
@@ -30,6 +34,8 @@ var requiredRoles = map[string]string{
 	atc.ListJobs:                      "viewer",
 	atc.ListJobBuilds:                 "viewer",
 	atc.ListJobInputs:                 "viewer",
+	atc.GetJobSchedulingExplanation:   "viewer",
+	atc.GetJobTestHistory:             "viewer",
 	atc.GetJobBuild:                   "viewer",
 	atc.PauseJob:                      "pipeline-operator",
 	atc.UnpauseJob:                    "pipeline-operator",
@@ -94,6 +100,20 @@ var requiredRoles = map[string]string{
 	atc.RenameTeam:                    "owner",
 	atc.DestroyTeam:                   "owner",
 	atc.ListTeamBuilds:                "viewer",
+	atc.SearchBuildLogs:               "viewer",
+	atc.ListTeamAuditEvents:           "owner",
+	atc.ListStepTemplates:             "viewer",
+	atc.GetStepTemplate:               "viewer",
+	atc.SaveStepTemplate:              "member",
+	atc.DestroyStepTemplate:           "member",
+	atc.ListNotifications:             "member",
+	atc.SaveNotification:              "member",
+	atc.DestroyNotification:           "member",
+	atc.ListNotificationDeliveries:    "member",
+	atc.ListAPITokens:                 "owner",
+	atc.CreateAPIToken:                "owner",
+	atc.RevokeAPIToken:                "owner",
+	atc.DestroyServiceAccount:         "owner",
 	atc.CreateArtifact:                "member",
 	atc.GetArtifact:                   "member",
 	atc.ListBuildArtifacts:            "viewer",
@@ -20,6 +20,8 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/gc/gcfakes"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
 	. "github.com/onsi/ginkgo"
@@ -53,6 +55,12 @@ var (
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
 	fakeSecretManager       *credsfakes.FakeSecrets
+	fakePolicyChecker       *policyfakes.FakeChecker
+	fakeLogArchiver         *logarchivefakes.FakeArchiver
+	fakeAuditEvents         *dbfakes.FakeAuditEventRepository
+	fakeStepTemplates       *dbfakes.FakeStepTemplateRepository
+	fakeNotifications       *dbfakes.FakeNotificationRepository
+	fakeServiceAccounts     *dbfakes.FakeServiceAccountRepository
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	credsManagers           creds.Managers
 	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
@@ -124,6 +132,12 @@ var _ = BeforeEach(func() {
 	fakeDestroyer = new(gcfakes.FakeDestroyer)
 
 	fakeSecretManager = new(credsfakes.FakeSecrets)
+	fakePolicyChecker = new(policyfakes.FakeChecker)
+	fakeLogArchiver = new(logarchivefakes.FakeArchiver)
+	fakeAuditEvents = new(dbfakes.FakeAuditEventRepository)
+	fakeStepTemplates = new(dbfakes.FakeStepTemplateRepository)
+	fakeNotifications = new(dbfakes.FakeNotificationRepository)
+	fakeServiceAccounts = new(dbfakes.FakeServiceAccountRepository)
 	fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	credsManagers = make(creds.Managers)
 	var err error
@@ -191,6 +205,12 @@ var _ = BeforeEach(func() {
 		credsManagers,
 		interceptTimeoutFactory,
 		dbWall,
+		fakePolicyChecker,
+		fakeLogArchiver,
+		fakeAuditEvents,
+		fakeStepTemplates,
+		fakeNotifications,
+		fakeServiceAccounts,
 	)
 
 	Expect(err).NotTo(HaveOccurred())
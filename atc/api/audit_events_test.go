@@ -0,0 +1,187 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events API", func() {
+	Describe("GET /api/v1/teams/:team_name/audit_events", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = ""
+			dbTeam.NameReturns("some-team")
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/audit_events" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeAuditEvents.TeamAuditEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeAuditEvents.TeamAuditEventsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when the team does not exist", func() {
+					BeforeEach(func() {
+						dbTeamFactory.FindTeamReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when no params are passed", func() {
+					It("uses the default limit", func() {
+						Expect(fakeAuditEvents.TeamAuditEventsCallCount()).To(Equal(1))
+
+						teamName, page := fakeAuditEvents.TeamAuditEventsArgsForCall(0)
+						Expect(teamName).To(Equal("some-team"))
+						Expect(page).To(Equal(db.Page{Limit: 100}))
+					})
+
+					It("returns an empty list", func() {
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[]`))
+					})
+				})
+
+				Context("when all the params are passed", func() {
+					BeforeEach(func() {
+						queryParams = "?since=2&until=3&limit=8"
+					})
+
+					It("passes them through", func() {
+						_, page := fakeAuditEvents.TeamAuditEventsArgsForCall(0)
+						Expect(page).To(Equal(db.Page{Since: 2, Until: 3, Limit: 8}))
+					})
+				})
+
+				Context("when getting the audit events succeeds", func() {
+					BeforeEach(func() {
+						queryParams = "?since=5&limit=2"
+
+						fakeAuditEvents.TeamAuditEventsReturns([]atc.AuditEvent{
+							{
+								ID:       4,
+								Time:     100,
+								Action:   atc.PausePipeline,
+								Actor:    "some-user",
+								SourceIP: "1.2.3.4",
+								TeamName: "some-team",
+								Targets:  map[string]string{"pipeline_name": "some-pipeline"},
+								Status:   200,
+							},
+							{
+								ID:       2,
+								Time:     50,
+								Action:   atc.SetTeam,
+								Actor:    "some-admin",
+								SourceIP: "5.6.7.8",
+								TeamName: "some-team",
+								Status:   403,
+							},
+						}, db.Pagination{
+							Previous: &db.Page{Until: 4, Limit: 2},
+							Next:     &db.Page{Since: 2, Limit: 2},
+						}, nil)
+					})
+
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						expectedHeaderEntries := map[string]string{
+							"Content-Type": "application/json",
+						}
+						Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+					})
+
+					It("returns the audit events", func() {
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+							{
+								"id": 4,
+								"time": 100,
+								"action": "PausePipeline",
+								"actor": "some-user",
+								"source_ip": "1.2.3.4",
+								"team_name": "some-team",
+								"targets": {"pipeline_name": "some-pipeline"},
+								"status": 200
+							},
+							{
+								"id": 2,
+								"time": 50,
+								"action": "SetTeam",
+								"actor": "some-admin",
+								"source_ip": "5.6.7.8",
+								"team_name": "some-team",
+								"status": 403
+							}
+						]`))
+					})
+
+					It("returns Link headers per rfc5988", func() {
+						Expect(response.Header["Link"]).To(ConsistOf([]string{
+							`<https://example.com/api/v1/teams/some-team/audit_events?until=4&limit=2>; rel="previous"`,
+							`<https://example.com/api/v1/teams/some-team/audit_events?since=2&limit=2>; rel="next"`,
+						}))
+					})
+				})
+
+				Context("when getting the audit events fails", func() {
+					BeforeEach(func() {
+						fakeAuditEvents.TeamAuditEventsReturns(nil, db.Pagination{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,68 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListTeamAuditEvents(team db.Team) http.Handler {
+	logger := s.logger.Session("list-team-audit-events")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		until, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryUntil))
+		since, _ := strconv.Atoi(r.FormValue(atc.PaginationQuerySince))
+
+		limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+		if limit == 0 {
+			limit = atc.PaginationAPIDefaultLimit
+		}
+
+		page := db.Page{Until: until, Since: since, Limit: limit}
+
+		events, pagination, err := s.auditEvents.TeamAuditEvents(team.Name(), page)
+		if err != nil {
+			logger.Error("failed-to-get-audit-events", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if pagination.Next != nil {
+			s.addLink(w, team.Name(), atc.PaginationQuerySince, pagination.Next.Since, pagination.Next.Limit, atc.LinkRelNext)
+		}
+
+		if pagination.Previous != nil {
+			s.addLink(w, team.Name(), atc.PaginationQueryUntil, pagination.Previous.Until, pagination.Previous.Limit, atc.LinkRelPrevious)
+		}
+
+		if events == nil {
+			events = []atc.AuditEvent{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(events)
+		if err != nil {
+			logger.Error("failed-to-encode-audit-events", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) addLink(w http.ResponseWriter, teamName string, param string, id int, limit int, rel string) {
+	w.Header().Add("Link", fmt.Sprintf(
+		`<%s/api/v1/teams/%s/audit_events?%s=%d&%s=%d>; rel="%s"`,
+		s.externalURL,
+		teamName,
+		param,
+		id,
+		atc.PaginationQueryLimit,
+		limit,
+		rel,
+	))
+}
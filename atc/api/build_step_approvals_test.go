@@ -0,0 +1,356 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Step Approvals API", func() {
+	var approved = true
+
+	Describe("GET /api/v1/builds/:build_id/approvals", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/128/approvals")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(build, true, nil)
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(nil, false, nil)
+			})
+
+			Context("when not authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					Expect(build.StepApprovalsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when getting the approvals succeeds", func() {
+					BeforeEach(func() {
+						build.StepApprovalsReturns([]atc.BuildStepApproval{
+							{
+								PlanID:    "some-plan-id",
+								Name:      "ship-it",
+								Message:   "ready?",
+								Roles:     []string{"owner"},
+								CreatedAt: 100,
+							},
+							{
+								PlanID:    "other-plan-id",
+								Name:      "really-ship-it",
+								Approved:  &approved,
+								DecidedBy: "some-user",
+								CreatedAt: 200,
+								DecidedAt: 300,
+							},
+						}, nil)
+					})
+
+					It("returns the build's approvals", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response).Should(IncludeHeaderEntries(map[string]string{
+							"Content-Type": "application/json",
+						}))
+
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+							{
+								"plan_id": "some-plan-id",
+								"name": "ship-it",
+								"message": "ready?",
+								"roles": ["owner"],
+								"created_at": 100
+							},
+							{
+								"plan_id": "other-plan-id",
+								"name": "really-ship-it",
+								"approved": true,
+								"decided_by": "some-user",
+								"created_at": 200,
+								"decided_at": 300
+							}
+						]`))
+					})
+				})
+
+				Context("when the build has no approvals", func() {
+					BeforeEach(func() {
+						build.StepApprovalsReturns(nil, nil)
+					})
+
+					It("returns an empty list", func() {
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[]`))
+					})
+				})
+
+				Context("when getting the approvals fails", func() {
+					BeforeEach(func() {
+						build.StepApprovalsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	for _, d := range []struct {
+		action   string
+		approved bool
+	}{
+		{"approve", true},
+		{"reject", false},
+	} {
+		decision := d
+
+		Describe("PUT /api/v1/builds/:build_id/steps/:plan_id/"+decision.action, func() {
+			var (
+				body     string
+				response *http.Response
+			)
+
+			BeforeEach(func() {
+				body = `{"comment":"some-comment"}`
+			})
+
+			JustBeforeEach(func() {
+				req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/steps/some-plan-id/"+decision.action, bytes.NewBufferString(body))
+				Expect(err).NotTo(HaveOccurred())
+
+				response, err = client.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("when not authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.UserNameReturns("some-user")
+					dbBuildFactory.BuildReturns(build, true, nil)
+					build.TeamNameReturns("some-team")
+					build.IsRunningReturns(true)
+				})
+
+				Context("when not authorized for the team", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(build.DecideStepApprovalCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when authorized for the team", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+						fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"member"}})
+						build.DecideStepApprovalReturns(true, nil)
+					})
+
+					Context("when the approval does not exist", func() {
+						BeforeEach(func() {
+							build.StepApprovalReturns(atc.BuildStepApproval{}, false, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+
+					Context("when looking up the approval fails", func() {
+						BeforeEach(func() {
+							build.StepApprovalReturns(atc.BuildStepApproval{}, false, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the approval is pending", func() {
+						var approval atc.BuildStepApproval
+
+						BeforeEach(func() {
+							approval = atc.BuildStepApproval{
+								PlanID: "some-plan-id",
+								Name:   "ship-it",
+							}
+
+							build.StepApprovalStub = func(atc.PlanID) (atc.BuildStepApproval, bool, error) {
+								return approval, true, nil
+							}
+						})
+
+						Context("with no approvers configured", func() {
+							It("records the decision", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+								Expect(build.StepApprovalArgsForCall(0)).To(Equal(atc.PlanID("some-plan-id")))
+
+								Expect(build.DecideStepApprovalCallCount()).To(Equal(1))
+								planID, approved, decidedBy, comment := build.DecideStepApprovalArgsForCall(0)
+								Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+								Expect(approved).To(Equal(decision.approved))
+								Expect(decidedBy).To(Equal("some-user"))
+								Expect(comment).To(Equal("some-comment"))
+							})
+
+							Context("when the requester is only a viewer", func() {
+								BeforeEach(func() {
+									fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"viewer"}})
+								})
+
+								It("returns 403", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+									Expect(build.DecideStepApprovalCallCount()).To(BeZero())
+								})
+							})
+						})
+
+						Context("when the step requires a higher role", func() {
+							BeforeEach(func() {
+								approval.Roles = []string{"owner"}
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+
+							Context("when the requester is an admin", func() {
+								BeforeEach(func() {
+									fakeAccess.IsAdminReturns(true)
+								})
+
+								It("returns 204", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+								})
+							})
+						})
+
+						Context("when the step requires a lower role", func() {
+							BeforeEach(func() {
+								approval.Roles = []string{"viewer"}
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+
+						Context("when the step lists the requester as an approver", func() {
+							BeforeEach(func() {
+								approval.Roles = []string{"owner"}
+								approval.Users = []string{"some-user"}
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+
+						Context("when the step lists only other approvers", func() {
+							BeforeEach(func() {
+								approval.Users = []string{"other-user"}
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+						})
+
+						Context("when the build is no longer running", func() {
+							BeforeEach(func() {
+								build.IsRunningReturns(false)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+								Expect(build.DecideStepApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when someone else decides first", func() {
+							BeforeEach(func() {
+								build.DecideStepApprovalReturns(false, nil)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							})
+						})
+
+						Context("when deciding fails", func() {
+							BeforeEach(func() {
+								build.DecideStepApprovalReturns(false, errors.New("nope"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when the request body is malformed", func() {
+							BeforeEach(func() {
+								body = `{`
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(build.DecideStepApprovalCallCount()).To(BeZero())
+							})
+						})
+					})
+
+					Context("when the approval was already decided", func() {
+						BeforeEach(func() {
+							build.StepApprovalReturns(atc.BuildStepApproval{
+								PlanID:   "some-plan-id",
+								Approved: &approved,
+							}, true, nil)
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							Expect(build.DecideStepApprovalCallCount()).To(BeZero())
+						})
+					})
+				})
+			})
+		})
+	}
+})
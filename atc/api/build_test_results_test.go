@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Test Results API", func() {
+	Describe("GET /api/v1/builds/:build_id/test_results", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/128/test_results")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(build, true, nil)
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(nil, false, nil)
+			})
+
+			Context("when not authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					Expect(build.TestSummaryCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when getting the test summary succeeds", func() {
+					BeforeEach(func() {
+						build.TestSummaryReturns(atc.TestSummary{
+							Passed: 1,
+							Failed: 1,
+							Tests: []atc.TestResult{
+								{Task: "unit", Suite: "api", Name: "some-test", Status: atc.TestStatusPassed, Duration: 0.5},
+								{Task: "unit", Suite: "api", Name: "other-test", Status: atc.TestStatusFailed, Message: "nope"},
+							},
+						}, nil)
+					})
+
+					It("returns the build's test summary", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response).Should(IncludeHeaderEntries(map[string]string{
+							"Content-Type": "application/json",
+						}))
+
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+							"passed": 1,
+							"failed": 1,
+							"errored": 0,
+							"skipped": 0,
+							"tests": [
+								{"task": "unit", "suite": "api", "name": "some-test", "status": "passed", "duration": 0.5},
+								{"task": "unit", "suite": "api", "name": "other-test", "status": "failed", "duration": 0, "message": "nope"}
+							]
+						}`))
+					})
+				})
+
+				Context("when getting the test summary fails", func() {
+					BeforeEach(func() {
+						build.TestSummaryReturns(atc.TestSummary{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+})
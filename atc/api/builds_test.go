@@ -198,7 +198,7 @@ var _ = Describe("Builds API", func() {
 				It("does not set defaults for since and until", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					teamName, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					teamName, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 0,
 						Until: 0,
@@ -216,7 +216,7 @@ var _ = Describe("Builds API", func() {
 				It("passes them through", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 2,
 						Until: 3,
@@ -230,7 +230,7 @@ var _ = Describe("Builds API", func() {
 					})
 
 					It("calls AllBuilds", func() {
-						_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+						_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 						Expect(page.UseDate).To(Equal(true))
 					})
 				})
@@ -337,7 +337,7 @@ var _ = Describe("Builds API", func() {
 				It("does not set defaults for since and until", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 0,
 						Until: 0,
@@ -354,7 +354,7 @@ var _ = Describe("Builds API", func() {
 				It("passes them through", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
 
-					_, page := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					_, page, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(page).To(Equal(db.Page{
 						Since: 2,
 						Until: 3,
@@ -413,11 +413,78 @@ var _ = Describe("Builds API", func() {
 
 				It("returns builds for teams from the token", func() {
 					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
-					teamName, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					teamName, _, _ := dbBuildFactory.VisibleBuildsArgsForCall(0)
 					Expect(teamName).To(ConsistOf("some-team"))
 				})
 			})
 
+			Context("when annotations are passed", func() {
+				BeforeEach(func() {
+					queryParams = "?annotation=env:prod&annotation=url:https://example.com"
+					dbBuildFactory.VisibleBuildsReturns(returnedBuilds, db.Pagination{
+						Next: &db.Page{Since: 3, Limit: 2},
+					}, nil)
+				})
+
+				It("filters the builds by the annotations", func() {
+					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(Equal(1))
+
+					_, page, annotations := dbBuildFactory.VisibleBuildsArgsForCall(0)
+					Expect(page).To(Equal(db.Page{Limit: 100}))
+					Expect(annotations).To(Equal(map[string]string{
+						"env": "prod",
+						"url": "https://example.com",
+					}))
+				})
+
+				It("keeps the annotations in the Link headers", func() {
+					Expect(response.Header["Link"]).To(ConsistOf([]string{
+						fmt.Sprintf(`<%s/api/v1/builds?since=3&limit=2&annotation=env%%3Aprod&annotation=url%%3Ahttps%%3A%%2F%%2Fexample.com>; rel="next"`, externalURL),
+					}))
+				})
+			})
+
+			Context("when an annotation is malformed", func() {
+				BeforeEach(func() {
+					queryParams = "?annotation=env"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("invalid annotation 'env': must be of the form 'key:value'")))
+					Expect(dbBuildFactory.VisibleBuildsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the builds have annotations", func() {
+				BeforeEach(func() {
+					build := new(dbfakes.FakeBuild)
+					build.IDReturns(5)
+					build.NameReturns("3")
+					build.TeamNameReturns("some-team")
+					build.StatusReturns(db.BuildStatusSucceeded)
+					build.AnnotationsReturns(map[string]string{"digest": "sha256:abc"})
+
+					dbBuildFactory.VisibleBuildsReturns([]db.Build{build}, db.Pagination{}, nil)
+				})
+
+				It("returns the annotations", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 5,
+							"name": "3",
+							"team_name": "some-team",
+							"status": "succeeded",
+							"api_url": "/api/v1/builds/5",
+							"annotations": {"digest": "sha256:abc"}
+						}
+					]`))
+				})
+			})
+
 			Context("when next/previous pages are available", func() {
 				BeforeEach(func() {
 					dbBuildFactory.VisibleBuildsReturns(returnedBuilds, db.Pagination{
@@ -858,6 +925,44 @@ var _ = Describe("Builds API", func() {
 					buildID := dbBuildFactory.BuildArgsForCall(0)
 					Expect(buildID).To(Equal(128))
 				})
+
+				Context("when the build's events have been archived and reaped", func() {
+					var fakeEventSource *dbfakes.FakeEventSource
+
+					BeforeEach(func() {
+						build.LogArchiveReturns("builds/128.ndjson.gz")
+						build.ReapTimeReturns(time.Now())
+
+						fakeEventSource = new(dbfakes.FakeEventSource)
+						fakeLogArchiver.EventsReturns(fakeEventSource, nil)
+					})
+
+					It("serves the events from the archive", func() {
+						Expect(response.StatusCode).To(Equal(200))
+
+						Expect(constructedEventHandler.build.ID()).To(Equal(build.ID()))
+
+						events, err := constructedEventHandler.build.Events(3)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(events).To(Equal(fakeEventSource))
+
+						Expect(fakeLogArchiver.EventsCallCount()).To(Equal(1))
+						_, archivedBuild, from := fakeLogArchiver.EventsArgsForCall(0)
+						Expect(archivedBuild).To(Equal(build))
+						Expect(from).To(Equal(uint(3)))
+						Expect(build.EventsCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the build's events have been archived but not yet reaped", func() {
+					BeforeEach(func() {
+						build.LogArchiveReturns("builds/128.ndjson.gz")
+					})
+
+					It("serves the events from the database", func() {
+						Expect(constructedEventHandler.build).To(Equal(build))
+					})
+				})
 			})
 
 			Context("when not authenticated", func() {
@@ -1238,6 +1343,24 @@ var _ = Describe("Builds API", func() {
 				}`))
 				})
 
+				Context("when the build is queued", func() {
+					BeforeEach(func() {
+						build.PreparationReturns(db.BuildPreparation{
+							BuildID:       42,
+							QueuePosition: 3,
+						}, true, nil)
+					})
+
+					It("returns its position in the queue", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						var prep atc.BuildPreparation
+						Expect(json.Unmarshal(body, &prep)).To(Succeed())
+						Expect(prep.QueuePosition).To(Equal(3))
+					})
+				})
+
 				Context("when the build preparation is not found", func() {
 					BeforeEach(func() {
 						dbBuildFactory.BuildReturns(build, true, nil)
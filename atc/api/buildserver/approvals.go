@@ -0,0 +1,140 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// roleRanks orders team roles from least to most privileged, so that a role
+// required by an approve step is satisfied by that role or any above it.
+var roleRanks = map[string]int{
+	"viewer":            1,
+	"pipeline-operator": 2,
+	"member":            3,
+	"owner":             4,
+}
+
+func (s *Server) ListBuildStepApprovals(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-build-step-approvals", lager.Data{
+			"build": build.ID(),
+		})
+
+		approvals, err := build.StepApprovals()
+		if err != nil {
+			logger.Error("failed-to-get-step-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if approvals == nil {
+			approvals = []atc.BuildStepApproval{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(approvals)
+		if err != nil {
+			logger.Error("failed-to-encode-step-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) DecideBuildStep(approved bool) func(db.Build) http.Handler {
+	return func(build db.Build) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			planID := atc.PlanID(r.FormValue(":plan_id"))
+
+			logger := s.logger.Session("decide-build-step", lager.Data{
+				"build":    build.ID(),
+				"plan-id":  planID,
+				"approved": approved,
+			})
+
+			var decision atc.BuildStepDecision
+			if r.ContentLength != 0 {
+				err := json.NewDecoder(r.Body).Decode(&decision)
+				if err != nil {
+					logger.Info("malformed-request", lager.Data{"error": err.Error()})
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			}
+
+			approval, found, err := build.StepApproval(planID)
+			if err != nil {
+				logger.Error("failed-to-get-step-approval", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			acc := accessor.GetAccessor(r)
+			if !canDecide(acc, build.TeamName(), approval) {
+				s.rejector.Forbidden(w, r)
+				return
+			}
+
+			if !build.IsRunning() || !approval.Pending() {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+
+			decided, err := build.DecideStepApproval(planID, approved, acc.UserName(), decision.Comment)
+			if err != nil {
+				logger.Error("failed-to-decide-step-approval", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !decided {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+
+			logger.Info("decided", lager.Data{"decided-by": acc.UserName()})
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+// canDecide returns true if the requester may approve or reject the step.
+// With no approvers configured, any pipeline-operator of the team may decide.
+func canDecide(acc accessor.Access, teamName string, approval atc.BuildStepApproval) bool {
+	if acc.IsAdmin() {
+		return true
+	}
+
+	roles := approval.Roles
+	if len(roles) == 0 && len(approval.Users) == 0 {
+		roles = []string{"pipeline-operator"}
+	}
+
+	for _, user := range approval.Users {
+		if user == acc.UserName() {
+			return true
+		}
+	}
+
+	for _, teamRole := range acc.TeamRoles()[teamName] {
+		for _, role := range roles {
+			if roleRanks[teamRole] >= roleRanks[role] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -1,13 +1,25 @@
 package buildserver
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/logarchive"
 )
 
 func (s *Server) BuildEvents(build db.Build) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// once a build's events have been reaped, stream them from its archive
+		// instead
+		if s.logArchiver != nil && build.LogArchive() != "" && !build.ReapTime().IsZero() {
+			build = archivedBuild{
+				Build:    build,
+				ctx:      r.Context(),
+				archiver: s.logArchiver,
+			}
+		}
+
 		streamDone := make(chan struct{})
 
 		go func() {
@@ -21,3 +33,14 @@ func (s *Server) BuildEvents(build db.Build) http.Handler {
 		}
 	})
 }
+
+type archivedBuild struct {
+	db.Build
+
+	ctx      context.Context
+	archiver logarchive.Archiver
+}
+
+func (b archivedBuild) Events(from uint) (db.EventSource, error) {
+	return b.archiver.Events(b.ctx, b.Build, from)
+}
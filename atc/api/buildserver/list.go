@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
@@ -44,14 +47,30 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 
 	page := db.Page{Until: until, Since: since, Limit: limit, UseDate: useDate}
 
+	var annotations map[string]string
+	for _, annotation := range r.Form[atc.BuildsQueryAnnotation] {
+		segs := strings.SplitN(annotation, ":", 2)
+		if len(segs) != 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid annotation '%s': must be of the form 'key:value'", annotation)
+			return
+		}
+
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+
+		annotations[segs[0]] = segs[1]
+	}
+
 	var builds []db.Build
 	var pagination db.Pagination
 
 	acc := accessor.GetAccessor(r)
 	if acc.IsAdmin() {
-		builds, pagination, err = s.buildFactory.AllBuilds(page)
+		builds, pagination, err = s.buildFactory.AllBuilds(page, annotations)
 	} else {
-		builds, pagination, err = s.buildFactory.VisibleBuilds(acc.TeamNames(), page)
+		builds, pagination, err = s.buildFactory.VisibleBuilds(acc.TeamNames(), page, annotations)
 	}
 
 	if err != nil {
@@ -61,11 +80,11 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if pagination.Next != nil {
-		s.addNextLink(w, *pagination.Next)
+		s.addNextLink(w, *pagination.Next, annotations)
 	}
 
 	if pagination.Previous != nil {
-		s.addPreviousLink(w, *pagination.Previous)
+		s.addPreviousLink(w, *pagination.Previous, annotations)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -84,26 +103,44 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (s *Server) addNextLink(w http.ResponseWriter, page db.Page) {
+func (s *Server) addNextLink(w http.ResponseWriter, page db.Page, annotations map[string]string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		atc.PaginationQuerySince,
 		page.Since,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		annotationsQuery(annotations),
 		atc.LinkRelNext,
 	))
 }
 
-func (s *Server) addPreviousLink(w http.ResponseWriter, page db.Page) {
+func (s *Server) addPreviousLink(w http.ResponseWriter, page db.Page, annotations map[string]string) {
 	w.Header().Add("Link", fmt.Sprintf(
-		`<%s/api/v1/builds?%s=%d&%s=%d>; rel="%s"`,
+		`<%s/api/v1/builds?%s=%d&%s=%d%s>; rel="%s"`,
 		s.externalURL,
 		atc.PaginationQueryUntil,
 		page.Until,
 		atc.PaginationQueryLimit,
 		page.Limit,
+		annotationsQuery(annotations),
 		atc.LinkRelPrevious,
 	))
 }
+
+func annotationsQuery(annotations map[string]string) string {
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	query := ""
+	for _, key := range keys {
+		query += "&" + atc.BuildsQueryAnnotation + "=" + url.QueryEscape(key+":"+annotations[key])
+	}
+
+	return query
+}
@@ -0,0 +1,33 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildTestResults(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-build-test-results", lager.Data{
+			"build": build.ID(),
+		})
+
+		summary, err := build.TestSummary()
+		if err != nil {
+			logger.Error("failed-to-get-test-summary", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(summary)
+		if err != nil {
+			logger.Error("failed-to-encode-test-summary", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
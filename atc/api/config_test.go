@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/policy"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	"github.com/onsi/gomega/gbytes"
 	"github.com/tedsuo/rata"
@@ -385,7 +386,7 @@ var _ = Describe("Config API", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -405,6 +406,65 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when the action is subject to policy checks", func() {
+							BeforeEach(func() {
+								fakePolicyChecker.ShouldCheckActionReturns(true)
+								fakeaccess.UserNameReturns("some-user")
+							})
+
+							Context("when the policy allows it", func() {
+								BeforeEach(func() {
+									fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+								})
+
+								It("checks the parsed config", func() {
+									Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+									Expect(fakePolicyChecker.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+										HttpMethod: "PUT",
+										Action:     atc.SaveConfig,
+										User:       "some-user",
+										Team:       "a-team",
+										Pipeline:   "a-pipeline",
+										Data:       pipelineConfig,
+									}))
+								})
+
+								It("saves it", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+								})
+							})
+
+							Context("when the policy denies it", func() {
+								BeforeEach(func() {
+									fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+										Allowed: false,
+										Reasons: []string{"no privileged tasks"},
+									}, nil)
+								})
+
+								It("returns 403 with the reasons", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+									Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("policy check failed: no privileged tasks")))
+								})
+
+								It("does not save anything", func() {
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when the check errors", func() {
+								BeforeEach(func() {
+									fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+							})
+						})
+
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
@@ -420,6 +480,68 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when it refers to step templates", func() {
+							BeforeEach(func() {
+								pipelineConfig.Jobs[0].Plan = append(pipelineConfig.Jobs[0].Plan, atc.PlanConfig{
+									Template: "some-template",
+									Vars:     atc.Params{"resource": "some-resource"},
+								})
+
+								payload, err := json.Marshal(pipelineConfig)
+								Expect(err).NotTo(HaveOccurred())
+								request.Body = gbytes.BufferWithBytes(payload)
+							})
+
+							Context("when the templates exist", func() {
+								BeforeEach(func() {
+									fakeStepTemplates.VersionsReturns(atc.StepTemplates{
+										{
+											Name:    "some-template",
+											Version: 1,
+											Params:  []string{"resource"},
+											Step:    "put: ((resource))",
+										},
+									}, nil)
+								})
+
+								It("saves the config with the templates expanded", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+									teamID, names := fakeStepTemplates.VersionsArgsForCall(0)
+									Expect(teamID).To(Equal(734))
+									Expect(names).To(Equal([]string{"some-template"}))
+
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+									_, savedConfig, _, _ := dbTeam.SavePipelineArgsForCall(0)
+
+									plan := savedConfig.Jobs[0].Plan
+									Expect(plan[len(plan)-1]).To(Equal(atc.PlanConfig{Put: "some-resource"}))
+								})
+							})
+
+							Context("when a template does not exist", func() {
+								BeforeEach(func() {
+									fakeStepTemplates.VersionsReturns(atc.StepTemplates{}, nil)
+								})
+
+								It("returns 400 without saving it", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+									Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("invalid step templates"))
+									Expect(dbTeam.SavePipelineCallCount()).To(Equal(0))
+								})
+							})
+
+							Context("when getting the templates fails", func() {
+								BeforeEach(func() {
+									fakeStepTemplates.VersionsReturns(nil, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
 						Context("when the config is invalid", func() {
 							BeforeEach(func() {
 								pipelineConfig.Groups[0].Resources = []string{"missing-resource"}
@@ -483,7 +605,7 @@ var _ = Describe("Config API", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -530,7 +652,7 @@ jobs:
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 								name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -800,7 +922,7 @@ jobs:
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 										name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
 										Expect(initiallyPaused).To(BeTrue())
@@ -966,7 +1088,7 @@ jobs:
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
 						name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
@@ -13,9 +13,15 @@ import (
 
 func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("get-config")
-	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	pipelineRef, err := atc.GetPipelineRefFromRequest(r)
+	if err != nil {
+		logger.Error("malformed-pipeline-ref", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		logger.Error("failed-to-find-team", err)
@@ -29,7 +35,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pipeline, found, err := team.Pipeline(pipelineName)
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		logger.Error("failed-to-find-pipeline", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -37,7 +43,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !found {
-		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineName})
+		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineRef.String()})
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
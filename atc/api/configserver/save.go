@@ -9,10 +9,12 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/vars"
 	"github.com/hashicorp/go-multierror"
 	"github.com/tedsuo/rata"
@@ -61,6 +63,26 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	teamName := rata.Param(r, "team_name")
+
+	if len(config.StepTemplateNames()) > 0 {
+		expanded, errorMessages, err := s.expandStepTemplates(teamName, config)
+		if err != nil {
+			session.Error("failed-to-expand-step-templates", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, "failed to expand step templates: %s", err)
+			return
+		}
+
+		if len(errorMessages) > 0 {
+			session.Info("ignoring-invalid-step-templates", lager.Data{"errors": errorMessages})
+			s.handleBadRequest(w, errorMessages...)
+			return
+		}
+
+		config = expanded
+	}
+
 	warnings, errorMessages := configvalidate.Validate(config)
 	if len(errorMessages) > 0 {
 		session.Info("ignoring-invalid-config", lager.Data{"errors": errorMessages})
@@ -68,11 +90,32 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pipelineName := rata.Param(r, "pipeline_name")
-	teamName := rata.Param(r, "team_name")
+	pipelineRef, err := atc.GetPipelineRefFromRequest(r)
+	if err != nil {
+		session.Error("malformed-pipeline-ref", err)
+		s.handleBadRequest(w, err.Error())
+		return
+	}
+
+	if s.policyChecker != nil {
+		result, err := s.checkPolicy(r, teamName, pipelineRef, config)
+		if err != nil {
+			session.Error("failed-to-check-policy", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, "policy check error: %s", err)
+			return
+		}
+
+		if !result.Allowed {
+			session.Info("policy-check-denied", lager.Data{"reasons": result.Reasons})
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, policy.PolicyCheckNotPassedError{Reasons: result.Reasons}.Error())
+			return
+		}
+	}
 
 	if checkCredentials {
-		variables := creds.NewVariables(s.secretManager, teamName, pipelineName, false)
+		variables := creds.NewVariables(s.secretManager, teamName, pipelineRef.Name, false)
 
 		errs := validateCredParams(variables, config, session)
 		if errs != nil {
@@ -96,7 +139,7 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, true)
+	_, created, err := team.SavePipeline(pipelineRef, config, version, true)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -123,6 +166,39 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	s.writeSaveConfigResponse(w, atc.SaveConfigResponse{Warnings: warnings})
 }
 
+// expandStepTemplates replaces the steps of the config which refer to the
+// team's step templates with the steps of the templates. Invalid references
+// are returned as error messages for the user.
+func (s *Server) expandStepTemplates(teamName string, config atc.Config) (atc.Config, []string, error) {
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		return atc.Config{}, nil, err
+	}
+
+	if !found {
+		return atc.Config{}, nil, fmt.Errorf("team '%s' not found", teamName)
+	}
+
+	return db.ExpandStepTemplates(s.stepTemplates, team.ID(), config)
+}
+
+func (s *Server) checkPolicy(r *http.Request, teamName string, pipelineRef atc.PipelineRef, config atc.Config) (policy.PolicyCheckOutput, error) {
+	if !s.policyChecker.ShouldCheckAction(atc.SaveConfig) &&
+		(!s.policyChecker.ShouldCheckHttpMethod(r.Method) || s.policyChecker.ShouldSkipAction(atc.SaveConfig)) {
+		return policy.PolicyCheckOutput{Allowed: true}, nil
+	}
+
+	return s.policyChecker.Check(policy.PolicyCheckInput{
+		HttpMethod:   r.Method,
+		Action:       atc.SaveConfig,
+		User:         accessor.GetAccessor(r).UserName(),
+		Team:         teamName,
+		Pipeline:     pipelineRef.Name,
+		InstanceVars: pipelineRef.InstanceVars,
+		Data:         config,
+	})
+}
+
 // Simply validate that the credentials exist; don't do anything with the actual secrets
 func validateCredParams(credMgrVars vars.Variables, config atc.Config, session lager.Logger) error {
 	var errs error
@@ -70,9 +70,17 @@ func createContainerLocatorFromRequest(team db.Team, r *http.Request, secretMana
 	}
 
 	if query.Get("type") == "check" {
+		instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+		if err != nil {
+			return nil, err
+		}
+
 		return &checkContainerLocator{
-			team:          team,
-			pipelineName:  query.Get("pipeline_name"),
+			team: team,
+			pipelineRef: atc.PipelineRef{
+				Name:         query.Get("pipeline_name"),
+				InstanceVars: instanceVars,
+			},
 			resourceName:  query.Get("resource_name"),
 			secretManager: secretManager,
 			varSourcePool: varSourcePool,
@@ -134,14 +142,14 @@ func (l *allContainersLocator) Locate(logger lager.Logger) ([]db.Container, map[
 
 type checkContainerLocator struct {
 	team          db.Team
-	pipelineName  string
+	pipelineRef   atc.PipelineRef
 	resourceName  string
 	secretManager creds.Secrets
 	varSourcePool creds.VarSourcePool
 }
 
 func (l *checkContainerLocator) Locate(logger lager.Logger) ([]db.Container, map[int]time.Time, error) {
-	return l.team.FindCheckContainers(logger, l.pipelineName, l.resourceName, l.secretManager, l.varSourcePool)
+	return l.team.FindCheckContainers(logger, l.pipelineRef, l.resourceName, l.secretManager, l.varSourcePool)
 }
 
 type stepContainerLocator struct {
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/checkserver"
@@ -16,9 +17,12 @@ import (
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
+	"github.com/concourse/concourse/atc/api/notificationserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/serviceaccountserver"
+	"github.com/concourse/concourse/atc/api/steptemplateserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
@@ -27,7 +31,9 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/logarchive"
 	"github.com/concourse/concourse/atc/mainredirect"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/tedsuo/rata"
@@ -70,6 +76,12 @@ func NewHandler(
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	dbWall db.Wall,
+	policyChecker policy.Checker,
+	logArchiver logarchive.Archiver,
+	auditEvents db.AuditEventRepository,
+	stepTemplates db.StepTemplateRepository,
+	notifications db.NotificationRepository,
+	serviceAccounts db.ServiceAccountRepository,
 ) (http.Handler, error) {
 
 	absCLIDownloadsDir, err := filepath.Abs(cliDownloadsDir)
@@ -81,14 +93,14 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, logArchiver)
 	checkServer := checkserver.NewServer(logger, dbCheckFactory)
 	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
-	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
+	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager, policyChecker, stepTemplates)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
 	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
@@ -100,6 +112,10 @@ func NewHandler(
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	auditServer := auditserver.NewServer(logger, externalURL, auditEvents)
+	stepTemplateServer := steptemplateserver.NewServer(logger, stepTemplates)
+	notificationServer := notificationserver.NewServer(logger, notifications)
+	serviceAccountServer := serviceaccountserver.NewServer(logger, serviceAccounts)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -117,6 +133,12 @@ func NewHandler(
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 
+		atc.ListBuildStepApprovals: buildHandlerFactory.HandlerFor(buildServer.ListBuildStepApprovals),
+		atc.ApproveBuildStep:       buildHandlerFactory.HandlerFor(buildServer.DecideBuildStep(true)),
+		atc.RejectBuildStep:        buildHandlerFactory.HandlerFor(buildServer.DecideBuildStep(false)),
+
+		atc.GetBuildTestResults: buildHandlerFactory.HandlerFor(buildServer.GetBuildTestResults),
+
 		atc.GetCheck: http.HandlerFunc(checkServer.GetCheck),
 
 		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
@@ -138,6 +160,9 @@ func NewHandler(
 
 		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
 
+		atc.GetJobSchedulingExplanation: pipelineHandlerFactory.HandlerFor(jobServer.GetJobSchedulingExplanation),
+		atc.GetJobTestHistory:           pipelineHandlerFactory.HandlerFor(jobServer.GetJobTestHistory),
+
 		atc.ListAllPipelines:    http.HandlerFunc(pipelineServer.ListAllPipelines),
 		atc.ListPipelines:       http.HandlerFunc(pipelineServer.ListPipelines),
 		atc.GetPipeline:         pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipeline),
@@ -206,6 +231,25 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.SearchBuildLogs: teamHandlerFactory.HandlerFor(teamServer.SearchBuildLogs),
+
+		atc.ListTeamAuditEvents: teamHandlerFactory.HandlerFor(auditServer.ListTeamAuditEvents),
+
+		atc.ListStepTemplates:   teamHandlerFactory.HandlerFor(stepTemplateServer.ListStepTemplates),
+		atc.GetStepTemplate:     teamHandlerFactory.HandlerFor(stepTemplateServer.GetStepTemplate),
+		atc.SaveStepTemplate:    teamHandlerFactory.HandlerFor(stepTemplateServer.SaveStepTemplate),
+		atc.DestroyStepTemplate: teamHandlerFactory.HandlerFor(stepTemplateServer.DestroyStepTemplate),
+
+		atc.ListNotifications:          teamHandlerFactory.HandlerFor(notificationServer.ListNotifications),
+		atc.SaveNotification:           teamHandlerFactory.HandlerFor(notificationServer.SaveNotification),
+		atc.DestroyNotification:        teamHandlerFactory.HandlerFor(notificationServer.DestroyNotification),
+		atc.ListNotificationDeliveries: teamHandlerFactory.HandlerFor(notificationServer.ListNotificationDeliveries),
+
+		atc.ListAPITokens:         teamHandlerFactory.HandlerFor(serviceAccountServer.ListAPITokens),
+		atc.CreateAPIToken:        teamHandlerFactory.HandlerFor(serviceAccountServer.CreateAPIToken),
+		atc.RevokeAPIToken:        teamHandlerFactory.HandlerFor(serviceAccountServer.RevokeAPIToken),
+		atc.DestroyServiceAccount: teamHandlerFactory.HandlerFor(serviceAccountServer.DestroyServiceAccount),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
@@ -16,6 +16,7 @@ import (
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/api/trace"
 )
 
 var _ = Describe("Jobs API", func() {
@@ -511,6 +512,24 @@ var _ = Describe("Jobs API", func() {
 								})
 							})
 
+							Context("when the job has a schedule", func() {
+								BeforeEach(func() {
+									fakeJob.ScheduleReturns(&atc.JobSchedule{
+										Cron:     "0 2 * * *",
+										Location: "America/New_York",
+									})
+									fakeJob.ScheduleLastFiredReturns(time.Date(2020, 3, 18, 12, 0, 0, 0, time.UTC))
+								})
+
+								It("returns the time of the next scheduled build", func() {
+									var job atc.Job
+									err := json.NewDecoder(response.Body).Decode(&job)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(job.NextScheduledBuild).To(Equal(time.Date(2020, 3, 19, 6, 0, 0, 0, time.UTC).Unix()))
+								})
+							})
+
 							Context("when getting the job's builds fails", func() {
 								BeforeEach(func() {
 									fakeJob.FinishedAndNextBuildReturns(nil, nil, errors.New("oh no!"))
@@ -1515,6 +1534,17 @@ var _ = Describe("Jobs API", func() {
 							Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
 						})
 
+						Context("when the request carries a traceparent", func() {
+							BeforeEach(func() {
+								request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+							})
+
+							It("creates the build under the caller's span", func() {
+								ctx := fakeJob.CreateBuildArgsForCall(0)
+								Expect(trace.SpanFromContext(ctx).SpanContext().SpanIDString()).To(Equal("00f067aa0ba902b7"))
+							})
+						})
+
 						Context("when finding the pipeline resources fails", func() {
 							BeforeEach(func() {
 								fakePipeline.ResourcesReturns(nil, errors.New("nope"))
@@ -1838,6 +1868,298 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/scheduling_explanation", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/scheduling_explanation")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakePipeline.PausedReturns(true)
+
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{
+								Get:      "some-input",
+								Resource: "some-resource",
+								Trigger:  true,
+							},
+							{
+								Get:      "some-passed-input",
+								Resource: "some-other-resource",
+								Passed:   []string{"upstream-job"},
+							},
+							{
+								Get:      "some-undetermined-input",
+								Resource: "some-other-resource",
+							},
+						},
+					}, nil)
+
+					fakeJob.GetNextBuildInputsReturns([]db.BuildInput{
+						{
+							Name:            "some-input",
+							Version:         atc.Version{"some": "version"},
+							ResourceID:      1,
+							FirstOccurrence: true,
+						},
+						{
+							Name:          "some-passed-input",
+							ResourceID:    2,
+							ResolveError:  string(db.NoSatisfiableBuilds),
+							ResolveReason: atc.InputSchedulingNoVersionPassedUpstream,
+						},
+					}, nil)
+				})
+
+				Context("when getting the next build inputs fails", func() {
+					BeforeEach(func() {
+						fakeJob.GetNextBuildInputsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the job has no pending build", func() {
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("explains each input", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"pipeline_paused": true,
+							"job_paused": false,
+							"inputs_determined": false,
+							"inputs": [
+								{
+									"name": "some-input",
+									"resource": "some-resource",
+									"trigger": true,
+									"version": {"some": "version"},
+									"first_occurrence": true
+								},
+								{
+									"name": "some-passed-input",
+									"resource": "some-other-resource",
+									"trigger": false,
+									"passed": ["upstream-job"],
+									"first_occurrence": false,
+									"reason": "no-version-passed-upstream",
+									"message": "no satisfiable builds from passed jobs found for set of inputs"
+								},
+								{
+									"name": "some-undetermined-input",
+									"resource": "some-other-resource",
+									"trigger": false,
+									"first_occurrence": false,
+									"reason": "not-determined"
+								}
+							]
+						}`))
+					})
+				})
+
+				Context("when the job has a pending build", func() {
+					BeforeEach(func() {
+						fakeBuild := new(dbfakes.FakeBuild)
+						fakeBuild.PreparationReturns(db.BuildPreparation{
+							BuildID:          42,
+							PausedPipeline:   db.BuildPreparationStatusBlocking,
+							PausedJob:        db.BuildPreparationStatusNotBlocking,
+							MaxRunningBuilds: db.BuildPreparationStatusNotBlocking,
+							Inputs: map[string]db.BuildPreparationStatus{
+								"some-passed-input": db.BuildPreparationStatusBlocking,
+							},
+							InputsSatisfied: db.BuildPreparationStatusBlocking,
+							MissingInputReasons: db.MissingInputReasons{
+								"some-passed-input": string(db.NoSatisfiableBuilds),
+							},
+						}, true, nil)
+
+						fakeJob.GetPendingBuildsReturns([]db.Build{fakeBuild}, nil)
+					})
+
+					It("includes the preparation of the build", func() {
+						var explanation atc.SchedulingExplanation
+						err := json.NewDecoder(response.Body).Decode(&explanation)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(explanation.PendingBuild).To(Equal(&atc.BuildPreparation{
+							BuildID:          42,
+							PausedPipeline:   atc.BuildPreparationStatusBlocking,
+							PausedJob:        atc.BuildPreparationStatusNotBlocking,
+							MaxRunningBuilds: atc.BuildPreparationStatusNotBlocking,
+							Inputs: map[string]atc.BuildPreparationStatus{
+								"some-passed-input": atc.BuildPreparationStatusBlocking,
+							},
+							InputsSatisfied: atc.BuildPreparationStatusBlocking,
+							MissingInputReasons: atc.MissingInputReasons{
+								"some-passed-input": string(db.NoSatisfiableBuilds),
+							},
+						}))
+					})
+				})
+
+				Context("when getting the pending builds fails", func() {
+					BeforeEach(func() {
+						fakeJob.GetPendingBuildsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/test_history", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/test_history" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+
+					fakeJob.TestHistoryReturns([]atc.TestHistory{
+						{
+							Task:  "unit",
+							Suite: "api",
+							Name:  "some-test",
+							Flaky: true,
+							Runs: []atc.TestRun{
+								{BuildID: 2, BuildName: "2", Status: atc.TestStatusPassed},
+								{BuildID: 1, BuildName: "1", Status: atc.TestStatusFailed},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns the test history of the job", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"task": "unit",
+							"suite": "api",
+							"name": "some-test",
+							"flaky": true,
+							"runs": [
+								{"build_id": 2, "build_name": "2", "status": "passed"},
+								{"build_id": 1, "build_name": "1", "status": "failed"}
+							]
+						}
+					]`))
+				})
+
+				It("uses the default limit", func() {
+					Expect(fakeJob.TestHistoryCallCount()).To(Equal(1))
+					Expect(fakeJob.TestHistoryArgsForCall(0)).To(Equal(atc.TestHistoryDefaultLimit))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=3"
+					})
+
+					It("uses the limit", func() {
+						Expect(fakeJob.TestHistoryArgsForCall(0)).To(Equal(3))
+					})
+				})
+
+				Context("when getting the test history fails", func() {
+					BeforeEach(func() {
+						fakeJob.TestHistoryReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var response *http.Response
 
@@ -2135,6 +2457,25 @@ var _ = Describe("Jobs API", func() {
 								Expect(response.StatusCode).To(Equal(http.StatusOK))
 							})
 
+							It("reruns the whole build", func() {
+								Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+								buildToRerun, fromFailed := fakeJob.RerunBuildArgsForCall(0)
+								Expect(buildToRerun).To(Equal(fakeBuild))
+								Expect(fromFailed).To(BeFalse())
+							})
+
+							Context("when rerunning from the failed step", func() {
+								BeforeEach(func() {
+									request.URL.RawQuery = "from_failed=true"
+								})
+
+								It("reruns the build from the failed step", func() {
+									Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+									_, fromFailed := fakeJob.RerunBuildArgsForCall(0)
+									Expect(fromFailed).To(BeTrue())
+								})
+							})
+
 							It("returns Content-Type 'application/json'", func() {
 								expectedHeaderEntries := map[string]string{
 									"Content-Type": "application/json",
@@ -16,6 +16,7 @@ func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
 
 		jobName := r.FormValue(":job_name")
 		buildName := r.FormValue(":build_name")
+		fromFailed := r.URL.Query().Get("from_failed") == "true"
 
 		job, found, err := pipeline.Job(jobName)
 		if err != nil {
@@ -47,7 +48,7 @@ func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, err := job.RerunBuild(buildToRerun)
+		build, err := job.RerunBuild(buildToRerun, fromFailed)
 		if err != nil {
 			logger.Error("failed-to-retrigger-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -0,0 +1,114 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetJobSchedulingExplanation(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-scheduling-explanation")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		jobConfig, err := job.Config()
+		if err != nil {
+			logger.Error("failed-to-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		buildInputs, err := job.GetNextBuildInputs()
+		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		explanation := atc.SchedulingExplanation{
+			PipelinePaused:   pipeline.Paused(),
+			JobPaused:        job.Paused(),
+			InputsDetermined: true,
+			Inputs:           []atc.InputSchedulingExplanation{},
+		}
+
+		for _, config := range jobConfig.Inputs() {
+			input := atc.InputSchedulingExplanation{
+				Name:     config.Name,
+				Resource: config.Resource,
+				Trigger:  config.Trigger,
+				Passed:   config.Passed,
+				Reason:   atc.InputSchedulingNotDetermined,
+			}
+
+			for _, buildInput := range buildInputs {
+				if buildInput.Name != config.Name {
+					continue
+				}
+
+				input.Version = buildInput.Version
+				input.FirstOccurrence = buildInput.FirstOccurrence
+				input.Reason = buildInput.ResolveReason
+				input.Message = buildInput.ResolveError
+
+				if input.Message != "" && input.Reason == "" {
+					// inputs resolved before reasons were recorded
+					input.Reason = atc.InputSchedulingUnknown
+				}
+
+				break
+			}
+
+			if input.Reason != "" {
+				explanation.InputsDetermined = false
+			}
+
+			explanation.Inputs = append(explanation.Inputs, input)
+		}
+
+		pendingBuilds, err := job.GetPendingBuilds()
+		if err != nil {
+			logger.Error("failed-to-get-pending-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if len(pendingBuilds) > 0 {
+			prep, found, err := pendingBuilds[0].Preparation()
+			if err != nil {
+				logger.Error("failed-to-get-build-preparation", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if found {
+				preparation := present.BuildPreparation(prep)
+				explanation.PendingBuild = &preparation
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(explanation)
+		if err != nil {
+			logger.Error("failed-to-encode-scheduling-explanation", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,310 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifications API", func() {
+	BeforeEach(func() {
+		dbTeam.IDReturns(42)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/notifications")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeNotifications.SubscriptionsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the notifications succeeds", func() {
+				BeforeEach(func() {
+					fakeNotifications.SubscriptionsReturns([]atc.NotificationSubscription{
+						{
+							Name:     "some-notification",
+							URL:      "https://example.com/hooks",
+							Statuses: []atc.BuildStatus{atc.StatusFailed},
+						},
+					}, nil)
+				})
+
+				It("returns the team's notifications", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"name": "some-notification",
+							"url": "https://example.com/hooks",
+							"statuses": ["failed"]
+						}
+					]`))
+
+					Expect(fakeNotifications.SubscriptionsArgsForCall(0)).To(Equal(42))
+				})
+			})
+
+			Context("when getting the notifications fails", func() {
+				BeforeEach(func() {
+					fakeNotifications.SubscriptionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/notifications/:notification_name", func() {
+		var (
+			subscription atc.NotificationSubscription
+			response     *http.Response
+		)
+
+		BeforeEach(func() {
+			subscription = atc.NotificationSubscription{
+				URL:      "https://example.com/hooks",
+				Secret:   "some-secret",
+				Statuses: []atc.BuildStatus{atc.StatusFailed},
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(subscription)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/notifications/some-notification", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeNotifications.SaveSubscriptionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the notification", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(fakeNotifications.SaveSubscriptionCallCount()).To(Equal(1))
+				teamID, saved := fakeNotifications.SaveSubscriptionArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+				Expect(saved).To(Equal(atc.NotificationSubscription{
+					Name:     "some-notification",
+					URL:      "https://example.com/hooks",
+					Secret:   "some-secret",
+					Statuses: []atc.BuildStatus{atc.StatusFailed},
+				}))
+			})
+
+			Context("when the notification is invalid", func() {
+				BeforeEach(func() {
+					subscription.URL = "not-a-url"
+				})
+
+				It("returns 400 without saving it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("is not an absolute http(s) url"))
+					Expect(fakeNotifications.SaveSubscriptionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeNotifications.SaveSubscriptionReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/notifications/:notification_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/notifications/some-notification", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the notification exists", func() {
+				BeforeEach(func() {
+					fakeNotifications.DestroySubscriptionReturns(true, nil)
+				})
+
+				It("destroys it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, name := fakeNotifications.DestroySubscriptionArgsForCall(0)
+					Expect(teamID).To(Equal(42))
+					Expect(name).To(Equal("some-notification"))
+				})
+			})
+
+			Context("when the notification does not exist", func() {
+				BeforeEach(func() {
+					fakeNotifications.DestroySubscriptionReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications/:notification_name/deliveries", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/notifications/some-notification/deliveries" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the notification exists", func() {
+				BeforeEach(func() {
+					fakeNotifications.DeliveriesReturns([]atc.NotificationDelivery{
+						{
+							ID:             1,
+							Notification:   "some-notification",
+							BuildID:        2,
+							BuildStatus:    atc.StatusFailed,
+							Status:         atc.NotificationDeliveryPending,
+							Attempts:       1,
+							ResponseStatus: 503,
+							Error:          "unexpected response status 503",
+							CreatedAt:      100,
+							LastAttemptAt:  101,
+						},
+					}, true, nil)
+				})
+
+				It("returns its deliveries", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"id": 1,
+							"notification": "some-notification",
+							"build_id": 2,
+							"build_status": "failed",
+							"status": "pending",
+							"attempts": 1,
+							"response_status": 503,
+							"error": "unexpected response status 503",
+							"created_at": 100,
+							"last_attempt_at": 101
+						}
+					]`))
+
+					teamID, name, limit := fakeNotifications.DeliveriesArgsForCall(0)
+					Expect(teamID).To(Equal(42))
+					Expect(name).To(Equal("some-notification"))
+					Expect(limit).To(Equal(100))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						queryParams = "?limit=5"
+					})
+
+					It("limits the deliveries", func() {
+						_, _, limit := fakeNotifications.DeliveriesArgsForCall(0)
+						Expect(limit).To(Equal(5))
+					})
+				})
+
+				Context("when the limit is malformed", func() {
+					BeforeEach(func() {
+						queryParams = "?limit=all"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeNotifications.DeliveriesCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the notification does not exist", func() {
+				BeforeEach(func() {
+					fakeNotifications.DeliveriesReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package notificationserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+const defaultDeliveriesLimit = 100
+
+func (s *Server) ListNotificationDeliveries(team db.Team) http.Handler {
+	logger := s.logger.Session("list-notification-deliveries")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := defaultDeliveriesLimit
+		if limitStr := r.FormValue(atc.PaginationQueryLimit); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit <= 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		deliveries, found, err := s.notifications.Deliveries(team.ID(), rata.Param(r, "notification_name"), limit)
+		if err != nil {
+			logger.Error("failed-to-get-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(deliveries)
+		if err != nil {
+			logger.Error("failed-to-encode-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
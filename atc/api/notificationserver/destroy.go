@@ -0,0 +1,28 @@
+package notificationserver
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) DestroyNotification(team db.Team) http.Handler {
+	logger := s.logger.Session("destroy-notification")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		destroyed, err := s.notifications.DestroySubscription(team.ID(), rata.Param(r, "notification_name"))
+		if err != nil {
+			logger.Error("failed-to-destroy-notification", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
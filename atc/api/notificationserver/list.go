@@ -0,0 +1,30 @@
+package notificationserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListNotifications(team db.Team) http.Handler {
+	logger := s.logger.Session("list-notifications")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subscriptions, err := s.notifications.Subscriptions(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-notifications", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(subscriptions)
+		if err != nil {
+			logger.Error("failed-to-encode-notifications", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
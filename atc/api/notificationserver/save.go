@@ -0,0 +1,46 @@
+package notificationserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) SaveNotification(team db.Team) http.Handler {
+	logger := s.logger.Session("save-notification")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var subscription atc.NotificationSubscription
+		err := json.NewDecoder(r.Body).Decode(&subscription)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed notification: %s", err)
+			return
+		}
+
+		subscription.Name = rata.Param(r, "notification_name")
+
+		err = subscription.Validate()
+		if err != nil {
+			logger.Info("ignoring-invalid-notification", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		err = s.notifications.SaveSubscription(team.ID(), subscription)
+		if err != nil {
+			logger.Error("failed-to-save-notification", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,21 @@
+package notificationserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger        lager.Logger
+	notifications db.NotificationRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	notifications db.NotificationRepository,
+) *Server {
+	return &Server{
+		logger:        logger,
+		notifications: notifications,
+	}
+}
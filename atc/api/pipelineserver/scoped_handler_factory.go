@@ -3,6 +3,7 @@ package pipelineserver
 import (
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -22,10 +23,15 @@ func NewScopedHandlerFactory(
 func (pdbh *ScopedHandlerFactory) HandlerFor(pipelineScopedHandler func(db.Pipeline) http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		teamName := r.FormValue(":team_name")
-		pipelineName := r.FormValue(":pipeline_name")
 
 		pipeline, ok := r.Context().Value(auth.PipelineContextKey).(db.Pipeline)
 		if !ok {
+			pipelineRef, err := atc.GetPipelineRefFromRequest(r)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
 			dbTeam, found, err := pdbh.teamDBFactory.FindTeam(teamName)
 			if err != nil {
 				w.WriteHeader(http.StatusInternalServerError)
@@ -37,7 +43,7 @@ func (pdbh *ScopedHandlerFactory) HandlerFor(pipelineScopedHandler func(db.Pipel
 				return
 			}
 
-			pipeline, found, err = dbTeam.Pipeline(pipelineName)
+			pipeline, found, err = dbTeam.Pipeline(pipelineRef)
 			if err != nil {
 				w.WriteHeader(http.StatusInternalServerError)
 				return
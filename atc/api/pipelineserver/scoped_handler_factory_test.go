@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/db"
@@ -25,11 +26,13 @@ var _ = Describe("Handler", func() {
 		fakeTeam      *dbfakes.FakeTeam
 		fakePipeline  *dbfakes.FakePipeline
 
-		handler http.Handler
+		handler    http.Handler
+		extraQuery string
 	)
 
 	BeforeEach(func() {
 		delegate = &delegateHandler{}
+		extraQuery = ""
 
 		dbTeamFactory = new(dbfakes.FakeTeamFactory)
 		fakeTeam = new(dbfakes.FakeTeam)
@@ -42,7 +45,7 @@ var _ = Describe("Handler", func() {
 	JustBeforeEach(func() {
 		server = httptest.NewServer(handler)
 
-		request, err := http.NewRequest("POST", server.URL+"?:team_name=some-team&:pipeline_name=some-pipeline", nil)
+		request, err := http.NewRequest("POST", server.URL+"?:team_name=some-team&:pipeline_name=some-pipeline"+extraQuery, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		response, err = new(http.Client).Do(request)
@@ -115,7 +118,7 @@ var _ = Describe("Handler", func() {
 
 				It("looks up the pipeline by the right name", func() {
 					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
-					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal("some-pipeline"))
+					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 				})
 
 				It("returns 200", func() {
@@ -125,6 +128,34 @@ var _ = Describe("Handler", func() {
 				It("calls the scoped handler", func() {
 					Expect(delegate.IsCalled).To(BeTrue())
 				})
+
+				Context("when instance vars are given", func() {
+					BeforeEach(func() {
+						extraQuery = "&instance_vars=%7B%22branch%22%3A%22feature%22%7D"
+					})
+
+					It("looks up the pipeline instance", func() {
+						Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
+						Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "feature"},
+						}))
+					})
+				})
+
+				Context("when the instance vars are malformed", func() {
+					BeforeEach(func() {
+						extraQuery = "&instance_vars=%7B"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("does not call the scoped handler", func() {
+						Expect(delegate.IsCalled).To(BeFalse())
+					})
+				})
 			})
 
 			Context("when the pipeline does not exist", func() {
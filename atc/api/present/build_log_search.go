@@ -0,0 +1,21 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func BuildLogSearchResult(result db.BuildLogSearchResult) atc.BuildLogSearchResult {
+	lines := make([]atc.BuildLogLine, len(result.Lines))
+	for i, line := range result.Lines {
+		lines[i] = atc.BuildLogLine{
+			Origin: line.Origin,
+			Line:   line.Line,
+		}
+	}
+
+	return atc.BuildLogSearchResult{
+		Build: Build(result.Build),
+		Lines: lines,
+	}
+}
@@ -49,6 +49,14 @@ func Job(
 		})
 	}
 
+	var nextScheduledBuild int64
+	if schedule := job.Schedule(); schedule != nil {
+		next, err := schedule.Next(job.ScheduleLastFired())
+		if err == nil {
+			nextScheduledBuild = next.Unix()
+		}
+	}
+
 	return atc.Job{
 		ID: job.ID(),
 
@@ -62,6 +70,7 @@ func Job(
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
 		HasNewInputs:         job.HasNewInputs(),
+		NextScheduledBuild:   nextScheduledBuild,
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
@@ -2,9 +2,14 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -1390,6 +1395,10 @@ var _ = Describe("Resources API", func() {
 			checkRequestBody atc.CheckRequestBody
 			response         *http.Response
 			fakeResource     *dbfakes.FakeResource
+
+			reqPayload  []byte
+			queryParams string
+			headers     http.Header
 		)
 
 		BeforeEach(func() {
@@ -1398,15 +1407,22 @@ var _ = Describe("Resources API", func() {
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
 			fakeResource.IDReturns(10)
-		})
 
-		JustBeforeEach(func() {
-			reqPayload, err := json.Marshal(checkRequestBody)
+			var err error
+			reqPayload, err = json.Marshal(checkRequestBody)
 			Expect(err).NotTo(HaveOccurred())
 
-			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
+			queryParams = "?webhook_token=fake-token"
+			headers = http.Header{}
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook"+queryParams, bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
 			request.Header.Set("Content-Type", "application/json")
+			for name, values := range headers {
+				request.Header[name] = values
+			}
 
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
@@ -1517,6 +1533,120 @@ var _ = Describe("Resources API", func() {
 							}`))
 						})
 					})
+
+					Context("when the resource has a webhook filter", func() {
+						BeforeEach(func() {
+							fakeResource.WebhookFilterReturns(&atc.WebhookFilter{
+								Match:   map[string]string{"ref": "refs/heads/main"},
+								Version: map[string]string{"ref": "after"},
+							})
+
+							dbCheckFactory.TryCreateCheckReturns(new(dbfakes.FakeCheck), true, nil)
+						})
+
+						Context("when the payload matches", func() {
+							BeforeEach(func() {
+								reqPayload = []byte(`{"ref":"refs/heads/main","after":"abc123"}`)
+							})
+
+							It("checks from the version in the payload", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+								Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+								_, _, _, actualFromVersion, _ := dbCheckFactory.TryCreateCheckArgsForCall(0)
+								Expect(actualFromVersion).To(Equal(atc.Version{"ref": "abc123"}))
+							})
+						})
+
+						Context("when the payload does not match", func() {
+							BeforeEach(func() {
+								reqPayload = []byte(`{"ref":"refs/heads/feature","after":"abc123"}`)
+							})
+
+							It("returns 204 without checking", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+								Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the payload is malformed", func() {
+							BeforeEach(func() {
+								reqPayload = []byte(`ref=refs/heads/main`)
+							})
+
+							It("returns 400 without checking", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when the request is signed instead of given a token", func() {
+						BeforeEach(func() {
+							queryParams = ""
+							reqPayload = []byte(`{"ref":"refs/heads/main"}`)
+
+							dbCheckFactory.TryCreateCheckReturns(new(dbfakes.FakeCheck), true, nil)
+						})
+
+						sign := func(h func() hash.Hash, secret string) string {
+							mac := hmac.New(h, []byte(secret))
+							mac.Write(reqPayload)
+							return hex.EncodeToString(mac.Sum(nil))
+						}
+
+						Context("with a valid GitHub sha256 signature", func() {
+							BeforeEach(func() {
+								headers.Set("X-Hub-Signature-256", "sha256="+sign(sha256.New, "fake-token"))
+							})
+
+							It("checks the resource", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusCreated))
+								Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+							})
+						})
+
+						Context("with a valid GitHub sha1 signature", func() {
+							BeforeEach(func() {
+								headers.Set("X-Hub-Signature", "sha1="+sign(sha1.New, "fake-token"))
+							})
+
+							It("checks the resource", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusCreated))
+							})
+						})
+
+						Context("with a signature using another secret", func() {
+							BeforeEach(func() {
+								headers.Set("X-Hub-Signature-256", "sha256="+sign(sha256.New, "wrong-token"))
+							})
+
+							It("returns 401", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+								Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("with a valid GitLab token", func() {
+							BeforeEach(func() {
+								headers.Set("X-Gitlab-Token", "fake-token")
+							})
+
+							It("checks the resource", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusCreated))
+							})
+						})
+
+						Context("with an invalid GitLab token", func() {
+							BeforeEach(func() {
+								headers.Set("X-Gitlab-Token", "wrong-token")
+							})
+
+							It("returns 401", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+							})
+						})
+					})
 				})
 			})
 
@@ -1550,5 +1680,27 @@ var _ = Describe("Resources API", func() {
 				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
 			})
 		})
+
+		Context("when neither a token nor a signature is given", func() {
+			BeforeEach(func() {
+				queryParams = ""
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(fakePipeline.ResourceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the payload is too large", func() {
+			BeforeEach(func() {
+				reqPayload = bytes.Repeat([]byte("a"), 1024*1024+1)
+			})
+
+			It("returns 400 without looking up the resource", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(fakePipeline.ResourceCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
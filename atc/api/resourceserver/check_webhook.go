@@ -1,18 +1,41 @@
 package resourceserver
 
 import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/tedsuo/rata"
 )
 
+const (
+	githubSignatureHeader    = "X-Hub-Signature"
+	githubSignature256Header = "X-Hub-Signature-256"
+	gitlabTokenHeader        = "X-Gitlab-Token"
+
+	// webhook payloads are read before the request is authenticated, so they
+	// are capped well above what GitHub or GitLab send for a push
+	maxWebhookPayloadSize = 1024 * 1024
+)
+
 // CheckResourceWebHook defines a handler for process a check resource request via an access token.
+//
+// Instead of the webhook_token query param, requests may be authenticated
+// with a GitHub HMAC signature of the payload or a GitLab token header, using
+// the resource's webhook token as the secret.
 func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource-webhook")
 
@@ -20,12 +43,19 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 		resourceName := rata.Param(r, "resource_name")
 		webhookToken := r.URL.Query().Get("webhook_token")
 
-		if webhookToken == "" {
+		if webhookToken == "" && !hasWebhookSignature(r) {
 			logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
 			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
 
+		payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+		if err != nil {
+			logger.Error("failed-to-read-payload", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
 		dbResource, found, err := dbPipeline.Resource(resourceName)
 		if err != nil {
 			logger.Error("database-error", err, lager.Data{"resource-name": resourceName})
@@ -39,19 +69,44 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		variables, err := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool)
+		variables, err := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool, creds.BuildScope{})
 		if err != nil {
 			logger.Error("failed-to-create-var-sources", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
 		token, err := creds.NewString(variables, dbResource.WebhookToken()).Evaluate()
-		if token != webhookToken {
-			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
+		if webhookToken != "" {
+			if token != webhookToken {
+				logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		} else if err != nil || token == "" || !validWebhookSignature(r, token, payload) {
+			logger.Info("invalid-signature", lager.Data{"error": "invalid signature for webhook"})
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
 
+		var fromVersion atc.Version
+		if filter := dbResource.WebhookFilter(); filter != nil {
+			parsed, err := atc.ParseWebhookPayload(payload)
+			if err != nil {
+				logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "malformed payload: %s", err)
+				return
+			}
+
+			if !filter.Matches(parsed) {
+				logger.Debug("payload-filtered")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			fromVersion = filter.FromVersion(parsed)
+		}
+
 		dbResourceTypes, err := dbPipeline.ResourceTypes()
 		if err != nil {
 			logger.Error("failed-to-get-resource-types", err)
@@ -59,7 +114,7 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		check, created, err := s.checkFactory.TryCreateCheck(logger, dbResource, dbResourceTypes, nil, true)
+		check, created, err := s.checkFactory.TryCreateCheck(logger, dbResource, dbResourceTypes, fromVersion, true)
 		if err != nil {
 			s.logger.Error("failed-to-create-check", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -89,3 +144,41 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+func hasWebhookSignature(r *http.Request) bool {
+	return r.Header.Get(githubSignature256Header) != "" ||
+		r.Header.Get(githubSignatureHeader) != "" ||
+		r.Header.Get(gitlabTokenHeader) != ""
+}
+
+func validWebhookSignature(r *http.Request, secret string, payload []byte) bool {
+	if signature := r.Header.Get(githubSignature256Header); signature != "" {
+		return validHMAC(sha256.New, "sha256=", signature, secret, payload)
+	}
+
+	if signature := r.Header.Get(githubSignatureHeader); signature != "" {
+		return validHMAC(sha1.New, "sha1=", signature, secret, payload)
+	}
+
+	if token := r.Header.Get(gitlabTokenHeader); token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+	}
+
+	return false
+}
+
+func validHMAC(h func() hash.Hash, prefix string, signature string, secret string, payload []byte) bool {
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+
+	actual, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(actual, mac.Sum(nil))
+}
@@ -0,0 +1,328 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	"github.com/concourse/concourse/skymarshal/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Accounts API", func() {
+	BeforeEach(func() {
+		dbTeam.IDReturns(42)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/api_tokens", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/api_tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeServiceAccounts.TokensCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeServiceAccounts.TokensCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the tokens succeeds", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.TokensReturns([]atc.APIToken{
+						{
+							ID:             1,
+							TeamName:       "some-team",
+							ServiceAccount: "deployer",
+							Role:           "member",
+							Actions:        []string{atc.CreateJobBuild},
+							ExpiresAt:      2000,
+							LastUsedAt:     1500,
+							CreatedAt:      1000,
+						},
+					}, nil)
+				})
+
+				It("returns the team's tokens", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"id": 1,
+							"team_name": "some-team",
+							"service_account": "deployer",
+							"role": "member",
+							"actions": ["CreateJobBuild"],
+							"expires_at": 2000,
+							"last_used_at": 1500,
+							"created_at": 1000
+						}
+					]`))
+
+					Expect(fakeServiceAccounts.TokensArgsForCall(0)).To(Equal(42))
+				})
+			})
+
+			Context("when getting the tokens fails", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.TokensReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/service_accounts/:service_account_name/api_tokens", func() {
+		var (
+			request   atc.APITokenRequest
+			expiresAt int64
+			response  *http.Response
+		)
+
+		BeforeEach(func() {
+			expiresAt = time.Now().Add(time.Hour).Unix()
+
+			request = atc.APITokenRequest{
+				Role:      "member",
+				Actions:   []string{atc.CreateJobBuild},
+				ExpiresAt: expiresAt,
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Post(
+				server.URL+"/api/v1/teams/some-team/service_accounts/deployer/api_tokens",
+				"application/json",
+				bytes.NewBuffer(payload),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeServiceAccounts.CreateTokenCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeServiceAccounts.CreateTokenReturns(atc.APIToken{
+					ID:             1,
+					TeamName:       "some-team",
+					ServiceAccount: "deployer",
+					Role:           "member",
+					Actions:        []string{atc.CreateJobBuild},
+					ExpiresAt:      expiresAt,
+					CreatedAt:      1000,
+				}, nil)
+			})
+
+			It("creates the token and returns it once", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				var created atc.APIToken
+				err := json.NewDecoder(response.Body).Decode(&created)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(created.ID).To(Equal(1))
+				Expect(created.ServiceAccount).To(Equal("deployer"))
+				Expect(token.IsAPIToken(created.Token)).To(BeTrue())
+
+				Expect(fakeServiceAccounts.CreateTokenCallCount()).To(Equal(1))
+				teamID, serviceAccount, createRequest, hash := fakeServiceAccounts.CreateTokenArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+				Expect(serviceAccount).To(Equal("deployer"))
+				Expect(createRequest).To(Equal(request))
+				Expect(hash).To(Equal(token.HashAPIToken(created.Token)))
+			})
+
+			Context("when the request is invalid", func() {
+				BeforeEach(func() {
+					request.Role = "admin"
+				})
+
+				It("returns 400 without creating a token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("unknown role 'admin'"))
+					Expect(fakeServiceAccounts.CreateTokenCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the token would already have expired", func() {
+				BeforeEach(func() {
+					request.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+				})
+
+				It("returns 400 without creating a token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("expires_at must be in the future"))
+					Expect(fakeServiceAccounts.CreateTokenCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when creating the token fails", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.CreateTokenReturns(atc.APIToken{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/api_tokens/:api_token_id", func() {
+		var (
+			tokenID  string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			tokenID = "1"
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/api_tokens/"+tokenID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the token exists", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.RevokeTokenReturns(true, nil)
+				})
+
+				It("revokes it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, id := fakeServiceAccounts.RevokeTokenArgsForCall(0)
+					Expect(teamID).To(Equal(42))
+					Expect(id).To(Equal(1))
+				})
+			})
+
+			Context("when the token does not exist", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.RevokeTokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the token id is not a number", func() {
+				BeforeEach(func() {
+					tokenID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeServiceAccounts.RevokeTokenCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/service_accounts/:service_account_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/service_accounts/deployer", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the service account exists", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.DestroyServiceAccountReturns(true, nil)
+				})
+
+				It("destroys it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, name := fakeServiceAccounts.DestroyServiceAccountArgsForCall(0)
+					Expect(teamID).To(Equal(42))
+					Expect(name).To(Equal("deployer"))
+				})
+			})
+
+			Context("when the service account does not exist", func() {
+				BeforeEach(func() {
+					fakeServiceAccounts.DestroyServiceAccountReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
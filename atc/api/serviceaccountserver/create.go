@@ -0,0 +1,63 @@
+package serviceaccountserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+	"github.com/tedsuo/rata"
+)
+
+// CreateAPIToken responds with the generated token. It is the only time the
+// token is revealed, as only its hash is stored.
+func (s *Server) CreateAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("create-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request atc.APITokenRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed api token request: %s", err)
+			return
+		}
+
+		err = request.Validate()
+		if err != nil {
+			logger.Info("ignoring-invalid-api-token-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		apiToken, hash, err := token.GenerateAPIToken()
+		if err != nil {
+			logger.Error("failed-to-generate-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		created, err := s.serviceAccounts.CreateToken(team.ID(), rata.Param(r, "service_account_name"), request, hash)
+		if err != nil {
+			logger.Error("failed-to-create-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		created.Token = apiToken
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(created)
+		if err != nil {
+			logger.Error("failed-to-encode-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
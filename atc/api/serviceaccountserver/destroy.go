@@ -0,0 +1,28 @@
+package serviceaccountserver
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) DestroyServiceAccount(team db.Team) http.Handler {
+	logger := s.logger.Session("destroy-service-account")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		destroyed, err := s.serviceAccounts.DestroyServiceAccount(team.ID(), rata.Param(r, "service_account_name"))
+		if err != nil {
+			logger.Error("failed-to-destroy-service-account", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
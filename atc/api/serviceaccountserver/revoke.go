@@ -0,0 +1,35 @@
+package serviceaccountserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) RevokeAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("revoke-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenID, err := strconv.Atoi(rata.Param(r, "api_token_id"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		revoked, err := s.serviceAccounts.RevokeToken(team.ID(), tokenID)
+		if err != nil {
+			logger.Error("failed-to-revoke-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !revoked {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,21 @@
+package serviceaccountserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger          lager.Logger
+	serviceAccounts db.ServiceAccountRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	serviceAccounts db.ServiceAccountRepository,
+) *Server {
+	return &Server{
+		logger:          logger,
+		serviceAccounts: serviceAccounts,
+	}
+}
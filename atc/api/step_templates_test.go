@@ -0,0 +1,308 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Step Templates API", func() {
+	BeforeEach(func() {
+		dbTeam.IDReturns(42)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/step_templates", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/step_templates")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeStepTemplates.LatestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the templates succeeds", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.LatestReturns([]atc.StepTemplate{
+						{
+							Name:      "some-template",
+							Version:   2,
+							Params:    []string{"some-param"},
+							Step:      "task: some-task",
+							CreatedAt: 100,
+						},
+					}, nil)
+				})
+
+				It("returns the latest version of the team's templates", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{
+							"name": "some-template",
+							"version": 2,
+							"params": ["some-param"],
+							"step": "task: some-task",
+							"created_at": 100
+						}
+					]`))
+
+					Expect(fakeStepTemplates.LatestArgsForCall(0)).To(Equal(42))
+				})
+			})
+
+			Context("when getting the templates fails", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.LatestReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/step_templates/:step_template_name", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/step_templates/some-template" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the template exists", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.FindReturns(atc.StepTemplate{
+						Name:    "some-template",
+						Version: 3,
+						Step:    "task: some-task",
+					}, true, nil)
+				})
+
+				It("returns the latest version", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"name": "some-template",
+						"version": 3,
+						"step": "task: some-task"
+					}`))
+
+					teamID, name, version := fakeStepTemplates.FindArgsForCall(0)
+					Expect(teamID).To(Equal(42))
+					Expect(name).To(Equal("some-template"))
+					Expect(version).To(Equal(0))
+				})
+
+				Context("when a version is given", func() {
+					BeforeEach(func() {
+						queryParams = "?version=2"
+					})
+
+					It("finds that version", func() {
+						_, _, version := fakeStepTemplates.FindArgsForCall(0)
+						Expect(version).To(Equal(2))
+					})
+				})
+
+				Context("when the version is malformed", func() {
+					BeforeEach(func() {
+						queryParams = "?version=latest"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeStepTemplates.FindCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the template does not exist", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.FindReturns(atc.StepTemplate{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/step_templates/:step_template_name", func() {
+		var (
+			template atc.StepTemplate
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			template = atc.StepTemplate{
+				Params: []string{"some-param"},
+				Step:   "task: ((some-param))",
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(template)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/step_templates/some-template", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeStepTemplates.SaveCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeStepTemplates.SaveReturns(atc.StepTemplate{
+					Name:    "some-template",
+					Version: 4,
+					Params:  []string{"some-param"},
+					Step:    "task: ((some-param))",
+				}, nil)
+			})
+
+			It("saves a new version of the template", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				Expect(fakeStepTemplates.SaveCallCount()).To(Equal(1))
+				teamID, saved := fakeStepTemplates.SaveArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+				Expect(saved).To(Equal(atc.StepTemplate{
+					Name:   "some-template",
+					Params: []string{"some-param"},
+					Step:   "task: ((some-param))",
+				}))
+
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"name": "some-template",
+					"version": 4,
+					"params": ["some-param"],
+					"step": "task: ((some-param))"
+				}`))
+			})
+
+			Context("when the template is invalid", func() {
+				BeforeEach(func() {
+					template.Step = ""
+				})
+
+				It("returns 400 without saving it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("step template has no step"))
+					Expect(fakeStepTemplates.SaveCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.SaveReturns(atc.StepTemplate{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/step_templates/:step_template_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/step_templates/some-template", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the template exists", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.DestroyReturns(true, nil)
+				})
+
+				It("destroys it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, name := fakeStepTemplates.DestroyArgsForCall(0)
+					Expect(teamID).To(Equal(42))
+					Expect(name).To(Equal("some-template"))
+				})
+			})
+
+			Context("when the template does not exist", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.DestroyReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
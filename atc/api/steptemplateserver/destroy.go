@@ -0,0 +1,28 @@
+package steptemplateserver
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) DestroyStepTemplate(team db.Team) http.Handler {
+	logger := s.logger.Session("destroy-step-template")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		destroyed, err := s.stepTemplates.Destroy(team.ID(), rata.Param(r, "step_template_name"))
+		if err != nil {
+			logger.Error("failed-to-destroy-step-template", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
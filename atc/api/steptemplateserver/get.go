@@ -0,0 +1,52 @@
+package steptemplateserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) GetStepTemplate(team db.Team) http.Handler {
+	logger := s.logger.Session("get-step-template")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := rata.Param(r, "step_template_name")
+
+		var version int
+		if versionStr := r.FormValue(atc.StepTemplateQueryVersion); versionStr != "" {
+			var err error
+			version, err = strconv.Atoi(versionStr)
+			if err != nil || version < 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "malformed version: %s", versionStr)
+				return
+			}
+		}
+
+		template, found, err := s.stepTemplates.Find(team.ID(), name, version)
+		if err != nil {
+			logger.Error("failed-to-find-step-template", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(template)
+		if err != nil {
+			logger.Error("failed-to-encode-step-template", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
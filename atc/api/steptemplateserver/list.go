@@ -0,0 +1,30 @@
+package steptemplateserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListStepTemplates(team db.Team) http.Handler {
+	logger := s.logger.Session("list-step-templates")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		templates, err := s.stepTemplates.Latest(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-step-templates", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(templates)
+		if err != nil {
+			logger.Error("failed-to-encode-step-templates", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package steptemplateserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) SaveStepTemplate(team db.Team) http.Handler {
+	logger := s.logger.Session("save-step-template")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var template atc.StepTemplate
+		err := json.NewDecoder(r.Body).Decode(&template)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed step template: %s", err)
+			return
+		}
+
+		template.Name = rata.Param(r, "step_template_name")
+
+		err = template.Validate()
+		if err != nil {
+			logger.Info("ignoring-invalid-step-template", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		saved, err := s.stepTemplates.Save(team.ID(), template)
+		if err != nil {
+			logger.Error("failed-to-save-step-template", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(saved)
+		if err != nil {
+			logger.Error("failed-to-encode-step-template", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package steptemplateserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger        lager.Logger
+	stepTemplates db.StepTemplateRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	stepTemplates db.StepTemplateRepository,
+) *Server {
+	return &Server{
+		logger:        logger,
+		stepTemplates: stepTemplates,
+	}
+}
@@ -788,4 +788,168 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/builds/search", func() {
+		var (
+			response    *http.Response
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			queryParams = "?q=some+error"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/builds/search" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			Context("when the query is missing", func() {
+				BeforeEach(func() {
+					queryParams = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when all the params are passed", func() {
+				BeforeEach(func() {
+					queryParams = "?q=some+error&pipeline_name=some-pipeline&job_name=some-job&since=100&until=200&limit=5"
+				})
+
+				It("passes them through", func() {
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(fakeTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query:    "some error",
+						Pipeline: atc.PipelineRef{Name: "some-pipeline"},
+						JobName:  "some-job",
+						Since:    time.Unix(100, 0),
+						Until:    time.Unix(200, 0),
+						Limit:    5,
+					}))
+				})
+			})
+
+			Context("when the pipeline is an instance", func() {
+				BeforeEach(func() {
+					queryParams = `?q=some+error&pipeline_name=some-pipeline&instance_vars=%7B%22branch%22%3A%22feature%22%7D`
+				})
+
+				It("searches the builds of the instance", func() {
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(fakeTeam.SearchBuildLogsArgsForCall(0).Pipeline).To(Equal(atc.PipelineRef{
+						Name:         "some-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}))
+				})
+			})
+
+			Context("when the instance vars are malformed", func() {
+				BeforeEach(func() {
+					queryParams = "?q=some+error&pipeline_name=some-pipeline&instance_vars=nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when searching succeeds", func() {
+				BeforeEach(func() {
+					build := new(dbfakes.FakeBuild)
+					build.IDReturns(4)
+					build.NameReturns("2")
+					build.JobNameReturns("some-job")
+					build.PipelineNameReturns("some-pipeline")
+					build.TeamNameReturns("some-team")
+					build.StatusReturns(db.BuildStatusFailed)
+					build.StartTimeReturns(time.Unix(1, 0))
+					build.EndTimeReturns(time.Unix(100, 0))
+
+					fakeTeam.SearchBuildLogsReturns([]db.BuildLogSearchResult{
+						{
+							Build: build,
+							Lines: []db.BuildLogLine{
+								{Origin: "some-plan-id", Line: "some error happened"},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns the builds with the matching lines", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"build": {
+								"id": 4,
+								"name": "2",
+								"job_name": "some-job",
+								"status": "failed",
+								"api_url": "/api/v1/builds/4",
+								"pipeline_name": "some-pipeline",
+								"team_name": "some-team",
+								"start_time": 1,
+								"end_time": 100
+							},
+							"lines": [
+								{"origin": "some-plan-id", "line": "some error happened"}
+							]
+						}
+					]`))
+				})
+			})
+
+			Context("when searching fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SearchBuildLogsReturns(nil, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,74 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchBuildLogs(team db.Team) http.Handler {
+	logger := s.logger.Session("search-build-logs")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		search := db.BuildLogSearch{
+			Query:   r.FormValue(atc.SearchBuildLogsQuery),
+			JobName: r.FormValue(atc.SearchBuildLogsQueryJob),
+		}
+
+		if search.Query == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+		if err != nil {
+			logger.Info("malformed-instance-vars", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		search.Pipeline = atc.PipelineRef{
+			Name:         r.FormValue(atc.SearchBuildLogsQueryPipeline),
+			InstanceVars: instanceVars,
+		}
+
+		since, _ := strconv.Atoi(r.FormValue(atc.PaginationQuerySince))
+		if since != 0 {
+			search.Since = time.Unix(int64(since), 0)
+		}
+
+		until, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryUntil))
+		if until != 0 {
+			search.Until = time.Unix(int64(until), 0)
+		}
+
+		search.Limit, _ = strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+
+		results, err := team.SearchBuildLogs(search)
+		if err != nil {
+			logger.Error("failed-to-search-build-logs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.BuildLogSearchResult, len(results))
+		for i, result := range results {
+			presented[i] = present.BuildLogSearchResult(result)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-results", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
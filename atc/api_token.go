@@ -0,0 +1,75 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// An APIToken authenticates a team's service account, letting automation
+// use the API without logging in as a user. Only a hash of the token is
+// stored, so the token itself is only known when it is created.
+type APIToken struct {
+	ID             int    `json:"id"`
+	TeamName       string `json:"team_name"`
+	ServiceAccount string `json:"service_account"`
+	Role           string `json:"role"`
+
+	// Actions limits the token to the given API actions, e.g. CreateJobBuild.
+	// The token may perform any action allowed by its role if it is empty.
+	Actions []string `json:"actions,omitempty"`
+
+	ExpiresAt  int64 `json:"expires_at,omitempty"`
+	LastUsedAt int64 `json:"last_used_at,omitempty"`
+	CreatedAt  int64 `json:"created_at"`
+
+	Token string `json:"token,omitempty"`
+}
+
+// APITokenRequest creates a token for a service account, creating the account
+// if it does not exist yet. The account's role is updated if one is given,
+// and defaults to viewer for new accounts.
+type APITokenRequest struct {
+	Role      string   `json:"role,omitempty"`
+	Actions   []string `json:"actions,omitempty"`
+	ExpiresAt int64    `json:"expires_at,omitempty"`
+}
+
+const DefaultServiceAccountRole = "viewer"
+
+func (request APITokenRequest) Validate() error {
+	var errorMessages []string
+
+	switch request.Role {
+	case "", "owner", "member", "pipeline-operator", "viewer":
+	default:
+		errorMessages = append(errorMessages, fmt.Sprintf("unknown role '%s'", request.Role))
+	}
+
+	for _, action := range request.Actions {
+		if !isRouteName(action) {
+			errorMessages = append(errorMessages, fmt.Sprintf("unknown action '%s'", action))
+		}
+	}
+
+	if request.ExpiresAt != 0 && request.ExpiresAt <= time.Now().Unix() {
+		errorMessages = append(errorMessages, "expires_at must be in the future")
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New(strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+func isRouteName(name string) bool {
+	for _, route := range Routes {
+		if route.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
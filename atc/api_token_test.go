@@ -0,0 +1,46 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APITokenRequest", func() {
+	Describe("Validate", func() {
+		It("accepts a request without a role or actions", func() {
+			Expect(atc.APITokenRequest{}.Validate()).To(Succeed())
+		})
+
+		It("accepts known roles and actions", func() {
+			Expect(atc.APITokenRequest{
+				Role:    "pipeline-operator",
+				Actions: []string{atc.CreateJobBuild, atc.GetBuild},
+			}.Validate()).To(Succeed())
+		})
+
+		It("rejects unknown roles and actions", func() {
+			err := atc.APITokenRequest{
+				Role:    "admin",
+				Actions: []string{"DoEverything"},
+			}.Validate()
+			Expect(err).To(MatchError("unknown role 'admin'\nunknown action 'DoEverything'"))
+		})
+
+		It("accepts an expiry in the future", func() {
+			Expect(atc.APITokenRequest{
+				ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			}.Validate()).To(Succeed())
+		})
+
+		It("rejects an expiry in the past", func() {
+			err := atc.APITokenRequest{
+				ExpiresAt: time.Now().Add(-time.Hour).Unix(),
+			}.Validate()
+			Expect(err).To(MatchError("expires_at must be in the future"))
+		})
+	})
+})
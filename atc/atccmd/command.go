@@ -26,6 +26,7 @@ import (
 	"github.com/concourse/concourse/atc/auditor"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/idtoken"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
@@ -35,15 +36,21 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/logsearch"
 	"github.com/concourse/concourse/atc/lockrunner"
+	"github.com/concourse/concourse/atc/logarchive"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifications"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/atc/scheduler/factory"
 	"github.com/concourse/concourse/atc/syslog"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/gclient"
 	"github.com/concourse/concourse/atc/worker/image"
+	"github.com/concourse/concourse/atc/worker/k8s"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/concourse/concourse/skymarshal"
 	"github.com/concourse/concourse/skymarshal/skycmd"
@@ -62,6 +69,7 @@ import (
 	"github.com/tedsuo/ifrit/sigmon"
 	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
+	"k8s.io/client-go/kubernetes"
 
 	// dynamically registered metric emitters
 	_ "github.com/concourse/concourse/atc/metric/emitter"
@@ -74,6 +82,9 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
 	_ "github.com/concourse/concourse/atc/creds/vault"
+
+	// dynamically registered policy checkers
+	_ "github.com/concourse/concourse/atc/policy/opa"
 )
 
 const algorithmLimitRows = 100
@@ -94,6 +105,10 @@ type RunCommand struct {
 	Logger flag.Lager
 
 	varSourcePool creds.VarSourcePool
+	idTokenIssuer *idtoken.Issuer
+
+	kubernetesClient   gclient.Client
+	kubernetesExecutor k8s.Executor
 
 	BindIP   flag.IP `long:"bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for web traffic."`
 	BindPort uint16  `long:"bind-port" default:"8080"    description:"Port on which to listen for HTTP traffic."`
@@ -117,6 +132,8 @@ type RunCommand struct {
 	CredentialManagement creds.CredentialManagementConfig `group:"Credential Management"`
 	CredentialManagers   creds.Managers
 
+	IDTokenSigningKey *flag.PrivateKey `long:"idtoken-signing-key" description:"File containing an RSA private key, used to sign the ID tokens issued to builds by idtoken var sources. Must be the same on every web node. If not specified, idtoken var sources cannot issue tokens."`
+
 	EncryptionKey    flag.Cipher `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
 	OldEncryptionKey flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is encrypted. If provided with a new key, data is re-encrypted."`
 
@@ -130,6 +147,9 @@ type RunCommand struct {
 	LidarScannerInterval time.Duration `long:"lidar-scanner-interval" default:"1m" description:"Interval on which the resource scanner will run to see if new checks need to be scheduled"`
 	LidarCheckerInterval time.Duration `long:"lidar-checker-interval" default:"10s" description:"Interval on which the resource checker runs any scheduled checks"`
 
+	MaxChecksPerSecond             float64            `long:"max-checks-per-second" default:"0" description:"Maximum number of checks started per second. Manual and webhook checks are never deferred, but count towards it. 0 means no limit."`
+	ResourceTypeMaxChecksPerSecond map[string]float64 `long:"resource-type-max-checks-per-second" description:"Maximum number of checks started per second for a base resource type, e.g. git:5. Can be specified multiple times." value-name:"TYPE:RATE"`
+
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 
@@ -147,6 +167,8 @@ type RunCommand struct {
 		ResourceTypes   map[string]string `long:"resource"         description:"A resource type to advertise for the worker. Can be specified multiple times." value-name:"TYPE:IMAGE"`
 	} `group:"Static Worker (optional)" namespace:"worker"`
 
+	KubernetesWorker k8s.Config `group:"Kubernetes Worker (experimental)" namespace:"kubernetes-worker"`
+
 	Metrics struct {
 		HostName            string            `long:"metrics-host-name" description:"Host string to attach to emitted metrics."`
 		Attributes          map[string]string `long:"metrics-attribute" description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
@@ -157,8 +179,18 @@ type RunCommand struct {
 	Tracing struct {
 		Jaeger      tracing.Jaeger
 		Stackdriver tracing.Stackdriver
+		OTLP        tracing.OTLP
 	} `group:"Tracing" namespace:"tracing"`
 
+	PolicyCheckers struct {
+		Filter policy.Filter
+	} `group:"Policy Checking"`
+
+	BuildLogArchive struct {
+		Local logarchive.Local
+		S3    logarchive.S3
+	} `group:"Build Log Archival" namespace:"build-log-archive"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" default:"deny" description:"The value to set for X-Frame-Options."`
 		ClusterName   string `long:"cluster-name" description:"A name for this Concourse cluster, to be displayed on the dashboard page."`
@@ -185,11 +217,20 @@ type RunCommand struct {
 	DefaultDaysToRetainBuildLogs uint64 `long:"default-days-to-retain-build-logs" description:"Default days to retain build logs. 0 means unlimited"`
 	MaxDaysToRetainBuildLogs     uint64 `long:"max-days-to-retain-build-logs" description:"Maximum days to retain build logs, 0 means not specified. Will override values configured in jobs"`
 
+	EnableBuildLogSearch bool `long:"enable-build-log-search" description:"Index the logs of finished builds so that they can be searched."`
+
+	BuildNotificationsAllowPrivateNetworks bool `long:"build-notifications-allow-private-networks" description:"Allow build notifications to be delivered to loopback, link-local and private addresses. Any team member may subscribe to notifications, letting them send requests to services on the web node's network."`
+
+	DefaultResourceVersionsToRetain     uint64 `long:"default-resource-versions-to-retain" description:"Default number of versions to retain for each resource, 0 means all. Used when a resource's version_history does not specify it"`
+	DefaultDaysToRetainResourceVersions uint64 `long:"default-days-to-retain-resource-versions" description:"Default days to retain resource versions, 0 means unlimited. Used when a resource's version_history does not specify it"`
+
 	JobSchedulingMaxInFlight uint64 `long:"job-scheduling-max-in-flight" default:"32" description:"Maximum number of jobs to be scheduling at the same time"`
 
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
 
+	DefaultIdleTimeout time.Duration `long:"default-idle-timeout" description:"Default length of time for a get, put, or task step to produce no output before it is interrupted, 0 means unlimited. Used when a step does not specify idle_timeout"`
+
 	Auditor struct {
 		EnableBuildAuditLog     bool `long:"enable-build-auditing" description:"Enable auditing for all api requests connected to builds."`
 		EnableContainerAuditLog bool `long:"enable-container-auditing" description:"Enable auditing for all api requests connected to containers."`
@@ -208,6 +249,8 @@ type RunCommand struct {
 		Transport     string        `long:"syslog-transport" description:"Transport protocol for syslog messages (Currently supporting tcp, udp & tls)."`
 		DrainInterval time.Duration `long:"syslog-drain-interval" description:"Interval over which checking is done for new build logs to send to syslog server (duration measurement units are s/m/h; eg. 30s/30m/1h)" default:"30s"`
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
+
+		DrainAuditEvents bool `long:"syslog-drain-audit-events" description:"Also send audit events to the syslog server."`
 	} ` group:"Syslog Drainer Configuration"`
 
 	Auth struct {
@@ -315,6 +358,7 @@ func (cmd *RunCommand) WireDynamicFlags(commandFlags *flags.Command) {
 	var metricsGroup *flags.Group
 	var credsGroup *flags.Group
 	var authGroup *flags.Group
+	var policyChecksGroup *flags.Group
 
 	groups := commandFlags.Groups()
 	for i := 0; i < len(groups); i++ {
@@ -332,7 +376,11 @@ func (cmd *RunCommand) WireDynamicFlags(commandFlags *flags.Command) {
 			authGroup = group
 		}
 
-		if metricsGroup != nil && credsGroup != nil && authGroup != nil {
+		if policyChecksGroup == nil && group.ShortDescription == "Policy Checking" {
+			policyChecksGroup = group
+		}
+
+		if metricsGroup != nil && credsGroup != nil && authGroup != nil && policyChecksGroup != nil {
 			break
 		}
 
@@ -351,6 +399,10 @@ func (cmd *RunCommand) WireDynamicFlags(commandFlags *flags.Command) {
 		panic("could not find Authentication group for registering connectors")
 	}
 
+	if policyChecksGroup == nil {
+		panic("could not find Policy Checking group for registering policy checkers")
+	}
+
 	managerConfigs := make(creds.Managers)
 	for name, p := range creds.ManagerFactories() {
 		managerConfigs[name] = p.AddConfig(credsGroup)
@@ -361,6 +413,8 @@ func (cmd *RunCommand) WireDynamicFlags(commandFlags *flags.Command) {
 
 	skycmd.WireConnectors(authGroup)
 	skycmd.WireTeamConnectors(authGroup.Find("Authentication (Main Team)"))
+
+	policy.WireCheckers(policyChecksGroup)
 }
 
 func (cmd *RunCommand) Execute(args []string) error {
@@ -432,6 +486,13 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 			return nil, err
 		}
 
+		tracing.ConfigureTracer(exp)
+	case cmd.Tracing.OTLP.IsConfigured():
+		exp, err := cmd.Tracing.OTLP.Exporter()
+		if err != nil {
+			return nil, err
+		}
+
 		tracing.ConfigureTracer(exp)
 	}
 
@@ -479,7 +540,35 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 
 	cmd.varSourcePool = creds.NewVarSourcePool(5*time.Minute, clock.NewClock())
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, gcConn, storage, lockFactory, secretManager)
+	cmd.idTokenIssuer, err = cmd.constructIDTokenIssuer()
+	if err != nil {
+		return nil, err
+	}
+
+	idtoken.UseIssuer(cmd.idTokenIssuer)
+
+	if cmd.KubernetesWorker.IsConfigured() {
+		logger.Info("kubernetes-worker-is-experimental", lager.Data{
+			"limitations": "builds do not survive restarts of the web node, and volumes can't be created outside of pods",
+		})
+
+		err = cmd.configureKubernetesWorker()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	policyChecker, err := policy.Initialize(logger, cmd.Server.ClusterName, concourse.Version, cmd.PolicyCheckers.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logArchiver, err := cmd.constructLogArchiver()
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, gcConn, storage, lockFactory, secretManager, policyChecker, logArchiver)
 	if err != nil {
 		return nil, err
 	}
@@ -523,6 +612,8 @@ func (cmd *RunCommand) constructMembers(
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	policyChecker policy.Checker,
+	logArchiver logarchive.Archiver,
 ) ([]grouper.Member, error) {
 	if cmd.TelemetryOptIn {
 		url := fmt.Sprintf("http://telemetry.concourse-ci.org/?version=%s", concourse.Version)
@@ -534,12 +625,12 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, policyChecker, logArchiver)
 	if err != nil {
 		return nil, err
 	}
 
-	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, secretManager)
+	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, secretManager, policyChecker, logArchiver)
 	if err != nil {
 		return nil, err
 	}
@@ -559,6 +650,8 @@ func (cmd *RunCommand) constructAPIMembers(
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	policyChecker policy.Checker,
+	logArchiver logarchive.Archiver,
 ) ([]grouper.Member, error) {
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 	userFactory := db.NewUserFactory(dbConn)
@@ -630,6 +723,14 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.GardenRequestTimeout,
 	)
 
+	workerProvider = cmd.kubernetesWorkerProvider(
+		workerProvider,
+		resourceFetcher,
+		dbVolumeRepository,
+		teamFactory,
+		dbWorkerFactory,
+	)
+
 	pool := worker.NewPool(workerProvider)
 	workerClient := worker.NewClient(pool, workerProvider)
 
@@ -643,8 +744,12 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbAuditEventRepository := db.NewAuditEventRepository(dbConn)
+	dbStepTemplateRepository := db.NewStepTemplateRepository(dbConn)
+	dbNotificationRepository := db.NewNotificationRepository(dbConn)
+	dbServiceAccountRepository := db.NewServiceAccountRepository(dbConn)
 
-	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
+	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey(), dbServiceAccountRepository)
 	customActionRoleMap := accessor.CustomActionRoleMap{}
 	err = accessor.ParseCustomActionRoleMap(cmd.ConfigRBAC, &customActionRoleMap)
 	if err != nil {
@@ -675,6 +780,12 @@ func (cmd *RunCommand) constructAPIMembers(
 		credsManagers,
 		accessFactory,
 		dbWall,
+		policyChecker,
+		logArchiver,
+		dbAuditEventRepository,
+		dbStepTemplateRepository,
+		dbNotificationRepository,
+		dbServiceAccountRepository,
 	)
 
 	if err != nil {
@@ -759,6 +870,8 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	policyChecker policy.Checker,
+	logArchiver logarchive.Archiver,
 ) ([]grouper.Member, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -770,6 +883,11 @@ func (cmd *RunCommand) constructBackendMembers(
 		syslogDrainConfigured = false
 	}
 
+	var syslogAuditEvents db.AuditEventRepository
+	if cmd.Syslog.DrainAuditEvents {
+		syslogAuditEvents = db.NewAuditEventRepository(dbConn)
+	}
+
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 
 	resourceFactory := resource.NewResourceFactory()
@@ -812,6 +930,14 @@ func (cmd *RunCommand) constructBackendMembers(
 		cmd.GardenRequestTimeout,
 	)
 
+	workerProvider = cmd.kubernetesWorkerProvider(
+		workerProvider,
+		resourceFetcher,
+		dbVolumeRepository,
+		teamFactory,
+		dbWorkerFactory,
+	)
+
 	pool := worker.NewPool(workerProvider)
 	workerClient := worker.NewClient(pool, workerProvider)
 
@@ -836,6 +962,9 @@ func (cmd *RunCommand) constructBackendMembers(
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
+		policyChecker,
+		db.NewTaskMemoFactory(dbConn),
+		db.NewStepTemplateRepository(dbConn),
 	)
 
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
@@ -868,6 +997,12 @@ func (cmd *RunCommand) constructBackendMembers(
 				logger.Session(atc.ComponentLidarChecker),
 				dbCheckFactory,
 				engine,
+				lidar.CheckRateLimits{
+					MaxChecksPerSecond:             cmd.MaxChecksPerSecond,
+					ResourceTypeMaxChecksPerSecond: cmd.ResourceTypeMaxChecksPerSecond,
+					Burst:                          cmd.LidarCheckerInterval,
+				},
+				clock.NewClock(),
 			),
 			runnerInterval,
 			bus,
@@ -881,6 +1016,7 @@ func (cmd *RunCommand) constructBackendMembers(
 			componentFactory,
 			scheduler.NewRunner(
 				logger.Session("scheduler"),
+				clock.NewClock(),
 				dbJobFactory,
 				&scheduler.Scheduler{
 					Algorithm: alg,
@@ -888,7 +1024,9 @@ func (cmd *RunCommand) constructBackendMembers(
 						factory.NewBuildFactory(
 							atc.NewPlanFactory(time.Now().Unix()),
 						),
-						alg),
+						alg,
+						db.NewBuildQueue(dbConn, cmd.MaxActiveTasksPerWorker),
+					),
 				},
 				cmd.JobSchedulingMaxInFlight,
 			),
@@ -907,6 +1045,23 @@ func (cmd *RunCommand) constructBackendMembers(
 			lockFactory,
 			componentFactory,
 		)},
+		{Name: atc.ComponentBuildNotifier, Runner: lidar.NewIntervalRunner(
+			logger.Session("notifier-interval-runner"),
+			clock.NewClock(),
+			notifications.NewNotifier(
+				logger.Session(atc.ComponentBuildNotifier),
+				db.NewNotificationRepository(dbConn),
+				dbBuildFactory,
+				notifications.NewHTTPClient(30*time.Second, cmd.BuildNotificationsAllowPrivateNetworks),
+				cmd.ExternalURL.String(),
+				clock.NewClock(),
+			),
+			runnerInterval,
+			bus,
+			atc.ComponentBuildNotifier,
+			lockFactory,
+			componentFactory,
+		)},
 		// run separately so as to not preempt critical GC
 		{Name: atc.ComponentBuildReaper, Runner: lockrunner.NewRunner(
 			logger.Session(atc.ComponentBuildReaper),
@@ -920,6 +1075,7 @@ func (cmd *RunCommand) constructBackendMembers(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				logArchiver,
 			),
 			atc.ComponentBuildReaper,
 			lockFactory,
@@ -939,6 +1095,7 @@ func (cmd *RunCommand) constructBackendMembers(
 					cmd.Syslog.Hostname,
 					cmd.Syslog.CACerts,
 					dbBuildFactory,
+					syslogAuditEvents,
 				),
 				atc.ComponentSyslogDrainer,
 				lockFactory,
@@ -948,9 +1105,33 @@ func (cmd *RunCommand) constructBackendMembers(
 			)},
 		)
 	}
+	if cmd.EnableBuildLogSearch {
+		members = append(members, grouper.Member{
+			Name: atc.ComponentBuildLogIndexer, Runner: lockrunner.NewRunner(
+				logger.Session(atc.ComponentBuildLogIndexer),
+				logsearch.NewIndexer(dbBuildFactory, 100),
+				atc.ComponentBuildLogIndexer,
+				lockFactory,
+				componentFactory,
+				clock.NewClock(),
+				runnerInterval,
+			)},
+		)
+	}
 	if cmd.Worker.GardenURL.URL != nil {
 		members = cmd.appendStaticWorker(logger, dbWorkerFactory, members)
 	}
+	if cmd.kubernetesClient != nil {
+		members = append(members, grouper.Member{
+			Name: "kubernetes-worker",
+			Runner: k8s.NewRegistrar(
+				logger.Session("kubernetes-worker"),
+				dbWorkerFactory,
+				clock.NewClock(),
+				cmd.KubernetesWorker.Worker(concourse.WorkerVersion),
+			),
+		})
+	}
 	return members, nil
 }
 
@@ -967,6 +1148,8 @@ func (cmd *RunCommand) constructGCMember(
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbResourceConfigVersionLifecycle := db.NewResourceConfigVersionLifecycle(gcConn)
+	dbPipelineFactory := db.NewPipelineFactory(gcConn, lockFactory)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	resourceFactory := resource.NewResourceFactory()
@@ -1010,6 +1193,14 @@ func (cmd *RunCommand) constructGCMember(
 		cmd.GardenRequestTimeout,
 	)
 
+	workerProvider = cmd.kubernetesWorkerProvider(
+		workerProvider,
+		resourceFetcher,
+		dbVolumeRepository,
+		teamFactory,
+		dbWorkerFactory,
+	)
+
 	jobRunner := gc.NewWorkerJobRunner(
 		logger.Session("container-collector-worker-job-runner"),
 		workerProvider,
@@ -1028,6 +1219,23 @@ func (cmd *RunCommand) constructGCMember(
 		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, jobRunner, cmd.GC.MissingGracePeriod),
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
 		atc.ComponentCollectorVarSources:        gc.NewCollectorTask(cmd.varSourcePool.(gc.Collector)),
+		atc.ComponentCollectorResourceVersions: gc.NewResourceConfigVersionCollector(
+			dbPipelineFactory,
+			dbResourceConfigVersionLifecycle,
+			atc.VersionHistory{
+				Versions: int(cmd.DefaultResourceVersionsToRetain),
+				Days:     int(cmd.DefaultDaysToRetainResourceVersions),
+			},
+		),
+	}
+
+	if cmd.kubernetesClient != nil {
+		collectors[atc.ComponentCollectorKubernetesPods] = k8s.NewSweeper(
+			cmd.KubernetesWorker.Name,
+			cmd.kubernetesClient,
+			dbContainerRepository,
+			dbVolumeRepository,
+		)
 	}
 
 	for collectorName, collector := range collectors {
@@ -1047,10 +1255,72 @@ func (cmd *RunCommand) constructGCMember(
 	return members, nil
 }
 
+func (cmd *RunCommand) configureKubernetesWorker() error {
+	config, err := cmd.KubernetesWorker.RestConfig()
+	if err != nil {
+		return fmt.Errorf("failed to configure kubernetes worker: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to configure kubernetes worker: %w", err)
+	}
+
+	cmd.kubernetesExecutor = k8s.NewExecutor(clientset, config)
+	cmd.kubernetesClient = k8s.NewClient(
+		clientset,
+		cmd.kubernetesExecutor,
+		cmd.KubernetesWorker.Namespace,
+		cmd.KubernetesWorker.Name,
+		cmd.KubernetesWorker.SidecarImage,
+		time.Second,
+		cmd.KubernetesWorker.PodStartTimeout,
+	)
+
+	return nil
+}
+
+// kubernetesWorkerProvider makes the provider run the Kubernetes worker's
+// containers as pods, if one is configured.
+func (cmd *RunCommand) kubernetesWorkerProvider(
+	provider worker.WorkerProvider,
+	fetcher worker.Fetcher,
+	dbVolumeRepository db.VolumeRepository,
+	teamFactory db.TeamFactory,
+	dbWorkerFactory db.WorkerFactory,
+) worker.WorkerProvider {
+	if cmd.kubernetesClient == nil {
+		return provider
+	}
+
+	return k8s.NewWorkerProvider(
+		provider,
+		cmd.kubernetesClient,
+		cmd.kubernetesExecutor,
+		cmd.KubernetesWorker.Namespace,
+		fetcher,
+		dbVolumeRepository,
+		teamFactory,
+		dbWorkerFactory,
+		cmd.KubernetesWorker.Name,
+	)
+}
+
 func workerVersion() (version.Version, error) {
 	return version.NewVersionFromString(concourse.WorkerVersion)
 }
 
+// constructIDTokenIssuer only returns an issuer if a signing key is given, as
+// a key generated on startup would differ between web nodes and change on
+// every restart, invalidating the tokens verified against the served key set.
+func (cmd *RunCommand) constructIDTokenIssuer() (*idtoken.Issuer, error) {
+	if cmd.IDTokenSigningKey == nil || cmd.IDTokenSigningKey.PrivateKey == nil {
+		return nil, nil
+	}
+
+	return idtoken.NewIssuer(cmd.ExternalURL.String(), cmd.IDTokenSigningKey.PrivateKey)
+}
+
 func (cmd *RunCommand) secretManager(logger lager.Logger) (creds.Secrets, error) {
 	var secretsFactory creds.SecretsFactory = noop.NewNoopFactory()
 	for name, manager := range cmd.CredentialManagers {
@@ -1285,6 +1555,10 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if err := cmd.KubernetesWorker.Validate(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1341,6 +1615,28 @@ type Closer interface {
 	Close() error
 }
 
+func (cmd *RunCommand) constructLogArchiver() (logarchive.Archiver, error) {
+	var (
+		store logarchive.Store
+		err   error
+	)
+
+	switch {
+	case cmd.BuildLogArchive.Local.IsConfigured():
+		store, err = cmd.BuildLogArchive.Local.Store()
+	case cmd.BuildLogArchive.S3.IsConfigured():
+		store, err = cmd.BuildLogArchive.S3.Store()
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure build log archive: %w", err)
+	}
+
+	return logarchive.NewArchiver(store), nil
+}
+
 func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	dbConn, err := sql.Open(driverName, cmd.Postgres.ConnectionString())
 	if err != nil {
@@ -1417,9 +1713,15 @@ func (cmd *RunCommand) configureComponentIntervals(componentFactory db.Component
 			}, {
 				Name:     atc.ComponentBuildReaper,
 				Interval: 30 * time.Second,
+			}, {
+				Name:     atc.ComponentBuildNotifier,
+				Interval: 10 * time.Second,
 			}, {
 				Name:     atc.ComponentSyslogDrainer,
 				Interval: cmd.Syslog.DrainInterval,
+			}, {
+				Name:     atc.ComponentBuildLogIndexer,
+				Interval: 30 * time.Second,
 			}, {
 				Name:     atc.ComponentCollectorArtifacts,
 				Interval: cmd.GC.Interval,
@@ -1435,6 +1737,9 @@ func (cmd *RunCommand) configureComponentIntervals(componentFactory db.Component
 			}, {
 				Name:     atc.ComponentCollectorContainers,
 				Interval: cmd.GC.Interval,
+			}, {
+				Name:     atc.ComponentCollectorKubernetesPods,
+				Interval: cmd.GC.Interval,
 			}, {
 				Name:     atc.ComponentCollectorResourceCaches,
 				Interval: cmd.GC.Interval,
@@ -1444,6 +1749,9 @@ func (cmd *RunCommand) configureComponentIntervals(componentFactory db.Component
 			}, {
 				Name:     atc.ComponentCollectorResourceConfigs,
 				Interval: cmd.GC.Interval,
+			}, {
+				Name:     atc.ComponentCollectorResourceVersions,
+				Interval: cmd.GC.Interval,
 			}, {
 				Name:     atc.ComponentCollectorVolumes,
 				Interval: cmd.GC.Interval,
@@ -1468,6 +1776,9 @@ func (cmd *RunCommand) constructEngine(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	policyChecker policy.Checker,
+	taskMemoFactory db.TaskMemoFactory,
+	stepTemplates db.StepTemplateRepository,
 ) engine.Engine {
 
 	stepFactory := builder.NewStepFactory(
@@ -1478,8 +1789,12 @@ func (cmd *RunCommand) constructEngine(
 		resourceCacheFactory,
 		resourceConfigFactory,
 		defaultLimits,
+		cmd.DefaultIdleTimeout,
 		strategy,
 		lockFactory,
+		policyChecker,
+		taskMemoFactory,
+		stepTemplates,
 	)
 
 	stepBuilder := builder.NewStepBuilder(
@@ -1506,6 +1821,9 @@ func (cmd *RunCommand) constructHTTPHandler(
 	webMux.Handle("/auth/", authHandler)
 	webMux.Handle("/login", authHandler)
 	webMux.Handle("/logout", authHandler)
+	if cmd.idTokenIssuer != nil {
+		webMux.Handle("/.well-known/", idtoken.NewHandler(cmd.idTokenIssuer))
+	}
 	webMux.Handle("/", webHandler)
 
 	httpHandler := wrappa.LoggerHandler{
@@ -1545,6 +1863,12 @@ func (cmd *RunCommand) constructAPIHandler(
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	policyChecker policy.Checker,
+	logArchiver logarchive.Archiver,
+	auditEvents db.AuditEventRepository,
+	stepTemplates db.StepTemplateRepository,
+	notifications db.NotificationRepository,
+	serviceAccounts db.ServiceAccountRepository,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1563,9 +1887,11 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
 		logger,
+		auditEvents,
 	)
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewAPIMetricsWrappa(logger),
+		wrappa.NewPolicyCheckWrappa(logger, policyChecker),
 		wrappa.NewAPIAuthWrappa(
 			checkPipelineAccessHandlerFactory,
 			checkBuildReadAccessHandlerFactory,
@@ -1612,6 +1938,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		dbWall,
+		policyChecker,
+		logArchiver,
+		auditEvents,
+		stepTemplates,
+		notifications,
+		serviceAccounts,
 	)
 }
 
@@ -0,0 +1,23 @@
+package atc
+
+// AuditEvent is a record of an API request, kept for auditing.
+type AuditEvent struct {
+	ID   int   `json:"id"`
+	Time int64 `json:"time"`
+
+	Action string `json:"action"`
+
+	// Actor is the name of the user or system that made the request.
+	Actor    string `json:"actor"`
+	SourceIP string `json:"source_ip,omitempty"`
+
+	TeamName string `json:"team_name,omitempty"`
+
+	// Targets identifies the objects the request acted on, keyed by route
+	// parameter, e.g. `{"pipeline_name": "some-pipeline"}`.
+	Targets map[string]string `json:"targets,omitempty"`
+
+	// Status is the HTTP status the request was responded to with, or 0 while
+	// the request is still being handled.
+	Status int `json:"status"`
+}
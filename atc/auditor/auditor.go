@@ -2,10 +2,14 @@ package auditor
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate counterfeiter . Auditor
@@ -21,6 +25,7 @@ func NewAuditor(
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
 	logger lager.Logger,
+	auditEvents db.AuditEventRepository,
 ) *auditor {
 	return &auditor{
 		EnableBuildAuditLog:     EnableBuildAuditLog,
@@ -33,11 +38,16 @@ func NewAuditor(
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
 		logger:                  logger,
+		auditEvents:             auditEvents,
 	}
 }
 
 type Auditor interface {
-	Audit(action string, userName string, r *http.Request)
+	// Audit records a request before it is handled.
+	Audit(action string, userName string, r *http.Request) atc.AuditEvent
+
+	// Responded records the status an audited request was responded to with.
+	Responded(event atc.AuditEvent, status int)
 }
 
 type auditor struct {
@@ -51,6 +61,7 @@ type auditor struct {
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
 	logger                  lager.Logger
+	auditEvents             db.AuditEventRepository
 }
 
 func (a *auditor) ValidateAction(action string) bool {
@@ -64,6 +75,10 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
+		atc.ListBuildStepApprovals,
+		atc.GetBuildTestResults,
+		atc.ApproveBuildStep,
+		atc.RejectBuildStep,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -82,6 +97,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.GetJobSchedulingExplanation,
+		atc.GetJobTestHistory,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -141,6 +158,20 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.SearchBuildLogs,
+		atc.ListTeamAuditEvents,
+		atc.ListStepTemplates,
+		atc.GetStepTemplate,
+		atc.SaveStepTemplate,
+		atc.DestroyStepTemplate,
+		atc.ListNotifications,
+		atc.SaveNotification,
+		atc.DestroyNotification,
+		atc.ListNotificationDeliveries,
+		atc.ListAPITokens,
+		atc.CreateAPIToken,
+		atc.RevokeAPIToken,
+		atc.DestroyServiceAccount,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
@@ -160,9 +191,105 @@ func (a *auditor) ValidateAction(action string) bool {
 	}
 }
 
-func (a *auditor) Audit(action string, userName string, r *http.Request) {
-	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
-		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+// Audit records a request before it is handled, so that requests which are
+// never responded to, or only after a long time, are still audited. The
+// returned event is to be passed to Responded once the request has been
+// responded to.
+//
+// The event is logged and, if a repository has been configured, persisted
+// with a status of 0.
+func (a *auditor) Audit(action string, userName string, r *http.Request) atc.AuditEvent {
+	if !a.ValidateAction(action) {
+		return atc.AuditEvent{Action: action}
+	}
+
+	// a malformed body shouldn't keep the request out of the audit trail; the
+	// route parameters are in the query string regardless
+	_ = r.ParseForm()
+
+	event := atc.AuditEvent{
+		Time:     time.Now().Unix(),
+		Action:   action,
+		Actor:    userName,
+		SourceIP: sourceIP(r),
+		TeamName: r.FormValue(":team_name"),
+		Targets:  targets(r),
+	}
+
+	a.logger.Info("audit", lager.Data{
+		"action":     action,
+		"user":       userName,
+		"parameters": r.Form,
+		"team":       event.TeamName,
+		"targets":    event.Targets,
+		"source_ip":  event.SourceIP,
+	})
+
+	if a.auditEvents != nil {
+		id, err := a.auditEvents.Save(event)
+		if err != nil {
+			a.logger.Error("failed-to-save-audit-event", err)
+		}
+
+		event.ID = id
+	}
+
+	return event
+}
+
+// Responded records the status an audited request was responded to with.
+func (a *auditor) Responded(event atc.AuditEvent, status int) {
+	if !a.ValidateAction(event.Action) {
+		return
+	}
+
+	a.logger.Info("audit-responded", lager.Data{
+		"action": event.Action,
+		"user":   event.Actor,
+		"team":   event.TeamName,
+		"status": status,
+	})
+
+	if a.auditEvents != nil && event.ID != 0 {
+		err := a.auditEvents.UpdateStatus(event.ID, status)
+		if err != nil {
+			a.logger.Error("failed-to-update-audit-event-status", err)
+		}
+	}
+}
+
+// sourceIP is the address the request was made from.
+//
+// Only the address of the connection is considered: headers such as
+// X-Forwarded-For can be set by any client, and the web node has no notion of
+// which proxies are trusted to set them. When the web node is behind a load
+// balancer or reverse proxy, this is the address of the proxy rather than of
+// the client.
+func sourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
+
+	return host
+}
+
+// targets collects the route parameters identifying what the request acted
+// on, other than the team, which is recorded separately.
+func targets(r *http.Request) map[string]string {
+	var targets map[string]string
+
+	for key, values := range r.Form {
+		if !strings.HasPrefix(key, ":") || key == ":team_name" || len(values) == 0 {
+			continue
+		}
+
+		if targets == nil {
+			targets = map[string]string{}
+		}
+
+		targets[strings.TrimPrefix(key, ":")] = values[0]
+	}
+
+	return targets
 }
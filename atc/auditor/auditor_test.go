@@ -7,6 +7,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -28,10 +29,12 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog      bool
 		EnableWorkerAuditLog    bool
 		EnableVolumeAuditLog    bool
+		fakeAuditEvents         *dbfakes.FakeAuditEventRepository
 	)
 
 	BeforeEach(func() {
 		userName = "test"
+		fakeAuditEvents = new(dbfakes.FakeAuditEventRepository)
 
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -52,6 +55,7 @@ var _ = Describe("Audit", func() {
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
 			logger,
+			fakeAuditEvents,
 		)
 	})
 
@@ -87,6 +91,81 @@ var _ = Describe("Audit", func() {
 		})
 	})
 
+	Describe("audit events", func() {
+		BeforeEach(func() {
+			EnablePipelineAuditLog = true
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/main/pipelines/some-pipeline/pause?:team_name=main&:pipeline_name=some-pipeline", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.RemoteAddr = "10.0.0.1:54321"
+		})
+
+		It("saves a structured event for audited actions before they are responded to", func() {
+			aud.Audit(atc.PausePipeline, userName, req)
+
+			Expect(fakeAuditEvents.SaveCallCount()).To(Equal(1))
+			event := fakeAuditEvents.SaveArgsForCall(0)
+			Expect(event.Action).To(Equal(atc.PausePipeline))
+			Expect(event.Actor).To(Equal(userName))
+			Expect(event.TeamName).To(Equal("main"))
+			Expect(event.Targets).To(Equal(map[string]string{"pipeline_name": "some-pipeline"}))
+			Expect(event.Status).To(BeZero())
+			Expect(event.SourceIP).To(Equal("10.0.0.1"))
+			Expect(event.Time).ToNot(BeZero())
+		})
+
+		It("does not trust the X-Forwarded-For header for the source IP", func() {
+			req.Header.Set("X-Forwarded-For", "1.2.3.4")
+
+			aud.Audit(atc.PausePipeline, userName, req)
+
+			event := fakeAuditEvents.SaveArgsForCall(0)
+			Expect(event.SourceIP).To(Equal("10.0.0.1"))
+		})
+
+		It("does not save events for actions that are not audited", func() {
+			aud.Audit(atc.GetBuild, userName, req)
+			Expect(fakeAuditEvents.SaveCallCount()).To(Equal(0))
+		})
+
+		Context("when the request has been responded to", func() {
+			BeforeEach(func() {
+				fakeAuditEvents.SaveReturns(42, nil)
+			})
+
+			JustBeforeEach(func() {
+				event := aud.Audit(atc.PausePipeline, userName, req)
+				aud.Responded(event, http.StatusForbidden)
+			})
+
+			It("updates the status of the saved event", func() {
+				Expect(fakeAuditEvents.UpdateStatusCallCount()).To(Equal(1))
+				id, status := fakeAuditEvents.UpdateStatusArgsForCall(0)
+				Expect(id).To(Equal(42))
+				Expect(status).To(Equal(http.StatusForbidden))
+			})
+
+			It("includes the outcome in the log", func() {
+				logs := logger.Logs()
+				Expect(logs).To(HaveLen(2))
+				Expect(logs[1].Message).To(Equal("access_handler.audit-responded"))
+				Expect(logs[1].Data["status"]).To(BeNumerically("==", http.StatusForbidden))
+				Expect(logs[1].Data["team"]).To(Equal("main"))
+			})
+		})
+
+		Context("when a request that is not audited has been responded to", func() {
+			It("does not update any event", func() {
+				event := aud.Audit(atc.GetBuild, userName, req)
+				aud.Responded(event, http.StatusOK)
+
+				Expect(fakeAuditEvents.UpdateStatusCallCount()).To(Equal(0))
+				Expect(logger.Logs()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
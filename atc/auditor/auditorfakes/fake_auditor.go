@@ -5,23 +5,37 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
 )
 
 type FakeAuditor struct {
-	AuditStub        func(string, string, *http.Request)
+	AuditStub        func(string, string, *http.Request) atc.AuditEvent
 	auditMutex       sync.RWMutex
 	auditArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 *http.Request
 	}
+	auditReturns struct {
+		result1 atc.AuditEvent
+	}
+	auditReturnsOnCall map[int]struct {
+		result1 atc.AuditEvent
+	}
+	RespondedStub        func(atc.AuditEvent, int)
+	respondedMutex       sync.RWMutex
+	respondedArgsForCall []struct {
+		arg1 atc.AuditEvent
+		arg2 int
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) {
+func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) atc.AuditEvent {
 	fake.auditMutex.Lock()
+	ret, specificReturn := fake.auditReturnsOnCall[len(fake.auditArgsForCall)]
 	fake.auditArgsForCall = append(fake.auditArgsForCall, struct {
 		arg1 string
 		arg2 string
@@ -30,8 +44,13 @@ func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) {
 	fake.recordInvocation("Audit", []interface{}{arg1, arg2, arg3})
 	fake.auditMutex.Unlock()
 	if fake.AuditStub != nil {
-		fake.AuditStub(arg1, arg2, arg3)
+		return fake.AuditStub(arg1, arg2, arg3)
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.auditReturns
+	return fakeReturns.result1
 }
 
 func (fake *FakeAuditor) AuditCallCount() int {
@@ -40,7 +59,7 @@ func (fake *FakeAuditor) AuditCallCount() int {
 	return len(fake.auditArgsForCall)
 }
 
-func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request)) {
+func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request) atc.AuditEvent) {
 	fake.auditMutex.Lock()
 	defer fake.auditMutex.Unlock()
 	fake.AuditStub = stub
@@ -53,11 +72,68 @@ func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request)
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeAuditor) AuditReturns(result1 atc.AuditEvent) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	fake.auditReturns = struct {
+		result1 atc.AuditEvent
+	}{result1}
+}
+
+func (fake *FakeAuditor) AuditReturnsOnCall(i int, result1 atc.AuditEvent) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	if fake.auditReturnsOnCall == nil {
+		fake.auditReturnsOnCall = make(map[int]struct {
+			result1 atc.AuditEvent
+		})
+	}
+	fake.auditReturnsOnCall[i] = struct {
+		result1 atc.AuditEvent
+	}{result1}
+}
+
+func (fake *FakeAuditor) Responded(arg1 atc.AuditEvent, arg2 int) {
+	fake.respondedMutex.Lock()
+	fake.respondedArgsForCall = append(fake.respondedArgsForCall, struct {
+		arg1 atc.AuditEvent
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("Responded", []interface{}{arg1, arg2})
+	fake.respondedMutex.Unlock()
+	if fake.RespondedStub != nil {
+		fake.RespondedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAuditor) RespondedCallCount() int {
+	fake.respondedMutex.RLock()
+	defer fake.respondedMutex.RUnlock()
+	return len(fake.respondedArgsForCall)
+}
+
+func (fake *FakeAuditor) RespondedCalls(stub func(atc.AuditEvent, int)) {
+	fake.respondedMutex.Lock()
+	defer fake.respondedMutex.Unlock()
+	fake.RespondedStub = stub
+}
+
+func (fake *FakeAuditor) RespondedArgsForCall(i int) (atc.AuditEvent, int) {
+	fake.respondedMutex.RLock()
+	defer fake.respondedMutex.RUnlock()
+	argsForCall := fake.respondedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.auditMutex.RLock()
 	defer fake.auditMutex.RUnlock()
+	fake.respondedMutex.RLock()
+	defer fake.respondedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -24,6 +24,8 @@ type Build struct {
 	ReapTime     int64         `json:"reap_time,omitempty"`
 	RerunNumber  int           `json:"rerun_number,omitempty"`
 	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -66,4 +68,5 @@ type BuildPreparation struct {
 	Inputs              map[string]BuildPreparationStatus `json:"inputs"`
 	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
 	MissingInputReasons MissingInputReasons               `json:"missing_input_reasons"`
+	QueuePosition       int                               `json:"queue_position,omitempty"`
 }
@@ -0,0 +1,14 @@
+package atc
+
+// BuildLogSearchResult is a build whose logs matched a search, along with the
+// first few lines that matched.
+type BuildLogSearchResult struct {
+	Build Build          `json:"build"`
+	Lines []BuildLogLine `json:"lines"`
+}
+
+type BuildLogLine struct {
+	// Origin is the ID of the plan of the step which printed the line.
+	Origin string `json:"origin"`
+	Line   string `json:"line"`
+}
@@ -0,0 +1,30 @@
+package atc
+
+// BuildStepApproval is the state of an 'approve' step of a build. It is
+// pending until someone approves or rejects it.
+type BuildStepApproval struct {
+	PlanID  PlanID   `json:"plan_id"`
+	Name    string   `json:"name"`
+	Message string   `json:"message,omitempty"`
+	Roles   []string `json:"roles,omitempty"`
+	Users   []string `json:"users,omitempty"`
+
+	// Approved is nil while the approval is pending.
+	Approved  *bool  `json:"approved,omitempty"`
+	DecidedBy string `json:"decided_by,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+
+	CreatedAt int64 `json:"created_at"`
+	DecidedAt int64 `json:"decided_at,omitempty"`
+}
+
+// Pending returns true if nobody has decided the approval yet.
+func (approval BuildStepApproval) Pending() bool {
+	return approval.Approved == nil
+}
+
+// BuildStepDecision is the request body for approving or rejecting an
+// 'approve' step.
+type BuildStepDecision struct {
+	Comment string `json:"comment,omitempty"`
+}
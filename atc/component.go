@@ -9,14 +9,18 @@ const (
 	ComponentLidarChecker               = "checker"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentBuildLogIndexer            = "log_indexer"
+	ComponentBuildNotifier              = "notifier"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
 	ComponentCollectorChecks            = "collector_checks"
 	ComponentCollectorContainers        = "collector_containers"
+	ComponentCollectorKubernetesPods    = "collector_kubernetes_pods"
 	ComponentCollectorResourceCacheUses = "collector_resource_cache_uses"
 	ComponentCollectorResourceCaches    = "collector_resource_caches"
 	ComponentCollectorResourceConfigs   = "collector_resource_configs"
+	ComponentCollectorResourceVersions  = "collector_resource_versions"
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 	ComponentCollectorVarSources        = "collector_var_sources"
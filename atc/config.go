@@ -173,29 +173,43 @@ func (c VarSourceConfigs) OrderByDependency() (VarSourceConfigs, error) {
 }
 
 type ResourceConfig struct {
-	Name         string  `json:"name"`
-	Public       bool    `json:"public,omitempty"`
-	WebhookToken string  `json:"webhook_token,omitempty"`
-	Type         string  `json:"type"`
-	Source       Source  `json:"source"`
-	CheckEvery   string  `json:"check_every,omitempty"`
-	CheckTimeout string  `json:"check_timeout,omitempty"`
-	Tags         Tags    `json:"tags,omitempty"`
-	Version      Version `json:"version,omitempty"`
-	Icon         string  `json:"icon,omitempty"`
+	Name           string          `json:"name"`
+	Public         bool            `json:"public,omitempty"`
+	WebhookToken   string          `json:"webhook_token,omitempty"`
+	WebhookFilter  *WebhookFilter  `json:"webhook_filter,omitempty"`
+	Type           string          `json:"type"`
+	Source         Source          `json:"source"`
+	CheckEvery     string          `json:"check_every,omitempty"`
+	CheckTimeout   string          `json:"check_timeout,omitempty"`
+	Tags           Tags            `json:"tags,omitempty"`
+	Version        Version         `json:"version,omitempty"`
+	Icon           string          `json:"icon,omitempty"`
+	VersionHistory *VersionHistory `json:"version_history,omitempty"`
+}
+
+// VersionHistory limits how many of a resource's versions are kept around.
+// A version is removed once it falls outside of either limit; 0 means no
+// limit.
+//
+// Versions used by builds, pinned versions and the latest version are always
+// kept.
+type VersionHistory struct {
+	Versions int `json:"versions,omitempty"`
+	Days     int `json:"days,omitempty"`
 }
 
 type ResourceType struct {
-	Name                 string `json:"name"`
-	Type                 string `json:"type"`
-	Source               Source `json:"source"`
-	Privileged           bool   `json:"privileged,omitempty"`
-	CheckEvery           string `json:"check_every,omitempty"`
-	Tags                 Tags   `json:"tags,omitempty"`
-	Params               Params `json:"params,omitempty"`
-	CheckSetupError      string `json:"check_setup_error,omitempty"`
-	CheckError           string `json:"check_error,omitempty"`
-	UniqueVersionHistory bool   `json:"unique_version_history,omitempty"`
+	Name                 string  `json:"name"`
+	Type                 string  `json:"type"`
+	Source               Source  `json:"source"`
+	Privileged           bool    `json:"privileged,omitempty"`
+	CheckEvery           string  `json:"check_every,omitempty"`
+	Tags                 Tags    `json:"tags,omitempty"`
+	Params               Params  `json:"params,omitempty"`
+	CheckSetupError      string  `json:"check_setup_error,omitempty"`
+	CheckError           string  `json:"check_error,omitempty"`
+	UniqueVersionHistory bool    `json:"unique_version_history,omitempty"`
+	MaxChecksPerSecond   float64 `json:"max_checks_per_second,omitempty"`
 }
 
 type ResourceTypes []ResourceType
@@ -429,10 +443,15 @@ type PlanConfig struct {
 	Privileged bool `json:"privileged,omitempty"`
 	// inlined task config
 	TaskConfig *TaskConfig `json:"config,omitempty"`
+	// skip the task if it succeeded before with the same config and inputs,
+	// reusing the outputs of that run
+	Memoize bool `json:"memoize,omitempty"`
 
 	// name of 'set_pipeline'
 	SetPipeline string   `json:"set_pipeline,omitempty"`
 	VarFiles    []string `json:"var_files,omitempty"`
+	// vars identifying the pipeline instance to set
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
 
 	// config path, e.g. foo/build.yml. Multiple steps might have this field, e.g. Task step and SetPipeline step.
 	File string `json:"file,omitempty"`
@@ -477,6 +496,10 @@ type PlanConfig struct {
 	// used on any step to interrupt the step after a given duration
 	Timeout string `json:"timeout,omitempty"`
 
+	// used on get, put, and task steps to interrupt the step once its process
+	// has started and not written any output for a given duration
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+
 	// not present in yaml
 	DependentGet string `json:"-" json:"-"`
 
@@ -493,6 +516,39 @@ type PlanConfig struct {
 
 	// if true, then it will not be redacted.
 	Reveal bool `json:"reveal,omitempty"`
+
+	// name of 'approve' step, which waits for someone to approve the build.
+	// the step fails if they reject it instead. combine with 'timeout' to
+	// limit how long to wait.
+	Approve string `json:"approve,omitempty"`
+	// message shown to whoever is asked for approval
+	Message string `json:"message,omitempty"`
+	// who may approve the build; any pipeline operator of the team if empty
+	Approvers *ApproversConfig `json:"approvers,omitempty"`
+
+	// used on any step to run it once for each combination of var values
+	Across []AcrossVarConfig `json:"across,omitempty"`
+
+	// name of the team's step template to use in place of the step, with its
+	// params given as vars. the latest version is used unless one is given.
+	Template        string `json:"template,omitempty"`
+	TemplateVersion int    `json:"template_version,omitempty"`
+}
+
+// An AcrossVarConfig configures one var of an 'across' step modifier. Values
+// is either a static list or a string referencing a list var, e.g.
+// ((.:versions)), which is resolved when the step runs.
+type AcrossVarConfig struct {
+	Var         string      `json:"var"`
+	Values      interface{} `json:"values"`
+	MaxInFlight int         `json:"max_in_flight,omitempty"`
+}
+
+// ApproversConfig restricts who may decide an 'approve' step. Someone who
+// has any of the roles in the pipeline's team or any of the user names may.
+type ApproversConfig struct {
+	Roles []string `json:"roles,omitempty"`
+	Users []string `json:"users,omitempty"`
 }
 
 func (config PlanConfig) Name() string {
@@ -3,6 +3,7 @@ package configvalidate
 import (
 	"errors"
 	"fmt"
+	"path"
 	"sort"
 	"strings"
 	"time"
@@ -140,6 +141,22 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.VersionHistory != nil {
+			if resource.VersionHistory.Versions < 0 {
+				errorMessages = append(errorMessages,
+					identifier+fmt.Sprintf(" has negative version_history.versions: %d", resource.VersionHistory.Versions))
+			}
+
+			if resource.VersionHistory.Days < 0 {
+				errorMessages = append(errorMessages,
+					identifier+fmt.Sprintf(" has negative version_history.days: %d", resource.VersionHistory.Days))
+			}
+		}
+
+		if resource.WebhookFilter != nil {
+			errorMessages = append(errorMessages, validateWebhookFilter(identifier, resource)...)
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -147,6 +164,36 @@ func validateResources(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateWebhookFilter(identifier string, resource ResourceConfig) []string {
+	var errorMessages []string
+
+	if resource.WebhookToken == "" {
+		errorMessages = append(errorMessages, identifier+" has a webhook_filter but no webhook_token")
+	}
+
+	for payloadPath, pattern := range resource.WebhookFilter.Match {
+		if payloadPath == "" {
+			errorMessages = append(errorMessages, identifier+" has an empty path in webhook_filter.match")
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			errorMessages = append(errorMessages,
+				identifier+fmt.Sprintf(" has an invalid pattern in webhook_filter.match for '%s': %s", payloadPath, err))
+		}
+	}
+
+	for field, payloadPath := range resource.WebhookFilter.Version {
+		if payloadPath == "" {
+			errorMessages = append(errorMessages,
+				identifier+fmt.Sprintf(" has an empty path in webhook_filter.version for '%s'", field))
+		}
+	}
+
+	sort.Strings(errorMessages)
+
+	return errorMessages
+}
+
 func validateResourceTypes(c Config) error {
 	var errorMessages []string
 
@@ -176,6 +223,10 @@ func validateResourceTypes(c Config) error {
 		if resourceType.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resourceType.MaxChecksPerSecond < 0 {
+			errorMessages = append(errorMessages, identifier+" has negative max_checks_per_second")
+		}
 	}
 
 	return compositeErr(errorMessages)
@@ -276,6 +327,13 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		if job.Schedule != nil {
+			err := job.Schedule.Validate()
+			if err != nil {
+				errorMessages = append(errorMessages, identifier+".schedule has "+err.Error())
+			}
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -400,6 +458,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		foundTypes.Find("load_var")
 	}
 
+	if plan.Approve != "" {
+		foundTypes.Find("approve")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -416,6 +478,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		foundTypes.Find("try")
 	}
 
+	if plan.Template != "" {
+		foundTypes.Find("template")
+	}
+
 	if valid, message := foundTypes.IsValid(); !valid {
 		return []ConfigWarning{}, []string{message}
 	}
@@ -456,7 +522,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "memoize"},
 			plan, identifier)...,
 		)
 
@@ -531,7 +597,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "memoize"},
 			plan, identifier)...,
 		)
 
@@ -606,11 +672,30 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			errorMessages = append(errorMessages, identifier+" does not specify any file")
 		}
 
+	case plan.Approve != "":
+		identifier = fmt.Sprintf("%s.approve.%s", identifier, plan.Approve)
+
+		if plan.Approvers != nil {
+			for _, role := range plan.Approvers.Roles {
+				if !isApproverRole(role) {
+					errorMessages = append(errorMessages,
+						identifier+fmt.Sprintf(" has an unknown approver role '%s'", role))
+				}
+			}
+		}
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
+
+	case plan.Template != "":
+		identifier = fmt.Sprintf("%s.template.%s", identifier, plan.Template)
+
+		if plan.TemplateVersion < 0 {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an invalid template_version (%d)", plan.TemplateVersion))
+		}
 	}
 
 	if plan.Abort != nil {
@@ -656,14 +741,62 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		}
 	}
 
+	if plan.IdleTimeout != "" {
+		subIdentifier := fmt.Sprintf("%s.idle_timeout", identifier)
+		if plan.Get == "" && plan.Put == "" && plan.Task == "" {
+			errorMessages = append(errorMessages, subIdentifier+" can only be specified on get, put, and task steps")
+		} else if _, err := time.ParseDuration(plan.IdleTimeout); err != nil {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" refers to a duration that could not be parsed ('%s')", plan.IdleTimeout))
+		}
+	}
+
 	if plan.Attempts < 0 {
 		subIdentifier := fmt.Sprintf("%s.attempts", identifier)
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if len(plan.Across) > 0 {
+		errorMessages = append(errorMessages, validateAcross(identifier, plan.Across)...)
+	}
+
 	return warnings, errorMessages
 }
 
+func validateAcross(identifier string, across []AcrossVarConfig) []string {
+	var errorMessages []string
+
+	names := map[string]bool{}
+	for i, v := range across {
+		subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+		if v.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" does not specify a var name")
+		} else if names[v.Var] {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" repeats var name '%s'", v.Var))
+		}
+		names[v.Var] = true
+
+		switch values := v.Values.(type) {
+		case []interface{}:
+			if len(values) == 0 {
+				errorMessages = append(errorMessages, subIdentifier+" does not specify any values")
+			}
+		case string:
+			if !strings.HasPrefix(values, "((") || !strings.HasSuffix(values, "))") {
+				errorMessages = append(errorMessages, subIdentifier+" values must be a list or a var reference")
+			}
+		default:
+			errorMessages = append(errorMessages, subIdentifier+" values must be a list or a var reference")
+		}
+
+		if v.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid max_in_flight (%d)", v.MaxInFlight))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	var errorMessages []string
 	var foundInapplicableFields []string
@@ -694,6 +827,10 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.File != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "memoize":
+			if plan.Memoize {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
@@ -731,7 +868,7 @@ func validateVarSources(c Config) error {
 		// TODO: this check should eventually be removed once all credential managers
 		// are supported in pipeline. - @evanchaoli
 		switch cm.Type {
-		case "vault", "dummy":
+		case "vault", "dummy", "idtoken":
 		default:
 			return fmt.Errorf("credential manager type %s is not supported in pipeline yet", cm.Type)
 		}
@@ -757,3 +894,118 @@ func validateVarSources(c Config) error {
 
 	return nil
 }
+
+// ValidateStepTemplates checks that the step templates referred to by the
+// config's steps exist, and that every one of their params is given a value.
+func ValidateStepTemplates(c Config, templates StepTemplates) []string {
+	var errorMessages []string
+
+	for i, job := range c.Jobs {
+		var identifier string
+		if job.Name == "" {
+			identifier = fmt.Sprintf("jobs[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("jobs.%s", job.Name)
+		}
+
+		errorMessages = append(errorMessages, validatePlanStepTemplates(templates, identifier+".plan", PlanConfig{
+			Do: &job.Plan,
+		})...)
+
+		errorMessages = append(errorMessages, validatePlanStepTemplates(templates, identifier, PlanConfig{
+			Abort:   job.Abort,
+			Error:   job.Error,
+			Failure: job.Failure,
+			Ensure:  job.Ensure,
+			Success: job.Success,
+		})...)
+	}
+
+	if len(errorMessages) > 0 {
+		return []string{formatErr("step templates", compositeErr(errorMessages))}
+	}
+
+	return nil
+}
+
+func validatePlanStepTemplates(templates StepTemplates, identifier string, plan PlanConfig) []string {
+	var errorMessages []string
+
+	if plan.Template != "" {
+		templateIdentifier := fmt.Sprintf("%s.template.%s", identifier, plan.Template)
+
+		template, found := templates.Lookup(plan.Template, plan.TemplateVersion)
+		if !found && plan.TemplateVersion != 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf(
+				"%s refers to a step template version that does not exist ('%s' version %d)",
+				templateIdentifier,
+				plan.Template,
+				plan.TemplateVersion,
+			))
+		} else if !found {
+			errorMessages = append(errorMessages, fmt.Sprintf(
+				"%s refers to a step template that does not exist ('%s')",
+				templateIdentifier,
+				plan.Template,
+			))
+		} else if missing := template.MissingParams(plan.Vars); len(missing) > 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf(
+				"%s is missing vars for params of step template version %d (%s)",
+				templateIdentifier,
+				template.Version,
+				strings.Join(missing, ", "),
+			))
+		}
+	}
+
+	if plan.Do != nil {
+		for i, plan := range *plan.Do {
+			subIdentifier := fmt.Sprintf("%s[%d]", identifier, i)
+			errorMessages = append(errorMessages, validatePlanStepTemplates(templates, subIdentifier, plan)...)
+		}
+	}
+
+	if plan.Aggregate != nil {
+		for i, plan := range *plan.Aggregate {
+			subIdentifier := fmt.Sprintf("%s.aggregate[%d]", identifier, i)
+			errorMessages = append(errorMessages, validatePlanStepTemplates(templates, subIdentifier, plan)...)
+		}
+	}
+
+	if plan.InParallel != nil {
+		for i, plan := range plan.InParallel.Steps {
+			subIdentifier := fmt.Sprintf("%s.in_parallel[%d]", identifier, i)
+			errorMessages = append(errorMessages, validatePlanStepTemplates(templates, subIdentifier, plan)...)
+		}
+	}
+
+	hooks := []struct {
+		name string
+		plan *PlanConfig
+	}{
+		{"try", plan.Try},
+		{"abort", plan.Abort},
+		{"error", plan.Error},
+		{"ensure", plan.Ensure},
+		{"success", plan.Success},
+		{"failure", plan.Failure},
+	}
+
+	for _, hook := range hooks {
+		if hook.plan != nil {
+			subIdentifier := fmt.Sprintf("%s.%s", identifier, hook.name)
+			errorMessages = append(errorMessages, validatePlanStepTemplates(templates, subIdentifier, *hook.plan)...)
+		}
+	}
+
+	return errorMessages
+}
+
+func isApproverRole(role string) bool {
+	switch role {
+	case "owner", "member", "pipeline-operator", "viewer":
+		return true
+	}
+
+	return false
+}
@@ -6,8 +6,9 @@ import (
 
 	. "github.com/concourse/concourse/atc"
 
-	// load dummy credential manager
+	// load dummy and idtoken credential managers
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/idtoken"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -224,6 +225,24 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when an idtoken var source's expiry is too long", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name: "id",
+					Type: "idtoken",
+					Config: map[string]interface{}{
+						"audience":   []interface{}{"sts.amazonaws.com"},
+						"expires_in": "48h",
+					},
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("credential manager id is invalid: expires_in must be at most 24h0m0s"))
+			})
+		})
+
 		Context("when duplicate var source names", func() {
 			BeforeEach(func() {
 				config.VarSources = append(config.VarSources,
@@ -363,6 +382,62 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has a negative version history", func() {
+			BeforeEach(func() {
+				config.Resources[0].VersionHistory = &VersionHistory{
+					Versions: -1,
+					Days:     -2,
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has negative version_history.versions: -1"))
+				Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has negative version_history.days: -2"))
+			})
+		})
+
+		Context("when a resource has a webhook filter", func() {
+			BeforeEach(func() {
+				config.Resources[0].WebhookToken = "some-token"
+				config.Resources[0].WebhookFilter = &WebhookFilter{
+					Match:   map[string]string{"ref": "refs/heads/*"},
+					Version: map[string]string{"ref": "after"},
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("without a webhook token", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookToken = ""
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook_filter but no webhook_token"))
+				})
+			})
+
+			Context("with malformed paths and patterns", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookFilter = &WebhookFilter{
+						Match:   map[string]string{"ref": "refs/heads/["},
+						Version: map[string]string{"ref": ""},
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has an invalid pattern in webhook_filter.match for 'ref'"))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has an empty path in webhook_filter.version for 'ref'"))
+				})
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -620,6 +695,18 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("resource_types[0] and resource_types[1] have the same name ('some-resource-type')"))
 			})
 		})
+
+		Context("when a resource type has a negative max checks per second", func() {
+			BeforeEach(func() {
+				config.ResourceTypes[0].MaxChecksPerSecond = -1
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resource types:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resource_types.some-resource-type has negative max_checks_per_second"))
+			})
+		})
 	})
 
 	Describe("validating a job", func() {
@@ -645,6 +732,42 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				job.Schedule = &JobSchedule{Cron: "0 2 * * 1-5", Location: "Europe/Berlin"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid cron expression", func() {
+			BeforeEach(func() {
+				job.Schedule = &JobSchedule{Cron: "nightly"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule has invalid cron expression 'nightly'"))
+			})
+		})
+
+		Context("when a job has a schedule in an unknown location", func() {
+			BeforeEach(func() {
+				job.Schedule = &JobSchedule{Cron: "@daily", Location: "Nowhere/Special"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.schedule has invalid location 'Nowhere/Special'"))
+			})
+		})
+
 		Context("when a job has a negative build_logs_to_retain", func() {
 			BeforeEach(func() {
 				job.BuildLogsToRetain = -1
@@ -844,6 +967,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a put plan is memoized", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:     "some-resource",
+						Memoize: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has invalid fields specified (memoize)"))
+				})
+			})
+
 			Context("when a task plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1310,6 +1450,40 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a plan has an invalid idle timeout in a step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:         "some-resource",
+						IdleTimeout: "nope",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.idle_timeout refers to a duration that could not be parsed ('nope')"))
+				})
+			})
+
+			Context("when a plan has an idle timeout on a step which produces no output", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Do: &PlanSequence{
+							{Get: "some-resource"},
+						},
+						IdleTimeout: "10m",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].idle_timeout can only be specified on get, put, and task steps"))
+				})
+			})
+
 			Context("when a plan has an invalid step within a try", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1344,6 +1518,61 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an across step has invalid vars", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: []AcrossVarConfig{
+							{Var: "some-var", Values: []interface{}{"a", "b"}},
+							{Var: "some-var", Values: "not-a-var"},
+							{Values: []interface{}{}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[1] repeats var name 'some-var'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[1] values must be a list or a var reference"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[2] does not specify a var name"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[2] does not specify any values"))
+				})
+			})
+
+			Context("when a template step has an invalid template_version", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Template:        "some-template",
+						TemplateVersion: -1,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].template.some-template has an invalid template_version (-1)"))
+				})
+			})
+
+			Context("when a template step also specifies another action", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Template: "some-template",
+						Put:      "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0] has multiple actions specified (put, template)"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1491,6 +1720,24 @@ var _ = Describe("ValidateConfig", func() {
 					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has load_var steps with the same name: a-var"))
 				})
 			})
+
+			Context("when an approve step has an unknown approver role", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approve: "ship-it",
+						Approvers: &ApproversConfig{
+							Roles: []string{"owner", "release-manager"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].approve.ship-it has an unknown approver role 'release-manager'"))
+				})
+			})
 		})
 
 		Context("when two jobs have the same name", func() {
@@ -1546,3 +1793,99 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidateStepTemplates", func() {
+	var (
+		config    Config
+		templates StepTemplates
+
+		errorMessages []string
+	)
+
+	BeforeEach(func() {
+		config = Config{
+			Jobs: JobConfigs{
+				{
+					Name: "some-job",
+					Plan: PlanSequence{
+						{
+							Template: "some-template",
+							Vars:     Params{"some-param": "some-value"},
+						},
+						{
+							InParallel: &InParallelConfig{
+								Steps: PlanSequence{
+									{
+										Template:        "some-template",
+										TemplateVersion: 1,
+										Vars:            Params{"some-param": "some-value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		templates = StepTemplates{
+			{Name: "some-template", Version: 1, Params: []string{"some-param"}, Step: "task: some-task"},
+			{Name: "some-template", Version: 2, Params: []string{"some-param"}, Step: "task: some-task"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		errorMessages = configvalidate.ValidateStepTemplates(config, templates)
+	})
+
+	Context("when every template exists and is given its params", func() {
+		It("returns no error", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+	})
+
+	Context("when a step refers to a template that does not exist", func() {
+		BeforeEach(func() {
+			config.Jobs[0].Plan[0].Template = "bogus-template"
+		})
+
+		It("returns an error", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("invalid step templates:"))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.plan[0].template.bogus-template refers to a step template that does not exist ('bogus-template')"))
+		})
+	})
+
+	Context("when a step refers to a version of a template that does not exist", func() {
+		BeforeEach(func() {
+			config.Jobs[0].Plan[1].InParallel.Steps[0].TemplateVersion = 3
+		})
+
+		It("returns an error", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.plan[1].in_parallel[0].template.some-template refers to a step template version that does not exist ('some-template' version 3)"))
+		})
+	})
+
+	Context("when a step does not give a template all of its params", func() {
+		BeforeEach(func() {
+			templates[1].Params = []string{"some-param", "other-param", "another-param"}
+		})
+
+		It("returns an error naming the missing params", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.plan[0].template.some-template is missing vars for params of step template version 2 (other-param, another-param)"))
+		})
+	})
+
+	Context("when a hook refers to a template that does not exist", func() {
+		BeforeEach(func() {
+			config.Jobs[0].Failure = &PlanConfig{Template: "bogus-template"}
+		})
+
+		It("returns an error", func() {
+			Expect(errorMessages).To(HaveLen(1))
+			Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.failure.template.bogus-template refers to a step template that does not exist ('bogus-template')"))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package idtoken
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"gopkg.in/square/go-jose.v2"
+)
+
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+	KeySetPath    = "/.well-known/jwks.json"
+)
+
+type discovery struct {
+	Issuer          string   `json:"issuer"`
+	JWKSURI         string   `json:"jwks_uri"`
+	ResponseTypes   []string `json:"response_types_supported"`
+	SubjectTypes    []string `json:"subject_types_supported"`
+	SigningAlgs     []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported []string `json:"claims_supported"`
+}
+
+// NewHandler serves the OIDC discovery document and key set of the issuer,
+// allowing cloud providers to trust the tokens it signs.
+func NewHandler(issuer *Issuer) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(DiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, discovery{
+			Issuer:        issuer.URL,
+			JWKSURI:       issuer.URL + KeySetPath,
+			ResponseTypes: []string{"id_token"},
+			SubjectTypes:  []string{"public"},
+			SigningAlgs:   []string{string(jose.RS256)},
+			ClaimsSupported: []string{
+				"iss", "sub", "aud", "exp", "iat", "nbf",
+				"team", "pipeline", "instance_vars", "job", "build_id", "build_name",
+			},
+		})
+	})
+
+	mux.HandleFunc(KeySetPath, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, issuer.KeySet())
+	})
+
+	return mux
+}
+
+func respond(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(body)
+}
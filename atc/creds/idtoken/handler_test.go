@@ -0,0 +1,62 @@
+package idtoken_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/concourse/concourse/atc/creds/idtoken"
+	"gopkg.in/square/go-jose.v2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		issuer  *idtoken.Issuer
+		handler http.Handler
+	)
+
+	BeforeEach(func() {
+		signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		issuer, err = idtoken.NewIssuer("https://concourse.example.com", signingKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		handler = idtoken.NewHandler(issuer)
+	})
+
+	It("serves the discovery document", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/.well-known/openid-configuration", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var body map[string]interface{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &body)).To(Succeed())
+
+		Expect(body["issuer"]).To(Equal("https://concourse.example.com"))
+		Expect(body["jwks_uri"]).To(Equal("https://concourse.example.com/.well-known/jwks.json"))
+		Expect(body["id_token_signing_alg_values_supported"]).To(ConsistOf("RS256"))
+	})
+
+	It("serves the public key set", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var keySet jose.JSONWebKeySet
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &keySet)).To(Succeed())
+
+		Expect(keySet.Keys).To(HaveLen(1))
+		Expect(keySet.Keys[0].KeyID).To(Equal(issuer.KeySet().Keys[0].KeyID))
+		Expect(keySet.Keys[0].IsPublic()).To(BeTrue())
+		Expect(keySet.Keys[0].Use).To(Equal("sig"))
+	})
+})
@@ -0,0 +1,90 @@
+package idtoken
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// Claims are the claims carried by an ID token issued to a build.
+type Claims struct {
+	jwt.Claims
+
+	Team         string           `json:"team"`
+	Pipeline     string           `json:"pipeline"`
+	InstanceVars atc.InstanceVars `json:"instance_vars,omitempty"`
+	Job          string           `json:"job,omitempty"`
+	BuildID      int              `json:"build_id,omitempty"`
+	BuildName    string           `json:"build_name,omitempty"`
+}
+
+// Issuer signs ID tokens on behalf of the ATC, which acts as an OIDC provider
+// for the builds it runs. Cloud providers trusting the issuer verify the
+// tokens against the key set served by the web node.
+type Issuer struct {
+	URL string
+
+	signingKey *rsa.PrivateKey
+	keyID      string
+}
+
+func NewIssuer(url string, signingKey *rsa.PrivateKey) (*Issuer, error) {
+	if signingKey == nil {
+		return nil, errors.New("missing signing key")
+	}
+
+	publicKey := jose.JSONWebKey{Key: &signingKey.PublicKey}
+
+	thumbprint, err := publicKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issuer{
+		URL: strings.TrimSuffix(url, "/"),
+
+		signingKey: signingKey,
+		keyID:      base64.RawURLEncoding.EncodeToString(thumbprint),
+	}, nil
+}
+
+func (issuer *Issuer) Sign(claims Claims) (string, error) {
+	claims.Issuer = issuer.URL
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.RS256,
+			Key: jose.JSONWebKey{
+				Key:   issuer.signingKey,
+				KeyID: issuer.keyID,
+			},
+		},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+// KeySet returns the public keys which tokens signed by the issuer can be
+// verified against.
+func (issuer *Issuer) KeySet() jose.JSONWebKeySet {
+	return jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{
+				Key:       &issuer.signingKey.PublicKey,
+				KeyID:     issuer.keyID,
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package idtoken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	DefaultExpiresIn = time.Hour
+	MaxExpiresIn     = 24 * time.Hour
+)
+
+type Manager struct {
+	Audience  []string      `mapstructure:"audience"`
+	ExpiresIn time.Duration `mapstructure:"expires_in"`
+
+	issuer *Issuer
+}
+
+func (manager *Manager) Config(config map[string]interface{}) error {
+	manager.ExpiresIn = DefaultExpiresIn
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:  mapstructure.StringToTimeDurationHookFunc(),
+		ErrorUnused: true,
+		Result:      &manager,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(config)
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	return nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"health": health,
+	})
+}
+
+// IsConfigured is always false, as id tokens are only issued by var sources
+// configured in a pipeline.
+func (manager Manager) IsConfigured() bool {
+	return false
+}
+
+func (manager Manager) Validate() error {
+	if manager.ExpiresIn <= 0 {
+		return errors.New("expires_in must be positive")
+	}
+
+	if manager.ExpiresIn > MaxExpiresIn {
+		return fmt.Errorf("expires_in must be at most %s", MaxExpiresIn)
+	}
+
+	for _, audience := range manager.Audience {
+		if audience == "" {
+			return errors.New("audience must not be empty")
+		}
+	}
+
+	return nil
+}
+
+func (manager Manager) Health() (*creds.HealthResponse, error) {
+	return &creds.HealthResponse{
+		Method: "idtoken",
+	}, nil
+}
+
+func (manager Manager) Close(logger lager.Logger) {
+}
+
+// NewSecretsFactory defaults the audience to the issuer itself when none is
+// configured.
+func (manager Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	if manager.issuer == nil {
+		return nil, errors.New("id tokens cannot be issued as the web node has no --idtoken-signing-key")
+	}
+
+	audience := manager.Audience
+	if len(audience) == 0 {
+		audience = []string{manager.issuer.URL}
+	}
+
+	return &SecretsFactory{
+		issuer:    manager.issuer,
+		audience:  audience,
+		expiresIn: manager.ExpiresIn,
+	}, nil
+}
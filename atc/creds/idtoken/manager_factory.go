@@ -0,0 +1,54 @@
+package idtoken
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type managerFactory struct {
+	issuer *Issuer
+}
+
+var factory = &managerFactory{}
+
+func init() {
+	creds.Register("idtoken", factory)
+}
+
+// UseIssuer configures the issuer which signs the tokens of every idtoken
+// var source. It is called by the web node on startup, before any builds are
+// run, with a nil issuer if no signing key is configured.
+func UseIssuer(issuer *Issuer) {
+	factory.issuer = issuer
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return factory
+}
+
+// AddConfig does not add any flags, as the idtoken credential manager can only
+// be configured as a var source. The issuer's signing key is configured on the
+// web node instead.
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	return &Manager{}
+}
+
+func (factory *managerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	configMap, ok := config.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid idtoken credential manager config: %T", config)
+	}
+
+	manager := &Manager{
+		issuer: factory.issuer,
+	}
+
+	err := manager.Config(configMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
@@ -0,0 +1,76 @@
+package idtoken_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds/idtoken"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	Describe("NewInstance", func() {
+		It("defaults the expiry", func() {
+			manager, err := idtoken.NewManagerFactory().NewInstance(map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.(*idtoken.Manager).ExpiresIn).To(Equal(idtoken.DefaultExpiresIn))
+		})
+
+		It("rejects unknown config", func() {
+			_, err := idtoken.NewManagerFactory().NewInstance(map[string]interface{}{
+				"bogus": "value",
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Validate", func() {
+		var manager idtoken.Manager
+
+		BeforeEach(func() {
+			manager = idtoken.Manager{
+				Audience:  []string{"sts.amazonaws.com"},
+				ExpiresIn: idtoken.DefaultExpiresIn,
+			}
+		})
+
+		It("passes", func() {
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("fails when the expiry is not positive", func() {
+			manager.ExpiresIn = 0
+			Expect(manager.Validate()).To(MatchError("expires_in must be positive"))
+		})
+
+		It("fails when the expiry is too long", func() {
+			manager.ExpiresIn = 25 * idtoken.DefaultExpiresIn
+			Expect(manager.Validate()).To(MatchError("expires_in must be at most 24h0m0s"))
+		})
+
+		It("fails when an audience is empty", func() {
+			manager.Audience = []string{""}
+			Expect(manager.Validate()).To(MatchError("audience must not be empty"))
+		})
+	})
+
+	Describe("NewSecretsFactory", func() {
+		Context("when the web node has no signing key", func() {
+			BeforeEach(func() {
+				idtoken.UseIssuer(nil)
+			})
+
+			It("fails", func() {
+				manager, err := idtoken.NewManagerFactory().NewInstance(map[string]interface{}{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = manager.NewSecretsFactory(lagertest.NewTestLogger("test"))
+				Expect(err).To(MatchError("id tokens cannot be issued as the web node has no --idtoken-signing-key"))
+			})
+		})
+	})
+
+	It("is never configured as the global credential manager", func() {
+		Expect(idtoken.Manager{}.IsConfigured()).To(BeFalse())
+	})
+})
@@ -0,0 +1,94 @@
+package idtoken
+
+import (
+	"errors"
+	"path"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// TokenVar is the only var provided by an idtoken var source, i.e.
+// ((name.token)).
+const TokenVar = "token"
+
+type SecretsFactory struct {
+	issuer    *Issuer
+	audience  []string
+	expiresIn time.Duration
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		issuer:    factory.issuer,
+		audience:  factory.audience,
+		expiresIn: factory.expiresIn,
+		now:       time.Now,
+	}
+}
+
+type Secrets struct {
+	issuer    *Issuer
+	audience  []string
+	expiresIn time.Duration
+	now       func() time.Time
+
+	scope *creds.BuildScope
+}
+
+func (secrets *Secrets) NewSecretLookupPaths(string, string, bool) []creds.SecretLookupPath {
+	return nil
+}
+
+func (secrets *Secrets) WithScope(scope creds.BuildScope) creds.Secrets {
+	scoped := *secrets
+	scoped.scope = &scope
+	return &scoped
+}
+
+// Get issues a new token for the scope the secrets are being resolved for.
+// The subject is the team and pipeline, including the instance vars of a
+// pipeline instance, followed by the job when the token is issued to a job
+// build.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	if secretPath != TokenVar {
+		return nil, nil, false, nil
+	}
+
+	if secrets.scope == nil {
+		return nil, nil, false, errors.New("id tokens can only be issued within a pipeline")
+	}
+
+	now := secrets.now()
+	expiry := now.Add(secrets.expiresIn)
+
+	scope := secrets.scope
+
+	pipelineRef := atc.PipelineRef{
+		Name:         scope.PipelineName,
+		InstanceVars: scope.InstanceVars,
+	}
+
+	token, err := secrets.issuer.Sign(Claims{
+		Claims: jwt.Claims{
+			Subject:   path.Join(scope.TeamName, pipelineRef.String(), scope.JobName),
+			Audience:  jwt.Audience(secrets.audience),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(expiry),
+		},
+		Team:         scope.TeamName,
+		Pipeline:     scope.PipelineName,
+		InstanceVars: scope.InstanceVars,
+		Job:          scope.JobName,
+		BuildID:      scope.BuildID,
+		BuildName:    scope.BuildName,
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	return token, &expiry, true, nil
+}
@@ -0,0 +1,183 @@
+package idtoken_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/idtoken"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets", func() {
+	var (
+		signingKey *rsa.PrivateKey
+		issuer     *idtoken.Issuer
+		config     map[string]interface{}
+
+		secrets creds.Secrets
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		issuer, err = idtoken.NewIssuer("https://concourse.example.com/", signingKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		idtoken.UseIssuer(issuer)
+
+		config = map[string]interface{}{
+			"audience":   []interface{}{"sts.amazonaws.com"},
+			"expires_in": "15m",
+		}
+	})
+
+	JustBeforeEach(func() {
+		manager, err := idtoken.NewManagerFactory().NewInstance(config)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(manager.Validate()).To(Succeed())
+
+		secretsFactory, err := manager.NewSecretsFactory(lagertest.NewTestLogger("test"))
+		Expect(err).ToNot(HaveOccurred())
+
+		secrets = secretsFactory.NewSecrets()
+	})
+
+	Context("when scoped to a build", func() {
+		JustBeforeEach(func() {
+			secrets = secrets.(creds.ScopedSecrets).WithScope(creds.BuildScope{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				BuildID:      42,
+				BuildName:    "7",
+			})
+		})
+
+		It("issues a token signed by the issuer", func() {
+			value, expiry, found, err := secrets.Get("token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			token, err := jwt.ParseSigned(value.(string))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(token.Headers).To(HaveLen(1))
+			Expect(token.Headers[0].KeyID).To(Equal(issuer.KeySet().Keys[0].KeyID))
+
+			var claims idtoken.Claims
+			err = token.Claims(&signingKey.PublicKey, &claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(claims.Issuer).To(Equal("https://concourse.example.com"))
+			Expect(claims.Subject).To(Equal("some-team/some-pipeline/some-job"))
+			Expect(claims.Audience).To(Equal(jwt.Audience{"sts.amazonaws.com"}))
+			Expect(claims.Team).To(Equal("some-team"))
+			Expect(claims.Pipeline).To(Equal("some-pipeline"))
+			Expect(claims.Job).To(Equal("some-job"))
+			Expect(claims.BuildID).To(Equal(42))
+			Expect(claims.BuildName).To(Equal("7"))
+
+			Expect(claims.Expiry.Time()).To(BeTemporally("~", time.Now().Add(15*time.Minute), time.Minute))
+			Expect(*expiry).To(BeTemporally("~", claims.Expiry.Time(), time.Second))
+		})
+
+		It("does not provide any other vars", func() {
+			_, _, found, err := secrets.Get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when no audience is configured", func() {
+			BeforeEach(func() {
+				delete(config, "audience")
+			})
+
+			It("defaults the audience to the issuer", func() {
+				value, _, _, err := secrets.Get("token")
+				Expect(err).ToNot(HaveOccurred())
+
+				token, err := jwt.ParseSigned(value.(string))
+				Expect(err).ToNot(HaveOccurred())
+
+				var claims idtoken.Claims
+				err = token.Claims(&signingKey.PublicKey, &claims)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(claims.Audience).To(Equal(jwt.Audience{"https://concourse.example.com"}))
+			})
+		})
+	})
+
+	Context("when scoped to a pipeline without a build", func() {
+		JustBeforeEach(func() {
+			secrets = secrets.(creds.ScopedSecrets).WithScope(creds.BuildScope{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+			})
+		})
+
+		It("issues a token for the pipeline", func() {
+			value, _, found, err := secrets.Get("token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			token, err := jwt.ParseSigned(value.(string))
+			Expect(err).ToNot(HaveOccurred())
+
+			var claims idtoken.Claims
+			err = token.Claims(&signingKey.PublicKey, &claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(claims.Subject).To(Equal("some-team/some-pipeline"))
+			Expect(claims.Job).To(BeEmpty())
+			Expect(claims.BuildID).To(BeZero())
+		})
+	})
+
+	Context("when scoped to a build of a pipeline instance", func() {
+		JustBeforeEach(func() {
+			secrets = secrets.(creds.ScopedSecrets).WithScope(creds.BuildScope{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "main"},
+				JobName:      "some-job",
+				BuildID:      42,
+				BuildName:    "7",
+			})
+		})
+
+		It("identifies the instance in the subject and claims", func() {
+			value, _, found, err := secrets.Get("token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			token, err := jwt.ParseSigned(value.(string))
+			Expect(err).ToNot(HaveOccurred())
+
+			var claims idtoken.Claims
+			err = token.Claims(&signingKey.PublicKey, &claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(claims.Subject).To(Equal("some-team/some-pipeline/branch:main/some-job"))
+			Expect(claims.Pipeline).To(Equal("some-pipeline"))
+			Expect(claims.InstanceVars).To(Equal(atc.InstanceVars{"branch": "main"}))
+		})
+	})
+
+	Context("when not scoped", func() {
+		It("returns an error", func() {
+			_, _, _, err := secrets.Get("token")
+			Expect(err).To(MatchError("id tokens can only be issued within a pipeline"))
+		})
+	})
+})
@@ -2,6 +2,8 @@ package creds
 
 import (
 	"time"
+
+	"github.com/concourse/concourse/atc"
 )
 
 //go:generate counterfeiter . SecretsFactory
@@ -20,3 +22,22 @@ type Secrets interface {
 	// NewSecretLookupPaths returns an instance of lookup policy, which can transform pipeline ((var)) into one or more secret paths, based on team name and pipeline name
 	NewSecretLookupPaths(string, string, bool) []SecretLookupPath
 }
+
+// BuildScope identifies what a pipeline's vars are being resolved for. The job
+// and build are only set when resolving vars for a job build.
+type BuildScope struct {
+	TeamName     string
+	PipelineName string
+	InstanceVars atc.InstanceVars
+	JobName      string
+	BuildID      int
+	BuildName    string
+}
+
+// ScopedSecrets is implemented by Secrets whose values depend on what they are
+// being resolved for, e.g. ID tokens carrying claims about the build.
+type ScopedSecrets interface {
+	Secrets
+
+	WithScope(BuildScope) Secrets
+}
@@ -0,0 +1,245 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . AuditEventRepository
+
+// AuditEventRepository persists the audit trail of API requests.
+//
+// Events are stored by team name rather than team ID so that the trail
+// outlives the teams it refers to.
+//
+// A request is saved before it is handled and its status is updated once it
+// has been responded to, so that long-lived requests such as event streams
+// and hijacked containers are in the trail from the moment they are made.
+type AuditEventRepository interface {
+	Save(atc.AuditEvent) (int, error)
+	UpdateStatus(id int, status int) error
+	TeamAuditEvents(teamName string, page Page) ([]atc.AuditEvent, Pagination, error)
+
+	UndrainedAuditEvents(limit int) ([]atc.AuditEvent, error)
+	MarkDrained(ids []int) error
+}
+
+var auditEventsQuery = psql.Select(
+	"e.id",
+	"e.time",
+	"e.action",
+	"e.actor",
+	"e.source_ip",
+	"e.team_name",
+	"e.targets",
+	"e.status",
+).From("audit_events e")
+
+type auditEventRepository struct {
+	conn Conn
+}
+
+func NewAuditEventRepository(conn Conn) AuditEventRepository {
+	return &auditEventRepository{
+		conn: conn,
+	}
+}
+
+func (r *auditEventRepository) Save(event atc.AuditEvent) (int, error) {
+	targets, err := json.Marshal(event.Targets)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = psql.Insert("audit_events").
+		SetMap(map[string]interface{}{
+			"time":      time.Unix(event.Time, 0),
+			"action":    event.Action,
+			"actor":     event.Actor,
+			"source_ip": sql.NullString{String: event.SourceIP, Valid: event.SourceIP != ""},
+			"team_name": sql.NullString{String: event.TeamName, Valid: event.TeamName != ""},
+			"targets":   targets,
+			"status":    event.Status,
+		}).
+		Suffix("RETURNING id").
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateStatus records the status an event's request was responded to with.
+// The event is drained again so that the status reaches the syslog as well.
+func (r *auditEventRepository) UpdateStatus(id int, status int) error {
+	_, err := psql.Update("audit_events").
+		Set("status", status).
+		Set("drained", false).
+		Where(sq.Eq{"id": id}).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
+func (r *auditEventRepository) TeamAuditEvents(teamName string, page Page) ([]atc.AuditEvent, Pagination, error) {
+	query := auditEventsQuery.
+		Where(sq.Eq{"e.team_name": teamName}).
+		Limit(uint64(page.Limit))
+
+	reverse := false
+	switch {
+	case page.Since == 0 && page.Until == 0:
+		query = query.OrderBy("e.id DESC")
+	case page.Until != 0 && page.Since == 0:
+		query = query.Where(sq.Gt{"e.id": page.Until}).OrderBy("e.id ASC")
+		reverse = true
+	case page.Since != 0 && page.Until == 0:
+		query = query.Where(sq.Lt{"e.id": page.Since}).OrderBy("e.id DESC")
+	default:
+		if page.Until > page.Since {
+			return nil, Pagination{}, fmt.Errorf("Invalid range boundaries")
+		}
+
+		query = query.Where(sq.And{
+			sq.Gt{"e.id": page.Until},
+			sq.Lt{"e.id": page.Since},
+		}).OrderBy("e.id ASC")
+		reverse = true
+	}
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Rollback(tx)
+
+	events, err := queryAuditEvents(query.RunWith(tx))
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	if reverse {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+
+	if len(events) == 0 {
+		return events, Pagination{}, nil
+	}
+
+	var minID, maxID int
+	err = psql.Select("COALESCE(MAX(id), 0)", "COALESCE(MIN(id), 0)").
+		From("audit_events").
+		Where(sq.Eq{"team_name": teamName}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&maxID, &minID)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	first := events[0]
+	last := events[len(events)-1]
+
+	var pagination Pagination
+	if first.ID < maxID {
+		pagination.Previous = &Page{
+			Until: first.ID,
+			Limit: page.Limit,
+		}
+	}
+
+	if last.ID > minID {
+		pagination.Next = &Page{
+			Since: last.ID,
+			Limit: page.Limit,
+		}
+	}
+
+	return events, pagination, nil
+}
+
+func (r *auditEventRepository) UndrainedAuditEvents(limit int) ([]atc.AuditEvent, error) {
+	return queryAuditEvents(auditEventsQuery.
+		Where(sq.Eq{"e.drained": false}).
+		OrderBy("e.id ASC").
+		Limit(uint64(limit)).
+		RunWith(r.conn))
+}
+
+func (r *auditEventRepository) MarkDrained(ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := psql.Update("audit_events").
+		Set("drained", true).
+		Where(sq.Eq{"id": ids}).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
+func queryAuditEvents(query sq.SelectBuilder) ([]atc.AuditEvent, error) {
+	rows, err := query.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		var (
+			event              atc.AuditEvent
+			eventTime          time.Time
+			sourceIP, teamName sql.NullString
+			targets            []byte
+		)
+
+		err := rows.Scan(
+			&event.ID,
+			&eventTime,
+			&event.Action,
+			&event.Actor,
+			&sourceIP,
+			&teamName,
+			&targets,
+			&event.Status,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Time = eventTime.Unix()
+		event.SourceIP = sourceIP.String
+		event.TeamName = teamName.String
+
+		if targets != nil {
+			err = json.Unmarshal(targets, &event.Targets)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
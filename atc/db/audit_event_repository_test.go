@@ -0,0 +1,121 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventRepository", func() {
+	var repository db.AuditEventRepository
+
+	BeforeEach(func() {
+		repository = db.NewAuditEventRepository(dbConn)
+	})
+
+	saveEvents := func(teamName string, count int) {
+		for i := 0; i < count; i++ {
+			_, err := repository.Save(atc.AuditEvent{
+				Time:     time.Now().Unix(),
+				Action:   atc.PausePipeline,
+				Actor:    "some-user",
+				SourceIP: "10.0.0.1",
+				TeamName: teamName,
+				Targets:  map[string]string{"pipeline_name": "some-pipeline"},
+				Status:   200,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	Describe("TeamAuditEvents", func() {
+		BeforeEach(func() {
+			saveEvents("some-team", 3)
+			saveEvents("other-team", 1)
+		})
+
+		It("returns the team's events, newest first", func() {
+			events, _, err := repository.TeamAuditEvents("some-team", db.Page{Limit: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(3))
+
+			Expect(events[0].ID).To(BeNumerically(">", events[1].ID))
+			Expect(events[0].Action).To(Equal(atc.PausePipeline))
+			Expect(events[0].Actor).To(Equal("some-user"))
+			Expect(events[0].SourceIP).To(Equal("10.0.0.1"))
+			Expect(events[0].TeamName).To(Equal("some-team"))
+			Expect(events[0].Targets).To(Equal(map[string]string{"pipeline_name": "some-pipeline"}))
+			Expect(events[0].Status).To(Equal(200))
+		})
+
+		It("paginates", func() {
+			events, pagination, err := repository.TeamAuditEvents("some-team", db.Page{Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(pagination.Previous).To(BeNil())
+			Expect(pagination.Next).To(Equal(&db.Page{Since: events[1].ID, Limit: 2}))
+
+			older, pagination, err := repository.TeamAuditEvents("some-team", *pagination.Next)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(older).To(HaveLen(1))
+			Expect(pagination.Previous).To(Equal(&db.Page{Until: older[0].ID, Limit: 2}))
+			Expect(pagination.Next).To(BeNil())
+
+			newer, _, err := repository.TeamAuditEvents("some-team", *pagination.Previous)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newer).To(Equal(events))
+		})
+	})
+
+	Describe("UpdateStatus", func() {
+		var id int
+
+		BeforeEach(func() {
+			var err error
+			id, err = repository.Save(atc.AuditEvent{
+				Time:     time.Now().Unix(),
+				Action:   atc.HijackContainer,
+				Actor:    "some-user",
+				TeamName: "some-team",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = repository.MarkDrained([]int{id})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the status and drains the event again", func() {
+			err := repository.UpdateStatus(id, 101)
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := repository.UndrainedAuditEvents(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].ID).To(Equal(id))
+			Expect(events[0].Status).To(Equal(101))
+		})
+	})
+
+	Describe("draining", func() {
+		BeforeEach(func() {
+			saveEvents("some-team", 2)
+		})
+
+		It("returns events until they are marked as drained", func() {
+			events, err := repository.UndrainedAuditEvents(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+
+			err = repository.MarkDrained([]int{events[0].ID})
+			Expect(err).NotTo(HaveOccurred())
+
+			undrained, err := repository.UndrainedAuditEvents(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(undrained).To(Equal(events[1:]))
+		})
+	})
+})
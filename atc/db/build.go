@@ -29,6 +29,7 @@ type BuildInput struct {
 
 	FirstOccurrence bool
 	ResolveError    string
+	ResolveReason   atc.InputSchedulingReason
 }
 
 type BuildOutput struct {
@@ -73,7 +74,11 @@ var buildsQuery = psql.Select(`
 		b.inputs_ready,
 		b.rerun_of,
 		r.name,
-		b.rerun_number
+		b.rerun_number,
+		b.span_context,
+		b.log_archive,
+		b.resume_of,
+		b.annotations
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -116,6 +121,8 @@ type Build interface {
 	RerunOf() int
 	RerunOfName() string
 	RerunNumber() int
+	ResumeOf() int
+	SpanContext() SpanContext
 
 	Reload() (bool, error)
 
@@ -149,6 +156,25 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+
+	IndexLogs([]BuildLogLine) error
+
+	LogArchive() string
+	SetLogArchive(string) error
+
+	Annotations() map[string]string
+	SaveAnnotations(map[string]string) error
+
+	RequestStepApproval(atc.BuildStepApproval) error
+	StepApproval(atc.PlanID) (atc.BuildStepApproval, bool, error)
+	StepApprovals() ([]atc.BuildStepApproval, error)
+	DecideStepApproval(planID atc.PlanID, approved bool, decidedBy string, comment string) (bool, error)
+
+	SaveStepOutputs(stepKey string, outputs map[string]string) error
+	ResumedStepOutputs(stepKey string) (BuildStepOutputs, bool, error)
+
+	SaveTestResults([]atc.TestResult) error
+	TestSummary() (atc.TestSummary, error)
 }
 
 type build struct {
@@ -172,6 +198,8 @@ type build struct {
 	rerunOfName string
 	rerunNumber int
 
+	resumeOf int
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -184,6 +212,10 @@ type build struct {
 	drained   bool
 	aborted   bool
 	completed bool
+
+	spanContext SpanContext
+	logArchive  string
+	annotations map[string]string
 }
 
 func newEmptyBuild(conn Conn, lockFactory lock.LockFactory) *build {
@@ -230,6 +262,14 @@ func (b *build) InputsReady() bool    { return b.inputsReady }
 func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
+func (b *build) ResumeOf() int        { return b.resumeOf }
+func (b *build) LogArchive() string   { return b.logArchive }
+func (b *build) Annotations() map[string]string {
+	return b.annotations
+}
+func (b *build) SpanContext() SpanContext {
+	return b.spanContext
+}
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -401,6 +441,11 @@ func (b *build) Finish(status BuildStatus) error {
 	}
 
 	if b.jobID != 0 && status == BuildStatusSucceeded {
+		err = releaseStepOutputs(tx, b.id)
+		if err != nil {
+			return err
+		}
+
 		_, err = psql.Delete("build_image_resource_caches birc USING builds b").
 			Where(sq.Expr("birc.build_id = b.id")).
 			Where(sq.Lt{"build_id": b.id}).
@@ -525,6 +570,19 @@ func (b *build) Finish(status BuildStatus) error {
 		}
 	}
 
+	_, err = psql.Delete("build_queue").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	notificationsQueued, err := queueNotificationDeliveries(tx, b.teamID, b.id, status)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -535,6 +593,13 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	if notificationsQueued {
+		err = b.conn.Bus().Notify(atc.ComponentBuildNotifier)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -551,6 +616,44 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// SetLogArchive records where the build's events have been archived to, so
+// that they can still be read once they've been reaped from the database.
+func (b *build) SetLogArchive(location string) error {
+	_, err := psql.Update("builds").
+		Set("log_archive", location).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+
+	if err == nil {
+		b.logArchive = location
+	}
+	return err
+}
+
+// SaveAnnotations merges the given annotations into the build's annotations,
+// replacing the values of any keys which were already set.
+func (b *build) SaveAnnotations(annotations map[string]string) error {
+	annotationsJSON, err := json.Marshal(annotations)
+	if err != nil {
+		return err
+	}
+
+	var mergedJSON []byte
+	err = psql.Update("builds").
+		Set("annotations", sq.Expr("COALESCE(annotations, '{}'::jsonb) || ?::jsonb", string(annotationsJSON))).
+		Where(sq.Eq{"id": b.id}).
+		Suffix("RETURNING annotations").
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&mergedJSON)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(mergedJSON, &b.annotations)
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -695,17 +798,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -791,6 +884,11 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		}
 	}
 
+	queuePosition, _, err := buildQueuePosition(b.conn, b.id)
+	if err != nil {
+		return BuildPreparation{}, false, err
+	}
+
 	buildPreparation := BuildPreparation{
 		BuildID:             b.id,
 		PausedPipeline:      pausedPipelineStatus,
@@ -799,6 +897,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		Inputs:              inputs,
 		InputsSatisfied:     inputsSatisfiedStatus,
 		MissingInputReasons: missingInputReasons,
+		QueuePosition:       queuePosition,
 	}
 
 	return buildPreparation, true, nil
@@ -1420,10 +1519,10 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
+		jobID, pipelineID, rerunOf, rerunNumber, resumeOf                   sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                            pq.NullTime
-		nonce                                                               sql.NullString
+		nonce, spanContext, logArchive, annotations                         sql.NullString
 		drained, aborted, completed                                         bool
 		status                                                              string
 	)
@@ -1455,6 +1554,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOf,
 		&rerunOfName,
 		&rerunNumber,
+		&spanContext,
+		&logArchive,
+		&resumeOf,
+		&annotations,
 	)
 	if err != nil {
 		return err
@@ -1476,6 +1579,8 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.resumeOf = int(resumeOf.Int64)
+	b.logArchive = logArchive.String
 
 	var (
 		noncense      *string
@@ -1506,6 +1611,20 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		}
 	}
 
+	if spanContext.Valid {
+		err = json.Unmarshal([]byte(spanContext.String), &b.spanContext)
+		if err != nil {
+			return err
+		}
+	}
+
+	if annotations.Valid {
+		err = json.Unmarshal([]byte(annotations.String), &b.annotations)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
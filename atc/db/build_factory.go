@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -14,11 +15,14 @@ import (
 
 type BuildFactory interface {
 	Build(int) (Build, bool, error)
-	VisibleBuilds([]string, Page) ([]Build, Pagination, error)
-	AllBuilds(Page) ([]Build, Pagination, error)
+	// VisibleBuilds and AllBuilds only return builds which have all of the
+	// given annotations, if any.
+	VisibleBuilds(teamNames []string, page Page, annotations map[string]string) ([]Build, Pagination, error)
+	AllBuilds(page Page, annotations map[string]string) ([]Build, Pagination, error)
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetLogIndexableBuilds(limit int) ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -55,30 +59,55 @@ func (f *buildFactory) Build(buildID int) (Build, bool, error) {
 	return build, true, nil
 }
 
-func (f *buildFactory) VisibleBuilds(teamNames []string, page Page) ([]Build, Pagination, error) {
-	newBuildsQuery := buildsQuery.
+func (f *buildFactory) VisibleBuilds(teamNames []string, page Page, annotations map[string]string) ([]Build, Pagination, error) {
+	newBuildsQuery, newMinMaxIdQuery, err := filterBuildsByAnnotations(buildsQuery, minMaxIdQuery, annotations)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	newBuildsQuery = newBuildsQuery.
 		Where(sq.Or{
 			sq.Eq{"p.public": true},
 			sq.Eq{"t.name": teamNames},
 		})
 
 	if page.UseDate {
-		return getBuildsWithDates(newBuildsQuery, minMaxIdQuery, page, f.conn,
+		return getBuildsWithDates(newBuildsQuery, newMinMaxIdQuery, page, f.conn,
 			f.lockFactory)
 	}
-	return getBuildsWithPagination(newBuildsQuery, minMaxIdQuery, page, f.conn,
+	return getBuildsWithPagination(newBuildsQuery, newMinMaxIdQuery, page, f.conn,
 		f.lockFactory)
 }
 
-func (f *buildFactory) AllBuilds(page Page) ([]Build, Pagination, error) {
+func (f *buildFactory) AllBuilds(page Page, annotations map[string]string) ([]Build, Pagination, error) {
+	newBuildsQuery, newMinMaxIdQuery, err := filterBuildsByAnnotations(buildsQuery, minMaxIdQuery, annotations)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
 	if page.UseDate {
-		return getBuildsWithDates(buildsQuery, minMaxIdQuery, page, f.conn,
+		return getBuildsWithDates(newBuildsQuery, newMinMaxIdQuery, page, f.conn,
 			f.lockFactory)
 	}
-	return getBuildsWithPagination(buildsQuery, minMaxIdQuery,
+	return getBuildsWithPagination(newBuildsQuery, newMinMaxIdQuery,
 		page, f.conn, f.lockFactory)
 }
 
+func filterBuildsByAnnotations(buildsQuery, minMaxIdQuery sq.SelectBuilder, annotations map[string]string) (sq.SelectBuilder, sq.SelectBuilder, error) {
+	if len(annotations) == 0 {
+		return buildsQuery, minMaxIdQuery, nil
+	}
+
+	annotationsJSON, err := json.Marshal(annotations)
+	if err != nil {
+		return sq.SelectBuilder{}, sq.SelectBuilder{}, err
+	}
+
+	contains := sq.Expr("b.annotations @> ?::jsonb", string(annotationsJSON))
+
+	return buildsQuery.Where(contains), minMaxIdQuery.Where(contains), nil
+}
+
 func (f *buildFactory) PublicBuilds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(
 		buildsQuery.Where(sq.Eq{"p.public": true}), minMaxIdQuery,
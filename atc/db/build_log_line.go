@@ -0,0 +1,229 @@
+package db
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildLogLine is a line of output printed by a step of a build.
+type BuildLogLine struct {
+	// Origin is the ID of the plan of the step which printed the line.
+	Origin string
+	Line   string
+}
+
+// BuildLogSearch filters the builds whose logs are searched. Only Query is
+// required; zero values for the other fields do not filter anything.
+type BuildLogSearch struct {
+	Query string
+
+	// Pipeline restricts the search to a single pipeline instance when its
+	// name is set; without instance vars, it is the pipeline which has none.
+	Pipeline atc.PipelineRef
+	JobName  string
+
+	// Since and Until bound the start time of the builds.
+	Since time.Time
+	Until time.Time
+
+	// Limit is the maximum number of builds to return.
+	Limit int
+}
+
+// BuildLogSearchResult is a build whose logs matched a search, along with the
+// first few lines that matched.
+type BuildLogSearchResult struct {
+	Build Build
+	Lines []BuildLogLine
+}
+
+const (
+	buildLogLinesBatchSize     = 500
+	buildLogSearchLinesPerHit  = 5
+	buildLogSearchDefaultLimit = 50
+	buildLogTextSearchConfig   = "simple"
+)
+
+// IndexLogs stores the lines of the build's logs for searching and marks the
+// build as indexed. The lines are not stored if the build's events have been
+// reaped in the meantime.
+func (b *build) IndexLogs(lines []BuildLogLine) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	// lock the build so that its events can't be reaped while indexing them;
+	// the reaper clears the lines of the build after setting its reap time
+	var reaped bool
+	err = psql.Select("reap_time IS NOT NULL").
+		From("builds").
+		Where(sq.Eq{"id": b.id}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&reaped)
+	if err != nil {
+		return err
+	}
+
+	if !reaped {
+		for start := 0; start < len(lines); start += buildLogLinesBatchSize {
+			end := start + buildLogLinesBatchSize
+			if end > len(lines) {
+				end = len(lines)
+			}
+
+			insert := psql.Insert("build_log_lines").
+				Columns("build_id", "team_id", "origin", "line", "search")
+
+			for _, line := range lines[start:end] {
+				insert = insert.Values(
+					b.id,
+					b.teamID,
+					line.Origin,
+					line.Line,
+					sq.Expr("to_tsvector('"+buildLogTextSearchConfig+"', ?)", line.Line),
+				)
+			}
+
+			_, err = insert.RunWith(tx).Exec()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = psql.Update("builds").
+		Set("logs_indexed", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) SearchBuildLogs(search BuildLogSearch) ([]BuildLogSearchResult, error) {
+	limit := search.Limit
+	if limit <= 0 {
+		limit = buildLogSearchDefaultLimit
+	}
+
+	// the subquery is built with the default placeholders, which the outer
+	// query then numbers
+	matches := sq.Select(
+		"l.build_id",
+		"l.origin",
+		"l.line",
+		"row_number() OVER (PARTITION BY l.build_id ORDER BY l.id) AS line_rank",
+		"dense_rank() OVER (ORDER BY l.build_id DESC) AS build_rank",
+	).
+		From("build_log_lines l").
+		Join("builds b ON b.id = l.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines p ON p.id = b.pipeline_id").
+		Where(sq.Eq{"l.team_id": t.id}).
+		Where(sq.Expr("l.search @@ plainto_tsquery('"+buildLogTextSearchConfig+"', ?)", search.Query))
+
+	if search.Pipeline.Name != "" {
+		instanceVars, err := instanceVarsPayload(search.Pipeline.InstanceVars)
+		if err != nil {
+			return nil, err
+		}
+
+		matches = matches.Where(sq.Eq{
+			"p.name":          search.Pipeline.Name,
+			"p.instance_vars": instanceVars,
+		})
+	}
+
+	if search.JobName != "" {
+		matches = matches.Where(sq.Eq{"j.name": search.JobName})
+	}
+
+	if !search.Since.IsZero() {
+		matches = matches.Where(sq.GtOrEq{"b.start_time": search.Since})
+	}
+
+	if !search.Until.IsZero() {
+		matches = matches.Where(sq.LtOrEq{"b.start_time": search.Until})
+	}
+
+	rows, err := psql.Select("m.build_id", "m.origin", "m.line").
+		FromSelect(matches, "m").
+		Where(sq.LtOrEq{
+			"m.line_rank":  buildLogSearchLinesPerHit,
+			"m.build_rank": limit,
+		}).
+		OrderBy("m.build_id DESC", "m.line_rank").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	buildIDs := []int{}
+	linesByBuild := map[int][]BuildLogLine{}
+	for rows.Next() {
+		var (
+			buildID int
+			line    BuildLogLine
+		)
+
+		err = rows.Scan(&buildID, &line.Origin, &line.Line)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := linesByBuild[buildID]; !found {
+			buildIDs = append(buildIDs, buildID)
+		}
+
+		linesByBuild[buildID] = append(linesByBuild[buildID], line)
+	}
+
+	if len(buildIDs) == 0 {
+		return []BuildLogSearchResult{}, nil
+	}
+
+	builds, err := getBuilds(
+		buildsQuery.Where(sq.Eq{"b.id": buildIDs}).OrderBy("b.id DESC"),
+		t.conn,
+		t.lockFactory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BuildLogSearchResult, len(builds))
+	for i, build := range builds {
+		results[i] = BuildLogSearchResult{
+			Build: build,
+			Lines: linesByBuild[build.ID()],
+		}
+	}
+
+	return results, nil
+}
+
+func (f *buildFactory) GetLogIndexableBuilds(limit int) ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.completed":    true,
+			"b.logs_indexed": false,
+		}).
+		Where(sq.Expr("b.reap_time IS NULL")).
+		OrderBy("b.id").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
@@ -0,0 +1,186 @@
+package db_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build log lines", func() {
+	var build db.Build
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultJob.CreateBuild(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.Finish(db.BuildStatusFailed)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("GetLogIndexableBuilds", func() {
+		It("returns completed builds which have not been indexed", func() {
+			builds, err := buildFactory.GetLogIndexableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildIDs(builds)).To(ContainElement(build.ID()))
+		})
+
+		It("does not return builds which are still running", func() {
+			running, err := defaultJob.CreateBuild(context.TODO())
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, err := buildFactory.GetLogIndexableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildIDs(builds)).ToNot(ContainElement(running.ID()))
+		})
+
+		It("does not return builds which have been indexed", func() {
+			err := build.IndexLogs(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, err := buildFactory.GetLogIndexableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildIDs(builds)).ToNot(ContainElement(build.ID()))
+		})
+
+		It("does not return builds which have been reaped", func() {
+			err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, err := buildFactory.GetLogIndexableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildIDs(builds)).ToNot(ContainElement(build.ID()))
+		})
+	})
+
+	Describe("SearchBuildLogs", func() {
+		BeforeEach(func() {
+			err := build.IndexLogs([]db.BuildLogLine{
+				{Origin: "some-plan-id", Line: "fetching dependencies"},
+				{Origin: "some-plan-id", Line: "panic: something went wrong"},
+				{Origin: "other-plan-id", Line: "something else"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the builds with the lines matching the query", func() {
+			results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query: "something wrong",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Build.ID()).To(Equal(build.ID()))
+			Expect(results[0].Lines).To(Equal([]db.BuildLogLine{
+				{Origin: "some-plan-id", Line: "panic: something went wrong"},
+			}))
+		})
+
+		It("does not return builds without matching lines", func() {
+			results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query: "segfault",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		It("does not return builds of other teams", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			results, err := otherTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query: "something",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		It("filters by pipeline and job", func() {
+			results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query:    "something",
+				Pipeline: atc.PipelineRef{Name: "default-pipeline"},
+				JobName:  "some-job",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+
+			results, err = defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query:   "something",
+				JobName: "some-other-job",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		It("does not return builds of other instances of the pipeline", func() {
+			results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query: "something",
+				Pipeline: atc.PipelineRef{
+					Name:         "default-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		It("filters by the start time of the builds", func() {
+			results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+				Query: "something",
+				Since: time.Now().Add(time.Hour),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		Context("when the logs of the build are reaped", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("no longer returns the build", func() {
+				results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+					Query: "something",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("IndexLogs", func() {
+		Context("when the build has been reaped", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not index the lines", func() {
+				err := build.IndexLogs([]db.BuildLogLine{
+					{Origin: "some-plan-id", Line: "something"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				results, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+					Query: "something",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(BeEmpty())
+			})
+		})
+	})
+})
+
+func buildIDs(builds []db.Build) []int {
+	ids := []int{}
+	for _, build := range builds {
+		ids = append(ids, build.ID())
+	}
+
+	return ids
+}
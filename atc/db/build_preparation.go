@@ -38,4 +38,8 @@ type BuildPreparation struct {
 	Inputs              map[string]BuildPreparationStatus
 	InputsSatisfied     BuildPreparationStatus
 	MissingInputReasons MissingInputReasons
+
+	// QueuePosition is the position of the build in the BuildQueue, starting
+	// at 1, or 0 if the build is not queued.
+	QueuePosition int
 }
@@ -0,0 +1,160 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . BuildQueue
+
+// BuildQueue is the cluster-wide queue of pending builds which are ready to
+// start while the workers have no free task slots. It orders them by the
+// priority of their jobs, then by the share of the workers used by their
+// teams, i.e. their number of running builds, and then by the time they were
+// queued.
+//
+// The queue only applies when the number of active tasks per worker is
+// limited; otherwise every build is admitted immediately.
+type BuildQueue interface {
+	// Admit queues the build if it is not queued yet, and admits it if its
+	// position in the queue is within the number of free task slots. It
+	// returns the position of the build if it remains queued.
+	Admit(build Build, priority int) (bool, int, error)
+}
+
+type buildQueue struct {
+	conn                    Conn
+	maxActiveTasksPerWorker int
+}
+
+func NewBuildQueue(conn Conn, maxActiveTasksPerWorker int) BuildQueue {
+	return &buildQueue{
+		conn:                    conn,
+		maxActiveTasksPerWorker: maxActiveTasksPerWorker,
+	}
+}
+
+// Admit reserves a task slot for each build it admits, as the workers' active
+// tasks only account for a build once its first task has been placed, which
+// may be long after it started. The reservation is kept as the build's row in
+// the queue, and lasts until the build has a task container or finishes.
+func (q *buildQueue) Admit(build Build, priority int) (bool, int, error) {
+	if q.maxActiveTasksPerWorker == 0 {
+		return true, 0, nil
+	}
+
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return false, 0, err
+	}
+
+	defer Rollback(tx)
+
+	// serialize admissions across ATCs, so that free slots are only ever
+	// handed out once
+	_, err = tx.Exec(`LOCK TABLE build_queue IN SHARE ROW EXCLUSIVE MODE`)
+	if err != nil {
+		return false, 0, err
+	}
+
+	var alreadyAdmitted bool
+	err = psql.Insert("build_queue").
+		Columns("build_id", "team_id", "priority").
+		Values(build.ID(), build.TeamID(), priority).
+		Suffix("ON CONFLICT (build_id) DO UPDATE SET priority = EXCLUDED.priority RETURNING admitted").
+		RunWith(tx).
+		QueryRow().
+		Scan(&alreadyAdmitted)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if alreadyAdmitted {
+		err = tx.Commit()
+		if err != nil {
+			return false, 0, err
+		}
+
+		return true, 0, nil
+	}
+
+	var freeSlots int
+	err = psql.Select().
+		Column(sq.Expr("COALESCE(SUM(GREATEST(? - active_tasks, 0)), 0)", q.maxActiveTasksPerWorker)).
+		From("workers").
+		Where(sq.Eq{"state": string(WorkerStateRunning)}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&freeSlots)
+	if err != nil {
+		return false, 0, err
+	}
+
+	var reservedSlots int
+	err = psql.Select("COUNT(*)").
+		From("build_queue q").
+		Where(sq.Expr("q.admitted")).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM containers c WHERE c.build_id = q.build_id AND c.meta_type = ?)", string(ContainerTypeTask))).
+		RunWith(tx).
+		QueryRow().
+		Scan(&reservedSlots)
+	if err != nil {
+		return false, 0, err
+	}
+
+	position, _, err := buildQueuePosition(tx, build.ID())
+	if err != nil {
+		return false, 0, err
+	}
+
+	admitted := position <= freeSlots-reservedSlots
+	if admitted {
+		_, err = psql.Update("build_queue").
+			Set("admitted", true).
+			Where(sq.Eq{"build_id": build.ID()}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return false, 0, err
+		}
+
+		position = 0
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return admitted, position, nil
+}
+
+func buildQueuePosition(runner sq.QueryRower, buildID int) (int, bool, error) {
+	var position int
+	err := runner.QueryRow(`
+		WITH queue AS (
+			SELECT q.build_id, row_number() OVER (
+				ORDER BY
+					q.priority DESC,
+					(SELECT COUNT(*) FROM builds rb WHERE rb.team_id = q.team_id AND rb.status = 'started') ASC,
+					q.queued_at ASC,
+					q.build_id ASC
+			) AS position
+			FROM build_queue q
+			JOIN builds b ON b.id = q.build_id
+			WHERE b.status = 'pending'
+			AND NOT q.admitted
+		)
+		SELECT position FROM queue WHERE build_id = $1
+	`, buildID).Scan(&position)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	return position, true, nil
+}
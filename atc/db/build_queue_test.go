@@ -0,0 +1,182 @@
+package db_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildQueue", func() {
+	var queue db.BuildQueue
+
+	createBuild := func() db.Build {
+		build, err := defaultJob.CreateBuild(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		return build
+	}
+
+	queuePosition := func(build db.Build) int {
+		prep, found, err := build.Preparation()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+		return prep.QueuePosition
+	}
+
+	Context("when the active tasks per worker are not limited", func() {
+		BeforeEach(func() {
+			queue = db.NewBuildQueue(dbConn, 0)
+		})
+
+		It("admits every build", func() {
+			admitted, position, err := queue.Admit(createBuild(), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+			Expect(position).To(BeZero())
+		})
+	})
+
+	Context("when the active tasks per worker are limited", func() {
+		BeforeEach(func() {
+			queue = db.NewBuildQueue(dbConn, 1)
+		})
+
+		Context("when the workers have free task slots", func() {
+			It("admits the build without queueing it", func() {
+				build := createBuild()
+
+				admitted, position, err := queue.Admit(build, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+				Expect(position).To(BeZero())
+				Expect(queuePosition(build)).To(BeZero())
+			})
+		})
+
+		Context("when a build has been admitted to the last free slot", func() {
+			var admittedBuild db.Build
+
+			BeforeEach(func() {
+				Expect(otherWorker.IncreaseActiveTasks()).To(Succeed())
+
+				admittedBuild = createBuild()
+
+				admitted, _, err := queue.Admit(admittedBuild, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+			})
+
+			It("reserves the slot until the build's first task starts", func() {
+				queued := createBuild()
+
+				admitted, position, err := queue.Admit(queued, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+				Expect(position).To(Equal(1))
+
+				By("admitting the build again")
+				admitted, _, err = queue.Admit(admittedBuild, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+
+				By("starting the admitted build's first task")
+				Expect(defaultWorker.IncreaseActiveTasks()).To(Succeed())
+				_, err = defaultWorker.CreateContainer(
+					db.NewBuildStepContainerOwner(admittedBuild.ID(), "some-plan", defaultTeam.ID()),
+					db.ContainerMetadata{Type: db.ContainerTypeTask},
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				admitted, position, err = queue.Admit(queued, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+				Expect(position).To(Equal(1))
+
+				By("finishing the task")
+				Expect(defaultWorker.DecreaseActiveTasks()).To(Succeed())
+
+				admitted, position, err = queue.Admit(queued, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+				Expect(position).To(BeZero())
+			})
+
+			It("releases the slot when the build finishes without running a task", func() {
+				queued := createBuild()
+
+				admitted, _, err := queue.Admit(queued, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+
+				Expect(admittedBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+				admitted, _, err = queue.Admit(queued, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+			})
+		})
+
+		Context("when the workers are saturated", func() {
+			BeforeEach(func() {
+				Expect(defaultWorker.IncreaseActiveTasks()).To(Succeed())
+				Expect(otherWorker.IncreaseActiveTasks()).To(Succeed())
+			})
+
+			It("queues builds by priority and then by the time they were queued", func() {
+				low := createBuild()
+				high := createBuild()
+				later := createBuild()
+
+				admitted, position, err := queue.Admit(low, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+				Expect(position).To(Equal(1))
+
+				admitted, position, err = queue.Admit(high, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+				Expect(position).To(Equal(1))
+
+				admitted, position, err = queue.Admit(later, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+				Expect(position).To(Equal(3))
+
+				Expect(queuePosition(high)).To(Equal(1))
+				Expect(queuePosition(low)).To(Equal(2))
+				Expect(queuePosition(later)).To(Equal(3))
+
+				By("freeing a task slot")
+				Expect(defaultWorker.DecreaseActiveTasks()).To(Succeed())
+
+				admitted, _, err = queue.Admit(low, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+
+				admitted, position, err = queue.Admit(high, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+				Expect(position).To(BeZero())
+
+				Expect(queuePosition(high)).To(BeZero())
+				Expect(queuePosition(low)).To(Equal(1))
+			})
+
+			It("removes finished builds from the queue", func() {
+				first := createBuild()
+				second := createBuild()
+
+				_, _, err := queue.Admit(first, 0)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = queue.Admit(second, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(queuePosition(second)).To(Equal(2))
+
+				Expect(first.Finish(db.BuildStatusAborted)).To(Succeed())
+				Expect(queuePosition(second)).To(Equal(1))
+			})
+		})
+	})
+})
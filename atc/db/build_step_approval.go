@@ -0,0 +1,177 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+var buildStepApprovalsQuery = psql.Select(
+	"a.plan_id",
+	"a.name",
+	"a.message",
+	"a.roles",
+	"a.users",
+	"a.approved",
+	"a.decided_by",
+	"a.comment",
+	"a.created_at",
+	"a.decided_at",
+).From("build_step_approvals a")
+
+// RequestStepApproval records that an 'approve' step is waiting to be
+// decided. Requesting an approval which was already requested, e.g. when the
+// build is resumed by another ATC, leaves the existing approval as-is.
+func (b *build) RequestStepApproval(approval atc.BuildStepApproval) error {
+	if approval.Roles == nil {
+		approval.Roles = []string{}
+	}
+
+	if approval.Users == nil {
+		approval.Users = []string{}
+	}
+
+	roles, err := json.Marshal(approval.Roles)
+	if err != nil {
+		return err
+	}
+
+	users, err := json.Marshal(approval.Users)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_step_approvals").
+		SetMap(map[string]interface{}{
+			"build_id": b.id,
+			"plan_id":  string(approval.PlanID),
+			"name":     approval.Name,
+			"message":  approval.Message,
+			"roles":    roles,
+			"users":    users,
+		}).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+func (b *build) StepApproval(planID atc.PlanID) (atc.BuildStepApproval, bool, error) {
+	approvals, err := b.queryStepApprovals(buildStepApprovalsQuery.
+		Where(sq.Eq{
+			"a.build_id": b.id,
+			"a.plan_id":  string(planID),
+		}))
+	if err != nil {
+		return atc.BuildStepApproval{}, false, err
+	}
+
+	if len(approvals) == 0 {
+		return atc.BuildStepApproval{}, false, nil
+	}
+
+	return approvals[0], true, nil
+}
+
+func (b *build) StepApprovals() ([]atc.BuildStepApproval, error) {
+	return b.queryStepApprovals(buildStepApprovalsQuery.
+		Where(sq.Eq{"a.build_id": b.id}).
+		OrderBy("a.created_at ASC"))
+}
+
+// DecideStepApproval approves or rejects a pending approval. It returns false
+// if there is no such approval or it was already decided.
+func (b *build) DecideStepApproval(planID atc.PlanID, approved bool, decidedBy string, comment string) (bool, error) {
+	result, err := psql.Update("build_step_approvals").
+		Set("approved", approved).
+		Set("decided_by", decidedBy).
+		Set("comment", comment).
+		Set("decided_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+			"approved": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (b *build) queryStepApprovals(query sq.SelectBuilder) ([]atc.BuildStepApproval, error) {
+	rows, err := query.RunWith(b.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	approvals := []atc.BuildStepApproval{}
+	for rows.Next() {
+		var (
+			approval                    atc.BuildStepApproval
+			planID                      string
+			roles, users                []byte
+			message, decidedBy, comment sql.NullString
+			approved                    sql.NullBool
+			createdAt                   time.Time
+			decidedAt                   pq.NullTime
+		)
+
+		err := rows.Scan(
+			&planID,
+			&approval.Name,
+			&message,
+			&roles,
+			&users,
+			&approved,
+			&decidedBy,
+			&comment,
+			&createdAt,
+			&decidedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(roles, &approval.Roles)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(users, &approval.Users)
+		if err != nil {
+			return nil, err
+		}
+
+		approval.PlanID = atc.PlanID(planID)
+		approval.Message = message.String
+		approval.DecidedBy = decidedBy.String
+		approval.Comment = comment.String
+		approval.CreatedAt = createdAt.Unix()
+
+		if approved.Valid {
+			approval.Approved = &approved.Bool
+		}
+
+		if decidedAt.Valid {
+			approval.DecidedAt = decidedAt.Time.Unix()
+		}
+
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
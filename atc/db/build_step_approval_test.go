@@ -0,0 +1,93 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build step approvals", func() {
+	var build db.Build
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		err = build.RequestStepApproval(atc.BuildStepApproval{
+			PlanID:  "some-plan-id",
+			Name:    "ship-it",
+			Message: "ship it?",
+			Roles:   []string{"owner"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("is pending once requested", func() {
+		approval, found, err := build.StepApproval("some-plan-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(approval.Pending()).To(BeTrue())
+		Expect(approval.Name).To(Equal("ship-it"))
+		Expect(approval.Message).To(Equal("ship it?"))
+		Expect(approval.Roles).To(Equal([]string{"owner"}))
+		Expect(approval.Users).To(BeEmpty())
+		Expect(approval.CreatedAt).NotTo(BeZero())
+	})
+
+	It("does not find approvals of other steps", func() {
+		_, found, err := build.StepApproval("other-plan-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("lists the build's approvals", func() {
+		approvals, err := build.StepApprovals()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(approvals).To(HaveLen(1))
+		Expect(approvals[0].PlanID).To(Equal(atc.PlanID("some-plan-id")))
+	})
+
+	Describe("DecideStepApproval", func() {
+		It("decides a pending approval once", func() {
+			decided, err := build.DecideStepApproval("some-plan-id", false, "some-user", "not today")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decided).To(BeTrue())
+
+			approval, _, err := build.StepApproval("some-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(approval.Pending()).To(BeFalse())
+			Expect(*approval.Approved).To(BeFalse())
+			Expect(approval.DecidedBy).To(Equal("some-user"))
+			Expect(approval.Comment).To(Equal("not today"))
+			Expect(approval.DecidedAt).NotTo(BeZero())
+
+			decided, err = build.DecideStepApproval("some-plan-id", true, "other-user", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decided).To(BeFalse())
+		})
+
+		It("keeps the decision when the approval is requested again", func() {
+			_, err := build.DecideStepApproval("some-plan-id", true, "some-user", "")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.RequestStepApproval(atc.BuildStepApproval{
+				PlanID: "some-plan-id",
+				Name:   "ship-it",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			approval, _, err := build.StepApproval("some-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*approval.Approved).To(BeTrue())
+		})
+
+		It("does not decide unknown approvals", func() {
+			decided, err := build.DecideStepApproval("other-plan-id", true, "some-user", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decided).To(BeFalse())
+		})
+	})
+})
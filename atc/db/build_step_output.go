@@ -0,0 +1,162 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// BuildStepOutputs are the artifacts produced by a step which succeeded in a
+// build, which a build resuming it may reuse instead of running the step
+// again.
+type BuildStepOutputs struct {
+	BuildID   int
+	BuildName string
+
+	// Outputs maps the names of the artifacts to their volume handles.
+	Outputs map[string]string
+}
+
+// SaveStepOutputs records that the step identified by the key succeeded,
+// producing the given artifacts. The volumes of the artifacts are retained as
+// worker artifacts of the build so that a rerun of the build from its failed
+// step can reuse them until the worker artifacts expire.
+func (b *build) SaveStepOutputs(stepKey string, outputs map[string]string) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	outputsJSON, err := json.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("build_step_outputs").
+		Columns("build_id", "step_key", "outputs").
+		Values(b.id, stepKey, outputsJSON).
+		Suffix("ON CONFLICT (build_id, step_key) DO UPDATE SET outputs = EXCLUDED.outputs").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for name, handle := range outputs {
+		var artifactID int
+		err = psql.Insert("worker_artifacts").
+			Columns("name", "build_id").
+			Values(name, b.id).
+			Suffix("RETURNING id").
+			RunWith(tx).
+			QueryRow().
+			Scan(&artifactID)
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Update("volumes").
+			Set("worker_artifact_id", artifactID).
+			Where(sq.Eq{"handle": handle}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ResumedStepOutputs returns the outputs of the step identified by the key in
+// the build which this build resumes. It returns false if the build does not
+// resume another build, if the step did not succeed in it, or if any of the
+// volumes of its outputs have gone away.
+func (b *build) ResumedStepOutputs(stepKey string) (BuildStepOutputs, bool, error) {
+	if b.resumeOf == 0 {
+		return BuildStepOutputs{}, false, nil
+	}
+
+	var (
+		buildName string
+		outputs   []byte
+	)
+
+	err := psql.Select("b.name", "o.outputs").
+		From("build_step_outputs o").
+		Join("builds b ON b.id = o.build_id").
+		Where(sq.Eq{
+			"o.build_id": b.resumeOf,
+			"o.step_key": stepKey,
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&buildName, &outputs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildStepOutputs{}, false, nil
+		}
+
+		return BuildStepOutputs{}, false, err
+	}
+
+	stepOutputs := BuildStepOutputs{
+		BuildID:   b.resumeOf,
+		BuildName: buildName,
+	}
+
+	err = json.Unmarshal(outputs, &stepOutputs.Outputs)
+	if err != nil {
+		return BuildStepOutputs{}, false, err
+	}
+
+	if len(stepOutputs.Outputs) == 0 {
+		return stepOutputs, true, nil
+	}
+
+	handles := []string{}
+	for _, handle := range stepOutputs.Outputs {
+		handles = append(handles, handle)
+	}
+
+	var volumes int
+	err = psql.Select("COUNT(*)").
+		From("volumes").
+		Where(sq.Eq{
+			"handle": handles,
+			"state":  VolumeStateCreated,
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&volumes)
+	if err != nil {
+		return BuildStepOutputs{}, false, err
+	}
+
+	if volumes != len(handles) {
+		return BuildStepOutputs{}, false, nil
+	}
+
+	return stepOutputs, true, nil
+}
+
+// releaseStepOutputs forgets the step outputs of a build and releases their
+// volumes, as a build which succeeded has no failed step to be resumed from.
+func releaseStepOutputs(tx Tx, buildID int) error {
+	_, err := psql.Delete("build_step_outputs").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("worker_artifacts").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(tx).
+		Exec()
+	return err
+}
@@ -0,0 +1,126 @@
+package db_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build step outputs", func() {
+	var (
+		failedBuild db.Build
+		output      db.CreatedVolume
+	)
+
+	orphanedHandles := func() []string {
+		orphaned, err := volumeRepository.GetOrphanedVolumes()
+		Expect(err).ToNot(HaveOccurred())
+
+		handles := []string{}
+		for _, volume := range orphaned {
+			handles = append(handles, volume.Handle())
+		}
+
+		return handles
+	}
+
+	BeforeEach(func() {
+		var err error
+		failedBuild, err = defaultJob.CreateBuild(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+
+		creating, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+		Expect(err).ToNot(HaveOccurred())
+
+		output, err = creating.Created()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = failedBuild.SaveStepOutputs("some-step", map[string]string{
+			"some-output": output.Handle(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("retains the volumes of the outputs", func() {
+		Expect(orphanedHandles()).ToNot(ContainElement(output.Handle()))
+	})
+
+	Context("when the build succeeds", func() {
+		BeforeEach(func() {
+			err := failedBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("releases the volumes of the outputs", func() {
+			Expect(orphanedHandles()).To(ContainElement(output.Handle()))
+		})
+	})
+
+	Context("when the build fails", func() {
+		BeforeEach(func() {
+			err := failedBuild.Finish(db.BuildStatusFailed)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps retaining the volumes of the outputs", func() {
+			Expect(orphanedHandles()).ToNot(ContainElement(output.Handle()))
+		})
+
+		Context("when the build is rerun from the failed step", func() {
+			var rerunBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				rerunBuild, err = defaultJob.RerunBuild(failedBuild, true)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("finds the outputs of the steps which succeeded", func() {
+				outputs, found, err := rerunBuild.ResumedStepOutputs("some-step")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(outputs).To(Equal(db.BuildStepOutputs{
+					BuildID:   failedBuild.ID(),
+					BuildName: failedBuild.Name(),
+					Outputs:   map[string]string{"some-output": output.Handle()},
+				}))
+			})
+
+			It("does not find the outputs of other steps", func() {
+				_, found, err := rerunBuild.ResumedStepOutputs("some-other-step")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when a volume of the outputs is gone", func() {
+				BeforeEach(func() {
+					destroying, err := output.Destroying()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = destroying.Destroy()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not find the outputs", func() {
+					_, found, err := rerunBuild.ResumedStepOutputs("some-step")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the build is rerun entirely", func() {
+			It("does not find the outputs", func() {
+				rerunBuild, err := defaultJob.RerunBuild(failedBuild, false)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, found, err := rerunBuild.ResumedStepOutputs("some-step")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
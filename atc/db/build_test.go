@@ -82,6 +82,53 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("LogArchive", func() {
+		It("defaults to not being archived", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchive()).To(BeEmpty())
+		})
+
+		It("has the location set after archiving and a reload", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetLogArchive("builds/1.ndjson.gz")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchive()).To(Equal("builds/1.ndjson.gz"))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.LogArchive()).To(Equal("builds/1.ndjson.gz"))
+		})
+	})
+
+	Describe("Annotations", func() {
+		It("defaults to no annotations", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Annotations()).To(BeEmpty())
+		})
+
+		It("merges saved annotations into the existing ones", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAnnotations(map[string]string{"url": "https://example.com", "coverage": "80"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAnnotations(map[string]string{"coverage": "87.5"})
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := map[string]string{"url": "https://example.com", "coverage": "87.5"}
+			Expect(build.Annotations()).To(Equal(expected))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Annotations()).To(Equal(expected))
+		})
+	})
+
 	Describe("Start", func() {
 		var err error
 		var started bool
@@ -241,7 +288,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -276,7 +323,7 @@ var _ = Describe("Build", func() {
 			})
 			Expect(err).ToNot(HaveOccurred())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			err = job.SaveNextInputMapping(db.InputMapping{
@@ -393,13 +440,13 @@ var _ = Describe("Build", func() {
 
 			Context("when there is a pending build that is not a rerun", func() {
 				BeforeEach(func() {
-					pdBuild, err = job.CreateBuild()
+					pdBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 				})
 
 				Context("when rerunning the latest completed build", func() {
 					BeforeEach(func() {
-						rrBuild, err = job.RerunBuild(build)
+						rrBuild, err = job.RerunBuild(build, false)
 						Expect(err).NotTo(HaveOccurred())
 					})
 
@@ -424,7 +471,7 @@ var _ = Describe("Build", func() {
 
 					Context("when there is another pending build that is not a rerun and the first pending build finishes", func() {
 						BeforeEach(func() {
-							pdBuild2, err = job.CreateBuild()
+							pdBuild2, err = job.CreateBuild(context.TODO())
 							Expect(err).NotTo(HaveOccurred())
 
 							err = pdBuild.Finish(db.BuildStatusSucceeded)
@@ -443,7 +490,7 @@ var _ = Describe("Build", func() {
 
 				Context("when rerunning the pending build and the pending build finished", func() {
 					BeforeEach(func() {
-						rrBuild, err = job.RerunBuild(pdBuild)
+						rrBuild, err = job.RerunBuild(pdBuild, false)
 						Expect(err).NotTo(HaveOccurred())
 
 						err = pdBuild.Finish(db.BuildStatusSucceeded)
@@ -464,7 +511,7 @@ var _ = Describe("Build", func() {
 						err = pdBuild.Finish(db.BuildStatusErrored)
 						Expect(err).NotTo(HaveOccurred())
 
-						rrBuild, err = job.RerunBuild(build)
+						rrBuild, err = job.RerunBuild(build, false)
 						Expect(err).NotTo(HaveOccurred())
 
 						err = rrBuild.Finish(db.BuildStatusSucceeded)
@@ -518,7 +565,7 @@ var _ = Describe("Build", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				newBuild, err := job.CreateBuild()
+				newBuild, err := job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				requestedSchedule := downstreamJob.ScheduleRequestedTime()
@@ -537,7 +584,7 @@ var _ = Describe("Build", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				newBuild, err := job.CreateBuild()
+				newBuild, err := job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				requestedSchedule := noRequestJob.ScheduleRequestedTime()
@@ -724,7 +771,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -753,7 +800,7 @@ var _ = Describe("Build", func() {
 
 		Context("when the version does not exist", func() {
 			It("can save a build's output", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -782,7 +829,7 @@ var _ = Describe("Build", func() {
 			It("requests schedule on all jobs using the resource config", func() {
 				atc.EnableGlobalResources = true
 
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				pipelineConfig := atc.Config{
@@ -808,7 +855,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -859,7 +906,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not increment the check order", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.SaveOutput("some-type", atc.Source{"some": "explicit-source"}, atc.VersionedResourceTypes{}, atc.Version{"some": "version"}, []db.ResourceConfigMetadataField{
@@ -882,7 +929,7 @@ var _ = Describe("Build", func() {
 			})
 
 			It("does not request schedule on all jobs using the resource config", func() {
-				build, err := job.CreateBuild()
+				build, err := job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				pipelineConfig := atc.Config{
@@ -908,7 +955,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -972,7 +1019,7 @@ var _ = Describe("Build", func() {
 						})
 
 						It("saves the output", func() {
-							build, err := job.CreateBuild()
+							build, err := job.CreateBuild(context.TODO())
 							Expect(err).ToNot(HaveOccurred())
 
 							err = build.SaveOutput(
@@ -1038,7 +1085,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1072,7 +1119,7 @@ var _ = Describe("Build", func() {
 		})
 
 		It("returns build inputs and outputs", func() {
-			build, err := job.CreateBuild()
+			build, err := job.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			// save a normal 'get'
@@ -1129,7 +1176,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				// save a normal 'get'
@@ -1188,7 +1235,7 @@ var _ = Describe("Build", func() {
 
 				BeforeEach(func() {
 					var err error
-					newBuild, err = job.CreateBuild()
+					newBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					// save a normal 'get'
@@ -1251,7 +1298,7 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -1264,7 +1311,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1349,7 +1396,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -1378,7 +1425,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err = job.CreateBuild()
+				build, err = job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				expectedBuildPrep.BuildID = build.ID()
@@ -1534,7 +1581,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							newBuild, err := job.CreateBuild()
+							newBuild, err := job.CreateBuild(context.TODO())
 							Expect(err).NotTo(HaveOccurred())
 
 							err = job.SaveNextInputMapping(nil, true)
@@ -1544,7 +1591,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(scheduled).To(BeTrue())
 
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1605,7 +1652,7 @@ var _ = Describe("Build", func() {
 					Context("when max running builds is de-reached", func() {
 						BeforeEach(func() {
 							var err error
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1635,7 +1682,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(found).To(BeTrue())
 
-							newBuild, err := job.CreateBuild()
+							newBuild, err := job.CreateBuild(context.TODO())
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err := job.ScheduleBuild(build)
@@ -1736,7 +1783,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					err = job.SaveNextInputMapping(db.InputMapping{
@@ -1781,7 +1828,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -1891,7 +1938,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -1899,17 +1946,17 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(context.TODO())
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err = pipeline.Job("some-other-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherBuild, err = otherJob.CreateBuild()
+			otherBuild, err = otherJob.CreateBuild(context.TODO())
 			Expect(err).ToNot(HaveOccurred())
 
-			otherBuild2, err = otherJob.CreateBuild()
+			otherBuild2, err = otherJob.CreateBuild(context.TODO())
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -2124,7 +2171,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2132,17 +2179,17 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			otherBuild, err = otherJob.CreateBuild()
+			otherBuild, err = otherJob.CreateBuild(context.TODO())
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			build, err = job.CreateBuild()
+			build, err = job.CreateBuild(context.TODO())
 			Expect(err).ToNot(HaveOccurred())
 
-			retriggerBuild, err = job.RerunBuild(build)
+			retriggerBuild, err = job.RerunBuild(build, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -39,6 +39,7 @@ type Check interface {
 	EndTime() time.Time
 	Status() CheckStatus
 	CheckError() error
+	IsManuallyTriggered() bool
 
 	Start() error
 	Finish() error
@@ -62,6 +63,7 @@ var checksQuery = psql.Select(
 	"c.nonce",
 	"c.check_error",
 	"c.metadata",
+	"c.manually_triggered",
 ).
 	From("checks c")
 
@@ -72,10 +74,11 @@ type check struct {
 	resourceConfigScopeID int
 	metadata              CheckMetadata
 
-	status     CheckStatus
-	schema     string
-	plan       atc.Plan
-	checkError error
+	status              CheckStatus
+	schema              string
+	plan                atc.Plan
+	checkError          error
+	isManuallyTriggered bool
 
 	createTime time.Time
 	startTime  time.Time
@@ -104,6 +107,7 @@ func (c *check) CreateTime() time.Time      { return c.createTime }
 func (c *check) StartTime() time.Time       { return c.startTime }
 func (c *check) EndTime() time.Time         { return c.endTime }
 func (c *check) CheckError() error          { return c.checkError }
+func (c *check) IsManuallyTriggered() bool  { return c.isManuallyTriggered }
 
 func (c *check) TeamID() int {
 	return c.metadata.TeamID
@@ -341,6 +345,7 @@ func scanCheck(c *check, row scannable) error {
 		&nonce,
 		&checkError,
 		&metadata,
+		&c.isManuallyTriggered,
 	)
 	if err != nil {
 		return err
@@ -161,7 +161,7 @@ func (c *checkFactory) TryCreateCheck(logger lager.Logger, checkable Checkable,
 		return nil, false, fmt.Errorf("pipeline not found")
 	}
 
-	varss, err := pp.Variables(logger, c.secrets, c.varSourcePool)
+	varss, err := pp.Variables(logger, c.secrets, c.varSourcePool, creds.BuildScope{})
 	if err != nil {
 		return nil, false, err
 	}
@@ -306,6 +306,7 @@ func (c *checkFactory) CreateCheck(
 		plan:                  plan,
 		createTime:            createTime,
 		metadata:              meta,
+		isManuallyTriggered:   manuallyTriggered,
 
 		pipelineRef: pipelineRef{
 			conn:         c.conn,
@@ -0,0 +1,432 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAuditEventRepository struct {
+	MarkDrainedStub        func([]int) error
+	markDrainedMutex       sync.RWMutex
+	markDrainedArgsForCall []struct {
+		arg1 []int
+	}
+	markDrainedReturns struct {
+		result1 error
+	}
+	markDrainedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveStub        func(atc.AuditEvent) (int, error)
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		arg1 atc.AuditEvent
+	}
+	saveReturns struct {
+		result1 int
+		result2 error
+	}
+	saveReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	TeamAuditEventsStub        func(string, db.Page) ([]atc.AuditEvent, db.Pagination, error)
+	teamAuditEventsMutex       sync.RWMutex
+	teamAuditEventsArgsForCall []struct {
+		arg1 string
+		arg2 db.Page
+	}
+	teamAuditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}
+	teamAuditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}
+	UndrainedAuditEventsStub        func(int) ([]atc.AuditEvent, error)
+	undrainedAuditEventsMutex       sync.RWMutex
+	undrainedAuditEventsArgsForCall []struct {
+		arg1 int
+	}
+	undrainedAuditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
+	undrainedAuditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
+	UpdateStatusStub        func(int, int) error
+	updateStatusMutex       sync.RWMutex
+	updateStatusArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	updateStatusReturns struct {
+		result1 error
+	}
+	updateStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditEventRepository) MarkDrained(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.markDrainedMutex.Lock()
+	ret, specificReturn := fake.markDrainedReturnsOnCall[len(fake.markDrainedArgsForCall)]
+	fake.markDrainedArgsForCall = append(fake.markDrainedArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("MarkDrained", []interface{}{arg1Copy})
+	fake.markDrainedMutex.Unlock()
+	if fake.MarkDrainedStub != nil {
+		return fake.MarkDrainedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markDrainedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditEventRepository) MarkDrainedCallCount() int {
+	fake.markDrainedMutex.RLock()
+	defer fake.markDrainedMutex.RUnlock()
+	return len(fake.markDrainedArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) MarkDrainedCalls(stub func([]int) error) {
+	fake.markDrainedMutex.Lock()
+	defer fake.markDrainedMutex.Unlock()
+	fake.MarkDrainedStub = stub
+}
+
+func (fake *FakeAuditEventRepository) MarkDrainedArgsForCall(i int) []int {
+	fake.markDrainedMutex.RLock()
+	defer fake.markDrainedMutex.RUnlock()
+	argsForCall := fake.markDrainedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditEventRepository) MarkDrainedReturns(result1 error) {
+	fake.markDrainedMutex.Lock()
+	defer fake.markDrainedMutex.Unlock()
+	fake.MarkDrainedStub = nil
+	fake.markDrainedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditEventRepository) MarkDrainedReturnsOnCall(i int, result1 error) {
+	fake.markDrainedMutex.Lock()
+	defer fake.markDrainedMutex.Unlock()
+	fake.MarkDrainedStub = nil
+	if fake.markDrainedReturnsOnCall == nil {
+		fake.markDrainedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markDrainedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditEventRepository) Save(arg1 atc.AuditEvent) (int, error) {
+	fake.saveMutex.Lock()
+	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		arg1 atc.AuditEvent
+	}{arg1})
+	fake.recordInvocation("Save", []interface{}{arg1})
+	fake.saveMutex.Unlock()
+	if fake.SaveStub != nil {
+		return fake.SaveStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.saveReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditEventRepository) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) SaveCalls(stub func(atc.AuditEvent) (int, error)) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = stub
+}
+
+func (fake *FakeAuditEventRepository) SaveArgsForCall(i int) atc.AuditEvent {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	argsForCall := fake.saveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditEventRepository) SaveReturns(result1 int, result2 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditEventRepository) SaveReturnsOnCall(i int, result1 int, result2 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	if fake.saveReturnsOnCall == nil {
+		fake.saveReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.saveReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditEventRepository) TeamAuditEvents(arg1 string, arg2 db.Page) ([]atc.AuditEvent, db.Pagination, error) {
+	fake.teamAuditEventsMutex.Lock()
+	ret, specificReturn := fake.teamAuditEventsReturnsOnCall[len(fake.teamAuditEventsArgsForCall)]
+	fake.teamAuditEventsArgsForCall = append(fake.teamAuditEventsArgsForCall, struct {
+		arg1 string
+		arg2 db.Page
+	}{arg1, arg2})
+	fake.recordInvocation("TeamAuditEvents", []interface{}{arg1, arg2})
+	fake.teamAuditEventsMutex.Unlock()
+	if fake.TeamAuditEventsStub != nil {
+		return fake.TeamAuditEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.teamAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAuditEventRepository) TeamAuditEventsCallCount() int {
+	fake.teamAuditEventsMutex.RLock()
+	defer fake.teamAuditEventsMutex.RUnlock()
+	return len(fake.teamAuditEventsArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) TeamAuditEventsCalls(stub func(string, db.Page) ([]atc.AuditEvent, db.Pagination, error)) {
+	fake.teamAuditEventsMutex.Lock()
+	defer fake.teamAuditEventsMutex.Unlock()
+	fake.TeamAuditEventsStub = stub
+}
+
+func (fake *FakeAuditEventRepository) TeamAuditEventsArgsForCall(i int) (string, db.Page) {
+	fake.teamAuditEventsMutex.RLock()
+	defer fake.teamAuditEventsMutex.RUnlock()
+	argsForCall := fake.teamAuditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditEventRepository) TeamAuditEventsReturns(result1 []atc.AuditEvent, result2 db.Pagination, result3 error) {
+	fake.teamAuditEventsMutex.Lock()
+	defer fake.teamAuditEventsMutex.Unlock()
+	fake.TeamAuditEventsStub = nil
+	fake.teamAuditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuditEventRepository) TeamAuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 db.Pagination, result3 error) {
+	fake.teamAuditEventsMutex.Lock()
+	defer fake.teamAuditEventsMutex.Unlock()
+	fake.TeamAuditEventsStub = nil
+	if fake.teamAuditEventsReturnsOnCall == nil {
+		fake.teamAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 db.Pagination
+			result3 error
+		})
+	}
+	fake.teamAuditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuditEventRepository) UndrainedAuditEvents(arg1 int) ([]atc.AuditEvent, error) {
+	fake.undrainedAuditEventsMutex.Lock()
+	ret, specificReturn := fake.undrainedAuditEventsReturnsOnCall[len(fake.undrainedAuditEventsArgsForCall)]
+	fake.undrainedAuditEventsArgsForCall = append(fake.undrainedAuditEventsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("UndrainedAuditEvents", []interface{}{arg1})
+	fake.undrainedAuditEventsMutex.Unlock()
+	if fake.UndrainedAuditEventsStub != nil {
+		return fake.UndrainedAuditEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.undrainedAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditEventRepository) UndrainedAuditEventsCallCount() int {
+	fake.undrainedAuditEventsMutex.RLock()
+	defer fake.undrainedAuditEventsMutex.RUnlock()
+	return len(fake.undrainedAuditEventsArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) UndrainedAuditEventsCalls(stub func(int) ([]atc.AuditEvent, error)) {
+	fake.undrainedAuditEventsMutex.Lock()
+	defer fake.undrainedAuditEventsMutex.Unlock()
+	fake.UndrainedAuditEventsStub = stub
+}
+
+func (fake *FakeAuditEventRepository) UndrainedAuditEventsArgsForCall(i int) int {
+	fake.undrainedAuditEventsMutex.RLock()
+	defer fake.undrainedAuditEventsMutex.RUnlock()
+	argsForCall := fake.undrainedAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditEventRepository) UndrainedAuditEventsReturns(result1 []atc.AuditEvent, result2 error) {
+	fake.undrainedAuditEventsMutex.Lock()
+	defer fake.undrainedAuditEventsMutex.Unlock()
+	fake.UndrainedAuditEventsStub = nil
+	fake.undrainedAuditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditEventRepository) UndrainedAuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 error) {
+	fake.undrainedAuditEventsMutex.Lock()
+	defer fake.undrainedAuditEventsMutex.Unlock()
+	fake.UndrainedAuditEventsStub = nil
+	if fake.undrainedAuditEventsReturnsOnCall == nil {
+		fake.undrainedAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 error
+		})
+	}
+	fake.undrainedAuditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditEventRepository) UpdateStatus(arg1 int, arg2 int) error {
+	fake.updateStatusMutex.Lock()
+	ret, specificReturn := fake.updateStatusReturnsOnCall[len(fake.updateStatusArgsForCall)]
+	fake.updateStatusArgsForCall = append(fake.updateStatusArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateStatus", []interface{}{arg1, arg2})
+	fake.updateStatusMutex.Unlock()
+	if fake.UpdateStatusStub != nil {
+		return fake.UpdateStatusStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateStatusReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditEventRepository) UpdateStatusCallCount() int {
+	fake.updateStatusMutex.RLock()
+	defer fake.updateStatusMutex.RUnlock()
+	return len(fake.updateStatusArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) UpdateStatusCalls(stub func(int, int) error) {
+	fake.updateStatusMutex.Lock()
+	defer fake.updateStatusMutex.Unlock()
+	fake.UpdateStatusStub = stub
+}
+
+func (fake *FakeAuditEventRepository) UpdateStatusArgsForCall(i int) (int, int) {
+	fake.updateStatusMutex.RLock()
+	defer fake.updateStatusMutex.RUnlock()
+	argsForCall := fake.updateStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditEventRepository) UpdateStatusReturns(result1 error) {
+	fake.updateStatusMutex.Lock()
+	defer fake.updateStatusMutex.Unlock()
+	fake.UpdateStatusStub = nil
+	fake.updateStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditEventRepository) UpdateStatusReturnsOnCall(i int, result1 error) {
+	fake.updateStatusMutex.Lock()
+	defer fake.updateStatusMutex.Unlock()
+	fake.UpdateStatusStub = nil
+	if fake.updateStatusReturnsOnCall == nil {
+		fake.updateStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditEventRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.markDrainedMutex.RLock()
+	defer fake.markDrainedMutex.RUnlock()
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	fake.teamAuditEventsMutex.RLock()
+	defer fake.teamAuditEventsMutex.RUnlock()
+	fake.undrainedAuditEventsMutex.RLock()
+	defer fake.undrainedAuditEventsMutex.RUnlock()
+	fake.updateStatusMutex.RLock()
+	defer fake.updateStatusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditEventRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditEventRepository = new(FakeAuditEventRepository)
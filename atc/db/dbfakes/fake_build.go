@@ -69,6 +69,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	AnnotationsStub        func() map[string]string
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct {
+	}
+	annotationsReturns struct {
+		result1 map[string]string
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -94,6 +104,22 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	DecideStepApprovalStub        func(atc.PlanID, bool, string, string) (bool, error)
+	decideStepApprovalMutex       sync.RWMutex
+	decideStepApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+		arg4 string
+	}
+	decideStepApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideStepApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -160,6 +186,17 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	IndexLogsStub        func([]db.BuildLogLine) error
+	indexLogsMutex       sync.RWMutex
+	indexLogsArgsForCall []struct {
+		arg1 []db.BuildLogLine
+	}
+	indexLogsReturns struct {
+		result1 error
+	}
+	indexLogsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InputsReadyStub        func() bool
 	inputsReadyMutex       sync.RWMutex
 	inputsReadyArgsForCall []struct {
@@ -273,6 +310,16 @@ type FakeBuild struct {
 	jobNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	LogArchiveStub        func() string
+	logArchiveMutex       sync.RWMutex
+	logArchiveArgsForCall []struct {
+	}
+	logArchiveReturns struct {
+		result1 string
+	}
+	logArchiveReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MarkAsAbortedStub        func() error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
@@ -383,6 +430,17 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RequestStepApprovalStub        func(atc.BuildStepApproval) error
+	requestStepApprovalMutex       sync.RWMutex
+	requestStepApprovalArgsForCall []struct {
+		arg1 atc.BuildStepApproval
+	}
+	requestStepApprovalReturns struct {
+		result1 error
+	}
+	requestStepApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RerunNumberStub        func() int
 	rerunNumberMutex       sync.RWMutex
 	rerunNumberArgsForCall []struct {
@@ -427,6 +485,42 @@ type FakeBuild struct {
 		result2 []db.BuildOutput
 		result3 error
 	}
+	ResumeOfStub        func() int
+	resumeOfMutex       sync.RWMutex
+	resumeOfArgsForCall []struct {
+	}
+	resumeOfReturns struct {
+		result1 int
+	}
+	resumeOfReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ResumedStepOutputsStub        func(string) (db.BuildStepOutputs, bool, error)
+	resumedStepOutputsMutex       sync.RWMutex
+	resumedStepOutputsArgsForCall []struct {
+		arg1 string
+	}
+	resumedStepOutputsReturns struct {
+		result1 db.BuildStepOutputs
+		result2 bool
+		result3 error
+	}
+	resumedStepOutputsReturnsOnCall map[int]struct {
+		result1 db.BuildStepOutputs
+		result2 bool
+		result3 error
+	}
+	SaveAnnotationsStub        func(map[string]string) error
+	saveAnnotationsMutex       sync.RWMutex
+	saveAnnotationsArgsForCall []struct {
+		arg1 map[string]string
+	}
+	saveAnnotationsReturns struct {
+		result1 error
+	}
+	saveAnnotationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -466,6 +560,29 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveStepOutputsStub        func(string, map[string]string) error
+	saveStepOutputsMutex       sync.RWMutex
+	saveStepOutputsArgsForCall []struct {
+		arg1 string
+		arg2 map[string]string
+	}
+	saveStepOutputsReturns struct {
+		result1 error
+	}
+	saveStepOutputsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTestResultsStub        func([]atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -498,6 +615,27 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetLogArchiveStub        func(string) error
+	setLogArchiveMutex       sync.RWMutex
+	setLogArchiveArgsForCall []struct {
+		arg1 string
+	}
+	setLogArchiveReturns struct {
+		result1 error
+	}
+	setLogArchiveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SpanContextStub        func() db.SpanContext
+	spanContextMutex       sync.RWMutex
+	spanContextArgsForCall []struct {
+	}
+	spanContextReturns struct {
+		result1 db.SpanContext
+	}
+	spanContextReturnsOnCall map[int]struct {
+		result1 db.SpanContext
+	}
 	StartStub        func(atc.Plan) (bool, error)
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -531,6 +669,33 @@ type FakeBuild struct {
 	statusReturnsOnCall map[int]struct {
 		result1 db.BuildStatus
 	}
+	StepApprovalStub        func(atc.PlanID) (atc.BuildStepApproval, bool, error)
+	stepApprovalMutex       sync.RWMutex
+	stepApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	stepApprovalReturns struct {
+		result1 atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}
+	stepApprovalReturnsOnCall map[int]struct {
+		result1 atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}
+	StepApprovalsStub        func() ([]atc.BuildStepApproval, error)
+	stepApprovalsMutex       sync.RWMutex
+	stepApprovalsArgsForCall []struct {
+	}
+	stepApprovalsReturns struct {
+		result1 []atc.BuildStepApproval
+		result2 error
+	}
+	stepApprovalsReturnsOnCall map[int]struct {
+		result1 []atc.BuildStepApproval
+		result2 error
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -551,6 +716,18 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestSummaryStub        func() (atc.TestSummary, error)
+	testSummaryMutex       sync.RWMutex
+	testSummaryArgsForCall []struct {
+	}
+	testSummaryReturns struct {
+		result1 atc.TestSummary
+		result2 error
+	}
+	testSummaryReturnsOnCall map[int]struct {
+		result1 atc.TestSummary
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -793,6 +970,58 @@ func (fake *FakeBuild) AdoptRerunInputsAndPipesReturnsOnCall(i int, result1 []db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Annotations() map[string]string {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Annotations", []interface{}{})
+	fake.annotationsMutex.Unlock()
+	if fake.AnnotationsStub != nil {
+		return fake.AnnotationsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.annotationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeBuild) AnnotationsCalls(stub func() map[string]string) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = stub
+}
+
+func (fake *FakeBuild) AnnotationsReturns(result1 map[string]string) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeBuild) AnnotationsReturnsOnCall(i int, result1 map[string]string) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -911,6 +1140,72 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) DecideStepApproval(arg1 atc.PlanID, arg2 bool, arg3 string, arg4 string) (bool, error) {
+	fake.decideStepApprovalMutex.Lock()
+	ret, specificReturn := fake.decideStepApprovalReturnsOnCall[len(fake.decideStepApprovalArgsForCall)]
+	fake.decideStepApprovalArgsForCall = append(fake.decideStepApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("DecideStepApproval", []interface{}{arg1, arg2, arg3, arg4})
+	fake.decideStepApprovalMutex.Unlock()
+	if fake.DecideStepApprovalStub != nil {
+		return fake.DecideStepApprovalStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.decideStepApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) DecideStepApprovalCallCount() int {
+	fake.decideStepApprovalMutex.RLock()
+	defer fake.decideStepApprovalMutex.RUnlock()
+	return len(fake.decideStepApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) DecideStepApprovalCalls(stub func(atc.PlanID, bool, string, string) (bool, error)) {
+	fake.decideStepApprovalMutex.Lock()
+	defer fake.decideStepApprovalMutex.Unlock()
+	fake.DecideStepApprovalStub = stub
+}
+
+func (fake *FakeBuild) DecideStepApprovalArgsForCall(i int) (atc.PlanID, bool, string, string) {
+	fake.decideStepApprovalMutex.RLock()
+	defer fake.decideStepApprovalMutex.RUnlock()
+	argsForCall := fake.decideStepApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBuild) DecideStepApprovalReturns(result1 bool, result2 error) {
+	fake.decideStepApprovalMutex.Lock()
+	defer fake.decideStepApprovalMutex.Unlock()
+	fake.DecideStepApprovalStub = nil
+	fake.decideStepApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DecideStepApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.decideStepApprovalMutex.Lock()
+	defer fake.decideStepApprovalMutex.Unlock()
+	fake.DecideStepApprovalStub = nil
+	if fake.decideStepApprovalReturnsOnCall == nil {
+		fake.decideStepApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideStepApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1245,6 +1540,71 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) IndexLogs(arg1 []db.BuildLogLine) error {
+	var arg1Copy []db.BuildLogLine
+	if arg1 != nil {
+		arg1Copy = make([]db.BuildLogLine, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.indexLogsMutex.Lock()
+	ret, specificReturn := fake.indexLogsReturnsOnCall[len(fake.indexLogsArgsForCall)]
+	fake.indexLogsArgsForCall = append(fake.indexLogsArgsForCall, struct {
+		arg1 []db.BuildLogLine
+	}{arg1Copy})
+	fake.recordInvocation("IndexLogs", []interface{}{arg1Copy})
+	fake.indexLogsMutex.Unlock()
+	if fake.IndexLogsStub != nil {
+		return fake.IndexLogsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.indexLogsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) IndexLogsCallCount() int {
+	fake.indexLogsMutex.RLock()
+	defer fake.indexLogsMutex.RUnlock()
+	return len(fake.indexLogsArgsForCall)
+}
+
+func (fake *FakeBuild) IndexLogsCalls(stub func([]db.BuildLogLine) error) {
+	fake.indexLogsMutex.Lock()
+	defer fake.indexLogsMutex.Unlock()
+	fake.IndexLogsStub = stub
+}
+
+func (fake *FakeBuild) IndexLogsArgsForCall(i int) []db.BuildLogLine {
+	fake.indexLogsMutex.RLock()
+	defer fake.indexLogsMutex.RUnlock()
+	argsForCall := fake.indexLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) IndexLogsReturns(result1 error) {
+	fake.indexLogsMutex.Lock()
+	defer fake.indexLogsMutex.Unlock()
+	fake.IndexLogsStub = nil
+	fake.indexLogsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) IndexLogsReturnsOnCall(i int, result1 error) {
+	fake.indexLogsMutex.Lock()
+	defer fake.indexLogsMutex.Unlock()
+	fake.IndexLogsStub = nil
+	if fake.indexLogsReturnsOnCall == nil {
+		fake.indexLogsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.indexLogsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) InputsReady() bool {
 	fake.inputsReadyMutex.Lock()
 	ret, specificReturn := fake.inputsReadyReturnsOnCall[len(fake.inputsReadyArgsForCall)]
@@ -1828,6 +2188,58 @@ func (fake *FakeBuild) JobNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) LogArchive() string {
+	fake.logArchiveMutex.Lock()
+	ret, specificReturn := fake.logArchiveReturnsOnCall[len(fake.logArchiveArgsForCall)]
+	fake.logArchiveArgsForCall = append(fake.logArchiveArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LogArchive", []interface{}{})
+	fake.logArchiveMutex.Unlock()
+	if fake.LogArchiveStub != nil {
+		return fake.LogArchiveStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.logArchiveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) LogArchiveCallCount() int {
+	fake.logArchiveMutex.RLock()
+	defer fake.logArchiveMutex.RUnlock()
+	return len(fake.logArchiveArgsForCall)
+}
+
+func (fake *FakeBuild) LogArchiveCalls(stub func() string) {
+	fake.logArchiveMutex.Lock()
+	defer fake.logArchiveMutex.Unlock()
+	fake.LogArchiveStub = stub
+}
+
+func (fake *FakeBuild) LogArchiveReturns(result1 string) {
+	fake.logArchiveMutex.Lock()
+	defer fake.logArchiveMutex.Unlock()
+	fake.LogArchiveStub = nil
+	fake.logArchiveReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) LogArchiveReturnsOnCall(i int, result1 string) {
+	fake.logArchiveMutex.Lock()
+	defer fake.logArchiveMutex.Unlock()
+	fake.LogArchiveStub = nil
+	if fake.logArchiveReturnsOnCall == nil {
+		fake.logArchiveReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.logArchiveReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) MarkAsAborted() error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
@@ -2363,6 +2775,66 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RequestStepApproval(arg1 atc.BuildStepApproval) error {
+	fake.requestStepApprovalMutex.Lock()
+	ret, specificReturn := fake.requestStepApprovalReturnsOnCall[len(fake.requestStepApprovalArgsForCall)]
+	fake.requestStepApprovalArgsForCall = append(fake.requestStepApprovalArgsForCall, struct {
+		arg1 atc.BuildStepApproval
+	}{arg1})
+	fake.recordInvocation("RequestStepApproval", []interface{}{arg1})
+	fake.requestStepApprovalMutex.Unlock()
+	if fake.RequestStepApprovalStub != nil {
+		return fake.RequestStepApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestStepApprovalReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RequestStepApprovalCallCount() int {
+	fake.requestStepApprovalMutex.RLock()
+	defer fake.requestStepApprovalMutex.RUnlock()
+	return len(fake.requestStepApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestStepApprovalCalls(stub func(atc.BuildStepApproval) error) {
+	fake.requestStepApprovalMutex.Lock()
+	defer fake.requestStepApprovalMutex.Unlock()
+	fake.RequestStepApprovalStub = stub
+}
+
+func (fake *FakeBuild) RequestStepApprovalArgsForCall(i int) atc.BuildStepApproval {
+	fake.requestStepApprovalMutex.RLock()
+	defer fake.requestStepApprovalMutex.RUnlock()
+	argsForCall := fake.requestStepApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) RequestStepApprovalReturns(result1 error) {
+	fake.requestStepApprovalMutex.Lock()
+	defer fake.requestStepApprovalMutex.Unlock()
+	fake.RequestStepApprovalStub = nil
+	fake.requestStepApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RequestStepApprovalReturnsOnCall(i int, result1 error) {
+	fake.requestStepApprovalMutex.Lock()
+	defer fake.requestStepApprovalMutex.Unlock()
+	fake.RequestStepApprovalStub = nil
+	if fake.requestStepApprovalReturnsOnCall == nil {
+		fake.requestStepApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestStepApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) RerunNumber() int {
 	fake.rerunNumberMutex.Lock()
 	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
@@ -2577,6 +3049,184 @@ func (fake *FakeBuild) ResourcesReturnsOnCall(i int, result1 []db.BuildInput, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) ResumeOf() int {
+	fake.resumeOfMutex.Lock()
+	ret, specificReturn := fake.resumeOfReturnsOnCall[len(fake.resumeOfArgsForCall)]
+	fake.resumeOfArgsForCall = append(fake.resumeOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResumeOf", []interface{}{})
+	fake.resumeOfMutex.Unlock()
+	if fake.ResumeOfStub != nil {
+		return fake.ResumeOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resumeOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ResumeOfCallCount() int {
+	fake.resumeOfMutex.RLock()
+	defer fake.resumeOfMutex.RUnlock()
+	return len(fake.resumeOfArgsForCall)
+}
+
+func (fake *FakeBuild) ResumeOfCalls(stub func() int) {
+	fake.resumeOfMutex.Lock()
+	defer fake.resumeOfMutex.Unlock()
+	fake.ResumeOfStub = stub
+}
+
+func (fake *FakeBuild) ResumeOfReturns(result1 int) {
+	fake.resumeOfMutex.Lock()
+	defer fake.resumeOfMutex.Unlock()
+	fake.ResumeOfStub = nil
+	fake.resumeOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) ResumeOfReturnsOnCall(i int, result1 int) {
+	fake.resumeOfMutex.Lock()
+	defer fake.resumeOfMutex.Unlock()
+	fake.ResumeOfStub = nil
+	if fake.resumeOfReturnsOnCall == nil {
+		fake.resumeOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.resumeOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) ResumedStepOutputs(arg1 string) (db.BuildStepOutputs, bool, error) {
+	fake.resumedStepOutputsMutex.Lock()
+	ret, specificReturn := fake.resumedStepOutputsReturnsOnCall[len(fake.resumedStepOutputsArgsForCall)]
+	fake.resumedStepOutputsArgsForCall = append(fake.resumedStepOutputsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ResumedStepOutputs", []interface{}{arg1})
+	fake.resumedStepOutputsMutex.Unlock()
+	if fake.ResumedStepOutputsStub != nil {
+		return fake.ResumedStepOutputsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.resumedStepOutputsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ResumedStepOutputsCallCount() int {
+	fake.resumedStepOutputsMutex.RLock()
+	defer fake.resumedStepOutputsMutex.RUnlock()
+	return len(fake.resumedStepOutputsArgsForCall)
+}
+
+func (fake *FakeBuild) ResumedStepOutputsCalls(stub func(string) (db.BuildStepOutputs, bool, error)) {
+	fake.resumedStepOutputsMutex.Lock()
+	defer fake.resumedStepOutputsMutex.Unlock()
+	fake.ResumedStepOutputsStub = stub
+}
+
+func (fake *FakeBuild) ResumedStepOutputsArgsForCall(i int) string {
+	fake.resumedStepOutputsMutex.RLock()
+	defer fake.resumedStepOutputsMutex.RUnlock()
+	argsForCall := fake.resumedStepOutputsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ResumedStepOutputsReturns(result1 db.BuildStepOutputs, result2 bool, result3 error) {
+	fake.resumedStepOutputsMutex.Lock()
+	defer fake.resumedStepOutputsMutex.Unlock()
+	fake.ResumedStepOutputsStub = nil
+	fake.resumedStepOutputsReturns = struct {
+		result1 db.BuildStepOutputs
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ResumedStepOutputsReturnsOnCall(i int, result1 db.BuildStepOutputs, result2 bool, result3 error) {
+	fake.resumedStepOutputsMutex.Lock()
+	defer fake.resumedStepOutputsMutex.Unlock()
+	fake.ResumedStepOutputsStub = nil
+	if fake.resumedStepOutputsReturnsOnCall == nil {
+		fake.resumedStepOutputsReturnsOnCall = make(map[int]struct {
+			result1 db.BuildStepOutputs
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resumedStepOutputsReturnsOnCall[i] = struct {
+		result1 db.BuildStepOutputs
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) SaveAnnotations(arg1 map[string]string) error {
+	fake.saveAnnotationsMutex.Lock()
+	ret, specificReturn := fake.saveAnnotationsReturnsOnCall[len(fake.saveAnnotationsArgsForCall)]
+	fake.saveAnnotationsArgsForCall = append(fake.saveAnnotationsArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	fake.recordInvocation("SaveAnnotations", []interface{}{arg1})
+	fake.saveAnnotationsMutex.Unlock()
+	if fake.SaveAnnotationsStub != nil {
+		return fake.SaveAnnotationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveAnnotationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveAnnotationsCallCount() int {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	return len(fake.saveAnnotationsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveAnnotationsCalls(stub func(map[string]string) error) {
+	fake.saveAnnotationsMutex.Lock()
+	defer fake.saveAnnotationsMutex.Unlock()
+	fake.SaveAnnotationsStub = stub
+}
+
+func (fake *FakeBuild) SaveAnnotationsArgsForCall(i int) map[string]string {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	argsForCall := fake.saveAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveAnnotationsReturns(result1 error) {
+	fake.saveAnnotationsMutex.Lock()
+	defer fake.saveAnnotationsMutex.Unlock()
+	fake.SaveAnnotationsStub = nil
+	fake.saveAnnotationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveAnnotationsReturnsOnCall(i int, result1 error) {
+	fake.saveAnnotationsMutex.Lock()
+	defer fake.saveAnnotationsMutex.Unlock()
+	fake.SaveAnnotationsStub = nil
+	if fake.saveAnnotationsReturnsOnCall == nil {
+		fake.saveAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAnnotationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -2763,6 +3413,132 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveStepOutputs(arg1 string, arg2 map[string]string) error {
+	fake.saveStepOutputsMutex.Lock()
+	ret, specificReturn := fake.saveStepOutputsReturnsOnCall[len(fake.saveStepOutputsArgsForCall)]
+	fake.saveStepOutputsArgsForCall = append(fake.saveStepOutputsArgsForCall, struct {
+		arg1 string
+		arg2 map[string]string
+	}{arg1, arg2})
+	fake.recordInvocation("SaveStepOutputs", []interface{}{arg1, arg2})
+	fake.saveStepOutputsMutex.Unlock()
+	if fake.SaveStepOutputsStub != nil {
+		return fake.SaveStepOutputsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveStepOutputsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveStepOutputsCallCount() int {
+	fake.saveStepOutputsMutex.RLock()
+	defer fake.saveStepOutputsMutex.RUnlock()
+	return len(fake.saveStepOutputsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveStepOutputsCalls(stub func(string, map[string]string) error) {
+	fake.saveStepOutputsMutex.Lock()
+	defer fake.saveStepOutputsMutex.Unlock()
+	fake.SaveStepOutputsStub = stub
+}
+
+func (fake *FakeBuild) SaveStepOutputsArgsForCall(i int) (string, map[string]string) {
+	fake.saveStepOutputsMutex.RLock()
+	defer fake.saveStepOutputsMutex.RUnlock()
+	argsForCall := fake.saveStepOutputsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveStepOutputsReturns(result1 error) {
+	fake.saveStepOutputsMutex.Lock()
+	defer fake.saveStepOutputsMutex.Unlock()
+	fake.SaveStepOutputsStub = nil
+	fake.saveStepOutputsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveStepOutputsReturnsOnCall(i int, result1 error) {
+	fake.saveStepOutputsMutex.Lock()
+	defer fake.saveStepOutputsMutex.Unlock()
+	fake.SaveStepOutputsStub = nil
+	if fake.saveStepOutputsReturnsOnCall == nil {
+		fake.saveStepOutputsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveStepOutputsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResults(arg1 []atc.TestResult) error {
+	var arg1Copy []atc.TestResult
+	if arg1 != nil {
+		arg1Copy = make([]atc.TestResult, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 []atc.TestResult
+	}{arg1Copy})
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if fake.SaveTestResultsStub != nil {
+		return fake.SaveTestResultsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveTestResultsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTestResultsCalls(stub func([]atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeBuild) SaveTestResultsArgsForCall(i int) []atc.TestResult {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -2935,6 +3711,118 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetLogArchive(arg1 string) error {
+	fake.setLogArchiveMutex.Lock()
+	ret, specificReturn := fake.setLogArchiveReturnsOnCall[len(fake.setLogArchiveArgsForCall)]
+	fake.setLogArchiveArgsForCall = append(fake.setLogArchiveArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetLogArchive", []interface{}{arg1})
+	fake.setLogArchiveMutex.Unlock()
+	if fake.SetLogArchiveStub != nil {
+		return fake.SetLogArchiveStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setLogArchiveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetLogArchiveCallCount() int {
+	fake.setLogArchiveMutex.RLock()
+	defer fake.setLogArchiveMutex.RUnlock()
+	return len(fake.setLogArchiveArgsForCall)
+}
+
+func (fake *FakeBuild) SetLogArchiveCalls(stub func(string) error) {
+	fake.setLogArchiveMutex.Lock()
+	defer fake.setLogArchiveMutex.Unlock()
+	fake.SetLogArchiveStub = stub
+}
+
+func (fake *FakeBuild) SetLogArchiveArgsForCall(i int) string {
+	fake.setLogArchiveMutex.RLock()
+	defer fake.setLogArchiveMutex.RUnlock()
+	argsForCall := fake.setLogArchiveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetLogArchiveReturns(result1 error) {
+	fake.setLogArchiveMutex.Lock()
+	defer fake.setLogArchiveMutex.Unlock()
+	fake.SetLogArchiveStub = nil
+	fake.setLogArchiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetLogArchiveReturnsOnCall(i int, result1 error) {
+	fake.setLogArchiveMutex.Lock()
+	defer fake.setLogArchiveMutex.Unlock()
+	fake.SetLogArchiveStub = nil
+	if fake.setLogArchiveReturnsOnCall == nil {
+		fake.setLogArchiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setLogArchiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SpanContext() db.SpanContext {
+	fake.spanContextMutex.Lock()
+	ret, specificReturn := fake.spanContextReturnsOnCall[len(fake.spanContextArgsForCall)]
+	fake.spanContextArgsForCall = append(fake.spanContextArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SpanContext", []interface{}{})
+	fake.spanContextMutex.Unlock()
+	if fake.SpanContextStub != nil {
+		return fake.SpanContextStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.spanContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SpanContextCallCount() int {
+	fake.spanContextMutex.RLock()
+	defer fake.spanContextMutex.RUnlock()
+	return len(fake.spanContextArgsForCall)
+}
+
+func (fake *FakeBuild) SpanContextCalls(stub func() db.SpanContext) {
+	fake.spanContextMutex.Lock()
+	defer fake.spanContextMutex.Unlock()
+	fake.SpanContextStub = stub
+}
+
+func (fake *FakeBuild) SpanContextReturns(result1 db.SpanContext) {
+	fake.spanContextMutex.Lock()
+	defer fake.spanContextMutex.Unlock()
+	fake.SpanContextStub = nil
+	fake.spanContextReturns = struct {
+		result1 db.SpanContext
+	}{result1}
+}
+
+func (fake *FakeBuild) SpanContextReturnsOnCall(i int, result1 db.SpanContext) {
+	fake.spanContextMutex.Lock()
+	defer fake.spanContextMutex.Unlock()
+	fake.SpanContextStub = nil
+	if fake.spanContextReturnsOnCall == nil {
+		fake.spanContextReturnsOnCall = make(map[int]struct {
+			result1 db.SpanContext
+		})
+	}
+	fake.spanContextReturnsOnCall[i] = struct {
+		result1 db.SpanContext
+	}{result1}
+}
+
 func (fake *FakeBuild) Start(arg1 atc.Plan) (bool, error) {
 	fake.startMutex.Lock()
 	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
@@ -3102,6 +3990,127 @@ func (fake *FakeBuild) StatusReturnsOnCall(i int, result1 db.BuildStatus) {
 	}{result1}
 }
 
+func (fake *FakeBuild) StepApproval(arg1 atc.PlanID) (atc.BuildStepApproval, bool, error) {
+	fake.stepApprovalMutex.Lock()
+	ret, specificReturn := fake.stepApprovalReturnsOnCall[len(fake.stepApprovalArgsForCall)]
+	fake.stepApprovalArgsForCall = append(fake.stepApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("StepApproval", []interface{}{arg1})
+	fake.stepApprovalMutex.Unlock()
+	if fake.StepApprovalStub != nil {
+		return fake.StepApprovalStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.stepApprovalReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) StepApprovalCallCount() int {
+	fake.stepApprovalMutex.RLock()
+	defer fake.stepApprovalMutex.RUnlock()
+	return len(fake.stepApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) StepApprovalCalls(stub func(atc.PlanID) (atc.BuildStepApproval, bool, error)) {
+	fake.stepApprovalMutex.Lock()
+	defer fake.stepApprovalMutex.Unlock()
+	fake.StepApprovalStub = stub
+}
+
+func (fake *FakeBuild) StepApprovalArgsForCall(i int) atc.PlanID {
+	fake.stepApprovalMutex.RLock()
+	defer fake.stepApprovalMutex.RUnlock()
+	argsForCall := fake.stepApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) StepApprovalReturns(result1 atc.BuildStepApproval, result2 bool, result3 error) {
+	fake.stepApprovalMutex.Lock()
+	defer fake.stepApprovalMutex.Unlock()
+	fake.StepApprovalStub = nil
+	fake.stepApprovalReturns = struct {
+		result1 atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) StepApprovalReturnsOnCall(i int, result1 atc.BuildStepApproval, result2 bool, result3 error) {
+	fake.stepApprovalMutex.Lock()
+	defer fake.stepApprovalMutex.Unlock()
+	fake.StepApprovalStub = nil
+	if fake.stepApprovalReturnsOnCall == nil {
+		fake.stepApprovalReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildStepApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.stepApprovalReturnsOnCall[i] = struct {
+		result1 atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) StepApprovals() ([]atc.BuildStepApproval, error) {
+	fake.stepApprovalsMutex.Lock()
+	ret, specificReturn := fake.stepApprovalsReturnsOnCall[len(fake.stepApprovalsArgsForCall)]
+	fake.stepApprovalsArgsForCall = append(fake.stepApprovalsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StepApprovals", []interface{}{})
+	fake.stepApprovalsMutex.Unlock()
+	if fake.StepApprovalsStub != nil {
+		return fake.StepApprovalsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.stepApprovalsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) StepApprovalsCallCount() int {
+	fake.stepApprovalsMutex.RLock()
+	defer fake.stepApprovalsMutex.RUnlock()
+	return len(fake.stepApprovalsArgsForCall)
+}
+
+func (fake *FakeBuild) StepApprovalsCalls(stub func() ([]atc.BuildStepApproval, error)) {
+	fake.stepApprovalsMutex.Lock()
+	defer fake.stepApprovalsMutex.Unlock()
+	fake.StepApprovalsStub = stub
+}
+
+func (fake *FakeBuild) StepApprovalsReturns(result1 []atc.BuildStepApproval, result2 error) {
+	fake.stepApprovalsMutex.Lock()
+	defer fake.stepApprovalsMutex.Unlock()
+	fake.StepApprovalsStub = nil
+	fake.stepApprovalsReturns = struct {
+		result1 []atc.BuildStepApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) StepApprovalsReturnsOnCall(i int, result1 []atc.BuildStepApproval, result2 error) {
+	fake.stepApprovalsMutex.Lock()
+	defer fake.stepApprovalsMutex.Unlock()
+	fake.StepApprovalsStub = nil
+	if fake.stepApprovalsReturnsOnCall == nil {
+		fake.stepApprovalsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildStepApproval
+			result2 error
+		})
+	}
+	fake.stepApprovalsReturnsOnCall[i] = struct {
+		result1 []atc.BuildStepApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -3206,6 +4215,61 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TestSummary() (atc.TestSummary, error) {
+	fake.testSummaryMutex.Lock()
+	ret, specificReturn := fake.testSummaryReturnsOnCall[len(fake.testSummaryArgsForCall)]
+	fake.testSummaryArgsForCall = append(fake.testSummaryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TestSummary", []interface{}{})
+	fake.testSummaryMutex.Unlock()
+	if fake.TestSummaryStub != nil {
+		return fake.TestSummaryStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.testSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) TestSummaryCallCount() int {
+	fake.testSummaryMutex.RLock()
+	defer fake.testSummaryMutex.RUnlock()
+	return len(fake.testSummaryArgsForCall)
+}
+
+func (fake *FakeBuild) TestSummaryCalls(stub func() (atc.TestSummary, error)) {
+	fake.testSummaryMutex.Lock()
+	defer fake.testSummaryMutex.Unlock()
+	fake.TestSummaryStub = stub
+}
+
+func (fake *FakeBuild) TestSummaryReturns(result1 atc.TestSummary, result2 error) {
+	fake.testSummaryMutex.Lock()
+	defer fake.testSummaryMutex.Unlock()
+	fake.TestSummaryStub = nil
+	fake.testSummaryReturns = struct {
+		result1 atc.TestSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TestSummaryReturnsOnCall(i int, result1 atc.TestSummary, result2 error) {
+	fake.testSummaryMutex.Lock()
+	defer fake.testSummaryMutex.Unlock()
+	fake.TestSummaryStub = nil
+	if fake.testSummaryReturnsOnCall == nil {
+		fake.testSummaryReturnsOnCall = make(map[int]struct {
+			result1 atc.TestSummary
+			result2 error
+		})
+	}
+	fake.testSummaryReturnsOnCall[i] = struct {
+		result1 atc.TestSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -3217,10 +4281,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.decideStepApprovalMutex.RLock()
+	defer fake.decideStepApprovalMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -3233,6 +4301,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.hasPlanMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.indexLogsMutex.RLock()
+	defer fake.indexLogsMutex.RUnlock()
 	fake.inputsReadyMutex.RLock()
 	defer fake.inputsReadyMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
@@ -3255,6 +4325,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobIDMutex.RUnlock()
 	fake.jobNameMutex.RLock()
 	defer fake.jobNameMutex.RUnlock()
+	fake.logArchiveMutex.RLock()
+	defer fake.logArchiveMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -3275,6 +4347,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.requestStepApprovalMutex.RLock()
+	defer fake.requestStepApprovalMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
 	defer fake.rerunNumberMutex.RUnlock()
 	fake.rerunOfMutex.RLock()
@@ -3283,28 +4357,48 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.rerunOfNameMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.resumeOfMutex.RLock()
+	defer fake.resumeOfMutex.RUnlock()
+	fake.resumedStepOutputsMutex.RLock()
+	defer fake.resumedStepOutputsMutex.RUnlock()
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveStepOutputsMutex.RLock()
+	defer fake.saveStepOutputsMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
+	fake.setLogArchiveMutex.RLock()
+	defer fake.setLogArchiveMutex.RUnlock()
+	fake.spanContextMutex.RLock()
+	defer fake.spanContextMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.statusMutex.RLock()
 	defer fake.statusMutex.RUnlock()
+	fake.stepApprovalMutex.RLock()
+	defer fake.stepApprovalMutex.RUnlock()
+	fake.stepApprovalsMutex.RLock()
+	defer fake.stepApprovalsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testSummaryMutex.RLock()
+	defer fake.testSummaryMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
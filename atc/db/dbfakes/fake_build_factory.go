@@ -8,10 +8,11 @@ import (
 )
 
 type FakeBuildFactory struct {
-	AllBuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
+	AllBuildsStub        func(db.Page, map[string]string) ([]db.Build, db.Pagination, error)
 	allBuildsMutex       sync.RWMutex
 	allBuildsArgsForCall []struct {
 		arg1 db.Page
+		arg2 map[string]string
 	}
 	allBuildsReturns struct {
 		result1 []db.Build
@@ -62,6 +63,19 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetLogIndexableBuildsStub        func(int) ([]db.Build, error)
+	getLogIndexableBuildsMutex       sync.RWMutex
+	getLogIndexableBuildsArgsForCall []struct {
+		arg1 int
+	}
+	getLogIndexableBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getLogIndexableBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	MarkNonInterceptibleBuildsStub        func() error
 	markNonInterceptibleBuildsMutex       sync.RWMutex
 	markNonInterceptibleBuildsArgsForCall []struct {
@@ -87,11 +101,12 @@ type FakeBuildFactory struct {
 		result2 db.Pagination
 		result3 error
 	}
-	VisibleBuildsStub        func([]string, db.Page) ([]db.Build, db.Pagination, error)
+	VisibleBuildsStub        func([]string, db.Page, map[string]string) ([]db.Build, db.Pagination, error)
 	visibleBuildsMutex       sync.RWMutex
 	visibleBuildsArgsForCall []struct {
 		arg1 []string
 		arg2 db.Page
+		arg3 map[string]string
 	}
 	visibleBuildsReturns struct {
 		result1 []db.Build
@@ -107,16 +122,17 @@ type FakeBuildFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBuildFactory) AllBuilds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
+func (fake *FakeBuildFactory) AllBuilds(arg1 db.Page, arg2 map[string]string) ([]db.Build, db.Pagination, error) {
 	fake.allBuildsMutex.Lock()
 	ret, specificReturn := fake.allBuildsReturnsOnCall[len(fake.allBuildsArgsForCall)]
 	fake.allBuildsArgsForCall = append(fake.allBuildsArgsForCall, struct {
 		arg1 db.Page
-	}{arg1})
-	fake.recordInvocation("AllBuilds", []interface{}{arg1})
+		arg2 map[string]string
+	}{arg1, arg2})
+	fake.recordInvocation("AllBuilds", []interface{}{arg1, arg2})
 	fake.allBuildsMutex.Unlock()
 	if fake.AllBuildsStub != nil {
-		return fake.AllBuildsStub(arg1)
+		return fake.AllBuildsStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -131,17 +147,17 @@ func (fake *FakeBuildFactory) AllBuildsCallCount() int {
 	return len(fake.allBuildsArgsForCall)
 }
 
-func (fake *FakeBuildFactory) AllBuildsCalls(stub func(db.Page) ([]db.Build, db.Pagination, error)) {
+func (fake *FakeBuildFactory) AllBuildsCalls(stub func(db.Page, map[string]string) ([]db.Build, db.Pagination, error)) {
 	fake.allBuildsMutex.Lock()
 	defer fake.allBuildsMutex.Unlock()
 	fake.AllBuildsStub = stub
 }
 
-func (fake *FakeBuildFactory) AllBuildsArgsForCall(i int) db.Page {
+func (fake *FakeBuildFactory) AllBuildsArgsForCall(i int) (db.Page, map[string]string) {
 	fake.allBuildsMutex.RLock()
 	defer fake.allBuildsMutex.RUnlock()
 	argsForCall := fake.allBuildsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeBuildFactory) AllBuildsReturns(result1 []db.Build, result2 db.Pagination, result3 error) {
@@ -349,6 +365,69 @@ func (fake *FakeBuildFactory) GetDrainableBuildsReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetLogIndexableBuilds(arg1 int) ([]db.Build, error) {
+	fake.getLogIndexableBuildsMutex.Lock()
+	ret, specificReturn := fake.getLogIndexableBuildsReturnsOnCall[len(fake.getLogIndexableBuildsArgsForCall)]
+	fake.getLogIndexableBuildsArgsForCall = append(fake.getLogIndexableBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetLogIndexableBuilds", []interface{}{arg1})
+	fake.getLogIndexableBuildsMutex.Unlock()
+	if fake.GetLogIndexableBuildsStub != nil {
+		return fake.GetLogIndexableBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getLogIndexableBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetLogIndexableBuildsCallCount() int {
+	fake.getLogIndexableBuildsMutex.RLock()
+	defer fake.getLogIndexableBuildsMutex.RUnlock()
+	return len(fake.getLogIndexableBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetLogIndexableBuildsCalls(stub func(int) ([]db.Build, error)) {
+	fake.getLogIndexableBuildsMutex.Lock()
+	defer fake.getLogIndexableBuildsMutex.Unlock()
+	fake.GetLogIndexableBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetLogIndexableBuildsArgsForCall(i int) int {
+	fake.getLogIndexableBuildsMutex.RLock()
+	defer fake.getLogIndexableBuildsMutex.RUnlock()
+	argsForCall := fake.getLogIndexableBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) GetLogIndexableBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getLogIndexableBuildsMutex.Lock()
+	defer fake.getLogIndexableBuildsMutex.Unlock()
+	fake.GetLogIndexableBuildsStub = nil
+	fake.getLogIndexableBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetLogIndexableBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getLogIndexableBuildsMutex.Lock()
+	defer fake.getLogIndexableBuildsMutex.Unlock()
+	fake.GetLogIndexableBuildsStub = nil
+	if fake.getLogIndexableBuildsReturnsOnCall == nil {
+		fake.getLogIndexableBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getLogIndexableBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) MarkNonInterceptibleBuilds() error {
 	fake.markNonInterceptibleBuildsMutex.Lock()
 	ret, specificReturn := fake.markNonInterceptibleBuildsReturnsOnCall[len(fake.markNonInterceptibleBuildsArgsForCall)]
@@ -467,7 +546,7 @@ func (fake *FakeBuildFactory) PublicBuildsReturnsOnCall(i int, result1 []db.Buil
 	}{result1, result2, result3}
 }
 
-func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page) ([]db.Build, db.Pagination, error) {
+func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page, arg3 map[string]string) ([]db.Build, db.Pagination, error) {
 	var arg1Copy []string
 	if arg1 != nil {
 		arg1Copy = make([]string, len(arg1))
@@ -478,11 +557,12 @@ func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page) ([]db.B
 	fake.visibleBuildsArgsForCall = append(fake.visibleBuildsArgsForCall, struct {
 		arg1 []string
 		arg2 db.Page
-	}{arg1Copy, arg2})
-	fake.recordInvocation("VisibleBuilds", []interface{}{arg1Copy, arg2})
+		arg3 map[string]string
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("VisibleBuilds", []interface{}{arg1Copy, arg2, arg3})
 	fake.visibleBuildsMutex.Unlock()
 	if fake.VisibleBuildsStub != nil {
-		return fake.VisibleBuildsStub(arg1, arg2)
+		return fake.VisibleBuildsStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -497,17 +577,17 @@ func (fake *FakeBuildFactory) VisibleBuildsCallCount() int {
 	return len(fake.visibleBuildsArgsForCall)
 }
 
-func (fake *FakeBuildFactory) VisibleBuildsCalls(stub func([]string, db.Page) ([]db.Build, db.Pagination, error)) {
+func (fake *FakeBuildFactory) VisibleBuildsCalls(stub func([]string, db.Page, map[string]string) ([]db.Build, db.Pagination, error)) {
 	fake.visibleBuildsMutex.Lock()
 	defer fake.visibleBuildsMutex.Unlock()
 	fake.VisibleBuildsStub = stub
 }
 
-func (fake *FakeBuildFactory) VisibleBuildsArgsForCall(i int) ([]string, db.Page) {
+func (fake *FakeBuildFactory) VisibleBuildsArgsForCall(i int) ([]string, db.Page, map[string]string) {
 	fake.visibleBuildsMutex.RLock()
 	defer fake.visibleBuildsMutex.RUnlock()
 	argsForCall := fake.visibleBuildsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeBuildFactory) VisibleBuildsReturns(result1 []db.Build, result2 db.Pagination, result3 error) {
@@ -550,6 +630,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.getAllStartedBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
+	fake.getLogIndexableBuildsMutex.RLock()
+	defer fake.getLogIndexableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
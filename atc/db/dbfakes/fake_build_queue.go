@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeBuildQueue struct {
+	AdmitStub        func(db.Build, int) (bool, int, error)
+	admitMutex       sync.RWMutex
+	admitArgsForCall []struct {
+		arg1 db.Build
+		arg2 int
+	}
+	admitReturns struct {
+		result1 bool
+		result2 int
+		result3 error
+	}
+	admitReturnsOnCall map[int]struct {
+		result1 bool
+		result2 int
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildQueue) Admit(arg1 db.Build, arg2 int) (bool, int, error) {
+	fake.admitMutex.Lock()
+	ret, specificReturn := fake.admitReturnsOnCall[len(fake.admitArgsForCall)]
+	fake.admitArgsForCall = append(fake.admitArgsForCall, struct {
+		arg1 db.Build
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("Admit", []interface{}{arg1, arg2})
+	fake.admitMutex.Unlock()
+	if fake.AdmitStub != nil {
+		return fake.AdmitStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.admitReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuildQueue) AdmitCallCount() int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return len(fake.admitArgsForCall)
+}
+
+func (fake *FakeBuildQueue) AdmitCalls(stub func(db.Build, int) (bool, int, error)) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = stub
+}
+
+func (fake *FakeBuildQueue) AdmitArgsForCall(i int) (db.Build, int) {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	argsForCall := fake.admitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuildQueue) AdmitReturns(result1 bool, result2 int, result3 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	fake.admitReturns = struct {
+		result1 bool
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildQueue) AdmitReturnsOnCall(i int, result1 bool, result2 int, result3 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	if fake.admitReturnsOnCall == nil {
+		fake.admitReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 int
+			result3 error
+		})
+	}
+	fake.admitReturnsOnCall[i] = struct {
+		result1 bool
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuildQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBuildQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.BuildQueue = new(FakeBuildQueue)
@@ -110,6 +110,16 @@ type FakeCheck struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	IsManuallyTriggeredStub        func() bool
+	isManuallyTriggeredMutex       sync.RWMutex
+	isManuallyTriggeredArgsForCall []struct {
+	}
+	isManuallyTriggeredReturns struct {
+		result1 bool
+	}
+	isManuallyTriggeredReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -754,6 +764,58 @@ func (fake *FakeCheck) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeCheck) IsManuallyTriggered() bool {
+	fake.isManuallyTriggeredMutex.Lock()
+	ret, specificReturn := fake.isManuallyTriggeredReturnsOnCall[len(fake.isManuallyTriggeredArgsForCall)]
+	fake.isManuallyTriggeredArgsForCall = append(fake.isManuallyTriggeredArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsManuallyTriggered", []interface{}{})
+	fake.isManuallyTriggeredMutex.Unlock()
+	if fake.IsManuallyTriggeredStub != nil {
+		return fake.IsManuallyTriggeredStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isManuallyTriggeredReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeCheck) IsManuallyTriggeredCallCount() int {
+	fake.isManuallyTriggeredMutex.RLock()
+	defer fake.isManuallyTriggeredMutex.RUnlock()
+	return len(fake.isManuallyTriggeredArgsForCall)
+}
+
+func (fake *FakeCheck) IsManuallyTriggeredCalls(stub func() bool) {
+	fake.isManuallyTriggeredMutex.Lock()
+	defer fake.isManuallyTriggeredMutex.Unlock()
+	fake.IsManuallyTriggeredStub = stub
+}
+
+func (fake *FakeCheck) IsManuallyTriggeredReturns(result1 bool) {
+	fake.isManuallyTriggeredMutex.Lock()
+	defer fake.isManuallyTriggeredMutex.Unlock()
+	fake.IsManuallyTriggeredStub = nil
+	fake.isManuallyTriggeredReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCheck) IsManuallyTriggeredReturnsOnCall(i int, result1 bool) {
+	fake.isManuallyTriggeredMutex.Lock()
+	defer fake.isManuallyTriggeredMutex.Unlock()
+	fake.IsManuallyTriggeredStub = nil
+	if fake.isManuallyTriggeredReturnsOnCall == nil {
+		fake.isManuallyTriggeredReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isManuallyTriggeredReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeCheck) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1525,6 +1587,8 @@ func (fake *FakeCheck) Invocations() map[string][][]interface{} {
 	defer fake.finishWithErrorMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.isManuallyTriggeredMutex.RLock()
+	defer fake.isManuallyTriggeredMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -2,6 +2,7 @@
 package dbfakes
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -98,9 +99,10 @@ type FakeJob struct {
 		result1 atc.JobConfig
 		result2 error
 	}
-	CreateBuildStub        func() (db.Build, error)
+	CreateBuildStub        func(context.Context) (db.Build, error)
 	createBuildMutex       sync.RWMutex
 	createBuildArgsForCall []struct {
+		arg1 context.Context
 	}
 	createBuildReturns struct {
 		result1 db.Build
@@ -144,6 +146,19 @@ type FakeJob struct {
 		result2 db.Build
 		result3 error
 	}
+	FireScheduleStub        func(time.Time) (bool, error)
+	fireScheduleMutex       sync.RWMutex
+	fireScheduleArgsForCall []struct {
+		arg1 time.Time
+	}
+	fireScheduleReturns struct {
+		result1 bool
+		result2 error
+	}
+	fireScheduleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FirstLoggedBuildIDStub        func() int
 	firstLoggedBuildIDMutex       sync.RWMutex
 	firstLoggedBuildIDArgsForCall []struct {
@@ -192,6 +207,19 @@ type FakeJob struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetSupersededBuildsStub        func([]db.BuildInput) ([]db.Build, error)
+	getSupersededBuildsMutex       sync.RWMutex
+	getSupersededBuildsArgsForCall []struct {
+		arg1 []db.BuildInput
+	}
+	getSupersededBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getSupersededBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	HasNewInputsStub        func() bool
 	hasNewInputsMutex       sync.RWMutex
 	hasNewInputsArgsForCall []struct {
@@ -342,10 +370,11 @@ type FakeJob struct {
 	requestScheduleReturnsOnCall map[int]struct {
 		result1 error
 	}
-	RerunBuildStub        func(db.Build) (db.Build, error)
+	RerunBuildStub        func(db.Build, bool) (db.Build, error)
 	rerunBuildMutex       sync.RWMutex
 	rerunBuildArgsForCall []struct {
 		arg1 db.Build
+		arg2 bool
 	}
 	rerunBuildReturns struct {
 		result1 db.Build
@@ -367,6 +396,16 @@ type FakeJob struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScheduleStub        func() *atc.JobSchedule
+	scheduleMutex       sync.RWMutex
+	scheduleArgsForCall []struct {
+	}
+	scheduleReturns struct {
+		result1 *atc.JobSchedule
+	}
+	scheduleReturnsOnCall map[int]struct {
+		result1 *atc.JobSchedule
+	}
 	ScheduleBuildStub        func(db.Build) (bool, error)
 	scheduleBuildMutex       sync.RWMutex
 	scheduleBuildArgsForCall []struct {
@@ -380,6 +419,16 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	ScheduleLastFiredStub        func() time.Time
+	scheduleLastFiredMutex       sync.RWMutex
+	scheduleLastFiredArgsForCall []struct {
+	}
+	scheduleLastFiredReturns struct {
+		result1 time.Time
+	}
+	scheduleLastFiredReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	ScheduleRequestedTimeStub        func() time.Time
 	scheduleRequestedTimeMutex       sync.RWMutex
 	scheduleRequestedTimeArgsForCall []struct {
@@ -431,6 +480,19 @@ type FakeJob struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestHistoryStub        func(int) ([]atc.TestHistory, error)
+	testHistoryMutex       sync.RWMutex
+	testHistoryArgsForCall []struct {
+		arg1 int
+	}
+	testHistoryReturns struct {
+		result1 []atc.TestHistory
+		result2 error
+	}
+	testHistoryReturnsOnCall map[int]struct {
+		result1 []atc.TestHistory
+		result2 error
+	}
 	UnpauseStub        func() error
 	unpauseMutex       sync.RWMutex
 	unpauseArgsForCall []struct {
@@ -850,15 +912,16 @@ func (fake *FakeJob) ConfigReturnsOnCall(i int, result1 atc.JobConfig, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeJob) CreateBuild() (db.Build, error) {
+func (fake *FakeJob) CreateBuild(arg1 context.Context) (db.Build, error) {
 	fake.createBuildMutex.Lock()
 	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
 	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
-	}{})
-	fake.recordInvocation("CreateBuild", []interface{}{})
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("CreateBuild", []interface{}{arg1})
 	fake.createBuildMutex.Unlock()
 	if fake.CreateBuildStub != nil {
-		return fake.CreateBuildStub()
+		return fake.CreateBuildStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -873,12 +936,19 @@ func (fake *FakeJob) CreateBuildCallCount() int {
 	return len(fake.createBuildArgsForCall)
 }
 
-func (fake *FakeJob) CreateBuildCalls(stub func() (db.Build, error)) {
+func (fake *FakeJob) CreateBuildCalls(stub func(context.Context) (db.Build, error)) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
 	fake.CreateBuildStub = stub
 }
 
+func (fake *FakeJob) CreateBuildArgsForCall(i int) context.Context {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	argsForCall := fake.createBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeJob) CreateBuildReturns(result1 db.Build, result2 error) {
 	fake.createBuildMutex.Lock()
 	defer fake.createBuildMutex.Unlock()
@@ -1067,6 +1137,69 @@ func (fake *FakeJob) FinishedAndNextBuildReturnsOnCall(i int, result1 db.Build,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeJob) FireSchedule(arg1 time.Time) (bool, error) {
+	fake.fireScheduleMutex.Lock()
+	ret, specificReturn := fake.fireScheduleReturnsOnCall[len(fake.fireScheduleArgsForCall)]
+	fake.fireScheduleArgsForCall = append(fake.fireScheduleArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("FireSchedule", []interface{}{arg1})
+	fake.fireScheduleMutex.Unlock()
+	if fake.FireScheduleStub != nil {
+		return fake.FireScheduleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.fireScheduleReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) FireScheduleCallCount() int {
+	fake.fireScheduleMutex.RLock()
+	defer fake.fireScheduleMutex.RUnlock()
+	return len(fake.fireScheduleArgsForCall)
+}
+
+func (fake *FakeJob) FireScheduleCalls(stub func(time.Time) (bool, error)) {
+	fake.fireScheduleMutex.Lock()
+	defer fake.fireScheduleMutex.Unlock()
+	fake.FireScheduleStub = stub
+}
+
+func (fake *FakeJob) FireScheduleArgsForCall(i int) time.Time {
+	fake.fireScheduleMutex.RLock()
+	defer fake.fireScheduleMutex.RUnlock()
+	argsForCall := fake.fireScheduleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) FireScheduleReturns(result1 bool, result2 error) {
+	fake.fireScheduleMutex.Lock()
+	defer fake.fireScheduleMutex.Unlock()
+	fake.FireScheduleStub = nil
+	fake.fireScheduleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) FireScheduleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.fireScheduleMutex.Lock()
+	defer fake.fireScheduleMutex.Unlock()
+	fake.FireScheduleStub = nil
+	if fake.fireScheduleReturnsOnCall == nil {
+		fake.fireScheduleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.fireScheduleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) FirstLoggedBuildID() int {
 	fake.firstLoggedBuildIDMutex.Lock()
 	ret, specificReturn := fake.firstLoggedBuildIDReturnsOnCall[len(fake.firstLoggedBuildIDArgsForCall)]
@@ -1287,6 +1420,74 @@ func (fake *FakeJob) GetPendingBuildsReturnsOnCall(i int, result1 []db.Build, re
 	}{result1, result2}
 }
 
+func (fake *FakeJob) GetSupersededBuilds(arg1 []db.BuildInput) ([]db.Build, error) {
+	var arg1Copy []db.BuildInput
+	if arg1 != nil {
+		arg1Copy = make([]db.BuildInput, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getSupersededBuildsMutex.Lock()
+	ret, specificReturn := fake.getSupersededBuildsReturnsOnCall[len(fake.getSupersededBuildsArgsForCall)]
+	fake.getSupersededBuildsArgsForCall = append(fake.getSupersededBuildsArgsForCall, struct {
+		arg1 []db.BuildInput
+	}{arg1Copy})
+	fake.recordInvocation("GetSupersededBuilds", []interface{}{arg1Copy})
+	fake.getSupersededBuildsMutex.Unlock()
+	if fake.GetSupersededBuildsStub != nil {
+		return fake.GetSupersededBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getSupersededBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) GetSupersededBuildsCallCount() int {
+	fake.getSupersededBuildsMutex.RLock()
+	defer fake.getSupersededBuildsMutex.RUnlock()
+	return len(fake.getSupersededBuildsArgsForCall)
+}
+
+func (fake *FakeJob) GetSupersededBuildsCalls(stub func([]db.BuildInput) ([]db.Build, error)) {
+	fake.getSupersededBuildsMutex.Lock()
+	defer fake.getSupersededBuildsMutex.Unlock()
+	fake.GetSupersededBuildsStub = stub
+}
+
+func (fake *FakeJob) GetSupersededBuildsArgsForCall(i int) []db.BuildInput {
+	fake.getSupersededBuildsMutex.RLock()
+	defer fake.getSupersededBuildsMutex.RUnlock()
+	argsForCall := fake.getSupersededBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) GetSupersededBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getSupersededBuildsMutex.Lock()
+	defer fake.getSupersededBuildsMutex.Unlock()
+	fake.GetSupersededBuildsStub = nil
+	fake.getSupersededBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) GetSupersededBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getSupersededBuildsMutex.Lock()
+	defer fake.getSupersededBuildsMutex.Unlock()
+	fake.GetSupersededBuildsStub = nil
+	if fake.getSupersededBuildsReturnsOnCall == nil {
+		fake.getSupersededBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getSupersededBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) HasNewInputs() bool {
 	fake.hasNewInputsMutex.Lock()
 	ret, specificReturn := fake.hasNewInputsReturnsOnCall[len(fake.hasNewInputsArgsForCall)]
@@ -2030,16 +2231,17 @@ func (fake *FakeJob) RequestScheduleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+func (fake *FakeJob) RerunBuild(arg1 db.Build, arg2 bool) (db.Build, error) {
 	fake.rerunBuildMutex.Lock()
 	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
 	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
 		arg1 db.Build
-	}{arg1})
-	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1, arg2})
 	fake.rerunBuildMutex.Unlock()
 	if fake.RerunBuildStub != nil {
-		return fake.RerunBuildStub(arg1)
+		return fake.RerunBuildStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2054,17 +2256,17 @@ func (fake *FakeJob) RerunBuildCallCount() int {
 	return len(fake.rerunBuildArgsForCall)
 }
 
-func (fake *FakeJob) RerunBuildCalls(stub func(db.Build) (db.Build, error)) {
+func (fake *FakeJob) RerunBuildCalls(stub func(db.Build, bool) (db.Build, error)) {
 	fake.rerunBuildMutex.Lock()
 	defer fake.rerunBuildMutex.Unlock()
 	fake.RerunBuildStub = stub
 }
 
-func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+func (fake *FakeJob) RerunBuildArgsForCall(i int) (db.Build, bool) {
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
 	argsForCall := fake.rerunBuildArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
@@ -2154,6 +2356,58 @@ func (fake *FakeJob) SaveNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) Schedule() *atc.JobSchedule {
+	fake.scheduleMutex.Lock()
+	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
+	fake.scheduleArgsForCall = append(fake.scheduleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Schedule", []interface{}{})
+	fake.scheduleMutex.Unlock()
+	if fake.ScheduleStub != nil {
+		return fake.ScheduleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleCallCount() int {
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
+	return len(fake.scheduleArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleCalls(stub func() *atc.JobSchedule) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = stub
+}
+
+func (fake *FakeJob) ScheduleReturns(result1 *atc.JobSchedule) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	fake.scheduleReturns = struct {
+		result1 *atc.JobSchedule
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleReturnsOnCall(i int, result1 *atc.JobSchedule) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	if fake.scheduleReturnsOnCall == nil {
+		fake.scheduleReturnsOnCall = make(map[int]struct {
+			result1 *atc.JobSchedule
+		})
+	}
+	fake.scheduleReturnsOnCall[i] = struct {
+		result1 *atc.JobSchedule
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleBuild(arg1 db.Build) (bool, error) {
 	fake.scheduleBuildMutex.Lock()
 	ret, specificReturn := fake.scheduleBuildReturnsOnCall[len(fake.scheduleBuildArgsForCall)]
@@ -2217,6 +2471,58 @@ func (fake *FakeJob) ScheduleBuildReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeJob) ScheduleLastFired() time.Time {
+	fake.scheduleLastFiredMutex.Lock()
+	ret, specificReturn := fake.scheduleLastFiredReturnsOnCall[len(fake.scheduleLastFiredArgsForCall)]
+	fake.scheduleLastFiredArgsForCall = append(fake.scheduleLastFiredArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ScheduleLastFired", []interface{}{})
+	fake.scheduleLastFiredMutex.Unlock()
+	if fake.ScheduleLastFiredStub != nil {
+		return fake.ScheduleLastFiredStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleLastFiredReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleLastFiredCallCount() int {
+	fake.scheduleLastFiredMutex.RLock()
+	defer fake.scheduleLastFiredMutex.RUnlock()
+	return len(fake.scheduleLastFiredArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleLastFiredCalls(stub func() time.Time) {
+	fake.scheduleLastFiredMutex.Lock()
+	defer fake.scheduleLastFiredMutex.Unlock()
+	fake.ScheduleLastFiredStub = stub
+}
+
+func (fake *FakeJob) ScheduleLastFiredReturns(result1 time.Time) {
+	fake.scheduleLastFiredMutex.Lock()
+	defer fake.scheduleLastFiredMutex.Unlock()
+	fake.ScheduleLastFiredStub = nil
+	fake.scheduleLastFiredReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleLastFiredReturnsOnCall(i int, result1 time.Time) {
+	fake.scheduleLastFiredMutex.Lock()
+	defer fake.scheduleLastFiredMutex.Unlock()
+	fake.ScheduleLastFiredStub = nil
+	if fake.scheduleLastFiredReturnsOnCall == nil {
+		fake.scheduleLastFiredReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.scheduleLastFiredReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleRequestedTime() time.Time {
 	fake.scheduleRequestedTimeMutex.Lock()
 	ret, specificReturn := fake.scheduleRequestedTimeReturnsOnCall[len(fake.scheduleRequestedTimeArgsForCall)]
@@ -2485,6 +2791,69 @@ func (fake *FakeJob) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) TestHistory(arg1 int) ([]atc.TestHistory, error) {
+	fake.testHistoryMutex.Lock()
+	ret, specificReturn := fake.testHistoryReturnsOnCall[len(fake.testHistoryArgsForCall)]
+	fake.testHistoryArgsForCall = append(fake.testHistoryArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("TestHistory", []interface{}{arg1})
+	fake.testHistoryMutex.Unlock()
+	if fake.TestHistoryStub != nil {
+		return fake.TestHistoryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.testHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) TestHistoryCallCount() int {
+	fake.testHistoryMutex.RLock()
+	defer fake.testHistoryMutex.RUnlock()
+	return len(fake.testHistoryArgsForCall)
+}
+
+func (fake *FakeJob) TestHistoryCalls(stub func(int) ([]atc.TestHistory, error)) {
+	fake.testHistoryMutex.Lock()
+	defer fake.testHistoryMutex.Unlock()
+	fake.TestHistoryStub = stub
+}
+
+func (fake *FakeJob) TestHistoryArgsForCall(i int) int {
+	fake.testHistoryMutex.RLock()
+	defer fake.testHistoryMutex.RUnlock()
+	argsForCall := fake.testHistoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) TestHistoryReturns(result1 []atc.TestHistory, result2 error) {
+	fake.testHistoryMutex.Lock()
+	defer fake.testHistoryMutex.Unlock()
+	fake.TestHistoryStub = nil
+	fake.testHistoryReturns = struct {
+		result1 []atc.TestHistory
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) TestHistoryReturnsOnCall(i int, result1 []atc.TestHistory, result2 error) {
+	fake.testHistoryMutex.Lock()
+	defer fake.testHistoryMutex.Unlock()
+	fake.TestHistoryStub = nil
+	if fake.testHistoryReturnsOnCall == nil {
+		fake.testHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestHistory
+			result2 error
+		})
+	}
+	fake.testHistoryReturnsOnCall[i] = struct {
+		result1 []atc.TestHistory
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Unpause() error {
 	fake.unpauseMutex.Lock()
 	ret, specificReturn := fake.unpauseReturnsOnCall[len(fake.unpauseArgsForCall)]
@@ -2680,6 +3049,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.ensurePendingBuildExistsMutex.RUnlock()
 	fake.finishedAndNextBuildMutex.RLock()
 	defer fake.finishedAndNextBuildMutex.RUnlock()
+	fake.fireScheduleMutex.RLock()
+	defer fake.fireScheduleMutex.RUnlock()
 	fake.firstLoggedBuildIDMutex.RLock()
 	defer fake.firstLoggedBuildIDMutex.RUnlock()
 	fake.getFullNextBuildInputsMutex.RLock()
@@ -2688,6 +3059,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.getNextBuildInputsMutex.RUnlock()
 	fake.getPendingBuildsMutex.RLock()
 	defer fake.getPendingBuildsMutex.RUnlock()
+	fake.getSupersededBuildsMutex.RLock()
+	defer fake.getSupersededBuildsMutex.RUnlock()
 	fake.hasNewInputsMutex.RLock()
 	defer fake.hasNewInputsMutex.RUnlock()
 	fake.iDMutex.RLock()
@@ -2720,8 +3093,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
+	fake.scheduleLastFiredMutex.RLock()
+	defer fake.scheduleLastFiredMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
@@ -2732,6 +3109,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testHistoryMutex.RLock()
+	defer fake.testHistoryMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
 	fake.updateFirstLoggedBuildIDMutex.RLock()
@@ -33,6 +33,18 @@ type FakeJobFactory struct {
 		result1 db.Jobs
 		result2 error
 	}
+	JobsWithSchedulesStub        func() (db.Jobs, error)
+	jobsWithSchedulesMutex       sync.RWMutex
+	jobsWithSchedulesArgsForCall []struct {
+	}
+	jobsWithSchedulesReturns struct {
+		result1 db.Jobs
+		result2 error
+	}
+	jobsWithSchedulesReturnsOnCall map[int]struct {
+		result1 db.Jobs
+		result2 error
+	}
 	VisibleJobsStub        func([]string) (atc.Dashboard, error)
 	visibleJobsMutex       sync.RWMutex
 	visibleJobsArgsForCall []struct {
@@ -160,6 +172,61 @@ func (fake *FakeJobFactory) JobsToScheduleReturnsOnCall(i int, result1 db.Jobs,
 	}{result1, result2}
 }
 
+func (fake *FakeJobFactory) JobsWithSchedules() (db.Jobs, error) {
+	fake.jobsWithSchedulesMutex.Lock()
+	ret, specificReturn := fake.jobsWithSchedulesReturnsOnCall[len(fake.jobsWithSchedulesArgsForCall)]
+	fake.jobsWithSchedulesArgsForCall = append(fake.jobsWithSchedulesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("JobsWithSchedules", []interface{}{})
+	fake.jobsWithSchedulesMutex.Unlock()
+	if fake.JobsWithSchedulesStub != nil {
+		return fake.JobsWithSchedulesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.jobsWithSchedulesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) JobsWithSchedulesCallCount() int {
+	fake.jobsWithSchedulesMutex.RLock()
+	defer fake.jobsWithSchedulesMutex.RUnlock()
+	return len(fake.jobsWithSchedulesArgsForCall)
+}
+
+func (fake *FakeJobFactory) JobsWithSchedulesCalls(stub func() (db.Jobs, error)) {
+	fake.jobsWithSchedulesMutex.Lock()
+	defer fake.jobsWithSchedulesMutex.Unlock()
+	fake.JobsWithSchedulesStub = stub
+}
+
+func (fake *FakeJobFactory) JobsWithSchedulesReturns(result1 db.Jobs, result2 error) {
+	fake.jobsWithSchedulesMutex.Lock()
+	defer fake.jobsWithSchedulesMutex.Unlock()
+	fake.JobsWithSchedulesStub = nil
+	fake.jobsWithSchedulesReturns = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) JobsWithSchedulesReturnsOnCall(i int, result1 db.Jobs, result2 error) {
+	fake.jobsWithSchedulesMutex.Lock()
+	defer fake.jobsWithSchedulesMutex.Unlock()
+	fake.JobsWithSchedulesStub = nil
+	if fake.jobsWithSchedulesReturnsOnCall == nil {
+		fake.jobsWithSchedulesReturnsOnCall = make(map[int]struct {
+			result1 db.Jobs
+			result2 error
+		})
+	}
+	fake.jobsWithSchedulesReturnsOnCall[i] = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) VisibleJobs(arg1 []string) (atc.Dashboard, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -235,6 +302,8 @@ func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	defer fake.allActiveJobsMutex.RUnlock()
 	fake.jobsToScheduleMutex.RLock()
 	defer fake.jobsToScheduleMutex.RUnlock()
+	fake.jobsWithSchedulesMutex.RLock()
+	defer fake.jobsWithSchedulesMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
 	defer fake.visibleJobsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,511 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeNotificationRepository struct {
+	DeliveriesStub        func(int, string, int) ([]atc.NotificationDelivery, bool, error)
+	deliveriesMutex       sync.RWMutex
+	deliveriesArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 int
+	}
+	deliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	deliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	DestroySubscriptionStub        func(int, string) (bool, error)
+	destroySubscriptionMutex       sync.RWMutex
+	destroySubscriptionArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	destroySubscriptionReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroySubscriptionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FinishDeliveryAttemptStub        func(int, db.NotificationDeliveryAttempt) error
+	finishDeliveryAttemptMutex       sync.RWMutex
+	finishDeliveryAttemptArgsForCall []struct {
+		arg1 int
+		arg2 db.NotificationDeliveryAttempt
+	}
+	finishDeliveryAttemptReturns struct {
+		result1 error
+	}
+	finishDeliveryAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PendingDeliveriesStub        func(int) ([]db.PendingNotificationDelivery, error)
+	pendingDeliveriesMutex       sync.RWMutex
+	pendingDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	pendingDeliveriesReturns struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}
+	pendingDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}
+	SaveSubscriptionStub        func(int, atc.NotificationSubscription) error
+	saveSubscriptionMutex       sync.RWMutex
+	saveSubscriptionArgsForCall []struct {
+		arg1 int
+		arg2 atc.NotificationSubscription
+	}
+	saveSubscriptionReturns struct {
+		result1 error
+	}
+	saveSubscriptionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SubscriptionsStub        func(int) ([]atc.NotificationSubscription, error)
+	subscriptionsMutex       sync.RWMutex
+	subscriptionsArgsForCall []struct {
+		arg1 int
+	}
+	subscriptionsReturns struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}
+	subscriptionsReturnsOnCall map[int]struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNotificationRepository) Deliveries(arg1 int, arg2 string, arg3 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.deliveriesMutex.Lock()
+	ret, specificReturn := fake.deliveriesReturnsOnCall[len(fake.deliveriesArgsForCall)]
+	fake.deliveriesArgsForCall = append(fake.deliveriesArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Deliveries", []interface{}{arg1, arg2, arg3})
+	fake.deliveriesMutex.Unlock()
+	if fake.DeliveriesStub != nil {
+		return fake.DeliveriesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeNotificationRepository) DeliveriesCallCount() int {
+	fake.deliveriesMutex.RLock()
+	defer fake.deliveriesMutex.RUnlock()
+	return len(fake.deliveriesArgsForCall)
+}
+
+func (fake *FakeNotificationRepository) DeliveriesCalls(stub func(int, string, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.deliveriesMutex.Lock()
+	defer fake.deliveriesMutex.Unlock()
+	fake.DeliveriesStub = stub
+}
+
+func (fake *FakeNotificationRepository) DeliveriesArgsForCall(i int) (int, string, int) {
+	fake.deliveriesMutex.RLock()
+	defer fake.deliveriesMutex.RUnlock()
+	argsForCall := fake.deliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNotificationRepository) DeliveriesReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.deliveriesMutex.Lock()
+	defer fake.deliveriesMutex.Unlock()
+	fake.DeliveriesStub = nil
+	fake.deliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNotificationRepository) DeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.deliveriesMutex.Lock()
+	defer fake.deliveriesMutex.Unlock()
+	fake.DeliveriesStub = nil
+	if fake.deliveriesReturnsOnCall == nil {
+		fake.deliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.deliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNotificationRepository) DestroySubscription(arg1 int, arg2 string) (bool, error) {
+	fake.destroySubscriptionMutex.Lock()
+	ret, specificReturn := fake.destroySubscriptionReturnsOnCall[len(fake.destroySubscriptionArgsForCall)]
+	fake.destroySubscriptionArgsForCall = append(fake.destroySubscriptionArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DestroySubscription", []interface{}{arg1, arg2})
+	fake.destroySubscriptionMutex.Unlock()
+	if fake.DestroySubscriptionStub != nil {
+		return fake.DestroySubscriptionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroySubscriptionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNotificationRepository) DestroySubscriptionCallCount() int {
+	fake.destroySubscriptionMutex.RLock()
+	defer fake.destroySubscriptionMutex.RUnlock()
+	return len(fake.destroySubscriptionArgsForCall)
+}
+
+func (fake *FakeNotificationRepository) DestroySubscriptionCalls(stub func(int, string) (bool, error)) {
+	fake.destroySubscriptionMutex.Lock()
+	defer fake.destroySubscriptionMutex.Unlock()
+	fake.DestroySubscriptionStub = stub
+}
+
+func (fake *FakeNotificationRepository) DestroySubscriptionArgsForCall(i int) (int, string) {
+	fake.destroySubscriptionMutex.RLock()
+	defer fake.destroySubscriptionMutex.RUnlock()
+	argsForCall := fake.destroySubscriptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotificationRepository) DestroySubscriptionReturns(result1 bool, result2 error) {
+	fake.destroySubscriptionMutex.Lock()
+	defer fake.destroySubscriptionMutex.Unlock()
+	fake.DestroySubscriptionStub = nil
+	fake.destroySubscriptionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationRepository) DestroySubscriptionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroySubscriptionMutex.Lock()
+	defer fake.destroySubscriptionMutex.Unlock()
+	fake.DestroySubscriptionStub = nil
+	if fake.destroySubscriptionReturnsOnCall == nil {
+		fake.destroySubscriptionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroySubscriptionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationRepository) FinishDeliveryAttempt(arg1 int, arg2 db.NotificationDeliveryAttempt) error {
+	fake.finishDeliveryAttemptMutex.Lock()
+	ret, specificReturn := fake.finishDeliveryAttemptReturnsOnCall[len(fake.finishDeliveryAttemptArgsForCall)]
+	fake.finishDeliveryAttemptArgsForCall = append(fake.finishDeliveryAttemptArgsForCall, struct {
+		arg1 int
+		arg2 db.NotificationDeliveryAttempt
+	}{arg1, arg2})
+	fake.recordInvocation("FinishDeliveryAttempt", []interface{}{arg1, arg2})
+	fake.finishDeliveryAttemptMutex.Unlock()
+	if fake.FinishDeliveryAttemptStub != nil {
+		return fake.FinishDeliveryAttemptStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.finishDeliveryAttemptReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotificationRepository) FinishDeliveryAttemptCallCount() int {
+	fake.finishDeliveryAttemptMutex.RLock()
+	defer fake.finishDeliveryAttemptMutex.RUnlock()
+	return len(fake.finishDeliveryAttemptArgsForCall)
+}
+
+func (fake *FakeNotificationRepository) FinishDeliveryAttemptCalls(stub func(int, db.NotificationDeliveryAttempt) error) {
+	fake.finishDeliveryAttemptMutex.Lock()
+	defer fake.finishDeliveryAttemptMutex.Unlock()
+	fake.FinishDeliveryAttemptStub = stub
+}
+
+func (fake *FakeNotificationRepository) FinishDeliveryAttemptArgsForCall(i int) (int, db.NotificationDeliveryAttempt) {
+	fake.finishDeliveryAttemptMutex.RLock()
+	defer fake.finishDeliveryAttemptMutex.RUnlock()
+	argsForCall := fake.finishDeliveryAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotificationRepository) FinishDeliveryAttemptReturns(result1 error) {
+	fake.finishDeliveryAttemptMutex.Lock()
+	defer fake.finishDeliveryAttemptMutex.Unlock()
+	fake.FinishDeliveryAttemptStub = nil
+	fake.finishDeliveryAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationRepository) FinishDeliveryAttemptReturnsOnCall(i int, result1 error) {
+	fake.finishDeliveryAttemptMutex.Lock()
+	defer fake.finishDeliveryAttemptMutex.Unlock()
+	fake.FinishDeliveryAttemptStub = nil
+	if fake.finishDeliveryAttemptReturnsOnCall == nil {
+		fake.finishDeliveryAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishDeliveryAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationRepository) PendingDeliveries(arg1 int) ([]db.PendingNotificationDelivery, error) {
+	fake.pendingDeliveriesMutex.Lock()
+	ret, specificReturn := fake.pendingDeliveriesReturnsOnCall[len(fake.pendingDeliveriesArgsForCall)]
+	fake.pendingDeliveriesArgsForCall = append(fake.pendingDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("PendingDeliveries", []interface{}{arg1})
+	fake.pendingDeliveriesMutex.Unlock()
+	if fake.PendingDeliveriesStub != nil {
+		return fake.PendingDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pendingDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNotificationRepository) PendingDeliveriesCallCount() int {
+	fake.pendingDeliveriesMutex.RLock()
+	defer fake.pendingDeliveriesMutex.RUnlock()
+	return len(fake.pendingDeliveriesArgsForCall)
+}
+
+func (fake *FakeNotificationRepository) PendingDeliveriesCalls(stub func(int) ([]db.PendingNotificationDelivery, error)) {
+	fake.pendingDeliveriesMutex.Lock()
+	defer fake.pendingDeliveriesMutex.Unlock()
+	fake.PendingDeliveriesStub = stub
+}
+
+func (fake *FakeNotificationRepository) PendingDeliveriesArgsForCall(i int) int {
+	fake.pendingDeliveriesMutex.RLock()
+	defer fake.pendingDeliveriesMutex.RUnlock()
+	argsForCall := fake.pendingDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNotificationRepository) PendingDeliveriesReturns(result1 []db.PendingNotificationDelivery, result2 error) {
+	fake.pendingDeliveriesMutex.Lock()
+	defer fake.pendingDeliveriesMutex.Unlock()
+	fake.PendingDeliveriesStub = nil
+	fake.pendingDeliveriesReturns = struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationRepository) PendingDeliveriesReturnsOnCall(i int, result1 []db.PendingNotificationDelivery, result2 error) {
+	fake.pendingDeliveriesMutex.Lock()
+	defer fake.pendingDeliveriesMutex.Unlock()
+	fake.PendingDeliveriesStub = nil
+	if fake.pendingDeliveriesReturnsOnCall == nil {
+		fake.pendingDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.PendingNotificationDelivery
+			result2 error
+		})
+	}
+	fake.pendingDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.PendingNotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationRepository) SaveSubscription(arg1 int, arg2 atc.NotificationSubscription) error {
+	fake.saveSubscriptionMutex.Lock()
+	ret, specificReturn := fake.saveSubscriptionReturnsOnCall[len(fake.saveSubscriptionArgsForCall)]
+	fake.saveSubscriptionArgsForCall = append(fake.saveSubscriptionArgsForCall, struct {
+		arg1 int
+		arg2 atc.NotificationSubscription
+	}{arg1, arg2})
+	fake.recordInvocation("SaveSubscription", []interface{}{arg1, arg2})
+	fake.saveSubscriptionMutex.Unlock()
+	if fake.SaveSubscriptionStub != nil {
+		return fake.SaveSubscriptionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveSubscriptionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotificationRepository) SaveSubscriptionCallCount() int {
+	fake.saveSubscriptionMutex.RLock()
+	defer fake.saveSubscriptionMutex.RUnlock()
+	return len(fake.saveSubscriptionArgsForCall)
+}
+
+func (fake *FakeNotificationRepository) SaveSubscriptionCalls(stub func(int, atc.NotificationSubscription) error) {
+	fake.saveSubscriptionMutex.Lock()
+	defer fake.saveSubscriptionMutex.Unlock()
+	fake.SaveSubscriptionStub = stub
+}
+
+func (fake *FakeNotificationRepository) SaveSubscriptionArgsForCall(i int) (int, atc.NotificationSubscription) {
+	fake.saveSubscriptionMutex.RLock()
+	defer fake.saveSubscriptionMutex.RUnlock()
+	argsForCall := fake.saveSubscriptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotificationRepository) SaveSubscriptionReturns(result1 error) {
+	fake.saveSubscriptionMutex.Lock()
+	defer fake.saveSubscriptionMutex.Unlock()
+	fake.SaveSubscriptionStub = nil
+	fake.saveSubscriptionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationRepository) SaveSubscriptionReturnsOnCall(i int, result1 error) {
+	fake.saveSubscriptionMutex.Lock()
+	defer fake.saveSubscriptionMutex.Unlock()
+	fake.SaveSubscriptionStub = nil
+	if fake.saveSubscriptionReturnsOnCall == nil {
+		fake.saveSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveSubscriptionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationRepository) Subscriptions(arg1 int) ([]atc.NotificationSubscription, error) {
+	fake.subscriptionsMutex.Lock()
+	ret, specificReturn := fake.subscriptionsReturnsOnCall[len(fake.subscriptionsArgsForCall)]
+	fake.subscriptionsArgsForCall = append(fake.subscriptionsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Subscriptions", []interface{}{arg1})
+	fake.subscriptionsMutex.Unlock()
+	if fake.SubscriptionsStub != nil {
+		return fake.SubscriptionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.subscriptionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNotificationRepository) SubscriptionsCallCount() int {
+	fake.subscriptionsMutex.RLock()
+	defer fake.subscriptionsMutex.RUnlock()
+	return len(fake.subscriptionsArgsForCall)
+}
+
+func (fake *FakeNotificationRepository) SubscriptionsCalls(stub func(int) ([]atc.NotificationSubscription, error)) {
+	fake.subscriptionsMutex.Lock()
+	defer fake.subscriptionsMutex.Unlock()
+	fake.SubscriptionsStub = stub
+}
+
+func (fake *FakeNotificationRepository) SubscriptionsArgsForCall(i int) int {
+	fake.subscriptionsMutex.RLock()
+	defer fake.subscriptionsMutex.RUnlock()
+	argsForCall := fake.subscriptionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNotificationRepository) SubscriptionsReturns(result1 []atc.NotificationSubscription, result2 error) {
+	fake.subscriptionsMutex.Lock()
+	defer fake.subscriptionsMutex.Unlock()
+	fake.SubscriptionsStub = nil
+	fake.subscriptionsReturns = struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationRepository) SubscriptionsReturnsOnCall(i int, result1 []atc.NotificationSubscription, result2 error) {
+	fake.subscriptionsMutex.Lock()
+	defer fake.subscriptionsMutex.Unlock()
+	fake.SubscriptionsStub = nil
+	if fake.subscriptionsReturnsOnCall == nil {
+		fake.subscriptionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationSubscription
+			result2 error
+		})
+	}
+	fake.subscriptionsReturnsOnCall[i] = struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNotificationRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deliveriesMutex.RLock()
+	defer fake.deliveriesMutex.RUnlock()
+	fake.destroySubscriptionMutex.RLock()
+	defer fake.destroySubscriptionMutex.RUnlock()
+	fake.finishDeliveryAttemptMutex.RLock()
+	defer fake.finishDeliveryAttemptMutex.RUnlock()
+	fake.pendingDeliveriesMutex.RLock()
+	defer fake.pendingDeliveriesMutex.RUnlock()
+	fake.saveSubscriptionMutex.RLock()
+	defer fake.saveSubscriptionMutex.RUnlock()
+	fake.subscriptionsMutex.RLock()
+	defer fake.subscriptionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNotificationRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.NotificationRepository = new(FakeNotificationRepository)
@@ -402,6 +402,16 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	VersionHistoryStub        func() *atc.VersionHistory
+	versionHistoryMutex       sync.RWMutex
+	versionHistoryArgsForCall []struct {
+	}
+	versionHistoryReturns struct {
+		result1 *atc.VersionHistory
+	}
+	versionHistoryReturnsOnCall map[int]struct {
+		result1 *atc.VersionHistory
+	}
 	VersionsStub        func(db.Page, atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error)
 	versionsMutex       sync.RWMutex
 	versionsArgsForCall []struct {
@@ -420,6 +430,16 @@ type FakeResource struct {
 		result3 bool
 		result4 error
 	}
+	WebhookFilterStub        func() *atc.WebhookFilter
+	webhookFilterMutex       sync.RWMutex
+	webhookFilterArgsForCall []struct {
+	}
+	webhookFilterReturns struct {
+		result1 *atc.WebhookFilter
+	}
+	webhookFilterReturnsOnCall map[int]struct {
+		result1 *atc.WebhookFilter
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -2410,6 +2430,58 @@ func (fake *FakeResource) UpdateMetadataReturnsOnCall(i int, result1 bool, resul
 	}{result1, result2}
 }
 
+func (fake *FakeResource) VersionHistory() *atc.VersionHistory {
+	fake.versionHistoryMutex.Lock()
+	ret, specificReturn := fake.versionHistoryReturnsOnCall[len(fake.versionHistoryArgsForCall)]
+	fake.versionHistoryArgsForCall = append(fake.versionHistoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VersionHistory", []interface{}{})
+	fake.versionHistoryMutex.Unlock()
+	if fake.VersionHistoryStub != nil {
+		return fake.VersionHistoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.versionHistoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) VersionHistoryCallCount() int {
+	fake.versionHistoryMutex.RLock()
+	defer fake.versionHistoryMutex.RUnlock()
+	return len(fake.versionHistoryArgsForCall)
+}
+
+func (fake *FakeResource) VersionHistoryCalls(stub func() *atc.VersionHistory) {
+	fake.versionHistoryMutex.Lock()
+	defer fake.versionHistoryMutex.Unlock()
+	fake.VersionHistoryStub = stub
+}
+
+func (fake *FakeResource) VersionHistoryReturns(result1 *atc.VersionHistory) {
+	fake.versionHistoryMutex.Lock()
+	defer fake.versionHistoryMutex.Unlock()
+	fake.VersionHistoryStub = nil
+	fake.versionHistoryReturns = struct {
+		result1 *atc.VersionHistory
+	}{result1}
+}
+
+func (fake *FakeResource) VersionHistoryReturnsOnCall(i int, result1 *atc.VersionHistory) {
+	fake.versionHistoryMutex.Lock()
+	defer fake.versionHistoryMutex.Unlock()
+	fake.VersionHistoryStub = nil
+	if fake.versionHistoryReturnsOnCall == nil {
+		fake.versionHistoryReturnsOnCall = make(map[int]struct {
+			result1 *atc.VersionHistory
+		})
+	}
+	fake.versionHistoryReturnsOnCall[i] = struct {
+		result1 *atc.VersionHistory
+	}{result1}
+}
+
 func (fake *FakeResource) Versions(arg1 db.Page, arg2 atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error) {
 	fake.versionsMutex.Lock()
 	ret, specificReturn := fake.versionsReturnsOnCall[len(fake.versionsArgsForCall)]
@@ -2480,6 +2552,58 @@ func (fake *FakeResource) VersionsReturnsOnCall(i int, result1 []atc.ResourceVer
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeResource) WebhookFilter() *atc.WebhookFilter {
+	fake.webhookFilterMutex.Lock()
+	ret, specificReturn := fake.webhookFilterReturnsOnCall[len(fake.webhookFilterArgsForCall)]
+	fake.webhookFilterArgsForCall = append(fake.webhookFilterArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WebhookFilter", []interface{}{})
+	fake.webhookFilterMutex.Unlock()
+	if fake.WebhookFilterStub != nil {
+		return fake.WebhookFilterStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookFilterReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookFilterCallCount() int {
+	fake.webhookFilterMutex.RLock()
+	defer fake.webhookFilterMutex.RUnlock()
+	return len(fake.webhookFilterArgsForCall)
+}
+
+func (fake *FakeResource) WebhookFilterCalls(stub func() *atc.WebhookFilter) {
+	fake.webhookFilterMutex.Lock()
+	defer fake.webhookFilterMutex.Unlock()
+	fake.WebhookFilterStub = stub
+}
+
+func (fake *FakeResource) WebhookFilterReturns(result1 *atc.WebhookFilter) {
+	fake.webhookFilterMutex.Lock()
+	defer fake.webhookFilterMutex.Unlock()
+	fake.WebhookFilterStub = nil
+	fake.webhookFilterReturns = struct {
+		result1 *atc.WebhookFilter
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookFilterReturnsOnCall(i int, result1 *atc.WebhookFilter) {
+	fake.webhookFilterMutex.Lock()
+	defer fake.webhookFilterMutex.Unlock()
+	fake.WebhookFilterStub = nil
+	if fake.webhookFilterReturnsOnCall == nil {
+		fake.webhookFilterReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookFilter
+		})
+	}
+	fake.webhookFilterReturnsOnCall[i] = struct {
+		result1 *atc.WebhookFilter
+	}{result1}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -2607,8 +2731,12 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.unpinVersionMutex.RUnlock()
 	fake.updateMetadataMutex.RLock()
 	defer fake.updateMetadataMutex.RUnlock()
+	fake.versionHistoryMutex.RLock()
+	defer fake.versionHistoryMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
+	fake.webhookFilterMutex.RLock()
+	defer fake.webhookFilterMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,125 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeResourceConfigVersionLifecycle struct {
+	RemoveUnretainedVersionsStub        func(int, atc.VersionHistory, []atc.Version) (int, error)
+	removeUnretainedVersionsMutex       sync.RWMutex
+	removeUnretainedVersionsArgsForCall []struct {
+		arg1 int
+		arg2 atc.VersionHistory
+		arg3 []atc.Version
+	}
+	removeUnretainedVersionsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeUnretainedVersionsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveUnretainedVersions(arg1 int, arg2 atc.VersionHistory, arg3 []atc.Version) (int, error) {
+	var arg3Copy []atc.Version
+	if arg3 != nil {
+		arg3Copy = make([]atc.Version, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.removeUnretainedVersionsMutex.Lock()
+	ret, specificReturn := fake.removeUnretainedVersionsReturnsOnCall[len(fake.removeUnretainedVersionsArgsForCall)]
+	fake.removeUnretainedVersionsArgsForCall = append(fake.removeUnretainedVersionsArgsForCall, struct {
+		arg1 int
+		arg2 atc.VersionHistory
+		arg3 []atc.Version
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("RemoveUnretainedVersions", []interface{}{arg1, arg2, arg3Copy})
+	fake.removeUnretainedVersionsMutex.Unlock()
+	if fake.RemoveUnretainedVersionsStub != nil {
+		return fake.RemoveUnretainedVersionsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeUnretainedVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveUnretainedVersionsCallCount() int {
+	fake.removeUnretainedVersionsMutex.RLock()
+	defer fake.removeUnretainedVersionsMutex.RUnlock()
+	return len(fake.removeUnretainedVersionsArgsForCall)
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveUnretainedVersionsCalls(stub func(int, atc.VersionHistory, []atc.Version) (int, error)) {
+	fake.removeUnretainedVersionsMutex.Lock()
+	defer fake.removeUnretainedVersionsMutex.Unlock()
+	fake.RemoveUnretainedVersionsStub = stub
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveUnretainedVersionsArgsForCall(i int) (int, atc.VersionHistory, []atc.Version) {
+	fake.removeUnretainedVersionsMutex.RLock()
+	defer fake.removeUnretainedVersionsMutex.RUnlock()
+	argsForCall := fake.removeUnretainedVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveUnretainedVersionsReturns(result1 int, result2 error) {
+	fake.removeUnretainedVersionsMutex.Lock()
+	defer fake.removeUnretainedVersionsMutex.Unlock()
+	fake.RemoveUnretainedVersionsStub = nil
+	fake.removeUnretainedVersionsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) RemoveUnretainedVersionsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeUnretainedVersionsMutex.Lock()
+	defer fake.removeUnretainedVersionsMutex.Unlock()
+	fake.RemoveUnretainedVersionsStub = nil
+	if fake.removeUnretainedVersionsReturnsOnCall == nil {
+		fake.removeUnretainedVersionsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeUnretainedVersionsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.removeUnretainedVersionsMutex.RLock()
+	defer fake.removeUnretainedVersionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourceConfigVersionLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ResourceConfigVersionLifecycle = new(FakeResourceConfigVersionLifecycle)
@@ -90,6 +90,16 @@ type FakeResourceType struct {
 	lastCheckStartTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	MaxChecksPerSecondStub        func() float64
+	maxChecksPerSecondMutex       sync.RWMutex
+	maxChecksPerSecondArgsForCall []struct {
+	}
+	maxChecksPerSecondReturns struct {
+		result1 float64
+	}
+	maxChecksPerSecondReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -691,6 +701,58 @@ func (fake *FakeResourceType) LastCheckStartTimeReturnsOnCall(i int, result1 tim
 	}{result1}
 }
 
+func (fake *FakeResourceType) MaxChecksPerSecond() float64 {
+	fake.maxChecksPerSecondMutex.Lock()
+	ret, specificReturn := fake.maxChecksPerSecondReturnsOnCall[len(fake.maxChecksPerSecondArgsForCall)]
+	fake.maxChecksPerSecondArgsForCall = append(fake.maxChecksPerSecondArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxChecksPerSecond", []interface{}{})
+	fake.maxChecksPerSecondMutex.Unlock()
+	if fake.MaxChecksPerSecondStub != nil {
+		return fake.MaxChecksPerSecondStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxChecksPerSecondReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) MaxChecksPerSecondCallCount() int {
+	fake.maxChecksPerSecondMutex.RLock()
+	defer fake.maxChecksPerSecondMutex.RUnlock()
+	return len(fake.maxChecksPerSecondArgsForCall)
+}
+
+func (fake *FakeResourceType) MaxChecksPerSecondCalls(stub func() float64) {
+	fake.maxChecksPerSecondMutex.Lock()
+	defer fake.maxChecksPerSecondMutex.Unlock()
+	fake.MaxChecksPerSecondStub = stub
+}
+
+func (fake *FakeResourceType) MaxChecksPerSecondReturns(result1 float64) {
+	fake.maxChecksPerSecondMutex.Lock()
+	defer fake.maxChecksPerSecondMutex.Unlock()
+	fake.MaxChecksPerSecondStub = nil
+	fake.maxChecksPerSecondReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeResourceType) MaxChecksPerSecondReturnsOnCall(i int, result1 float64) {
+	fake.maxChecksPerSecondMutex.Lock()
+	defer fake.maxChecksPerSecondMutex.Unlock()
+	fake.MaxChecksPerSecondStub = nil
+	if fake.maxChecksPerSecondReturnsOnCall == nil {
+		fake.maxChecksPerSecondReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.maxChecksPerSecondReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeResourceType) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1623,6 +1685,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.lastCheckEndTimeMutex.RUnlock()
 	fake.lastCheckStartTimeMutex.RLock()
 	defer fake.lastCheckStartTimeMutex.RUnlock()
+	fake.maxChecksPerSecondMutex.RLock()
+	defer fake.maxChecksPerSecondMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.paramsMutex.RLock()
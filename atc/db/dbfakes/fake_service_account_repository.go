@@ -0,0 +1,443 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeServiceAccountRepository struct {
+	CreateTokenStub        func(int, string, atc.APITokenRequest, string) (atc.APIToken, error)
+	createTokenMutex       sync.RWMutex
+	createTokenArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 atc.APITokenRequest
+		arg4 string
+	}
+	createTokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createTokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	DestroyServiceAccountStub        func(int, string) (bool, error)
+	destroyServiceAccountMutex       sync.RWMutex
+	destroyServiceAccountArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	destroyServiceAccountReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyServiceAccountReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeTokenStub        func(int, int) (bool, error)
+	revokeTokenMutex       sync.RWMutex
+	revokeTokenArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	revokeTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	TokensStub        func(int) ([]atc.APIToken, error)
+	tokensMutex       sync.RWMutex
+	tokensArgsForCall []struct {
+		arg1 int
+	}
+	tokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	tokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	UseTokenStub        func(string) (atc.APIToken, bool, error)
+	useTokenMutex       sync.RWMutex
+	useTokenArgsForCall []struct {
+		arg1 string
+	}
+	useTokenReturns struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}
+	useTokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceAccountRepository) CreateToken(arg1 int, arg2 string, arg3 atc.APITokenRequest, arg4 string) (atc.APIToken, error) {
+	fake.createTokenMutex.Lock()
+	ret, specificReturn := fake.createTokenReturnsOnCall[len(fake.createTokenArgsForCall)]
+	fake.createTokenArgsForCall = append(fake.createTokenArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 atc.APITokenRequest
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createTokenMutex.Unlock()
+	if fake.CreateTokenStub != nil {
+		return fake.CreateTokenStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceAccountRepository) CreateTokenCallCount() int {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	return len(fake.createTokenArgsForCall)
+}
+
+func (fake *FakeServiceAccountRepository) CreateTokenCalls(stub func(int, string, atc.APITokenRequest, string) (atc.APIToken, error)) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = stub
+}
+
+func (fake *FakeServiceAccountRepository) CreateTokenArgsForCall(i int) (int, string, atc.APITokenRequest, string) {
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	argsForCall := fake.createTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeServiceAccountRepository) CreateTokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	fake.createTokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) CreateTokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createTokenMutex.Lock()
+	defer fake.createTokenMutex.Unlock()
+	fake.CreateTokenStub = nil
+	if fake.createTokenReturnsOnCall == nil {
+		fake.createTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createTokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) DestroyServiceAccount(arg1 int, arg2 string) (bool, error) {
+	fake.destroyServiceAccountMutex.Lock()
+	ret, specificReturn := fake.destroyServiceAccountReturnsOnCall[len(fake.destroyServiceAccountArgsForCall)]
+	fake.destroyServiceAccountArgsForCall = append(fake.destroyServiceAccountArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DestroyServiceAccount", []interface{}{arg1, arg2})
+	fake.destroyServiceAccountMutex.Unlock()
+	if fake.DestroyServiceAccountStub != nil {
+		return fake.DestroyServiceAccountStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyServiceAccountReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceAccountRepository) DestroyServiceAccountCallCount() int {
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	return len(fake.destroyServiceAccountArgsForCall)
+}
+
+func (fake *FakeServiceAccountRepository) DestroyServiceAccountCalls(stub func(int, string) (bool, error)) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = stub
+}
+
+func (fake *FakeServiceAccountRepository) DestroyServiceAccountArgsForCall(i int) (int, string) {
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	argsForCall := fake.destroyServiceAccountArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeServiceAccountRepository) DestroyServiceAccountReturns(result1 bool, result2 error) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = nil
+	fake.destroyServiceAccountReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) DestroyServiceAccountReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyServiceAccountMutex.Lock()
+	defer fake.destroyServiceAccountMutex.Unlock()
+	fake.DestroyServiceAccountStub = nil
+	if fake.destroyServiceAccountReturnsOnCall == nil {
+		fake.destroyServiceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyServiceAccountReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) RevokeToken(arg1 int, arg2 int) (bool, error) {
+	fake.revokeTokenMutex.Lock()
+	ret, specificReturn := fake.revokeTokenReturnsOnCall[len(fake.revokeTokenArgsForCall)]
+	fake.revokeTokenArgsForCall = append(fake.revokeTokenArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeToken", []interface{}{arg1, arg2})
+	fake.revokeTokenMutex.Unlock()
+	if fake.RevokeTokenStub != nil {
+		return fake.RevokeTokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceAccountRepository) RevokeTokenCallCount() int {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	return len(fake.revokeTokenArgsForCall)
+}
+
+func (fake *FakeServiceAccountRepository) RevokeTokenCalls(stub func(int, int) (bool, error)) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = stub
+}
+
+func (fake *FakeServiceAccountRepository) RevokeTokenArgsForCall(i int) (int, int) {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	argsForCall := fake.revokeTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeServiceAccountRepository) RevokeTokenReturns(result1 bool, result2 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	fake.revokeTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) RevokeTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	if fake.revokeTokenReturnsOnCall == nil {
+		fake.revokeTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) Tokens(arg1 int) ([]atc.APIToken, error) {
+	fake.tokensMutex.Lock()
+	ret, specificReturn := fake.tokensReturnsOnCall[len(fake.tokensArgsForCall)]
+	fake.tokensArgsForCall = append(fake.tokensArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Tokens", []interface{}{arg1})
+	fake.tokensMutex.Unlock()
+	if fake.TokensStub != nil {
+		return fake.TokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.tokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceAccountRepository) TokensCallCount() int {
+	fake.tokensMutex.RLock()
+	defer fake.tokensMutex.RUnlock()
+	return len(fake.tokensArgsForCall)
+}
+
+func (fake *FakeServiceAccountRepository) TokensCalls(stub func(int) ([]atc.APIToken, error)) {
+	fake.tokensMutex.Lock()
+	defer fake.tokensMutex.Unlock()
+	fake.TokensStub = stub
+}
+
+func (fake *FakeServiceAccountRepository) TokensArgsForCall(i int) int {
+	fake.tokensMutex.RLock()
+	defer fake.tokensMutex.RUnlock()
+	argsForCall := fake.tokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServiceAccountRepository) TokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.tokensMutex.Lock()
+	defer fake.tokensMutex.Unlock()
+	fake.TokensStub = nil
+	fake.tokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) TokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.tokensMutex.Lock()
+	defer fake.tokensMutex.Unlock()
+	fake.TokensStub = nil
+	if fake.tokensReturnsOnCall == nil {
+		fake.tokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.tokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceAccountRepository) UseToken(arg1 string) (atc.APIToken, bool, error) {
+	fake.useTokenMutex.Lock()
+	ret, specificReturn := fake.useTokenReturnsOnCall[len(fake.useTokenArgsForCall)]
+	fake.useTokenArgsForCall = append(fake.useTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UseToken", []interface{}{arg1})
+	fake.useTokenMutex.Unlock()
+	if fake.UseTokenStub != nil {
+		return fake.UseTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.useTokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeServiceAccountRepository) UseTokenCallCount() int {
+	fake.useTokenMutex.RLock()
+	defer fake.useTokenMutex.RUnlock()
+	return len(fake.useTokenArgsForCall)
+}
+
+func (fake *FakeServiceAccountRepository) UseTokenCalls(stub func(string) (atc.APIToken, bool, error)) {
+	fake.useTokenMutex.Lock()
+	defer fake.useTokenMutex.Unlock()
+	fake.UseTokenStub = stub
+}
+
+func (fake *FakeServiceAccountRepository) UseTokenArgsForCall(i int) string {
+	fake.useTokenMutex.RLock()
+	defer fake.useTokenMutex.RUnlock()
+	argsForCall := fake.useTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeServiceAccountRepository) UseTokenReturns(result1 atc.APIToken, result2 bool, result3 error) {
+	fake.useTokenMutex.Lock()
+	defer fake.useTokenMutex.Unlock()
+	fake.UseTokenStub = nil
+	fake.useTokenReturns = struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceAccountRepository) UseTokenReturnsOnCall(i int, result1 atc.APIToken, result2 bool, result3 error) {
+	fake.useTokenMutex.Lock()
+	defer fake.useTokenMutex.Unlock()
+	fake.UseTokenStub = nil
+	if fake.useTokenReturnsOnCall == nil {
+		fake.useTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.useTokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeServiceAccountRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createTokenMutex.RLock()
+	defer fake.createTokenMutex.RUnlock()
+	fake.destroyServiceAccountMutex.RLock()
+	defer fake.destroyServiceAccountMutex.RUnlock()
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	fake.tokensMutex.RLock()
+	defer fake.tokensMutex.RUnlock()
+	fake.useTokenMutex.RLock()
+	defer fake.useTokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceAccountRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ServiceAccountRepository = new(FakeServiceAccountRepository)
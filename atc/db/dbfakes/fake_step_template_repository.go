@@ -0,0 +1,448 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeStepTemplateRepository struct {
+	DestroyStub        func(int, string) (bool, error)
+	destroyMutex       sync.RWMutex
+	destroyArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	destroyReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FindStub        func(int, string, int) (atc.StepTemplate, bool, error)
+	findMutex       sync.RWMutex
+	findArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 int
+	}
+	findReturns struct {
+		result1 atc.StepTemplate
+		result2 bool
+		result3 error
+	}
+	findReturnsOnCall map[int]struct {
+		result1 atc.StepTemplate
+		result2 bool
+		result3 error
+	}
+	LatestStub        func(int) ([]atc.StepTemplate, error)
+	latestMutex       sync.RWMutex
+	latestArgsForCall []struct {
+		arg1 int
+	}
+	latestReturns struct {
+		result1 []atc.StepTemplate
+		result2 error
+	}
+	latestReturnsOnCall map[int]struct {
+		result1 []atc.StepTemplate
+		result2 error
+	}
+	SaveStub        func(int, atc.StepTemplate) (atc.StepTemplate, error)
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		arg1 int
+		arg2 atc.StepTemplate
+	}
+	saveReturns struct {
+		result1 atc.StepTemplate
+		result2 error
+	}
+	saveReturnsOnCall map[int]struct {
+		result1 atc.StepTemplate
+		result2 error
+	}
+	VersionsStub        func(int, []string) (atc.StepTemplates, error)
+	versionsMutex       sync.RWMutex
+	versionsArgsForCall []struct {
+		arg1 int
+		arg2 []string
+	}
+	versionsReturns struct {
+		result1 atc.StepTemplates
+		result2 error
+	}
+	versionsReturnsOnCall map[int]struct {
+		result1 atc.StepTemplates
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStepTemplateRepository) Destroy(arg1 int, arg2 string) (bool, error) {
+	fake.destroyMutex.Lock()
+	ret, specificReturn := fake.destroyReturnsOnCall[len(fake.destroyArgsForCall)]
+	fake.destroyArgsForCall = append(fake.destroyArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Destroy", []interface{}{arg1, arg2})
+	fake.destroyMutex.Unlock()
+	if fake.DestroyStub != nil {
+		return fake.DestroyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStepTemplateRepository) DestroyCallCount() int {
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	return len(fake.destroyArgsForCall)
+}
+
+func (fake *FakeStepTemplateRepository) DestroyCalls(stub func(int, string) (bool, error)) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = stub
+}
+
+func (fake *FakeStepTemplateRepository) DestroyArgsForCall(i int) (int, string) {
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	argsForCall := fake.destroyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStepTemplateRepository) DestroyReturns(result1 bool, result2 error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = nil
+	fake.destroyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) DestroyReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = nil
+	if fake.destroyReturnsOnCall == nil {
+		fake.destroyReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) Find(arg1 int, arg2 string, arg3 int) (atc.StepTemplate, bool, error) {
+	fake.findMutex.Lock()
+	ret, specificReturn := fake.findReturnsOnCall[len(fake.findArgsForCall)]
+	fake.findArgsForCall = append(fake.findArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Find", []interface{}{arg1, arg2, arg3})
+	fake.findMutex.Unlock()
+	if fake.FindStub != nil {
+		return fake.FindStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStepTemplateRepository) FindCallCount() int {
+	fake.findMutex.RLock()
+	defer fake.findMutex.RUnlock()
+	return len(fake.findArgsForCall)
+}
+
+func (fake *FakeStepTemplateRepository) FindCalls(stub func(int, string, int) (atc.StepTemplate, bool, error)) {
+	fake.findMutex.Lock()
+	defer fake.findMutex.Unlock()
+	fake.FindStub = stub
+}
+
+func (fake *FakeStepTemplateRepository) FindArgsForCall(i int) (int, string, int) {
+	fake.findMutex.RLock()
+	defer fake.findMutex.RUnlock()
+	argsForCall := fake.findArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStepTemplateRepository) FindReturns(result1 atc.StepTemplate, result2 bool, result3 error) {
+	fake.findMutex.Lock()
+	defer fake.findMutex.Unlock()
+	fake.FindStub = nil
+	fake.findReturns = struct {
+		result1 atc.StepTemplate
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStepTemplateRepository) FindReturnsOnCall(i int, result1 atc.StepTemplate, result2 bool, result3 error) {
+	fake.findMutex.Lock()
+	defer fake.findMutex.Unlock()
+	fake.FindStub = nil
+	if fake.findReturnsOnCall == nil {
+		fake.findReturnsOnCall = make(map[int]struct {
+			result1 atc.StepTemplate
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findReturnsOnCall[i] = struct {
+		result1 atc.StepTemplate
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStepTemplateRepository) Latest(arg1 int) ([]atc.StepTemplate, error) {
+	fake.latestMutex.Lock()
+	ret, specificReturn := fake.latestReturnsOnCall[len(fake.latestArgsForCall)]
+	fake.latestArgsForCall = append(fake.latestArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Latest", []interface{}{arg1})
+	fake.latestMutex.Unlock()
+	if fake.LatestStub != nil {
+		return fake.LatestStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.latestReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStepTemplateRepository) LatestCallCount() int {
+	fake.latestMutex.RLock()
+	defer fake.latestMutex.RUnlock()
+	return len(fake.latestArgsForCall)
+}
+
+func (fake *FakeStepTemplateRepository) LatestCalls(stub func(int) ([]atc.StepTemplate, error)) {
+	fake.latestMutex.Lock()
+	defer fake.latestMutex.Unlock()
+	fake.LatestStub = stub
+}
+
+func (fake *FakeStepTemplateRepository) LatestArgsForCall(i int) int {
+	fake.latestMutex.RLock()
+	defer fake.latestMutex.RUnlock()
+	argsForCall := fake.latestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStepTemplateRepository) LatestReturns(result1 []atc.StepTemplate, result2 error) {
+	fake.latestMutex.Lock()
+	defer fake.latestMutex.Unlock()
+	fake.LatestStub = nil
+	fake.latestReturns = struct {
+		result1 []atc.StepTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) LatestReturnsOnCall(i int, result1 []atc.StepTemplate, result2 error) {
+	fake.latestMutex.Lock()
+	defer fake.latestMutex.Unlock()
+	fake.LatestStub = nil
+	if fake.latestReturnsOnCall == nil {
+		fake.latestReturnsOnCall = make(map[int]struct {
+			result1 []atc.StepTemplate
+			result2 error
+		})
+	}
+	fake.latestReturnsOnCall[i] = struct {
+		result1 []atc.StepTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) Save(arg1 int, arg2 atc.StepTemplate) (atc.StepTemplate, error) {
+	fake.saveMutex.Lock()
+	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		arg1 int
+		arg2 atc.StepTemplate
+	}{arg1, arg2})
+	fake.recordInvocation("Save", []interface{}{arg1, arg2})
+	fake.saveMutex.Unlock()
+	if fake.SaveStub != nil {
+		return fake.SaveStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.saveReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStepTemplateRepository) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeStepTemplateRepository) SaveCalls(stub func(int, atc.StepTemplate) (atc.StepTemplate, error)) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = stub
+}
+
+func (fake *FakeStepTemplateRepository) SaveArgsForCall(i int) (int, atc.StepTemplate) {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	argsForCall := fake.saveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStepTemplateRepository) SaveReturns(result1 atc.StepTemplate, result2 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 atc.StepTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) SaveReturnsOnCall(i int, result1 atc.StepTemplate, result2 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	if fake.saveReturnsOnCall == nil {
+		fake.saveReturnsOnCall = make(map[int]struct {
+			result1 atc.StepTemplate
+			result2 error
+		})
+	}
+	fake.saveReturnsOnCall[i] = struct {
+		result1 atc.StepTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) Versions(arg1 int, arg2 []string) (atc.StepTemplates, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.versionsMutex.Lock()
+	ret, specificReturn := fake.versionsReturnsOnCall[len(fake.versionsArgsForCall)]
+	fake.versionsArgsForCall = append(fake.versionsArgsForCall, struct {
+		arg1 int
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("Versions", []interface{}{arg1, arg2Copy})
+	fake.versionsMutex.Unlock()
+	if fake.VersionsStub != nil {
+		return fake.VersionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.versionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStepTemplateRepository) VersionsCallCount() int {
+	fake.versionsMutex.RLock()
+	defer fake.versionsMutex.RUnlock()
+	return len(fake.versionsArgsForCall)
+}
+
+func (fake *FakeStepTemplateRepository) VersionsCalls(stub func(int, []string) (atc.StepTemplates, error)) {
+	fake.versionsMutex.Lock()
+	defer fake.versionsMutex.Unlock()
+	fake.VersionsStub = stub
+}
+
+func (fake *FakeStepTemplateRepository) VersionsArgsForCall(i int) (int, []string) {
+	fake.versionsMutex.RLock()
+	defer fake.versionsMutex.RUnlock()
+	argsForCall := fake.versionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStepTemplateRepository) VersionsReturns(result1 atc.StepTemplates, result2 error) {
+	fake.versionsMutex.Lock()
+	defer fake.versionsMutex.Unlock()
+	fake.VersionsStub = nil
+	fake.versionsReturns = struct {
+		result1 atc.StepTemplates
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) VersionsReturnsOnCall(i int, result1 atc.StepTemplates, result2 error) {
+	fake.versionsMutex.Lock()
+	defer fake.versionsMutex.Unlock()
+	fake.VersionsStub = nil
+	if fake.versionsReturnsOnCall == nil {
+		fake.versionsReturnsOnCall = make(map[int]struct {
+			result1 atc.StepTemplates
+			result2 error
+		})
+	}
+	fake.versionsReturnsOnCall[i] = struct {
+		result1 atc.StepTemplates
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStepTemplateRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	fake.findMutex.RLock()
+	defer fake.findMutex.RUnlock()
+	fake.latestMutex.RLock()
+	defer fake.latestMutex.RUnlock()
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	fake.versionsMutex.RLock()
+	defer fake.versionsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStepTemplateRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.StepTemplateRepository = new(FakeStepTemplateRepository)
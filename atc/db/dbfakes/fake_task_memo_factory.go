@@ -0,0 +1,288 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeTaskMemoFactory struct {
+	FindStub        func(int, string, string) (db.TaskMemo, bool, error)
+	findMutex       sync.RWMutex
+	findArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}
+	findReturns struct {
+		result1 db.TaskMemo
+		result2 bool
+		result3 error
+	}
+	findReturnsOnCall map[int]struct {
+		result1 db.TaskMemo
+		result2 bool
+		result3 error
+	}
+	SaveStub        func(int, string, string, int, map[string]string) error
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 map[string]string
+	}
+	saveReturns struct {
+		result1 error
+	}
+	saveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VolumeKeyStub        func(string) (string, bool, error)
+	volumeKeyMutex       sync.RWMutex
+	volumeKeyArgsForCall []struct {
+		arg1 string
+	}
+	volumeKeyReturns struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	volumeKeyReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskMemoFactory) Find(arg1 int, arg2 string, arg3 string) (db.TaskMemo, bool, error) {
+	fake.findMutex.Lock()
+	ret, specificReturn := fake.findReturnsOnCall[len(fake.findArgsForCall)]
+	fake.findArgsForCall = append(fake.findArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Find", []interface{}{arg1, arg2, arg3})
+	fake.findMutex.Unlock()
+	if fake.FindStub != nil {
+		return fake.FindStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskMemoFactory) FindCallCount() int {
+	fake.findMutex.RLock()
+	defer fake.findMutex.RUnlock()
+	return len(fake.findArgsForCall)
+}
+
+func (fake *FakeTaskMemoFactory) FindCalls(stub func(int, string, string) (db.TaskMemo, bool, error)) {
+	fake.findMutex.Lock()
+	defer fake.findMutex.Unlock()
+	fake.FindStub = stub
+}
+
+func (fake *FakeTaskMemoFactory) FindArgsForCall(i int) (int, string, string) {
+	fake.findMutex.RLock()
+	defer fake.findMutex.RUnlock()
+	argsForCall := fake.findArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskMemoFactory) FindReturns(result1 db.TaskMemo, result2 bool, result3 error) {
+	fake.findMutex.Lock()
+	defer fake.findMutex.Unlock()
+	fake.FindStub = nil
+	fake.findReturns = struct {
+		result1 db.TaskMemo
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskMemoFactory) FindReturnsOnCall(i int, result1 db.TaskMemo, result2 bool, result3 error) {
+	fake.findMutex.Lock()
+	defer fake.findMutex.Unlock()
+	fake.FindStub = nil
+	if fake.findReturnsOnCall == nil {
+		fake.findReturnsOnCall = make(map[int]struct {
+			result1 db.TaskMemo
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findReturnsOnCall[i] = struct {
+		result1 db.TaskMemo
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskMemoFactory) Save(arg1 int, arg2 string, arg3 string, arg4 int, arg5 map[string]string) error {
+	fake.saveMutex.Lock()
+	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 string
+		arg4 int
+		arg5 map[string]string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("Save", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.saveMutex.Unlock()
+	if fake.SaveStub != nil {
+		return fake.SaveStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskMemoFactory) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeTaskMemoFactory) SaveCalls(stub func(int, string, string, int, map[string]string) error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = stub
+}
+
+func (fake *FakeTaskMemoFactory) SaveArgsForCall(i int) (int, string, string, int, map[string]string) {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	argsForCall := fake.saveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTaskMemoFactory) SaveReturns(result1 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskMemoFactory) SaveReturnsOnCall(i int, result1 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	if fake.saveReturnsOnCall == nil {
+		fake.saveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskMemoFactory) VolumeKey(arg1 string) (string, bool, error) {
+	fake.volumeKeyMutex.Lock()
+	ret, specificReturn := fake.volumeKeyReturnsOnCall[len(fake.volumeKeyArgsForCall)]
+	fake.volumeKeyArgsForCall = append(fake.volumeKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("VolumeKey", []interface{}{arg1})
+	fake.volumeKeyMutex.Unlock()
+	if fake.VolumeKeyStub != nil {
+		return fake.VolumeKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.volumeKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskMemoFactory) VolumeKeyCallCount() int {
+	fake.volumeKeyMutex.RLock()
+	defer fake.volumeKeyMutex.RUnlock()
+	return len(fake.volumeKeyArgsForCall)
+}
+
+func (fake *FakeTaskMemoFactory) VolumeKeyCalls(stub func(string) (string, bool, error)) {
+	fake.volumeKeyMutex.Lock()
+	defer fake.volumeKeyMutex.Unlock()
+	fake.VolumeKeyStub = stub
+}
+
+func (fake *FakeTaskMemoFactory) VolumeKeyArgsForCall(i int) string {
+	fake.volumeKeyMutex.RLock()
+	defer fake.volumeKeyMutex.RUnlock()
+	argsForCall := fake.volumeKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTaskMemoFactory) VolumeKeyReturns(result1 string, result2 bool, result3 error) {
+	fake.volumeKeyMutex.Lock()
+	defer fake.volumeKeyMutex.Unlock()
+	fake.VolumeKeyStub = nil
+	fake.volumeKeyReturns = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskMemoFactory) VolumeKeyReturnsOnCall(i int, result1 string, result2 bool, result3 error) {
+	fake.volumeKeyMutex.Lock()
+	defer fake.volumeKeyMutex.Unlock()
+	fake.VolumeKeyStub = nil
+	if fake.volumeKeyReturnsOnCall == nil {
+		fake.volumeKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.volumeKeyReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskMemoFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.findMutex.RLock()
+	defer fake.findMutex.RUnlock()
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	fake.volumeKeyMutex.RLock()
+	defer fake.volumeKeyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTaskMemoFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.TaskMemoFactory = new(FakeTaskMemoFactory)
@@ -9,9 +9,11 @@ import (
 type ResolutionFailure string
 
 const (
-	LatestVersionNotFound ResolutionFailure = "latest version of resource not found"
-	VersionNotFound       ResolutionFailure = "version of resource not found"
-	NoSatisfiableBuilds   ResolutionFailure = "no satisfiable builds from passed jobs found for set of inputs"
+	LatestVersionNotFound     ResolutionFailure = "latest version of resource not found"
+	VersionNotFound           ResolutionFailure = "version of resource not found"
+	NoSatisfiableBuilds       ResolutionFailure = "no satisfiable builds from passed jobs found for set of inputs"
+	AllVersionsDisabled       ResolutionFailure = "all versions of resource are disabled"
+	AllPassedVersionsDisabled ResolutionFailure = "all versions of resource from passed jobs are disabled"
 )
 
 type PinnedVersionNotFound struct {
@@ -34,6 +36,7 @@ type InputResult struct {
 	Input          *AlgorithmInput
 	PassedBuildIDs []int
 	ResolveError   ResolutionFailure
+	ResolveReason  atc.InputSchedulingReason
 }
 
 type ResourceVersion string
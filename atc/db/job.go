@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -39,6 +40,8 @@ type Job interface {
 	ScheduleRequestedTime() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
+	Schedule() *atc.JobSchedule
+	ScheduleLastFired() time.Time
 
 	Config() (atc.JobConfig, error)
 	Inputs() ([]atc.JobInput, error)
@@ -50,11 +53,12 @@ type Job interface {
 	Unpause() error
 
 	ScheduleBuild(Build) (bool, error)
-	CreateBuild() (Build, error)
-	RerunBuild(Build) (Build, error)
+	CreateBuild(context.Context) (Build, error)
+	RerunBuild(buildToRerun Build, fromFailed bool) (Build, error)
 
 	RequestSchedule() error
 	UpdateLastScheduled(time.Time) error
+	FireSchedule(time.Time) (bool, error)
 
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
@@ -63,6 +67,7 @@ type Job interface {
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists() error
 	GetPendingBuilds() ([]Build, error)
+	GetSupersededBuilds(inputs []BuildInput) ([]Build, error)
 
 	GetNextBuildInputs() ([]BuildInput, error)
 	GetFullNextBuildInputs() ([]BuildInput, bool, error)
@@ -70,13 +75,15 @@ type Job interface {
 
 	ClearTaskCache(string, string) (int64, error)
 
+	TestHistory(limit int) ([]atc.TestHistory, error)
+
 	AcquireSchedulingLock(lager.Logger) (lock.Lock, bool, error)
 
 	SetHasNewInputs(bool) error
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger", "j.schedule", "j.schedule_last_fired").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -106,6 +113,8 @@ type job struct {
 	scheduleRequestedTime time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
+	schedule              *atc.JobSchedule
+	scheduleLastFired     time.Time
 
 	config    *atc.JobConfig
 	rawConfig []byte
@@ -167,6 +176,8 @@ func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
+func (j *job) Schedule() *atc.JobSchedule       { return j.schedule }
+func (j *job) ScheduleLastFired() time.Time     { return j.scheduleLastFired }
 
 func (j *job) Config() (atc.JobConfig, error) {
 	if j.config != nil {
@@ -639,7 +650,71 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 	return builds, nil
 }
 
-func (j *job) CreateBuild() (Build, error) {
+// GetSupersededBuilds returns the pending and started builds of the job which
+// have not been aborted yet and used an older version of any of the given
+// inputs. Reruns are never superseded, as they deliberately run old versions.
+func (j *job) GetSupersededBuilds(inputs []BuildInput) ([]Build, error) {
+	if len(inputs) == 0 {
+		return []Build{}, nil
+	}
+
+	olderVersions := sq.Or{}
+	for _, input := range inputs {
+		versionJSON, err := json.Marshal(input.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		olderVersions = append(olderVersions, sq.Expr(`EXISTS (
+			SELECT 1
+			FROM build_resource_config_version_inputs i
+			JOIN resources r ON r.id = i.resource_id
+			JOIN resource_config_versions old ON old.resource_config_scope_id = r.resource_config_scope_id AND old.version_md5 = i.version_md5
+			JOIN resource_config_versions new ON new.resource_config_scope_id = r.resource_config_scope_id AND new.version_md5 = md5(?)
+			WHERE i.build_id = b.id
+			AND i.name = ?
+			AND i.resource_id = ?
+			AND old.check_order < new.check_order
+		)`, string(versionJSON), input.Name, input.ResourceID))
+	}
+
+	rows, err := buildsQuery.
+		Where(sq.Eq{
+			"b.job_id":   j.id,
+			"b.status":   []BuildStatus{BuildStatusPending, BuildStatusStarted},
+			"b.aborted":  false,
+			"b.rerun_of": nil,
+		}).
+		Where(olderVersions).
+		OrderBy("b.id ASC").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	builds := []Build{}
+	for rows.Next() {
+		build := newEmptyBuild(j.conn, j.lockFactory)
+		err = scanBuild(build, rows, j.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
+func (j *job) CreateBuild(ctx context.Context) (Build, error) {
+	spanContext, err := json.Marshal(NewSpanContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -660,6 +735,7 @@ func (j *job) CreateBuild() (Build, error) {
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
+		"span_context":       string(spanContext),
 	})
 	if err != nil {
 		return nil, err
@@ -683,7 +759,11 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
-func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+// RerunBuild creates a pending build which reruns the given build with the
+// same inputs. When fromFailed is set, the steps which succeeded in the given
+// build are skipped, reusing their outputs, so that the rerun resumes from the
+// step which failed.
+func (j *job) RerunBuild(buildToRerun Build, fromFailed bool) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -701,8 +781,7 @@ func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
 		return nil, err
 	}
 
-	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, rerunBuild, map[string]interface{}{
+	values := map[string]interface{}{
 		"name":         rerunBuildName,
 		"job_id":       j.id,
 		"pipeline_id":  j.pipelineID,
@@ -710,7 +789,16 @@ func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
 		"status":       BuildStatusPending,
 		"rerun_of":     buildToRerunID,
 		"rerun_number": rerunNumber,
-	})
+	}
+
+	if fromFailed {
+		// resume the build itself rather than the build it reran, as that is
+		// the build whose step failed
+		values["resume_of"] = buildToRerun.ID()
+	}
+
+	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, rerunBuild, values)
 	if err != nil {
 		return nil, err
 	}
@@ -867,6 +955,31 @@ func (j *job) UpdateLastScheduled(requestedTime time.Time) error {
 	return err
 }
 
+// FireSchedule records that the job's schedule fired at the given time. It
+// returns false if the schedule was fired or changed since the job was
+// loaded, e.g. by another ATC, in which case no build should be created.
+func (j *job) FireSchedule(firedAt time.Time) (bool, error) {
+	err := psql.Update("jobs").
+		Set("schedule_last_fired", firedAt).
+		Where(sq.Eq{
+			"id":                  j.id,
+			"schedule_last_fired": j.scheduleLastFired,
+		}).
+		Suffix("RETURNING schedule_last_fired").
+		RunWith(j.conn).
+		QueryRow().
+		Scan(&j.scheduleLastFired)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (j *job) getRunningBuildsBySerialGroup(tx Tx, serialGroups []string) ([]Build, error) {
 	rows, err := buildsQuery.Options(`DISTINCT ON (b.id)`).
 		Join(`jobs_serial_groups jsg ON j.id = jsg.job_id`).
@@ -994,16 +1107,18 @@ func (j *job) SaveNextInputMapping(inputMapping InputMapping, inputsDetermined b
 	}
 
 	builder := psql.Insert("next_build_inputs").
-		Columns("input_name", "job_id", "version_md5", "resource_id", "first_occurrence", "resolve_error")
+		Columns("input_name", "job_id", "version_md5", "resource_id", "first_occurrence", "resolve_error", "resolve_reason")
 
 	for inputName, inputResult := range inputMapping {
 		var resolveError sql.NullString
+		var resolveReason sql.NullString
 		var firstOccurrence sql.NullBool
 		var versionMD5 sql.NullString
 		var resourceID sql.NullInt64
 
 		if inputResult.ResolveError != "" {
 			resolveError = sql.NullString{String: string(inputResult.ResolveError), Valid: true}
+			resolveReason = sql.NullString{String: string(inputResult.ResolveReason), Valid: inputResult.ResolveReason != ""}
 		} else {
 			if inputResult.Input == nil {
 				return InputVersionEmptyError{inputName}
@@ -1014,7 +1129,7 @@ func (j *job) SaveNextInputMapping(inputMapping InputMapping, inputsDetermined b
 			versionMD5 = sql.NullString{String: string(inputResult.Input.Version), Valid: true}
 		}
 
-		builder = builder.Values(inputName, j.id, versionMD5, resourceID, firstOccurrence, resolveError)
+		builder = builder.Values(inputName, j.id, versionMD5, resourceID, firstOccurrence, resolveError, resolveReason)
 	}
 
 	if len(inputMapping) != 0 {
@@ -1114,7 +1229,7 @@ func (j *job) getNewRerunBuildName(tx Tx, buildID int) (string, int, error) {
 }
 
 func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
-	rows, err := psql.Select("i.input_name, i.first_occurrence, i.resource_id, v.version, i.resolve_error").
+	rows, err := psql.Select("i.input_name, i.first_occurrence, i.resource_id, v.version, i.resolve_error, i.resolve_reason").
 		From("next_build_inputs i").
 		LeftJoin("resources r ON r.id = i.resource_id").
 		LeftJoin("resource_config_versions v ON v.version_md5 = i.version_md5 AND r.resource_config_scope_id = v.resource_config_scope_id").
@@ -1135,9 +1250,10 @@ func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
 			versionBlob sql.NullString
 			resID       sql.NullString
 			resolveErr  sql.NullString
+			resolveRsn  sql.NullString
 		)
 
-		err := rows.Scan(&inputName, &firstOcc, &resID, &versionBlob, &resolveErr)
+		err := rows.Scan(&inputName, &firstOcc, &resID, &versionBlob, &resolveErr, &resolveRsn)
 		if err != nil {
 			return nil, err
 		}
@@ -1168,12 +1284,18 @@ func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
 			resolveError = resolveErr.String
 		}
 
+		var resolveReason atc.InputSchedulingReason
+		if resolveRsn.Valid {
+			resolveReason = atc.InputSchedulingReason(resolveRsn.String)
+		}
+
 		buildInputs = append(buildInputs, BuildInput{
 			Name:            inputName,
 			ResourceID:      resourceID,
 			Version:         version,
 			FirstOccurrence: firstOccurrence,
 			ResolveError:    resolveError,
+			ResolveReason:   resolveReason,
 		})
 	}
 
@@ -1182,10 +1304,11 @@ func (j *job) getNextBuildInputs(tx Tx) ([]BuildInput, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		nonce sql.NullString
+		nonce    sql.NullString
+		schedule sql.NullString
 	)
 
-	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &schedule, &j.scheduleLastFired)
 	if err != nil {
 		return err
 	}
@@ -1194,6 +1317,14 @@ func scanJob(j *job, row scannable) error {
 		j.nonce = &nonce.String
 	}
 
+	j.schedule = nil
+	if schedule.Valid {
+		err = json.Unmarshal([]byte(schedule.String), &j.schedule)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ type JobFactory interface {
 	VisibleJobs([]string) (atc.Dashboard, error)
 	AllActiveJobs() (atc.Dashboard, error)
 	JobsToSchedule() (Jobs, error)
+	JobsWithSchedules() (Jobs, error)
 }
 
 type jobFactory struct {
@@ -95,6 +96,25 @@ func (j *jobFactory) JobsToSchedule() (Jobs, error) {
 	return scanJobs(j.conn, j.lockFactory, rows)
 }
 
+// JobsWithSchedules returns the active jobs which have a schedule and may be
+// triggered by it, i.e. neither they nor their pipelines are paused.
+func (j *jobFactory) JobsWithSchedules() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.NotEq{"j.schedule": nil}).
+		Where(sq.Eq{
+			"j.active": true,
+			"j.paused": false,
+			"p.paused": false,
+		}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
 type dashboardFactory struct {
 	// Constraints that are used by the dashboard queries. For example, a job ID
 	// constraint so that the dashboard will only return the job I have access to
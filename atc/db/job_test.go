@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -23,7 +24,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -122,6 +123,98 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("Schedule", func() {
+		It("is nil when the job has no schedule", func() {
+			Expect(job.Schedule()).To(BeNil())
+		})
+
+		Context("when the job has a schedule", func() {
+			var schedule atc.JobSchedule
+
+			BeforeEach(func() {
+				schedule = atc.JobSchedule{Cron: "0 2 * * *", Location: "Europe/Berlin"}
+				job = saveJobWithSchedule(team, &schedule)
+			})
+
+			It("returns the schedule", func() {
+				Expect(job.Schedule()).To(Equal(&schedule))
+			})
+
+			Describe("FireSchedule", func() {
+				var previouslyFired time.Time
+
+				BeforeEach(func() {
+					previouslyFired = job.ScheduleLastFired()
+					Expect(previouslyFired).ToNot(BeZero())
+				})
+
+				It("records when the schedule fired", func() {
+					firedAt := previouslyFired.Add(time.Hour)
+
+					fired, err := job.FireSchedule(firedAt)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fired).To(BeTrue())
+					Expect(job.ScheduleLastFired()).To(BeTemporally("~", firedAt, time.Millisecond))
+
+					found, err := job.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(job.ScheduleLastFired()).To(BeTemporally("~", firedAt, time.Millisecond))
+				})
+
+				It("can fire again after firing", func() {
+					fired, err := job.FireSchedule(previouslyFired.Add(time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fired).To(BeTrue())
+
+					fired, err = job.FireSchedule(previouslyFired.Add(2 * time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fired).To(BeTrue())
+				})
+
+				Context("when the schedule was fired by someone else since the job was loaded", func() {
+					BeforeEach(func() {
+						otherJob := saveJobWithSchedule(team, &schedule)
+
+						fired, err := otherJob.FireSchedule(previouslyFired.Add(time.Minute))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(fired).To(BeTrue())
+					})
+
+					It("does not fire it again", func() {
+						fired, err := job.FireSchedule(previouslyFired.Add(time.Minute))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(fired).To(BeFalse())
+					})
+				})
+			})
+
+			Context("when the pipeline is set again with the same schedule", func() {
+				It("keeps when it last fired", func() {
+					fired, err := job.FireSchedule(job.ScheduleLastFired().Add(-time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fired).To(BeTrue())
+
+					lastFired := job.ScheduleLastFired()
+
+					job = saveJobWithSchedule(team, &schedule)
+					Expect(job.ScheduleLastFired()).To(Equal(lastFired))
+				})
+			})
+
+			Context("when the schedule is changed", func() {
+				It("restarts it from now", func() {
+					fired, err := job.FireSchedule(job.ScheduleLastFired().Add(-time.Hour))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fired).To(BeTrue())
+
+					job = saveJobWithSchedule(team, &atc.JobSchedule{Cron: "@hourly"})
+					Expect(job.ScheduleLastFired()).To(BeTemporally("~", time.Now(), time.Minute))
+				})
+			})
+		})
+	})
+
 	Describe("Pause and Unpause", func() {
 		var initialRequestedTime time.Time
 		It("starts out as unpaused", func() {
@@ -179,7 +272,7 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
@@ -200,13 +293,13 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished).To(BeNil())
 
-			finishedBuild, err := job.CreateBuild()
+			finishedBuild, err := job.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			err = finishedBuild.Finish(db.BuildStatusSucceeded)
 			Expect(err).NotTo(HaveOccurred())
 
-			otherFinishedBuild, err := otherJob.CreateBuild()
+			otherFinishedBuild, err := otherJob.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			err = otherFinishedBuild.Finish(db.BuildStatusSucceeded)
@@ -218,14 +311,14 @@ var _ = Describe("Job", func() {
 			Expect(next).To(BeNil())
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			nextBuild, err := job.CreateBuild()
+			nextBuild, err := job.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			started, err := nextBuild.Start(atc.Plan{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(started).To(BeTrue())
 
-			otherNextBuild, err := otherJob.CreateBuild()
+			otherNextBuild, err := otherJob.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			otherStarted, err := otherNextBuild.Start(atc.Plan{})
@@ -238,7 +331,7 @@ var _ = Describe("Job", func() {
 			Expect(next.ID()).To(Equal(nextBuild.ID()))
 			Expect(finished.ID()).To(Equal(finishedBuild.ID()))
 
-			anotherRunningBuild, err := job.CreateBuild()
+			anotherRunningBuild, err := job.CreateBuild(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 
 			finished, next, err = job.FinishedAndNextBuild()
@@ -315,10 +408,10 @@ var _ = Describe("Job", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				build, err := someJob.CreateBuild()
+				build, err := someJob.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
-				_, err = someOtherJob.CreateBuild()
+				_, err = someOtherJob.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				builds[i] = build
@@ -409,7 +502,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -417,7 +510,7 @@ var _ = Describe("Job", func() {
 			Expect(found).To(BeTrue())
 
 			for i := range builds {
-				builds[i], err = job.CreateBuild()
+				builds[i], err = job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				buildStart := time.Date(2020, 11, i+1, 0, 0, 0, 0, time.UTC)
@@ -496,12 +589,12 @@ var _ = Describe("Job", func() {
 		Context("when a build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 			})
 
 			It("finds the latest build", func() {
-				secondBuild, err := job.CreateBuild()
+				secondBuild, err := job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				build, found, err := job.Build("latest")
@@ -540,7 +633,7 @@ var _ = Describe("Job", func() {
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
-				_, err := job.CreateBuild()
+				_, err := job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				found, err := job.Reload()
@@ -557,15 +650,20 @@ var _ = Describe("Job", func() {
 		var rerunErr error
 		var rerunBuild db.Build
 		var buildToRerun db.Build
+		var fromFailed bool
+
+		BeforeEach(func() {
+			fromFailed = false
+		})
 
 		JustBeforeEach(func() {
-			rerunBuild, rerunErr = job.RerunBuild(buildToRerun)
+			rerunBuild, rerunErr = job.RerunBuild(buildToRerun, fromFailed)
 		})
 
 		Context("when the first build exists", func() {
 			BeforeEach(func() {
 				var err error
-				firstBuild, err = job.CreateBuild()
+				firstBuild, err = job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				buildToRerun = firstBuild
@@ -586,7 +684,7 @@ var _ = Describe("Job", func() {
 			It("requests schedule on the job", func() {
 				requestedSchedule := job.ScheduleRequestedTime()
 
-				_, err := job.RerunBuild(buildToRerun)
+				_, err := job.RerunBuild(buildToRerun, false)
 				Expect(err).NotTo(HaveOccurred())
 
 				found, err := job.Reload()
@@ -601,7 +699,7 @@ var _ = Describe("Job", func() {
 
 				BeforeEach(func() {
 					var err error
-					rerun1, err = job.RerunBuild(buildToRerun)
+					rerun1, err = job.RerunBuild(buildToRerun, false)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(rerun1.Name()).To(Equal(fmt.Sprintf("%s.1", firstBuild.Name())))
 					Expect(rerun1.RerunNumber()).To(Equal(1))
@@ -619,7 +717,7 @@ var _ = Describe("Job", func() {
 
 				BeforeEach(func() {
 					var err error
-					rerun1, err = job.RerunBuild(buildToRerun)
+					rerun1, err = job.RerunBuild(buildToRerun, false)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(rerun1.Name()).To(Equal(fmt.Sprintf("%s.1", firstBuild.Name())))
 					Expect(rerun1.RerunNumber()).To(Equal(1))
@@ -632,6 +730,34 @@ var _ = Describe("Job", func() {
 					Expect(rerunBuild.Name()).To(Equal(fmt.Sprintf("%s.2", firstBuild.Name())))
 					Expect(rerunBuild.RerunNumber()).To(Equal(rerun1.RerunNumber() + 1))
 				})
+
+				Context("when rerunning from the failed step", func() {
+					BeforeEach(func() {
+						fromFailed = true
+					})
+
+					It("resumes the rerun build rather than the original build", func() {
+						Expect(rerunErr).ToNot(HaveOccurred())
+						Expect(rerunBuild.RerunOf()).To(Equal(firstBuild.ID()))
+						Expect(rerunBuild.ResumeOf()).To(Equal(rerun1.ID()))
+					})
+				})
+			})
+
+			It("does not resume the build", func() {
+				Expect(rerunErr).ToNot(HaveOccurred())
+				Expect(rerunBuild.ResumeOf()).To(BeZero())
+			})
+
+			Context("when rerunning from the failed step", func() {
+				BeforeEach(func() {
+					fromFailed = true
+				})
+
+				It("resumes the build", func() {
+					Expect(rerunErr).ToNot(HaveOccurred())
+					Expect(rerunBuild.ResumeOf()).To(Equal(firstBuild.ID()))
+				})
 			})
 		})
 	})
@@ -646,7 +772,7 @@ var _ = Describe("Job", func() {
 		saveMaxInFlightPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -719,7 +845,7 @@ var _ = Describe("Job", func() {
 		saveSerialGroupsPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -811,13 +937,13 @@ var _ = Describe("Job", func() {
 		Context("when the scheduling build is created first", func() {
 			BeforeEach(func() {
 				var err error
-				schedulingBuild, err = job.CreateBuild()
+				schedulingBuild, err = job.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 			})
 
 			Context("when the job config doesn't specify max in flight", func() {
 				BeforeEach(func() {
-					pipeline, created, err := team.SavePipeline("other-pipeline", atc.Config{
+					pipeline, created, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
@@ -870,7 +996,7 @@ var _ = Describe("Job", func() {
 
 					BeforeEach(func() {
 						var err error
-						startedBuild, err = job.CreateBuild()
+						startedBuild, err = job.CreateBuild(context.TODO())
 						Expect(err).ToNot(HaveOccurred())
 						scheduled, err := job.ScheduleBuild(startedBuild)
 						Expect(err).ToNot(HaveOccurred())
@@ -878,7 +1004,7 @@ var _ = Describe("Job", func() {
 						_, err = startedBuild.Start(atc.Plan{})
 						Expect(err).NotTo(HaveOccurred())
 
-						scheduledBuild, err = job.CreateBuild()
+						scheduledBuild, err = job.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 						scheduled, err = job.ScheduleBuild(scheduledBuild)
 						Expect(err).ToNot(HaveOccurred())
@@ -887,7 +1013,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-							finishedBuild, err := job.CreateBuild()
+							finishedBuild, err := job.CreateBuild(context.TODO())
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err = job.ScheduleBuild(finishedBuild)
@@ -902,7 +1028,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						_, err = otherJob.CreateBuild()
+						_, err = otherJob.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 					})
 
@@ -917,7 +1043,7 @@ var _ = Describe("Job", func() {
 
 				Context("when there is 1 build running", func() {
 					BeforeEach(func() {
-						startedBuild, err := job.CreateBuild()
+						startedBuild, err := job.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 						scheduled, err := job.ScheduleBuild(startedBuild)
 						Expect(err).NotTo(HaveOccurred())
@@ -926,7 +1052,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 
 						for _, s := range []db.BuildStatus{db.BuildStatusSucceeded, db.BuildStatusFailed, db.BuildStatusErrored, db.BuildStatusAborted} {
-							finishedBuild, err := job.CreateBuild()
+							finishedBuild, err := job.CreateBuild(context.TODO())
 							Expect(err).NotTo(HaveOccurred())
 
 							scheduled, err = job.ScheduleBuild(finishedBuild)
@@ -955,14 +1081,14 @@ var _ = Describe("Job", func() {
 				Context("when multiple jobs in the serial group is running", func() {
 					BeforeEach(func() {
 						var err error
-						_, err = job.CreateBuild()
+						_, err = job.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 
 						otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						serialGroupBuild, err := otherSerialJob.CreateBuild()
+						serialGroupBuild, err := otherSerialJob.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err := otherSerialJob.ScheduleBuild(serialGroupBuild)
@@ -973,7 +1099,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+						differentSerialGroupBuild, err := differentSerialJob.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err = differentSerialJob.ScheduleBuild(differentSerialGroupBuild)
@@ -995,7 +1121,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						serialGroupBuild, err := otherSerialJob.CreateBuild()
+						serialGroupBuild, err := otherSerialJob.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err := otherSerialJob.ScheduleBuild(serialGroupBuild)
@@ -1009,7 +1135,7 @@ var _ = Describe("Job", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(found).To(BeTrue())
 
-						differentSerialGroupBuild, err := differentSerialJob.CreateBuild()
+						differentSerialGroupBuild, err := differentSerialJob.CreateBuild(context.TODO())
 						Expect(err).NotTo(HaveOccurred())
 
 						scheduled, err = differentSerialJob.ScheduleBuild(differentSerialGroupBuild)
@@ -1035,7 +1161,7 @@ var _ = Describe("Job", func() {
 			Context("when the scheduling build has inputs determined as false", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, false)
@@ -1057,13 +1183,13 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = otherSerialJob.SaveNextInputMapping(nil, true)
 					Expect(err).NotTo(HaveOccurred())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1082,14 +1208,14 @@ var _ = Describe("Job", func() {
 			Context("when the scheduling build has it's inputs determined and created earlier", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1110,14 +1236,14 @@ var _ = Describe("Job", func() {
 			Context("when the job is paused but has inputs determined", func() {
 				BeforeEach(func() {
 					var err error
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					otherSerialJob, found, err := pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1144,7 +1270,7 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					succeededBuild, err := otherSerialJob.CreateBuild()
+					succeededBuild, err := otherSerialJob.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = succeededBuild.Finish(db.BuildStatusSucceeded)
@@ -1155,7 +1281,7 @@ var _ = Describe("Job", func() {
 					err = otherSerialJob.SaveNextInputMapping(nil, true)
 					Expect(err).NotTo(HaveOccurred())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 				})
 
@@ -1174,14 +1300,14 @@ var _ = Describe("Job", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					_, err = otherSerialJob.CreateBuild()
+					_, err = otherSerialJob.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					job, found, err = pipeline.Job("other-serial-group-job")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 
-					schedulingBuild, err = job.CreateBuild()
+					schedulingBuild, err = job.CreateBuild(context.TODO())
 					Expect(err).NotTo(HaveOccurred())
 
 					err = job.SaveNextInputMapping(nil, true)
@@ -1222,7 +1348,7 @@ var _ = Describe("Job", func() {
 			Expect(setupTx.Commit()).To(Succeed())
 
 			var created bool
-			pipeline, created, err = team.SavePipeline("build-inputs-pipeline", atc.Config{
+			pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "build-inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -1293,7 +1419,8 @@ var _ = Describe("Job", func() {
 			It("gets partial next build inputs for the given job name", func() {
 				inputVersions := db.InputMapping{
 					"some-input-2": db.InputResult{
-						ResolveError: "disaster",
+						ResolveError:  "disaster",
+						ResolveReason: atc.InputSchedulingUnknown,
 					},
 				}
 
@@ -1302,8 +1429,9 @@ var _ = Describe("Job", func() {
 
 				buildInputs := []db.BuildInput{
 					{
-						Name:         "some-input-2",
-						ResolveError: "disaster",
+						Name:          "some-input-2",
+						ResolveError:  "disaster",
+						ResolveReason: atc.InputSchedulingUnknown,
 					},
 				}
 
@@ -1453,7 +1581,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1636,10 +1764,10 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
-			build1DB, err = job.CreateBuild()
+			build1DB, err = job.CreateBuild(context.TODO())
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(build1DB.ID()).NotTo(BeZero())
@@ -1664,7 +1792,7 @@ var _ = Describe("Job", func() {
 
 		Context("and another build for a different pipeline is created with the same job name", func() {
 			BeforeEach(func() {
-				otherBuild, err := otherJob.CreateBuild()
+				otherBuild, err := otherJob.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(otherBuild.ID()).NotTo(BeZero())
@@ -1742,7 +1870,7 @@ var _ = Describe("Job", func() {
 
 			BeforeEach(func() {
 				var err error
-				build2DB, err = job.CreateBuild()
+				build2DB, err = job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(build2DB.ID()).NotTo(BeZero())
@@ -1766,7 +1894,7 @@ var _ = Describe("Job", func() {
 	Describe("EnsurePendingBuildExists", func() {
 		Context("when only a started build exists", func() {
 			BeforeEach(func() {
-				build1, err := job.CreateBuild()
+				build1, err := job.CreateBuild(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
 				started, err := build1.Start(atc.Plan{})
@@ -1805,6 +1933,99 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("GetSupersededBuilds", func() {
+		var newInputs []db.BuildInput
+
+		startBuildWithVersion := func(version atc.Version) db.Build {
+			build, err := defaultJob.CreateBuild(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			err = defaultJob.SaveNextInputMapping(db.InputMapping{
+				"some-input": db.InputResult{
+					Input: &db.AlgorithmInput{
+						AlgorithmVersion: db.AlgorithmVersion{
+							Version:    db.ResourceVersion(convertToMD5(version)),
+							ResourceID: defaultResource.ID(),
+						},
+						FirstOccurrence: true,
+					},
+					PassedBuildIDs: []int{},
+				},
+			}, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := build.AdoptInputsAndPipes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			started, err := build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			return build
+		}
+
+		BeforeEach(func() {
+			resourceConfigScope, err := defaultResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = resourceConfigScope.SaveVersions([]atc.Version{
+				{"ver": "1"},
+				{"ver": "2"},
+				{"ver": "3"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			newInputs = []db.BuildInput{
+				{
+					Name:            "some-input",
+					Version:         atc.Version{"ver": "2"},
+					ResourceID:      defaultResource.ID(),
+					FirstOccurrence: true,
+				},
+			}
+		})
+
+		It("returns the running builds which used older versions", func() {
+			olderBuild := startBuildWithVersion(atc.Version{"ver": "1"})
+			startBuildWithVersion(atc.Version{"ver": "2"})
+			startBuildWithVersion(atc.Version{"ver": "3"})
+
+			builds, err := defaultJob.GetSupersededBuilds(newInputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(olderBuild.ID()))
+		})
+
+		It("does not return finished or aborted builds", func() {
+			finishedBuild := startBuildWithVersion(atc.Version{"ver": "1"})
+			Expect(finishedBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			abortedBuild := startBuildWithVersion(atc.Version{"ver": "1"})
+			Expect(abortedBuild.MarkAsAborted()).To(Succeed())
+
+			builds, err := defaultJob.GetSupersededBuilds(newInputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+
+		It("does not return reruns", func() {
+			build := startBuildWithVersion(atc.Version{"ver": "1"})
+			Expect(build.Finish(db.BuildStatusFailed)).To(Succeed())
+
+			rerunBuild, err := defaultJob.RerunBuild(build, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := rerunBuild.AdoptRerunInputsAndPipes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			builds, err := defaultJob.GetSupersededBuilds(newInputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+	})
+
 	Describe("Clear task cache", func() {
 		Context("when task cache exists", func() {
 			var (
@@ -1972,7 +2193,7 @@ var _ = Describe("Job", func() {
 		var inputsJob db.Job
 
 		BeforeEach(func() {
-			inputsPipeline, _, err := team.SavePipeline("inputs-pipeline", atc.Config{
+			inputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2083,7 +2304,7 @@ var _ = Describe("Job", func() {
 		var outputsJob db.Job
 
 		BeforeEach(func() {
-			outputsPipeline, _, err := team.SavePipeline("outputs-pipeline", atc.Config{
+			outputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "outputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2162,3 +2383,30 @@ var _ = Describe("Job", func() {
 		})
 	})
 })
+
+func saveJobWithSchedule(team db.Team, schedule *atc.JobSchedule) db.Job {
+	ref := atc.PipelineRef{Name: "scheduled-pipeline"}
+
+	from := db.ConfigVersion(0)
+	existing, found, err := team.Pipeline(ref)
+	Expect(err).ToNot(HaveOccurred())
+	if found {
+		from = existing.ConfigVersion()
+	}
+
+	pipeline, _, err := team.SavePipeline(ref, atc.Config{
+		Jobs: atc.JobConfigs{
+			{
+				Name:     "scheduled-job",
+				Schedule: schedule,
+			},
+		},
+	}, from, false)
+	Expect(err).ToNot(HaveOccurred())
+
+	job, found, err := pipeline.Job("scheduled-job")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+
+	return job
+}
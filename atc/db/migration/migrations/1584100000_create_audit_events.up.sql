@@ -0,0 +1,17 @@
+BEGIN;
+  CREATE TABLE audit_events (
+    id serial PRIMARY KEY,
+    "time" timestamp with time zone NOT NULL DEFAULT now(),
+    action text NOT NULL,
+    actor text NOT NULL,
+    source_ip text,
+    team_name text,
+    targets jsonb,
+    status integer NOT NULL,
+    drained boolean NOT NULL DEFAULT false
+  );
+
+  CREATE INDEX audit_events_team_name_id_idx ON audit_events (team_name, id);
+
+  CREATE INDEX audit_events_undrained_idx ON audit_events (id) WHERE NOT drained;
+COMMIT;
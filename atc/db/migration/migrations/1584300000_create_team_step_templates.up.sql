@@ -0,0 +1,12 @@
+BEGIN;
+  CREATE TABLE team_step_templates (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    version integer NOT NULL,
+    params jsonb NOT NULL DEFAULT '[]',
+    step text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (team_id, name, version)
+  );
+COMMIT;
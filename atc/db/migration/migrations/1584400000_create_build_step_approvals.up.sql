@@ -0,0 +1,16 @@
+BEGIN;
+  CREATE TABLE build_step_approvals (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    plan_id text NOT NULL,
+    name text NOT NULL,
+    message text,
+    roles jsonb NOT NULL DEFAULT '[]',
+    users jsonb NOT NULL DEFAULT '[]',
+    approved boolean,
+    decided_by text,
+    comment text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    decided_at timestamp with time zone,
+    PRIMARY KEY (build_id, plan_id)
+  );
+COMMIT;
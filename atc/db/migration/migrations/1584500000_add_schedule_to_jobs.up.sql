@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN schedule jsonb,
+    ADD COLUMN schedule_last_fired timestamp with time zone NOT NULL DEFAULT now();
+COMMIT;
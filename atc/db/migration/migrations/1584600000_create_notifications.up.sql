@@ -0,0 +1,30 @@
+BEGIN;
+  CREATE TABLE notification_subscriptions (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    url text NOT NULL,
+    secret text,
+    nonce text,
+    statuses text[] NOT NULL DEFAULT '{}',
+    UNIQUE (team_id, name)
+  );
+
+  CREATE TABLE notification_deliveries (
+    id serial PRIMARY KEY,
+    subscription_id integer NOT NULL REFERENCES notification_subscriptions (id) ON DELETE CASCADE,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    build_status build_status NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    response_status integer,
+    error text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_attempt_at timestamp with time zone,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX notification_deliveries_subscription_id_idx ON notification_deliveries (subscription_id);
+  CREATE INDEX notification_deliveries_build_id_idx ON notification_deliveries (build_id);
+  CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE volumes
+    DROP COLUMN task_memo_id;
+
+  DROP TABLE task_memos;
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+  CREATE TABLE task_memos (
+    id serial PRIMARY KEY,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    step_name text NOT NULL,
+    key text NOT NULL,
+    build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+    outputs jsonb NOT NULL DEFAULT '{}',
+    UNIQUE (job_id, step_name)
+  );
+
+  ALTER TABLE volumes
+    ADD COLUMN task_memo_id integer REFERENCES task_memos (id) ON DELETE SET NULL;
+
+  CREATE INDEX volumes_task_memo_id ON volumes (task_memo_id);
+COMMIT;
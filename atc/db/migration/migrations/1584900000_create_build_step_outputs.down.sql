@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN resume_of;
+
+  DROP TABLE build_step_outputs;
+COMMIT;
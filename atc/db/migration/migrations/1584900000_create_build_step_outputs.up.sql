@@ -0,0 +1,11 @@
+BEGIN;
+  CREATE TABLE build_step_outputs (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    step_key text NOT NULL,
+    outputs jsonb NOT NULL DEFAULT '{}',
+    PRIMARY KEY (build_id, step_key)
+  );
+
+  ALTER TABLE builds
+    ADD COLUMN resume_of integer REFERENCES builds (id) ON DELETE SET NULL;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX builds_logs_indexed_idx;
+
+  ALTER TABLE builds DROP COLUMN logs_indexed;
+
+  DROP TABLE build_log_lines;
+COMMIT;
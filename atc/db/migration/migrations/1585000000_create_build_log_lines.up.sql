@@ -0,0 +1,19 @@
+BEGIN;
+  CREATE TABLE build_log_lines (
+    id bigserial PRIMARY KEY,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    origin text NOT NULL,
+    line text NOT NULL,
+    search tsvector NOT NULL
+  );
+
+  CREATE INDEX build_log_lines_build_id_idx ON build_log_lines (build_id);
+  CREATE INDEX build_log_lines_team_id_idx ON build_log_lines (team_id);
+  CREATE INDEX build_log_lines_search_idx ON build_log_lines USING gin (search);
+
+  ALTER TABLE builds ADD COLUMN logs_indexed boolean NOT NULL DEFAULT TRUE;
+  ALTER TABLE builds ALTER COLUMN logs_indexed SET DEFAULT FALSE;
+
+  CREATE INDEX builds_logs_indexed_idx ON builds (id) WHERE completed AND NOT logs_indexed;
+COMMIT;
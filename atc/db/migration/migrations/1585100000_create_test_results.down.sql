@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE test_results;
+COMMIT;
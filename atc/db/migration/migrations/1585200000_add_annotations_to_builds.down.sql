@@ -0,0 +1,5 @@
+BEGIN;
+  DROP INDEX builds_annotations_idx;
+
+  ALTER TABLE builds DROP COLUMN annotations;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN annotations jsonb;
+
+  CREATE INDEX builds_annotations_idx ON builds USING gin (annotations);
+COMMIT;
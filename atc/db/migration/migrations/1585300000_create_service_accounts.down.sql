@@ -0,0 +1,4 @@
+BEGIN;
+  DROP TABLE api_tokens;
+  DROP TABLE service_accounts;
+COMMIT;
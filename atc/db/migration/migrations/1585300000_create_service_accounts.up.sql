@@ -0,0 +1,22 @@
+BEGIN;
+  CREATE TABLE service_accounts (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    role text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (team_id, name)
+  );
+
+  CREATE TABLE api_tokens (
+    id serial PRIMARY KEY,
+    service_account_id integer NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE,
+    token_hash text NOT NULL UNIQUE,
+    actions jsonb NOT NULL DEFAULT '[]',
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX api_tokens_service_account_id_idx ON api_tokens (service_account_id);
+COMMIT;
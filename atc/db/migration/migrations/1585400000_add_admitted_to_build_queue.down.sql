@@ -0,0 +1,4 @@
+BEGIN;
+  DELETE FROM build_queue WHERE admitted;
+  ALTER TABLE build_queue DROP COLUMN admitted;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE build_queue ADD COLUMN admitted boolean NOT NULL DEFAULT false;
+COMMIT;
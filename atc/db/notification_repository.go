@@ -0,0 +1,357 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . NotificationRepository
+
+// NotificationRepository persists the notification subscriptions of teams and
+// the deliveries queued for them when builds finish.
+type NotificationRepository interface {
+	SaveSubscription(teamID int, subscription atc.NotificationSubscription) error
+	Subscriptions(teamID int) ([]atc.NotificationSubscription, error)
+	DestroySubscription(teamID int, name string) (bool, error)
+
+	Deliveries(teamID int, name string, limit int) ([]atc.NotificationDelivery, bool, error)
+
+	PendingDeliveries(limit int) ([]PendingNotificationDelivery, error)
+	FinishDeliveryAttempt(id int, attempt NotificationDeliveryAttempt) error
+}
+
+// A PendingNotificationDelivery is a delivery which is due to be attempted,
+// along with the subscription it is sent to.
+type PendingNotificationDelivery struct {
+	ID           int
+	BuildID      int
+	Attempts     int
+	Subscription atc.NotificationSubscription
+}
+
+// A NotificationDeliveryAttempt is the outcome of an attempt to deliver a
+// notification. A pending delivery is attempted again at RetryAt.
+type NotificationDeliveryAttempt struct {
+	Status         atc.NotificationDeliveryStatus
+	ResponseStatus int
+	Error          string
+	RetryAt        time.Time
+}
+
+type notificationRepository struct {
+	conn Conn
+}
+
+func NewNotificationRepository(conn Conn) NotificationRepository {
+	return &notificationRepository{
+		conn: conn,
+	}
+}
+
+func (r *notificationRepository) SaveSubscription(teamID int, subscription atc.NotificationSubscription) error {
+	var secret, nonce *string
+	if subscription.Secret != "" {
+		encrypted, encryptionNonce, err := r.conn.EncryptionStrategy().Encrypt([]byte(subscription.Secret))
+		if err != nil {
+			return err
+		}
+
+		secret = &encrypted
+		nonce = encryptionNonce
+	}
+
+	statuses := []string{}
+	for _, status := range subscription.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	_, err := psql.Insert("notification_subscriptions").
+		SetMap(map[string]interface{}{
+			"team_id":  teamID,
+			"name":     subscription.Name,
+			"url":      subscription.URL,
+			"secret":   secret,
+			"nonce":    nonce,
+			"statuses": pq.Array(statuses),
+		}).
+		Suffix(`
+			ON CONFLICT (team_id, name) DO UPDATE SET
+				url = EXCLUDED.url,
+				secret = EXCLUDED.secret,
+				nonce = EXCLUDED.nonce,
+				statuses = EXCLUDED.statuses
+		`).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
+// Subscriptions returns the team's subscriptions, without their secrets.
+func (r *notificationRepository) Subscriptions(teamID int) ([]atc.NotificationSubscription, error) {
+	rows, err := psql.Select("s.name", "s.url", "s.statuses").
+		From("notification_subscriptions s").
+		Where(sq.Eq{"s.team_id": teamID}).
+		OrderBy("s.name ASC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	subscriptions := []atc.NotificationSubscription{}
+	for rows.Next() {
+		var (
+			subscription atc.NotificationSubscription
+			statuses     []string
+		)
+
+		err := rows.Scan(&subscription.Name, &subscription.URL, pq.Array(&statuses))
+		if err != nil {
+			return nil, err
+		}
+
+		subscription.Statuses = buildStatuses(statuses)
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// DestroySubscription removes a subscription along with its deliveries.
+func (r *notificationRepository) DestroySubscription(teamID int, name string) (bool, error) {
+	result, err := psql.Delete("notification_subscriptions").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// Deliveries returns the latest deliveries of a subscription, most recent
+// first. It returns false if the team has no such subscription.
+func (r *notificationRepository) Deliveries(teamID int, name string, limit int) ([]atc.NotificationDelivery, bool, error) {
+	var subscriptionID int
+	err := psql.Select("id").
+		From("notification_subscriptions").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&subscriptionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	rows, err := psql.Select(
+		"d.id",
+		"d.build_id",
+		"d.build_status",
+		"d.status",
+		"d.attempts",
+		"d.response_status",
+		"d.error",
+		"d.created_at",
+		"d.last_attempt_at",
+	).
+		From("notification_deliveries d").
+		Where(sq.Eq{"d.subscription_id": subscriptionID}).
+		OrderBy("d.id DESC").
+		Limit(uint64(limit)).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.NotificationDelivery{}
+	for rows.Next() {
+		var (
+			delivery       atc.NotificationDelivery
+			responseStatus sql.NullInt64
+			deliveryErr    sql.NullString
+			createdAt      time.Time
+			lastAttemptAt  pq.NullTime
+		)
+
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.BuildID,
+			&delivery.BuildStatus,
+			&delivery.Status,
+			&delivery.Attempts,
+			&responseStatus,
+			&deliveryErr,
+			&createdAt,
+			&lastAttemptAt,
+		)
+		if err != nil {
+			return nil, false, err
+		}
+
+		delivery.Notification = name
+		delivery.ResponseStatus = int(responseStatus.Int64)
+		delivery.Error = deliveryErr.String
+		delivery.CreatedAt = createdAt.Unix()
+
+		if lastAttemptAt.Valid {
+			delivery.LastAttemptAt = lastAttemptAt.Time.Unix()
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, true, nil
+}
+
+// PendingDeliveries returns the pending deliveries which are due to be
+// attempted, oldest first.
+func (r *notificationRepository) PendingDeliveries(limit int) ([]PendingNotificationDelivery, error) {
+	rows, err := psql.Select(
+		"d.id",
+		"d.build_id",
+		"d.attempts",
+		"s.name",
+		"s.url",
+		"s.secret",
+		"s.nonce",
+	).
+		From("notification_deliveries d").
+		Join("notification_subscriptions s ON s.id = d.subscription_id").
+		Where(sq.Eq{"d.status": atc.NotificationDeliveryPending}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id ASC").
+		Limit(uint64(limit)).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []PendingNotificationDelivery{}
+	for rows.Next() {
+		var (
+			delivery      PendingNotificationDelivery
+			secret, nonce sql.NullString
+		)
+
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.BuildID,
+			&delivery.Attempts,
+			&delivery.Subscription.Name,
+			&delivery.Subscription.URL,
+			&secret,
+			&nonce,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if secret.Valid {
+			var noncense *string
+			if nonce.Valid {
+				noncense = &nonce.String
+			}
+
+			decrypted, err := r.conn.EncryptionStrategy().Decrypt(secret.String, noncense)
+			if err != nil {
+				return nil, err
+			}
+
+			delivery.Subscription.Secret = string(decrypted)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func (r *notificationRepository) FinishDeliveryAttempt(id int, attempt NotificationDeliveryAttempt) error {
+	var responseStatus, deliveryErr interface{}
+	if attempt.ResponseStatus != 0 {
+		responseStatus = attempt.ResponseStatus
+	}
+
+	if attempt.Error != "" {
+		deliveryErr = attempt.Error
+	}
+
+	query := psql.Update("notification_deliveries").
+		Set("status", attempt.Status).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("response_status", responseStatus).
+		Set("error", deliveryErr).
+		Set("last_attempt_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id})
+
+	if attempt.Status == atc.NotificationDeliveryPending {
+		query = query.Set("next_attempt_at", attempt.RetryAt)
+	}
+
+	_, err := query.RunWith(r.conn).Exec()
+	return err
+}
+
+// queueNotificationDeliveries queues a delivery of the finished build to each
+// of its team's subscriptions interested in its status.
+func queueNotificationDeliveries(tx Tx, teamID int, buildID int, status BuildStatus) (bool, error) {
+	result, err := tx.Exec(`
+		INSERT INTO notification_deliveries (subscription_id, build_id, build_status)
+		SELECT s.id, $2, $3
+		FROM notification_subscriptions s
+		WHERE s.team_id = $1
+		AND (cardinality(s.statuses) = 0 OR $4 = ANY(s.statuses))
+	`, teamID, buildID, status, string(status))
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func buildStatuses(statuses []string) []atc.BuildStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	buildStatuses := make([]atc.BuildStatus, len(statuses))
+	for i, status := range statuses {
+		buildStatuses[i] = atc.BuildStatus(status)
+	}
+
+	return buildStatuses
+}
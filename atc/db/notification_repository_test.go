@@ -0,0 +1,179 @@
+package db_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationRepository", func() {
+	var repository db.NotificationRepository
+
+	BeforeEach(func() {
+		repository = db.NewNotificationRepository(dbConn)
+	})
+
+	subscribe := func(name string, statuses ...atc.BuildStatus) {
+		err := repository.SaveSubscription(defaultTeam.ID(), atc.NotificationSubscription{
+			Name:     name,
+			URL:      "https://example.com/" + name,
+			Secret:   "some-secret",
+			Statuses: statuses,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	finishBuild := func(status db.BuildStatus) db.Build {
+		build, err := defaultJob.CreateBuild(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+
+		err = build.Finish(status)
+		Expect(err).NotTo(HaveOccurred())
+
+		return build
+	}
+
+	Describe("SaveSubscription", func() {
+		It("creates and updates subscriptions without returning their secrets", func() {
+			subscribe("some-notification")
+			subscribe("some-notification", atc.StatusFailed)
+			subscribe("other-notification")
+
+			subscriptions, err := repository.Subscriptions(defaultTeam.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subscriptions).To(Equal([]atc.NotificationSubscription{
+				{
+					Name: "other-notification",
+					URL:  "https://example.com/other-notification",
+				},
+				{
+					Name:     "some-notification",
+					URL:      "https://example.com/some-notification",
+					Statuses: []atc.BuildStatus{atc.StatusFailed},
+				},
+			}))
+		})
+	})
+
+	Describe("DestroySubscription", func() {
+		It("destroys the subscription", func() {
+			subscribe("some-notification")
+
+			destroyed, err := repository.DestroySubscription(defaultTeam.ID(), "some-notification")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeTrue())
+
+			Expect(repository.Subscriptions(defaultTeam.ID())).To(BeEmpty())
+
+			destroyed, err = repository.DestroySubscription(defaultTeam.ID(), "some-notification")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeFalse())
+		})
+	})
+
+	Describe("queueing deliveries when builds finish", func() {
+		BeforeEach(func() {
+			subscribe("all-builds")
+			subscribe("failed-builds", atc.StatusFailed)
+		})
+
+		It("queues a delivery to each subscription interested in the status", func() {
+			build := finishBuild(db.BuildStatusSucceeded)
+
+			deliveries, found, err := repository.Deliveries(defaultTeam.ID(), "all-builds", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Notification).To(Equal("all-builds"))
+			Expect(deliveries[0].BuildID).To(Equal(build.ID()))
+			Expect(deliveries[0].BuildStatus).To(Equal(atc.StatusSucceeded))
+			Expect(deliveries[0].Status).To(Equal(atc.NotificationDeliveryPending))
+			Expect(deliveries[0].Attempts).To(BeZero())
+
+			deliveries, found, err = repository.Deliveries(defaultTeam.ID(), "failed-builds", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(deliveries).To(BeEmpty())
+		})
+
+		It("returns the due deliveries with the decrypted secrets of their subscriptions", func() {
+			build := finishBuild(db.BuildStatusFailed)
+
+			pending, err := repository.PendingDeliveries(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pending).To(HaveLen(2))
+
+			for _, delivery := range pending {
+				Expect(delivery.BuildID).To(Equal(build.ID()))
+				Expect(delivery.Subscription.Secret).To(Equal("some-secret"))
+			}
+		})
+
+		Context("when a delivery attempt fails", func() {
+			var delivery db.PendingNotificationDelivery
+
+			BeforeEach(func() {
+				finishBuild(db.BuildStatusFailed)
+
+				pending, err := repository.PendingDeliveries(1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pending).To(HaveLen(1))
+				delivery = pending[0]
+			})
+
+			It("is attempted again once it is due", func() {
+				err := repository.FinishDeliveryAttempt(delivery.ID, db.NotificationDeliveryAttempt{
+					Status:         atc.NotificationDeliveryPending,
+					ResponseStatus: 503,
+					Error:          "unexpected response status 503",
+					RetryAt:        time.Now().Add(time.Hour),
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				pending, err := repository.PendingDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pending).To(HaveLen(1))
+				Expect(pending[0].ID).NotTo(Equal(delivery.ID))
+
+				deliveries, _, err := repository.Deliveries(defaultTeam.ID(), delivery.Subscription.Name, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries[0].Attempts).To(Equal(1))
+				Expect(deliveries[0].ResponseStatus).To(Equal(503))
+				Expect(deliveries[0].Error).To(Equal("unexpected response status 503"))
+				Expect(deliveries[0].LastAttemptAt).NotTo(BeZero())
+			})
+		})
+
+		Context("when a delivery succeeds", func() {
+			It("is no longer pending", func() {
+				finishBuild(db.BuildStatusFailed)
+
+				pending, err := repository.PendingDeliveries(10)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, delivery := range pending {
+					err := repository.FinishDeliveryAttempt(delivery.ID, db.NotificationDeliveryAttempt{
+						Status:         atc.NotificationDeliveryDelivered,
+						ResponseStatus: 200,
+					})
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				Expect(repository.PendingDeliveries(10)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Deliveries", func() {
+		It("returns false for unknown subscriptions", func() {
+			_, found, err := repository.Deliveries(defaultTeam.ID(), "bogus", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -140,6 +140,7 @@ var encryptedColumns = []encryptedColumn{
 	{"cert_cache", "cert", "domain"},
 	{"checks", "plan", "id"},
 	{"pipelines", "var_sources", "id"},
+	{"notification_subscriptions", "secret", "id"},
 }
 
 func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
@@ -37,6 +37,7 @@ type Cause struct {
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
@@ -86,12 +87,13 @@ type Pipeline interface {
 	Destroy() error
 	Rename(string) error
 
-	Variables(lager.Logger, creds.Secrets, creds.VarSourcePool) (vars.Variables, error)
+	Variables(lager.Logger, creds.Secrets, creds.VarSourcePool, creds.BuildScope) (vars.Variables, error)
 }
 
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
@@ -110,6 +112,7 @@ type ConfigVersion int
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
 		p.var_sources,
 		p.nonce,
@@ -135,6 +138,8 @@ func (p *pipeline) TeamID() int              { return p.teamID }
 func (p *pipeline) TeamName() string         { return p.teamName }
 func (p *pipeline) Groups() atc.GroupConfigs { return p.groups }
 
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+
 func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
 func (p *pipeline) Public() bool                     { return p.public }
@@ -918,6 +923,16 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 		return err
 	}
 
+	// cleared after setting the reap time, as indexing the build's logs
+	// checks it while holding a lock on the build
+	_, err = tx.Exec(`
+		DELETE FROM build_log_lines
+		WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
+	`, interfaceBuildIDs...)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	return err
 }
@@ -1037,7 +1052,10 @@ func (p *pipeline) getBuildsFrom(tx Tx, col string) (map[string]Build, error) {
 // Variables creates variables for this pipeline. If this pipeline has its own
 // var_sources, a vars.MultiVars containing all pipeline specific var_sources
 // plug the global variables, otherwise just return the global variables.
-func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
+//
+// The scope is passed on to var_sources whose values depend on the build they
+// are resolved for; its team and pipeline are always those of this pipeline.
+func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool, scope creds.BuildScope) (vars.Variables, error) {
 	globalVars := creds.NewVariables(globalSecrets, p.TeamName(), p.Name(), false)
 	namedVarsMap := vars.NamedVariables{}
 	// It's safe to add NamedVariables to allVars via an array here, because
@@ -1069,6 +1087,12 @@ func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, v
 		if err != nil {
 			return nil, errors.Wrapf(err, "create var_source '%s' error", cm.Name)
 		}
+		if scoped, ok := secrets.(creds.ScopedSecrets); ok {
+			scope.TeamName = p.TeamName()
+			scope.PipelineName = p.Name()
+			scope.InstanceVars = p.InstanceVars()
+			secrets = scoped.WithScope(scope)
+		}
 		namedVarsMap[cm.Name] = creds.NewVariables(secrets, p.TeamName(), p.Name(), true)
 	}
 
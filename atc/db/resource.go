@@ -35,6 +35,7 @@ type Resource interface {
 	CheckSetupError() error
 	CheckError() error
 	WebhookToken() string
+	WebhookFilter() *atc.WebhookFilter
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
@@ -42,6 +43,7 @@ type Resource interface {
 	ResourceConfigID() int
 	ResourceConfigScopeID() int
 	Icon() string
+	VersionHistory() *atc.VersionHistory
 
 	CurrentPinnedVersion() atc.Version
 
@@ -107,12 +109,14 @@ type resource struct {
 	checkSetupError       error
 	checkError            error
 	webhookToken          string
+	webhookFilter         *atc.WebhookFilter
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
 	resourceConfigID      int
 	resourceConfigScopeID int
 	icon                  string
+	versionHistory        *atc.VersionHistory
 }
 
 func newEmptyResource(conn Conn, lockFactory lock.LockFactory) *resource {
@@ -144,42 +148,46 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 
 	for _, r := range resources {
 		configs = append(configs, atc.ResourceConfig{
-			Name:         r.Name(),
-			Public:       r.Public(),
-			WebhookToken: r.WebhookToken(),
-			Type:         r.Type(),
-			Source:       r.Source(),
-			CheckEvery:   r.CheckEvery(),
-			Tags:         r.Tags(),
-			Version:      r.ConfigPinnedVersion(),
-			Icon:         r.Icon(),
+			Name:           r.Name(),
+			Public:         r.Public(),
+			WebhookToken:   r.WebhookToken(),
+			WebhookFilter:  r.WebhookFilter(),
+			Type:           r.Type(),
+			Source:         r.Source(),
+			CheckEvery:     r.CheckEvery(),
+			Tags:           r.Tags(),
+			Version:        r.ConfigPinnedVersion(),
+			Icon:           r.Icon(),
+			VersionHistory: r.VersionHistory(),
 		})
 	}
 
 	return configs
 }
 
-func (r *resource) ID() int                          { return r.id }
-func (r *resource) Name() string                     { return r.name }
-func (r *resource) Public() bool                     { return r.public }
-func (r *resource) TeamID() int                      { return r.teamID }
-func (r *resource) TeamName() string                 { return r.teamName }
-func (r *resource) Type() string                     { return r.type_ }
-func (r *resource) Source() atc.Source               { return r.source }
-func (r *resource) CheckEvery() string               { return r.checkEvery }
-func (r *resource) CheckTimeout() string             { return r.checkTimeout }
-func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTime }
-func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
-func (r *resource) Tags() atc.Tags                   { return r.tags }
-func (r *resource) CheckSetupError() error           { return r.checkSetupError }
-func (r *resource) CheckError() error                { return r.checkError }
-func (r *resource) WebhookToken() string             { return r.webhookToken }
-func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
-func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
-func (r *resource) PinComment() string               { return r.pinComment }
-func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
-func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
-func (r *resource) Icon() string                     { return r.icon }
+func (r *resource) ID() int                             { return r.id }
+func (r *resource) Name() string                        { return r.name }
+func (r *resource) Public() bool                        { return r.public }
+func (r *resource) TeamID() int                         { return r.teamID }
+func (r *resource) TeamName() string                    { return r.teamName }
+func (r *resource) Type() string                        { return r.type_ }
+func (r *resource) Source() atc.Source                  { return r.source }
+func (r *resource) CheckEvery() string                  { return r.checkEvery }
+func (r *resource) CheckTimeout() string                { return r.checkTimeout }
+func (r *resource) LastCheckStartTime() time.Time       { return r.lastCheckStartTime }
+func (r *resource) LastCheckEndTime() time.Time         { return r.lastCheckEndTime }
+func (r *resource) Tags() atc.Tags                      { return r.tags }
+func (r *resource) CheckSetupError() error              { return r.checkSetupError }
+func (r *resource) CheckError() error                   { return r.checkError }
+func (r *resource) WebhookToken() string                { return r.webhookToken }
+func (r *resource) WebhookFilter() *atc.WebhookFilter   { return r.webhookFilter }
+func (r *resource) ConfigPinnedVersion() atc.Version    { return r.configPinnedVersion }
+func (r *resource) APIPinnedVersion() atc.Version       { return r.apiPinnedVersion }
+func (r *resource) PinComment() string                  { return r.pinComment }
+func (r *resource) ResourceConfigID() int               { return r.resourceConfigID }
+func (r *resource) ResourceConfigScopeID() int          { return r.resourceConfigScopeID }
+func (r *resource) Icon() string                        { return r.icon }
+func (r *resource) VersionHistory() *atc.VersionHistory { return r.versionHistory }
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -744,8 +752,10 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
+	r.webhookFilter = config.WebhookFilter
 	r.configPinnedVersion = config.Version
 	r.icon = config.Icon
+	r.versionHistory = config.VersionHistory
 
 	if apiPinnedVersion.Valid {
 		err = json.Unmarshal([]byte(apiPinnedVersion.String), &r.apiPinnedVersion)
@@ -0,0 +1,118 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . ResourceConfigVersionLifecycle
+
+type ResourceConfigVersionLifecycle interface {
+	RemoveUnretainedVersions(scopeID int, retention atc.VersionHistory, keep []atc.Version) (int, error)
+}
+
+type resourceConfigVersionLifecycle struct {
+	conn Conn
+}
+
+func NewResourceConfigVersionLifecycle(conn Conn) *resourceConfigVersionLifecycle {
+	return &resourceConfigVersionLifecycle{
+		conn: conn,
+	}
+}
+
+// RemoveUnretainedVersions deletes the versions of a resource config scope
+// which fall outside of the retention policy.
+//
+// The latest version, versions used as inputs or outputs of builds or as the
+// next inputs of jobs, versions pinned through the API and versions matching
+// any of keep (i.e. those pinned in pipeline configs) are never deleted.
+func (lifecycle *resourceConfigVersionLifecycle) RemoveUnretainedVersions(scopeID int, retention atc.VersionHistory, keep []atc.Version) (int, error) {
+	unretained := sq.Or{}
+
+	if retention.Versions > 0 {
+		unretained = append(unretained, sq.Expr(`v.id NOT IN (
+			SELECT id
+			FROM resource_config_versions
+			WHERE resource_config_scope_id = ?
+			ORDER BY check_order DESC
+			LIMIT ?
+		)`, scopeID, retention.Versions))
+	}
+
+	if retention.Days > 0 {
+		unretained = append(unretained, sq.Expr(
+			"v.created_at < now() - ?::interval",
+			fmt.Sprintf("%d days", retention.Days),
+		))
+	}
+
+	if len(unretained) == 0 {
+		return 0, nil
+	}
+
+	conditions := sq.And{
+		sq.Eq{"v.resource_config_scope_id": scopeID},
+		unretained,
+		sq.Expr(`v.check_order < (
+			SELECT max(check_order)
+			FROM resource_config_versions
+			WHERE resource_config_scope_id = v.resource_config_scope_id
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1
+			FROM build_resource_config_version_inputs i
+			JOIN resources r ON r.id = i.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND i.version_md5 = v.version_md5
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1
+			FROM build_resource_config_version_outputs o
+			JOIN resources r ON r.id = o.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND o.version_md5 = v.version_md5
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1
+			FROM next_build_inputs n
+			JOIN resources r ON r.id = n.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND n.version_md5 = v.version_md5
+		)`),
+		sq.Expr(`NOT EXISTS (
+			SELECT 1
+			FROM resource_pins p
+			JOIN resources r ON r.id = p.resource_id
+			WHERE r.resource_config_scope_id = v.resource_config_scope_id
+			AND v.version @> p.version
+		)`),
+	}
+
+	for _, version := range keep {
+		versionJSON, err := json.Marshal(version)
+		if err != nil {
+			return 0, err
+		}
+
+		conditions = append(conditions, sq.Expr("NOT (v.version @> ?::jsonb)", string(versionJSON)))
+	}
+
+	result, err := psql.Delete("resource_config_versions v").
+		Where(conditions).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
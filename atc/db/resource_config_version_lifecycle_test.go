@@ -0,0 +1,165 @@
+package db_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionLifecycle", func() {
+	var (
+		lifecycle     db.ResourceConfigVersionLifecycle
+		resourceScope db.ResourceConfigScope
+
+		retention atc.VersionHistory
+		keep      []atc.Version
+
+		removed int
+	)
+
+	remainingVersions := func() []string {
+		rows, err := dbConn.Query(`
+			SELECT version->>'ref'
+			FROM resource_config_versions
+			WHERE resource_config_scope_id = $1
+			ORDER BY check_order
+		`, resourceScope.ID())
+		Expect(err).ToNot(HaveOccurred())
+
+		defer rows.Close()
+
+		var refs []string
+		for rows.Next() {
+			var ref string
+			Expect(rows.Scan(&ref)).To(Succeed())
+			refs = append(refs, ref)
+		}
+
+		return refs
+	}
+
+	BeforeEach(func() {
+		lifecycle = db.NewResourceConfigVersionLifecycle(dbConn)
+
+		var err error
+		resourceScope, err = defaultResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = resourceScope.SaveVersions([]atc.Version{
+			{"ref": "v1"},
+			{"ref": "v2"},
+			{"ref": "v3"},
+			{"ref": "v4"},
+			{"ref": "v5"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		retention = atc.VersionHistory{}
+		keep = nil
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		removed, err = lifecycle.RemoveUnretainedVersions(resourceScope.ID(), retention, keep)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("when there is no limit", func() {
+		It("keeps every version", func() {
+			Expect(removed).To(Equal(0))
+			Expect(remainingVersions()).To(Equal([]string{"v1", "v2", "v3", "v4", "v5"}))
+		})
+	})
+
+	Context("when the number of versions is limited", func() {
+		BeforeEach(func() {
+			retention.Versions = 2
+		})
+
+		It("keeps only the latest versions", func() {
+			Expect(removed).To(Equal(3))
+			Expect(remainingVersions()).To(Equal([]string{"v4", "v5"}))
+		})
+
+		Context("when a version is used by a build", func() {
+			BeforeEach(func() {
+				build, err := defaultJob.CreateBuild(context.TODO())
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec(`
+					INSERT INTO build_resource_config_version_inputs (build_id, resource_id, version_md5, name, first_occurrence)
+					SELECT $1, $2, version_md5, 'some-input', true
+					FROM resource_config_versions
+					WHERE resource_config_scope_id = $3 AND version->>'ref' = 'v1'
+				`, build.ID(), defaultResource.ID(), resourceScope.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec(`
+					INSERT INTO build_resource_config_version_outputs (build_id, resource_id, version_md5, name)
+					SELECT $1, $2, version_md5, 'some-output'
+					FROM resource_config_versions
+					WHERE resource_config_scope_id = $3 AND version->>'ref' = 'v2'
+				`, build.ID(), defaultResource.ID(), resourceScope.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps the version", func() {
+				Expect(remainingVersions()).To(Equal([]string{"v1", "v2", "v4", "v5"}))
+			})
+		})
+
+		Context("when a version is pinned through the api", func() {
+			BeforeEach(func() {
+				rcvID, found, err := defaultResource.ResourceConfigVersionID(atc.Version{"ref": "v1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				found, err = defaultResource.PinVersion(rcvID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("keeps the version", func() {
+				Expect(remainingVersions()).To(Equal([]string{"v1", "v4", "v5"}))
+			})
+		})
+
+		Context("when a version is to be kept", func() {
+			BeforeEach(func() {
+				keep = []atc.Version{{"ref": "v2"}}
+			})
+
+			It("keeps the version", func() {
+				Expect(remainingVersions()).To(Equal([]string{"v2", "v4", "v5"}))
+			})
+		})
+	})
+
+	Context("when the age of versions is limited", func() {
+		BeforeEach(func() {
+			retention.Days = 7
+
+			_, err := dbConn.Exec(`
+				UPDATE resource_config_versions
+				SET created_at = now() - '8 days'::interval
+				WHERE resource_config_scope_id = $1
+			`, resourceScope.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec(`
+				UPDATE resource_config_versions
+				SET created_at = now()
+				WHERE resource_config_scope_id = $1 AND version->>'ref' = 'v3'
+			`, resourceScope.ID())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes old versions but keeps the latest", func() {
+			Expect(removed).To(Equal(3))
+			Expect(remainingVersions()).To(Equal([]string{"v3", "v5"}))
+		})
+	})
+})
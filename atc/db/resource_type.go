@@ -43,6 +43,7 @@ type ResourceType interface {
 	CheckSetupError() error
 	CheckError() error
 	UniqueVersionHistory() bool
+	MaxChecksPerSecond() float64
 	CurrentPinnedVersion() atc.Version
 	ResourceConfigScopeID() int
 
@@ -95,6 +96,7 @@ func (resourceTypes ResourceTypes) Deserialize() atc.VersionedResourceTypes {
 				Tags:                 t.Tags(),
 				Params:               t.Params(),
 				UniqueVersionHistory: t.UniqueVersionHistory(),
+				MaxChecksPerSecond:   t.MaxChecksPerSecond(),
 			},
 			Version: t.Version(),
 		})
@@ -116,6 +118,7 @@ func (resourceTypes ResourceTypes) Configs() atc.ResourceTypes {
 			Tags:                 r.Tags(),
 			Params:               r.Params(),
 			UniqueVersionHistory: r.UniqueVersionHistory(),
+			MaxChecksPerSecond:   r.MaxChecksPerSecond(),
 		})
 	}
 
@@ -173,6 +176,7 @@ type resourceType struct {
 	checkSetupError       error
 	checkError            error
 	uniqueVersionHistory  bool
+	maxChecksPerSecond    float64
 }
 
 func (t *resourceType) ID() int                       { return t.id }
@@ -191,6 +195,7 @@ func (t *resourceType) Tags() atc.Tags                { return t.tags }
 func (t *resourceType) CheckSetupError() error        { return t.checkSetupError }
 func (t *resourceType) CheckError() error             { return t.checkError }
 func (t *resourceType) UniqueVersionHistory() bool    { return t.uniqueVersionHistory }
+func (t *resourceType) MaxChecksPerSecond() float64   { return t.maxChecksPerSecond }
 func (t *resourceType) ResourceConfigScopeID() int    { return t.resourceConfigScopeID }
 
 func (t *resourceType) Version() atc.Version              { return t.version }
@@ -323,6 +328,7 @@ func scanResourceType(t *resourceType, row scannable) error {
 	t.tags = config.Tags
 	t.checkEvery = config.CheckEvery
 	t.uniqueVersionHistory = config.UniqueVersionHistory
+	t.maxChecksPerSecond = config.MaxChecksPerSecond
 
 	if checkErr.Valid {
 		t.checkSetupError = errors.New(checkErr.String)
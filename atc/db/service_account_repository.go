@@ -0,0 +1,267 @@
+package db
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . ServiceAccountRepository
+
+// ServiceAccountRepository persists the service accounts of teams and the API
+// tokens which authenticate them. Tokens are looked up by their hash, as the
+// tokens themselves are never stored.
+type ServiceAccountRepository interface {
+	CreateToken(teamID int, serviceAccount string, request atc.APITokenRequest, tokenHash string) (atc.APIToken, error)
+	Tokens(teamID int) ([]atc.APIToken, error)
+	RevokeToken(teamID int, tokenID int) (bool, error)
+	DestroyServiceAccount(teamID int, serviceAccount string) (bool, error)
+
+	UseToken(tokenHash string) (atc.APIToken, bool, error)
+}
+
+// lastUsedInterval is how often the last use of a token is recorded, so that
+// a busy token does not update its row on every request.
+const lastUsedInterval = time.Minute
+
+var apiTokensQuery = psql.Select(
+	"t.id",
+	"tm.name",
+	"s.name",
+	"s.role",
+	"t.actions",
+	"t.expires_at",
+	"t.last_used_at",
+	"t.created_at",
+).
+	From("api_tokens t").
+	Join("service_accounts s ON s.id = t.service_account_id").
+	Join("teams tm ON tm.id = s.team_id")
+
+type serviceAccountRepository struct {
+	conn Conn
+}
+
+func NewServiceAccountRepository(conn Conn) ServiceAccountRepository {
+	return &serviceAccountRepository{
+		conn: conn,
+	}
+}
+
+// CreateToken creates the service account if it does not exist yet. Its role
+// is only updated if the request specifies one.
+func (r *serviceAccountRepository) CreateToken(teamID int, serviceAccount string, request atc.APITokenRequest, tokenHash string) (atc.APIToken, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	defer Rollback(tx)
+
+	role := request.Role
+	roleUpdate := "role = EXCLUDED.role"
+	if role == "" {
+		role = atc.DefaultServiceAccountRole
+		roleUpdate = "role = service_accounts.role"
+	}
+
+	var serviceAccountID int
+	err = tx.QueryRow(`
+		INSERT INTO service_accounts (team_id, name, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id, name) DO UPDATE SET `+roleUpdate+`
+		RETURNING id
+	`, teamID, serviceAccount, role).Scan(&serviceAccountID)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	actions := request.Actions
+	if actions == nil {
+		actions = []string{}
+	}
+
+	actionsJSON, err := json.Marshal(actions)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var expiresAt interface{}
+	if request.ExpiresAt != 0 {
+		expiresAt = time.Unix(request.ExpiresAt, 0)
+	}
+
+	var tokenID int
+	err = psql.Insert("api_tokens").
+		SetMap(map[string]interface{}{
+			"service_account_id": serviceAccountID,
+			"token_hash":         tokenHash,
+			"actions":            actionsJSON,
+			"expires_at":         expiresAt,
+		}).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&tokenID)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	tokens, err := queryAPITokens(apiTokensQuery.
+		Where(sq.Eq{"t.id": tokenID}).
+		RunWith(tx))
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	return tokens[0], nil
+}
+
+// Tokens returns every token of the team's service accounts, including
+// expired ones.
+func (r *serviceAccountRepository) Tokens(teamID int) ([]atc.APIToken, error) {
+	return queryAPITokens(apiTokensQuery.
+		Where(sq.Eq{"s.team_id": teamID}).
+		OrderBy("s.name ASC", "t.id ASC").
+		RunWith(r.conn))
+}
+
+func (r *serviceAccountRepository) RevokeToken(teamID int, tokenID int) (bool, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(sq.Eq{"id": tokenID}).
+		Where(sq.Expr("service_account_id IN (SELECT id FROM service_accounts WHERE team_id = ?)", teamID)).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// DestroyServiceAccount removes the service account along with all of its
+// tokens.
+func (r *serviceAccountRepository) DestroyServiceAccount(teamID int, serviceAccount string) (bool, error) {
+	result, err := psql.Delete("service_accounts").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    serviceAccount,
+		}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// UseToken finds the unexpired token with the given hash and records that it
+// has been used.
+func (r *serviceAccountRepository) UseToken(tokenHash string) (atc.APIToken, bool, error) {
+	tokens, err := queryAPITokens(apiTokensQuery.
+		Where(sq.Eq{"t.token_hash": tokenHash}).
+		Where(sq.Or{
+			sq.Eq{"t.expires_at": nil},
+			sq.Expr("t.expires_at > now()"),
+		}).
+		RunWith(r.conn))
+	if err != nil {
+		return atc.APIToken{}, false, err
+	}
+
+	if len(tokens) == 0 {
+		return atc.APIToken{}, false, nil
+	}
+
+	token := tokens[0]
+
+	_, err = psql.Update("api_tokens").
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": token.ID}).
+		Where(sq.Or{
+			sq.Eq{"last_used_at": nil},
+			sq.Expr("last_used_at < now() - ? * interval '1 second'", int(lastUsedInterval.Seconds())),
+		}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return atc.APIToken{}, false, err
+	}
+
+	return token, true, nil
+}
+
+func queryAPITokens(query sq.SelectBuilder) ([]atc.APIToken, error) {
+	rows, err := query.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	tokens := []atc.APIToken{}
+	for rows.Next() {
+		var (
+			token                 atc.APIToken
+			actions               []byte
+			expiresAt, lastUsedAt pq.NullTime
+			createdAt             time.Time
+		)
+
+		err := rows.Scan(
+			&token.ID,
+			&token.TeamName,
+			&token.ServiceAccount,
+			&token.Role,
+			&actions,
+			&expiresAt,
+			&lastUsedAt,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(actions, &token.Actions)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(token.Actions) == 0 {
+			token.Actions = nil
+		}
+
+		if expiresAt.Valid {
+			token.ExpiresAt = expiresAt.Time.Unix()
+		}
+
+		if lastUsedAt.Valid {
+			token.LastUsedAt = lastUsedAt.Time.Unix()
+		}
+
+		token.CreatedAt = createdAt.Unix()
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,161 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceAccountRepository", func() {
+	var (
+		repository db.ServiceAccountRepository
+		otherTeam  db.Team
+	)
+
+	BeforeEach(func() {
+		repository = db.NewServiceAccountRepository(dbConn)
+
+		var err error
+		otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	create := func(team db.Team, serviceAccount string, request atc.APITokenRequest, hash string) atc.APIToken {
+		token, err := repository.CreateToken(team.ID(), serviceAccount, request, hash)
+		Expect(err).NotTo(HaveOccurred())
+		return token
+	}
+
+	Describe("CreateToken", func() {
+		It("creates the service account with the default role", func() {
+			token := create(defaultTeam, "some-bot", atc.APITokenRequest{}, "some-hash")
+			Expect(token.ID).NotTo(BeZero())
+			Expect(token.TeamName).To(Equal(defaultTeam.Name()))
+			Expect(token.ServiceAccount).To(Equal("some-bot"))
+			Expect(token.Role).To(Equal("viewer"))
+			Expect(token.Actions).To(BeNil())
+			Expect(token.ExpiresAt).To(BeZero())
+			Expect(token.LastUsedAt).To(BeZero())
+			Expect(token.CreatedAt).NotTo(BeZero())
+		})
+
+		It("stores the actions and expiry of the token", func() {
+			expiresAt := time.Now().Add(time.Hour).Unix()
+
+			token := create(defaultTeam, "some-bot", atc.APITokenRequest{
+				Role:      "member",
+				Actions:   []string{atc.CreateJobBuild},
+				ExpiresAt: expiresAt,
+			}, "some-hash")
+			Expect(token.Role).To(Equal("member"))
+			Expect(token.Actions).To(Equal([]string{atc.CreateJobBuild}))
+			Expect(token.ExpiresAt).To(Equal(expiresAt))
+		})
+
+		It("only updates the role of an existing service account if one is given", func() {
+			create(defaultTeam, "some-bot", atc.APITokenRequest{Role: "member"}, "some-hash")
+
+			token := create(defaultTeam, "some-bot", atc.APITokenRequest{}, "some-other-hash")
+			Expect(token.Role).To(Equal("member"))
+
+			token = create(defaultTeam, "some-bot", atc.APITokenRequest{Role: "owner"}, "another-hash")
+			Expect(token.Role).To(Equal("owner"))
+
+			tokens, err := repository.Tokens(defaultTeam.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(HaveLen(3))
+			for _, token := range tokens {
+				Expect(token.Role).To(Equal("owner"))
+			}
+		})
+	})
+
+	Describe("Tokens", func() {
+		It("only returns the tokens of the team", func() {
+			token := create(defaultTeam, "some-bot", atc.APITokenRequest{}, "some-hash")
+			create(otherTeam, "some-bot", atc.APITokenRequest{}, "some-other-hash")
+
+			tokens, err := repository.Tokens(defaultTeam.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal([]atc.APIToken{token}))
+		})
+	})
+
+	Describe("RevokeToken", func() {
+		It("removes the token", func() {
+			token := create(defaultTeam, "some-bot", atc.APITokenRequest{}, "some-hash")
+
+			revoked, err := repository.RevokeToken(defaultTeam.ID(), token.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			_, found, err := repository.UseToken("some-hash")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not remove the tokens of other teams", func() {
+			token := create(otherTeam, "some-bot", atc.APITokenRequest{}, "some-hash")
+
+			revoked, err := repository.RevokeToken(defaultTeam.ID(), token.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+		})
+	})
+
+	Describe("DestroyServiceAccount", func() {
+		It("removes the service account along with its tokens", func() {
+			create(defaultTeam, "some-bot", atc.APITokenRequest{}, "some-hash")
+
+			destroyed, err := repository.DestroyServiceAccount(defaultTeam.ID(), "some-bot")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeTrue())
+
+			tokens, err := repository.Tokens(defaultTeam.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(BeEmpty())
+		})
+
+		It("returns false when the service account does not exist", func() {
+			destroyed, err := repository.DestroyServiceAccount(defaultTeam.ID(), "bogus-bot")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeFalse())
+		})
+	})
+
+	Describe("UseToken", func() {
+		It("finds the token by its hash and records its use", func() {
+			created := create(defaultTeam, "some-bot", atc.APITokenRequest{Role: "member"}, "some-hash")
+
+			token, found, err := repository.UseToken("some-hash")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(token.ID).To(Equal(created.ID))
+			Expect(token.Role).To(Equal("member"))
+
+			tokens, err := repository.Tokens(defaultTeam.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens[0].LastUsedAt).NotTo(BeZero())
+		})
+
+		It("does not find expired tokens", func() {
+			create(defaultTeam, "some-bot", atc.APITokenRequest{
+				ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+			}, "some-hash")
+
+			_, found, err := repository.UseToken("some-hash")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not find unknown tokens", func() {
+			_, found, err := repository.UseToken("bogus-hash")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/tracing"
+)
+
+// SpanContext is the serialized form of the span that a build was created
+// under, e.g. `{"traceparent": "..."}`, so that the build's own span can be
+// linked to it when it runs.
+type SpanContext map[string]string
+
+func NewSpanContext(ctx context.Context) SpanContext {
+	sc := SpanContext{}
+	tracing.Inject(ctx, sc)
+	return sc
+}
+
+func (sc SpanContext) Get(key string) string {
+	return sc[key]
+}
+
+func (sc SpanContext) Set(key string, value string) {
+	sc[key] = value
+}
@@ -0,0 +1,215 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configvalidate"
+)
+
+//go:generate counterfeiter . StepTemplateRepository
+
+// StepTemplateRepository persists the step templates of teams. Every save of
+// a template creates a new version of it, so that pipelines can keep using
+// older versions.
+type StepTemplateRepository interface {
+	Save(teamID int, template atc.StepTemplate) (atc.StepTemplate, error)
+	Find(teamID int, name string, version int) (atc.StepTemplate, bool, error)
+	Latest(teamID int) ([]atc.StepTemplate, error)
+	Versions(teamID int, names []string) (atc.StepTemplates, error)
+	Destroy(teamID int, name string) (bool, error)
+}
+
+// ExpandStepTemplates replaces the steps of the config which refer to the
+// team's step templates with the steps of the templates, as is done whenever
+// a pipeline is set. Invalid references are returned as error messages for
+// the user.
+func ExpandStepTemplates(repository StepTemplateRepository, teamID int, config atc.Config) (atc.Config, []string, error) {
+	templateNames := config.StepTemplateNames()
+	if len(templateNames) == 0 {
+		return config, nil, nil
+	}
+
+	templates, err := repository.Versions(teamID, templateNames)
+	if err != nil {
+		return atc.Config{}, nil, err
+	}
+
+	errorMessages := configvalidate.ValidateStepTemplates(config, templates)
+	if len(errorMessages) > 0 {
+		return atc.Config{}, errorMessages, nil
+	}
+
+	expanded, err := config.ExpandStepTemplates(templates)
+	if err != nil {
+		return atc.Config{}, []string{fmt.Sprintf("invalid step templates:\n\t%s\n", err)}, nil
+	}
+
+	return expanded, nil, nil
+}
+
+var stepTemplatesQuery = psql.Select(
+	"t.name",
+	"t.version",
+	"t.params",
+	"t.step",
+	"t.created_at",
+).From("team_step_templates t")
+
+type stepTemplateRepository struct {
+	conn Conn
+}
+
+func NewStepTemplateRepository(conn Conn) StepTemplateRepository {
+	return &stepTemplateRepository{
+		conn: conn,
+	}
+}
+
+func (r *stepTemplateRepository) Save(teamID int, template atc.StepTemplate) (atc.StepTemplate, error) {
+	if template.Params == nil {
+		template.Params = []string{}
+	}
+
+	params, err := json.Marshal(template.Params)
+	if err != nil {
+		return atc.StepTemplate{}, err
+	}
+
+	var createdAt time.Time
+	err = psql.Insert("team_step_templates").
+		SetMap(map[string]interface{}{
+			"team_id": teamID,
+			"name":    template.Name,
+			"version": sq.Expr(
+				"(SELECT COALESCE(MAX(version), 0) + 1 FROM team_step_templates WHERE team_id = ? AND name = ?)",
+				teamID,
+				template.Name,
+			),
+			"params": params,
+			"step":   template.Step,
+		}).
+		Suffix("RETURNING version, created_at").
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&template.Version, &createdAt)
+	if err != nil {
+		return atc.StepTemplate{}, err
+	}
+
+	template.CreatedAt = createdAt.Unix()
+
+	return template, nil
+}
+
+// Find returns the given version of a template, or its latest version if the
+// version is 0.
+func (r *stepTemplateRepository) Find(teamID int, name string, version int) (atc.StepTemplate, bool, error) {
+	query := stepTemplatesQuery.
+		Where(sq.Eq{
+			"t.team_id": teamID,
+			"t.name":    name,
+		}).
+		OrderBy("t.version DESC").
+		Limit(1)
+
+	if version != 0 {
+		query = query.Where(sq.Eq{"t.version": version})
+	}
+
+	templates, err := queryStepTemplates(query.RunWith(r.conn))
+	if err != nil {
+		return atc.StepTemplate{}, false, err
+	}
+
+	if len(templates) == 0 {
+		return atc.StepTemplate{}, false, nil
+	}
+
+	return templates[0], true, nil
+}
+
+// Latest returns the latest version of each of the team's templates.
+func (r *stepTemplateRepository) Latest(teamID int) ([]atc.StepTemplate, error) {
+	return queryStepTemplates(stepTemplatesQuery.
+		Options("DISTINCT ON (t.name)").
+		Where(sq.Eq{"t.team_id": teamID}).
+		OrderBy("t.name ASC", "t.version DESC").
+		RunWith(r.conn))
+}
+
+// Versions returns every version of the named templates.
+func (r *stepTemplateRepository) Versions(teamID int, names []string) (atc.StepTemplates, error) {
+	return queryStepTemplates(stepTemplatesQuery.
+		Where(sq.Eq{
+			"t.team_id": teamID,
+			"t.name":    names,
+		}).
+		OrderBy("t.name ASC", "t.version ASC").
+		RunWith(r.conn))
+}
+
+// Destroy removes every version of a template. Pipelines which were set with
+// it are unaffected, as templates are expanded when a pipeline is set.
+func (r *stepTemplateRepository) Destroy(teamID int, name string) (bool, error) {
+	result, err := psql.Delete("team_step_templates").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func queryStepTemplates(query sq.SelectBuilder) (atc.StepTemplates, error) {
+	rows, err := query.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	templates := atc.StepTemplates{}
+	for rows.Next() {
+		var (
+			template  atc.StepTemplate
+			params    []byte
+			createdAt time.Time
+		)
+
+		err := rows.Scan(
+			&template.Name,
+			&template.Version,
+			&params,
+			&template.Step,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(params, &template.Params)
+		if err != nil {
+			return nil, err
+		}
+
+		template.CreatedAt = createdAt.Unix()
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,140 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StepTemplateRepository", func() {
+	var (
+		repository db.StepTemplateRepository
+		otherTeam  db.Team
+	)
+
+	BeforeEach(func() {
+		repository = db.NewStepTemplateRepository(dbConn)
+
+		var err error
+		otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	save := func(team db.Team, name string, step string) atc.StepTemplate {
+		template, err := repository.Save(team.ID(), atc.StepTemplate{
+			Name:   name,
+			Params: []string{"some-param"},
+			Step:   step,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return template
+	}
+
+	Describe("Save", func() {
+		It("creates a new version each time a template is saved", func() {
+			first := save(defaultTeam, "some-template", "task: first")
+			Expect(first.Version).To(Equal(1))
+			Expect(first.CreatedAt).NotTo(BeZero())
+
+			second := save(defaultTeam, "some-template", "task: second")
+			Expect(second.Version).To(Equal(2))
+		})
+
+		It("versions templates per team and name", func() {
+			save(defaultTeam, "some-template", "task: first")
+
+			Expect(save(defaultTeam, "other-template", "task: first").Version).To(Equal(1))
+			Expect(save(otherTeam, "some-template", "task: first").Version).To(Equal(1))
+		})
+	})
+
+	Describe("Find", func() {
+		BeforeEach(func() {
+			save(defaultTeam, "some-template", "task: first")
+			save(defaultTeam, "some-template", "task: second")
+		})
+
+		It("finds the latest version when no version is given", func() {
+			template, found, err := repository.Find(defaultTeam.ID(), "some-template", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(template.Version).To(Equal(2))
+			Expect(template.Params).To(Equal([]string{"some-param"}))
+			Expect(template.Step).To(Equal("task: second"))
+		})
+
+		It("finds the given version", func() {
+			template, found, err := repository.Find(defaultTeam.ID(), "some-template", 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(template.Step).To(Equal("task: first"))
+		})
+
+		It("does not find templates of other teams", func() {
+			_, found, err := repository.Find(otherTeam.ID(), "some-template", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("Latest", func() {
+		BeforeEach(func() {
+			save(defaultTeam, "some-template", "task: first")
+			save(defaultTeam, "some-template", "task: second")
+			save(defaultTeam, "other-template", "task: other")
+			save(otherTeam, "another-template", "task: another")
+		})
+
+		It("returns the latest version of each of the team's templates", func() {
+			templates, err := repository.Latest(defaultTeam.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templates).To(HaveLen(2))
+
+			Expect(templates[0].Name).To(Equal("other-template"))
+			Expect(templates[0].Version).To(Equal(1))
+			Expect(templates[1].Name).To(Equal("some-template"))
+			Expect(templates[1].Version).To(Equal(2))
+		})
+	})
+
+	Describe("Versions", func() {
+		BeforeEach(func() {
+			save(defaultTeam, "some-template", "task: first")
+			save(defaultTeam, "some-template", "task: second")
+			save(defaultTeam, "other-template", "task: other")
+		})
+
+		It("returns every version of the named templates", func() {
+			templates, err := repository.Versions(defaultTeam.ID(), []string{"some-template"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templates).To(HaveLen(2))
+			Expect(templates[0].Version).To(Equal(1))
+			Expect(templates[1].Version).To(Equal(2))
+		})
+	})
+
+	Describe("Destroy", func() {
+		BeforeEach(func() {
+			save(defaultTeam, "some-template", "task: first")
+			save(defaultTeam, "some-template", "task: second")
+		})
+
+		It("removes every version of the template", func() {
+			destroyed, err := repository.Destroy(defaultTeam.ID(), "some-template")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeTrue())
+
+			_, found, err := repository.Find(defaultTeam.ID(), "some-template", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false when there is no such template", func() {
+			destroyed, err := repository.Destroy(defaultTeam.ID(), "bogus")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,223 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . TaskMemoFactory
+
+// TaskMemoFactory saves the outputs of memoized task steps, keyed by their
+// config and inputs, so that a later run with the same key can reuse them
+// instead of running the task again.
+//
+// Only the latest memo of each task step is kept. Its output volumes are
+// retained like the volumes of task caches until the memo is replaced.
+type TaskMemoFactory interface {
+	// VolumeKey identifies the contents of a volume by the resource cache or
+	// the memoized task output it holds. It returns false if the contents are
+	// not known, e.g. for the output of a task which is not memoized.
+	VolumeKey(handle string) (string, bool, error)
+
+	Find(jobID int, stepName string, key string) (TaskMemo, bool, error)
+	Save(jobID int, stepName string, key string, buildID int, outputs map[string]string) error
+}
+
+// TaskMemo is the successful run of a task step which a later run may reuse.
+type TaskMemo struct {
+	BuildID   int
+	BuildName string
+
+	// Outputs maps the names of the task outputs to their volume handles.
+	Outputs map[string]string
+}
+
+type taskMemoFactory struct {
+	conn Conn
+}
+
+func NewTaskMemoFactory(conn Conn) TaskMemoFactory {
+	return &taskMemoFactory{
+		conn: conn,
+	}
+}
+
+func (f *taskMemoFactory) VolumeKey(handle string) (string, bool, error) {
+	var (
+		resourceCacheID sql.NullInt64
+		memoKey         sql.NullString
+		outputName      sql.NullString
+	)
+
+	err := psql.Select("wrc.resource_cache_id", "tm.key").
+		Column("(SELECT o.key FROM jsonb_each_text(tm.outputs) o WHERE o.value = v.handle)").
+		From("volumes v").
+		LeftJoin("worker_resource_caches wrc ON wrc.id = v.worker_resource_cache_id").
+		LeftJoin("task_memos tm ON tm.id = v.task_memo_id").
+		Where(sq.Eq{
+			"v.handle": handle,
+			"v.state":  VolumeStateCreated,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&resourceCacheID, &memoKey, &outputName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	if resourceCacheID.Valid {
+		return fmt.Sprintf("resource-cache:%d", resourceCacheID.Int64), true, nil
+	}
+
+	if memoKey.Valid && outputName.Valid {
+		return fmt.Sprintf("task-memo:%s:%s", memoKey.String, outputName.String), true, nil
+	}
+
+	return "", false, nil
+}
+
+func (f *taskMemoFactory) Find(jobID int, stepName string, key string) (TaskMemo, bool, error) {
+	var (
+		id        int
+		buildID   sql.NullInt64
+		buildName sql.NullString
+		outputs   []byte
+	)
+
+	err := psql.Select("tm.id", "tm.build_id", "b.name", "tm.outputs").
+		From("task_memos tm").
+		LeftJoin("builds b ON b.id = tm.build_id").
+		Where(sq.Eq{
+			"tm.job_id":    jobID,
+			"tm.step_name": stepName,
+			"tm.key":       key,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&id, &buildID, &buildName, &outputs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TaskMemo{}, false, nil
+		}
+
+		return TaskMemo{}, false, err
+	}
+
+	memo := TaskMemo{
+		BuildID:   int(buildID.Int64),
+		BuildName: buildName.String,
+	}
+
+	err = json.Unmarshal(outputs, &memo.Outputs)
+	if err != nil {
+		return TaskMemo{}, false, err
+	}
+
+	handles := []string{}
+	for _, handle := range memo.Outputs {
+		handles = append(handles, handle)
+	}
+
+	// the memo is only usable while all of its volumes are around; they may
+	// have gone away with their worker
+	var volumes int
+	err = psql.Select("COUNT(*)").
+		From("volumes").
+		Where(sq.Eq{
+			"task_memo_id": id,
+			"handle":       handles,
+			"state":        VolumeStateCreated,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&volumes)
+	if err != nil {
+		return TaskMemo{}, false, err
+	}
+
+	if volumes != len(handles) {
+		return TaskMemo{}, false, nil
+	}
+
+	return memo, true, nil
+}
+
+func (f *taskMemoFactory) Save(jobID int, stepName string, key string, buildID int, outputs map[string]string) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	outputsJSON, err := json.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+
+	var id int
+	err = psql.Insert("task_memos").
+		Columns("job_id", "step_name", "key", "build_id", "outputs").
+		Values(jobID, stepName, key, buildID, outputsJSON).
+		Suffix(`
+			ON CONFLICT (job_id, step_name) DO UPDATE SET
+				key = EXCLUDED.key,
+				build_id = EXCLUDED.build_id,
+				outputs = EXCLUDED.outputs
+			RETURNING id
+		`).
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	// release the volumes of the previous memo for gc
+	_, err = psql.Update("volumes").
+		Set("task_memo_id", nil).
+		Where(sq.Eq{"task_memo_id": id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	handles := []string{}
+	for _, handle := range outputs {
+		handles = append(handles, handle)
+	}
+
+	if len(handles) > 0 {
+		_, err = psql.Update("volumes").
+			Set("task_memo_id", id).
+			Where(sq.Eq{"handle": handles}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func removeInactiveTaskMemos(tx Tx, pipelineID int) error {
+	_, err := psql.Delete("task_memos tm USING jobs j").
+		Where(sq.Expr("j.id = tm.job_id")).
+		Where(sq.Eq{
+			"j.pipeline_id": pipelineID,
+			"j.active":      false,
+		}).
+		RunWith(tx).
+		Exec()
+
+	return err
+}
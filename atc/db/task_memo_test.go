@@ -0,0 +1,136 @@
+package db_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskMemoFactory", func() {
+	var (
+		factory db.TaskMemoFactory
+		build   db.Build
+	)
+
+	createVolume := func() db.CreatedVolume {
+		creating, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeArtifact)
+		Expect(err).ToNot(HaveOccurred())
+
+		created, err := creating.Created()
+		Expect(err).ToNot(HaveOccurred())
+
+		return created
+	}
+
+	orphanedHandles := func() []string {
+		orphaned, err := volumeRepository.GetOrphanedVolumes()
+		Expect(err).ToNot(HaveOccurred())
+
+		handles := []string{}
+		for _, volume := range orphaned {
+			handles = append(handles, volume.Handle())
+		}
+
+		return handles
+	}
+
+	BeforeEach(func() {
+		factory = db.NewTaskMemoFactory(dbConn)
+
+		var err error
+		build, err = defaultJob.CreateBuild(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("when a memo is saved", func() {
+		var output db.CreatedVolume
+
+		BeforeEach(func() {
+			output = createVolume()
+
+			err := factory.Save(defaultJob.ID(), "some-task", "some-key", build.ID(), map[string]string{
+				"some-output": output.Handle(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("finds it by its key", func() {
+			memo, found, err := factory.Find(defaultJob.ID(), "some-task", "some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(memo).To(Equal(db.TaskMemo{
+				BuildID:   build.ID(),
+				BuildName: build.Name(),
+				Outputs:   map[string]string{"some-output": output.Handle()},
+			}))
+		})
+
+		It("does not find it by another key", func() {
+			_, found, err := factory.Find(defaultJob.ID(), "some-task", "some-other-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("retains its output volumes", func() {
+			Expect(orphanedHandles()).ToNot(ContainElement(output.Handle()))
+		})
+
+		It("identifies the contents of its output volumes", func() {
+			key, found, err := factory.VolumeKey(output.Handle())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(key).To(Equal("task-memo:some-key:some-output"))
+		})
+
+		Context("when the memo is replaced", func() {
+			var newOutput db.CreatedVolume
+
+			BeforeEach(func() {
+				newOutput = createVolume()
+
+				err := factory.Save(defaultJob.ID(), "some-task", "some-other-key", build.ID(), map[string]string{
+					"some-output": newOutput.Handle(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("releases the previous output volumes", func() {
+				Expect(orphanedHandles()).To(ContainElement(output.Handle()))
+				Expect(orphanedHandles()).ToNot(ContainElement(newOutput.Handle()))
+			})
+
+			It("no longer finds the previous memo", func() {
+				_, found, err := factory.Find(defaultJob.ID(), "some-task", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when an output volume is gone", func() {
+			BeforeEach(func() {
+				destroying, err := output.Destroying()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = destroying.Destroy()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not find the memo", func() {
+				_, found, err := factory.Find(defaultJob.ID(), "some-task", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("VolumeKey", func() {
+		It("does not identify the contents of other volumes", func() {
+			_, found, err := factory.VolumeKey(createVolume().Handle())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
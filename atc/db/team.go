@@ -33,13 +33,13 @@ type Team interface {
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
@@ -51,6 +51,8 @@ type Team interface {
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 
+	SearchBuildLogs(BuildLogSearch) ([]BuildLogSearchResult, error)
+
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
 	FindVolumeForWorkerArtifact(int) (CreatedVolume, bool, error)
@@ -60,7 +62,7 @@ type Team interface {
 	IsContainerWithinTeam(string, bool) (bool, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
@@ -340,7 +342,7 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
@@ -352,13 +354,24 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
+	instanceVars, err := instanceVarsPayload(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	var existingConfig bool
-	err = tx.QueryRow(`SELECT EXISTS (
-		SELECT 1
-		FROM pipelines
-		WHERE name = $1
-		AND team_id = $2
-	)`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("pipelines").
+		Where(sq.Eq{
+			"name":          pipelineRef.Name,
+			"team_id":       t.id,
+			"instance_vars": instanceVars,
+		}).
+		Suffix(")").
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -382,14 +395,15 @@ func (t *team) SavePipeline(
 	if !existingConfig {
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":        pipelineName,
-				"groups":      groupsPayload,
-				"var_sources": encryptedVarSourcesPayload,
-				"nonce":       nonce,
-				"version":     sq.Expr("nextval('config_version_seq')"),
-				"ordering":    sq.Expr("currval('pipelines_id_seq')"),
-				"paused":      initiallyPaused,
-				"team_id":     t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVars,
+				"groups":        groupsPayload,
+				"var_sources":   encryptedVarSourcesPayload,
+				"nonce":         nonce,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				"ordering":      sq.Expr("currval('pipelines_id_seq')"),
+				"paused":        initiallyPaused,
+				"team_id":       t.id,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -404,9 +418,10 @@ func (t *team) SavePipeline(
 			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Where(sq.Eq{
-				"name":    pipelineName,
-				"version": from,
-				"team_id": t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVars,
+				"version":       from,
+				"team_id":       t.id,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -463,6 +478,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	err = removeInactiveTaskMemos(tx, pipelineID)
+	if err != nil {
+		return nil, false, err
+	}
+
 	err = t.insertJobPipes(tx, config.Jobs, resourceNameToID, jobNameToID, pipelineID)
 	if err != nil {
 		return nil, false, err
@@ -493,15 +513,21 @@ func (t *team) SavePipeline(
 	return pipeline, !existingConfig, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVars, err := instanceVarsPayload(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
-				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.team_id":       t.id,
+				"p.name":          pipelineRef.Name,
+				"p.instance_vars": instanceVars,
 			}).
 			RunWith(t.conn).
 			QueryRow(),
@@ -735,8 +761,8 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -757,7 +783,7 @@ func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, res
 		return nil, nil, err
 	}
 
-	variables, err := pipeline.Variables(logger, secretManager, varSourcePool)
+	variables, err := pipeline.Variables(logger, secretManager, varSourcePool, creds.BuildScope{})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -947,11 +973,23 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return 0, err
 	}
 
+	var schedule interface{}
+	if job.Schedule != nil {
+		schedulePayload, err := json.Marshal(job.Schedule)
+		if err != nil {
+			return 0, err
+		}
+
+		schedule = string(schedulePayload)
+	}
+
+	// changing the schedule restarts it from now, so that the new schedule
+	// doesn't immediately fire for a time that has already passed
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags", "schedule").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups), schedule).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, schedule = EXCLUDED.schedule, schedule_last_fired = CASE WHEN jobs.schedule IS DISTINCT FROM EXCLUDED.schedule THEN now() ELSE jobs.schedule_last_fired END").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1090,16 +1128,24 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 
 func scanPipeline(p *pipeline, scan scannable) error {
 	var (
-		groups     sql.NullString
-		varSources sql.NullString
-		nonce      sql.NullString
-		nonceStr   *string
+		instanceVars sql.NullString
+		groups       sql.NullString
+		varSources   sql.NullString
+		nonce        sql.NullString
+		nonceStr     *string
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
 	if err != nil {
 		return err
 	}
 
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -1131,6 +1177,22 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	return nil
 }
 
+// instanceVarsPayload returns the value to compare the instance_vars column
+// against. Pipelines without instance vars have a NULL column, which sq.Eq
+// turns into an IS NULL check.
+func instanceVarsPayload(instanceVars atc.InstanceVars) (interface{}, error) {
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(payload), nil
+}
+
 func scanPipelines(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]Pipeline, error) {
 	defer Close(rows)
 
@@ -0,0 +1,213 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+const testResultsBatchSize = 500
+
+// SaveTestResults stores the results of the tests run by a step of the build.
+// The results are keyed by the versions of the build's inputs so that tests
+// which both passed and failed with the same inputs can be found.
+func (b *build) SaveTestResults(results []atc.TestResult) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	var inputsMD5 sql.NullString
+	err = psql.Select("md5(string_agg(i.resource_id::text || ':' || i.version_md5, ',' ORDER BY i.resource_id, i.version_md5))").
+		From("build_resource_config_version_inputs i").
+		Where(sq.Eq{"i.build_id": b.id}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&inputsMD5)
+	if err != nil {
+		return err
+	}
+
+	var jobID sql.NullInt64
+	if b.jobID != 0 {
+		jobID = sql.NullInt64{Int64: int64(b.jobID), Valid: true}
+	}
+
+	for start := 0; start < len(results); start += testResultsBatchSize {
+		end := start + testResultsBatchSize
+		if end > len(results) {
+			end = len(results)
+		}
+
+		insert := psql.Insert("test_results").
+			Columns("build_id", "job_id", "inputs_md5", "task", "suite", "name", "status", "duration", "message")
+
+		for _, result := range results[start:end] {
+			insert = insert.Values(
+				b.id,
+				jobID,
+				inputsMD5,
+				result.Task,
+				result.Suite,
+				result.Name,
+				string(result.Status),
+				result.Duration,
+				result.Message,
+			)
+		}
+
+		_, err = insert.RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// TestSummary returns the results of the tests run by the build.
+func (b *build) TestSummary() (atc.TestSummary, error) {
+	rows, err := psql.Select("task", "suite", "name", "status", "duration", "message").
+		From("test_results").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("id").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return atc.TestSummary{}, err
+	}
+
+	defer Close(rows)
+
+	summary := atc.TestSummary{
+		Tests: []atc.TestResult{},
+	}
+
+	for rows.Next() {
+		var result atc.TestResult
+		err = rows.Scan(&result.Task, &result.Suite, &result.Name, &result.Status, &result.Duration, &result.Message)
+		if err != nil {
+			return atc.TestSummary{}, err
+		}
+
+		switch result.Status {
+		case atc.TestStatusPassed:
+			summary.Passed++
+		case atc.TestStatusFailed:
+			summary.Failed++
+		case atc.TestStatusErrored:
+			summary.Errored++
+		case atc.TestStatusSkipped:
+			summary.Skipped++
+		}
+
+		summary.Tests = append(summary.Tests, result)
+	}
+
+	return summary, nil
+}
+
+type testKey struct {
+	task  string
+	suite string
+	name  string
+}
+
+// TestHistory returns the results of the tests run by the last builds of the
+// job which reported any, limited to the given number of builds. Tests which
+// both passed and failed in builds with the same inputs are flagged as flaky.
+func (j *job) TestHistory(limit int) ([]atc.TestHistory, error) {
+	recentBuilds := sq.Select("DISTINCT build_id").
+		From("test_results").
+		Where(sq.Eq{"job_id": j.id}).
+		OrderBy("build_id DESC").
+		Limit(uint64(limit))
+
+	recentBuildsSQL, recentBuildsArgs, err := recentBuilds.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := psql.Select("t.task", "t.suite", "t.name", "t.status", "t.inputs_md5", "b.id", "b.name").
+		From("test_results t").
+		Join("builds b ON b.id = t.build_id").
+		Where(sq.Eq{"t.job_id": j.id}).
+		Where(sq.Expr("t.build_id IN ("+recentBuildsSQL+")", recentBuildsArgs...)).
+		OrderBy("b.id DESC", "t.id").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	histories := map[testKey]*atc.TestHistory{}
+	outcomes := map[testKey]map[string]map[atc.TestStatus]bool{}
+
+	for rows.Next() {
+		var (
+			key       testKey
+			run       atc.TestRun
+			inputsMD5 sql.NullString
+		)
+
+		err = rows.Scan(&key.task, &key.suite, &key.name, &run.Status, &inputsMD5, &run.BuildID, &run.BuildName)
+		if err != nil {
+			return nil, err
+		}
+
+		history, found := histories[key]
+		if !found {
+			history = &atc.TestHistory{
+				Task:  key.task,
+				Suite: key.suite,
+				Name:  key.name,
+			}
+
+			histories[key] = history
+			outcomes[key] = map[string]map[atc.TestStatus]bool{}
+		}
+
+		history.Runs = append(history.Runs, run)
+
+		if !inputsMD5.Valid {
+			continue
+		}
+
+		statuses, found := outcomes[key][inputsMD5.String]
+		if !found {
+			statuses = map[atc.TestStatus]bool{}
+			outcomes[key][inputsMD5.String] = statuses
+		}
+
+		statuses[run.Status] = true
+
+		if statuses[atc.TestStatusPassed] && (statuses[atc.TestStatusFailed] || statuses[atc.TestStatusErrored]) {
+			history.Flaky = true
+		}
+	}
+
+	result := []atc.TestHistory{}
+	for _, history := range histories {
+		result = append(result, *history)
+	}
+
+	sort.Slice(result, func(i, k int) bool {
+		if result[i].Task != result[k].Task {
+			return result[i].Task < result[k].Task
+		}
+
+		if result[i].Suite != result[k].Suite {
+			return result[i].Suite < result[k].Suite
+		}
+
+		return result[i].Name < result[k].Name
+	})
+
+	return result, nil
+}
@@ -0,0 +1,180 @@
+package db_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test results", func() {
+	var build db.Build
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultJob.CreateBuild(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("TestSummary", func() {
+		BeforeEach(func() {
+			err := build.SaveTestResults([]atc.TestResult{
+				{Task: "unit", Suite: "api", Name: "lists builds", Status: atc.TestStatusPassed, Duration: 0.5},
+				{Task: "unit", Suite: "api", Name: "creates builds", Status: atc.TestStatusFailed, Message: "expected 201"},
+				{Task: "unit", Suite: "api", Name: "deletes builds", Status: atc.TestStatusErrored},
+				{Task: "unit", Suite: "api", Name: "hijacks builds", Status: atc.TestStatusSkipped},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the counts and results of the build's tests", func() {
+			summary, err := build.TestSummary()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(atc.TestSummary{
+				Passed:  1,
+				Failed:  1,
+				Errored: 1,
+				Skipped: 1,
+				Tests: []atc.TestResult{
+					{Task: "unit", Suite: "api", Name: "lists builds", Status: atc.TestStatusPassed, Duration: 0.5},
+					{Task: "unit", Suite: "api", Name: "creates builds", Status: atc.TestStatusFailed, Message: "expected 201"},
+					{Task: "unit", Suite: "api", Name: "deletes builds", Status: atc.TestStatusErrored},
+					{Task: "unit", Suite: "api", Name: "hijacks builds", Status: atc.TestStatusSkipped},
+				},
+			}))
+		})
+
+		It("does not include the results of other builds", func() {
+			otherBuild, err := defaultJob.CreateBuild(context.TODO())
+			Expect(err).ToNot(HaveOccurred())
+
+			summary, err := otherBuild.TestSummary()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summary).To(Equal(atc.TestSummary{Tests: []atc.TestResult{}}))
+		})
+	})
+
+	Describe("TestHistory", func() {
+		var scope db.ResourceConfigScope
+
+		adoptVersion := func(build db.Build, version string) {
+			err := defaultJob.SaveNextInputMapping(db.InputMapping{
+				"some-input": db.InputResult{
+					Input: &db.AlgorithmInput{
+						AlgorithmVersion: db.AlgorithmVersion{
+							Version:    db.ResourceVersion(convertToMD5(atc.Version{"ver": version})),
+							ResourceID: defaultResource.ID(),
+						},
+						FirstOccurrence: true,
+					},
+					PassedBuildIDs: []int{},
+				},
+			}, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := build.AdoptInputsAndPipes()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		}
+
+		BeforeEach(func() {
+			var err error
+			scope, err = defaultResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = scope.SaveVersions([]atc.Version{{"ver": "1"}, {"ver": "2"}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when a test passed and failed with the same inputs", func() {
+			var retriedBuild db.Build
+
+			BeforeEach(func() {
+				adoptVersion(build, "1")
+
+				err := build.SaveTestResults([]atc.TestResult{
+					{Task: "unit", Suite: "api", Name: "flaky test", Status: atc.TestStatusFailed},
+					{Task: "unit", Suite: "api", Name: "stable test", Status: atc.TestStatusPassed},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				retriedBuild, err = defaultJob.CreateBuild(context.TODO())
+				Expect(err).ToNot(HaveOccurred())
+
+				adoptVersion(retriedBuild, "1")
+
+				err = retriedBuild.SaveTestResults([]atc.TestResult{
+					{Task: "unit", Suite: "api", Name: "flaky test", Status: atc.TestStatusPassed},
+					{Task: "unit", Suite: "api", Name: "stable test", Status: atc.TestStatusPassed},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("flags the test as flaky", func() {
+				history, err := defaultJob.TestHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history).To(Equal([]atc.TestHistory{
+					{
+						Task:  "unit",
+						Suite: "api",
+						Name:  "flaky test",
+						Flaky: true,
+						Runs: []atc.TestRun{
+							{BuildID: retriedBuild.ID(), BuildName: retriedBuild.Name(), Status: atc.TestStatusPassed},
+							{BuildID: build.ID(), BuildName: build.Name(), Status: atc.TestStatusFailed},
+						},
+					},
+					{
+						Task:  "unit",
+						Suite: "api",
+						Name:  "stable test",
+						Runs: []atc.TestRun{
+							{BuildID: retriedBuild.ID(), BuildName: retriedBuild.Name(), Status: atc.TestStatusPassed},
+							{BuildID: build.ID(), BuildName: build.Name(), Status: atc.TestStatusPassed},
+						},
+					},
+				}))
+			})
+
+			It("limits the number of builds", func() {
+				history, err := defaultJob.TestHistory(1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history).To(HaveLen(2))
+				Expect(history[0].Runs).To(HaveLen(1))
+				Expect(history[0].Flaky).To(BeFalse())
+			})
+		})
+
+		Context("when a test passed and failed with different inputs", func() {
+			BeforeEach(func() {
+				adoptVersion(build, "1")
+
+				err := build.SaveTestResults([]atc.TestResult{
+					{Task: "unit", Suite: "api", Name: "some test", Status: atc.TestStatusPassed},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				otherBuild, err := defaultJob.CreateBuild(context.TODO())
+				Expect(err).ToNot(HaveOccurred())
+
+				adoptVersion(otherBuild, "2")
+
+				err = otherBuild.SaveTestResults([]atc.TestResult{
+					{Task: "unit", Suite: "api", Name: "some test", Status: atc.TestStatusFailed},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not flag the test as flaky", func() {
+				history, err := defaultJob.TestHistory(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history).To(HaveLen(1))
+				Expect(history[0].Runs).To(HaveLen(2))
+				Expect(history[0].Flaky).To(BeFalse())
+			})
+		})
+	})
+})
@@ -49,6 +49,25 @@ func (versions VersionsDB) VersionIsDisabled(ctx context.Context, resourceID int
 	return exists, nil
 }
 
+// ResourceHasVersions returns whether the resource has any versions, including
+// disabled ones.
+func (versions VersionsDB) ResourceHasVersions(ctx context.Context, resourceID int) (bool, error) {
+	var exists bool
+	err := versions.conn.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM resource_config_versions v
+			JOIN resources r ON r.resource_config_scope_id = v.resource_config_scope_id
+			WHERE r.id = $1
+		)`, resourceID).
+		Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
 func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int) (ResourceVersion, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
@@ -42,7 +42,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build, err = defaultJob.CreateBuild()
+				build, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				err = build.Finish(db.BuildStatusSucceeded)
@@ -69,7 +69,7 @@ var _ = Describe("VersionsDB", func() {
 				builds = []db.Build{}
 
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -105,38 +105,38 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build2Failed, err = defaultJob.CreateBuild()
+				build2Failed, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build2Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
 
-				build3Succeeded, err = defaultJob.CreateBuild()
+				build3Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build3Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build4Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build5Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build6Succeeded, err = defaultJob.CreateBuild()
+				build6Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -196,32 +196,32 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build2Failed, err = defaultJob.CreateBuild()
+				build2Failed, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build2Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
 
-				build3Succeeded, err = defaultJob.CreateBuild()
+				build3Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build3Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build4Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build5Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build6Succeeded, err = defaultJob.CreateBuild()
+				build6Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -267,7 +267,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Failed, err = defaultJob.CreateBuild()
+				build1Failed, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
@@ -275,7 +275,7 @@ var _ = Describe("VersionsDB", func() {
 				fillerBuilds = []db.Build{}
 
 				for i := 0; i < pageLimit-1; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -284,7 +284,7 @@ var _ = Describe("VersionsDB", func() {
 					fillerBuilds = append(fillerBuilds, build)
 				}
 
-				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -317,7 +317,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -325,7 +325,7 @@ var _ = Describe("VersionsDB", func() {
 				fillerBuilds = []db.Build{}
 
 				for i := 0; i < pageLimit-1; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -334,7 +334,7 @@ var _ = Describe("VersionsDB", func() {
 					fillerBuilds = append(fillerBuilds, build)
 				}
 
-				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Succeeded)
+				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Succeeded, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -374,7 +374,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Failed, err = defaultJob.CreateBuild()
+				build1Failed, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
@@ -382,7 +382,7 @@ var _ = Describe("VersionsDB", func() {
 				fillerBuilds = []db.Build{}
 
 				for i := 0; i < pageLimit-1; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -391,17 +391,17 @@ var _ = Describe("VersionsDB", func() {
 					fillerBuilds = append(fillerBuilds, build)
 				}
 
-				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build6Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build7Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build7Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build7Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build8Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed)
+				build8Rerun1Succeeded, err = defaultJob.RerunBuild(build1Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build8Rerun1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -457,7 +457,7 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				cursorBuild, err = defaultJob.CreateBuild()
+				cursorBuild, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				err = cursorBuild.Finish(db.BuildStatusSucceeded)
@@ -489,7 +489,7 @@ var _ = Describe("VersionsDB", func() {
 			BeforeEach(func() {
 				olderBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -499,7 +499,7 @@ var _ = Describe("VersionsDB", func() {
 				}
 
 				var err error
-				cursorBuild, err = defaultJob.CreateBuild()
+				cursorBuild, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				err = cursorBuild.Finish(db.BuildStatusSucceeded)
@@ -511,7 +511,7 @@ var _ = Describe("VersionsDB", func() {
 
 				newerBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -557,7 +557,7 @@ var _ = Describe("VersionsDB", func() {
 			BeforeEach(func() {
 				olderBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -567,7 +567,7 @@ var _ = Describe("VersionsDB", func() {
 				}
 
 				var err error
-				cursorBuild, err = defaultJob.CreateBuild()
+				cursorBuild, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 
 				err = cursorBuild.Finish(db.BuildStatusSucceeded)
@@ -579,7 +579,7 @@ var _ = Describe("VersionsDB", func() {
 
 				newerBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.CreateBuild()
+					build, err := defaultJob.CreateBuild(context.TODO())
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -590,7 +590,7 @@ var _ = Describe("VersionsDB", func() {
 
 				rerunBuilds = []db.Build{}
 				for i := 0; i < pageLimit; i++ {
-					build, err := defaultJob.RerunBuild(cursorBuild)
+					build, err := defaultJob.RerunBuild(cursorBuild, false)
 					Expect(err).ToNot(HaveOccurred())
 
 					err = build.Finish(db.BuildStatusSucceeded)
@@ -645,27 +645,27 @@ var _ = Describe("VersionsDB", func() {
 
 			BeforeEach(func() {
 				var err error
-				build1Succeeded, err = defaultJob.CreateBuild()
+				build1Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build1Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build2Failed, err = defaultJob.CreateBuild()
+				build2Failed, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build2Failed.Finish(db.BuildStatusFailed)
 				Expect(err).ToNot(HaveOccurred())
 
-				build3Succeeded, err = defaultJob.CreateBuild()
+				build3Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build3Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build4Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build4Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build5Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build5Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -678,12 +678,12 @@ var _ = Describe("VersionsDB", func() {
 					},
 				}
 
-				build6Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed)
+				build6Rerun2Succeeded, err = defaultJob.RerunBuild(build2Failed, false)
 				Expect(err).ToNot(HaveOccurred())
 				err = build6Rerun2Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
 
-				build7Succeeded, err = defaultJob.CreateBuild()
+				build7Succeeded, err = defaultJob.CreateBuild(context.TODO())
 				Expect(err).ToNot(HaveOccurred())
 				err = build7Succeeded.Finish(db.BuildStatusSucceeded)
 				Expect(err).ToNot(HaveOccurred())
@@ -53,6 +53,7 @@ const (
 	VolumeTypeResourceCerts VolumeType = "resource-certs"
 	VolumeTypeTaskCache     VolumeType = "task-cache"
 	VolumeTypeArtifact      VolumeType = "artifact"
+	VolumeTypeTaskMemo      VolumeType = "task-memo"
 	VolumeTypeUknown        VolumeType = "unknown" // for migration to life
 )
 
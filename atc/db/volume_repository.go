@@ -480,6 +480,7 @@ func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error
 				"v.worker_task_cache_id":         nil,
 				"v.worker_resource_certs_id":     nil,
 				"v.worker_artifact_id":           nil,
+				"v.task_memo_id":                 nil,
 			},
 		).
 		Where(sq.Eq{"v.state": string(VolumeStateCreated)}).
@@ -734,6 +735,7 @@ var volumeColumns = []string{
 	when v.worker_task_cache_id is not NULL then 'task-cache'
 	when v.worker_resource_certs_id is not NULL then 'resource-certs'
 	when v.worker_artifact_id is not NULL then 'artifact'
+	when v.task_memo_id is not NULL then 'task-memo'
 	else 'unknown'
 end`,
 }
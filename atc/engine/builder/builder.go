@@ -1,12 +1,15 @@
 package builder
 
 import (
-	"code.cloudfoundry.org/lager"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/lager"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -25,6 +28,7 @@ type StepFactory interface {
 	CheckStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, exec.CheckDelegate) exec.Step
 	SetPipelineStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
 	LoadVarStep(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step
+	ApproveStep(atc.Plan, exec.ApproveDelegate) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 }
@@ -37,6 +41,8 @@ type DelegateFactory interface {
 	TaskDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.TaskDelegate
 	CheckDelegate(db.Check, atc.PlanID, vars.CredVarsTracker) exec.CheckDelegate
 	BuildStepDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.BuildStepDelegate
+	AcrossDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.AcrossDelegate
+	ApproveDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.ApproveDelegate
 }
 
 func NewStepBuilder(
@@ -90,7 +96,11 @@ func (builder *stepBuilder) BuildStep(logger lager.Logger, build db.Build) (exec
 			return exec.IdentityStep{}, errors.New("pipeline not found")
 		}
 
-		varss, err := pipeline.Variables(logger, builder.globalSecrets, builder.varSourcePool)
+		varss, err := pipeline.Variables(logger, builder.globalSecrets, builder.varSourcePool, creds.BuildScope{
+			JobName:   build.JobName(),
+			BuildID:   build.ID(),
+			BuildName: build.Name(),
+		})
 		if err != nil {
 			return exec.IdentityStep{}, err
 		}
@@ -122,7 +132,7 @@ func (builder *stepBuilder) CheckStep(logger lager.Logger, check db.Check) (exec
 		return exec.IdentityStep{}, errors.New("pipeline not found")
 	}
 
-	varss, err := pipeline.Variables(logger, builder.globalSecrets, builder.varSourcePool)
+	varss, err := pipeline.Variables(logger, builder.globalSecrets, builder.varSourcePool, creds.BuildScope{})
 	if err != nil {
 		return exec.IdentityStep{}, fmt.Errorf("failed to create pipeline variables: %s", err.Error())
 	}
@@ -143,6 +153,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildDoStep(build, plan, credVarsTracker)
 	}
 
+	if plan.Across != nil {
+		return builder.buildAcrossStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Timeout != nil {
 		return builder.buildTimeoutStep(build, plan, credVarsTracker)
 	}
@@ -183,6 +197,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildLoadVarStep(build, plan, credVarsTracker)
 	}
 
+	if plan.Approve != nil {
+		return builder.buildApproveStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Get != nil {
 		return builder.buildGetStep(build, plan, credVarsTracker)
 	}
@@ -246,6 +264,18 @@ func (builder *stepBuilder) buildDoStep(build db.Build, plan atc.Plan, credVarsT
 	return step
 }
 
+func (builder *stepBuilder) buildAcrossStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	return exec.Across(
+		plan.ID,
+		*plan.Across,
+		builder.delegateFactory.AcrossDelegate(build, plan.ID, credVarsTracker),
+		func(innerPlan atc.Plan, scope vars.CredVarsTracker) exec.Step {
+			innerPlan.Attempts = plan.Attempts
+			return builder.buildStep(build, innerPlan, scope)
+		},
+	)
+}
+
 func (builder *stepBuilder) buildTimeoutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 	innerPlan := plan.Timeout.Step
 	innerPlan.Attempts = plan.Attempts
@@ -327,12 +357,16 @@ func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, credVars
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.GetStep(
+	delegate := builder.delegateFactory.GetDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.GetStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.GetDelegate(build, plan.ID, credVarsTracker),
+		delegate,
 	)
+
+	return builder.resumable(build, plan, step, delegate)
 }
 
 func (builder *stepBuilder) buildPutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -396,12 +430,16 @@ func (builder *stepBuilder) buildTaskStep(build db.Build, plan atc.Plan, credVar
 		builder.externalURL,
 	)
 
-	return builder.stepFactory.TaskStep(
+	delegate := builder.delegateFactory.TaskDelegate(build, plan.ID, credVarsTracker)
+
+	step := builder.stepFactory.TaskStep(
 		plan,
 		stepMetadata,
 		containerMetadata,
-		builder.delegateFactory.TaskDelegate(build, plan.ID, credVarsTracker),
+		delegate,
 	)
+
+	return builder.resumable(build, plan, step, delegate)
 }
 
 func (builder *stepBuilder) buildSetPipelineStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
@@ -432,6 +470,14 @@ func (builder *stepBuilder) buildLoadVarStep(build db.Build, plan atc.Plan, cred
 	)
 }
 
+func (builder *stepBuilder) buildApproveStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+
+	return builder.stepFactory.ApproveStep(
+		plan,
+		builder.delegateFactory.ApproveDelegate(build, plan.ID, credVarsTracker),
+	)
+}
+
 func (builder *stepBuilder) buildArtifactInputStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 
 	return builder.stepFactory.ArtifactInputStep(
@@ -450,6 +496,96 @@ func (builder *stepBuilder) buildArtifactOutputStep(build db.Build, plan atc.Pla
 	)
 }
 
+// resumable wraps the get or task step of a job build so that a rerun of the
+// build from its failed step can skip it if it succeeded. Steps run by hooks
+// reacting to a failure are always run, as are gets of versions produced by
+// puts, since puts are always run.
+func (builder *stepBuilder) resumable(build db.Build, plan atc.Plan, step exec.Step, delegate exec.ResumeDelegate) exec.Step {
+	if build.JobID() == 0 {
+		return step
+	}
+
+	if plan.Get != nil && plan.Get.VersionFrom != nil {
+		return step
+	}
+
+	if isFailureHook(build.PrivatePlan(), plan.ID) {
+		return step
+	}
+
+	stepKey, err := resumeKey(plan)
+	if err != nil {
+		return step
+	}
+
+	return exec.Resume(step, stepKey, build, delegate)
+}
+
+// resumeKey identifies a step by its plan, as plan IDs differ between builds.
+// The steps of an across step share a plan and are told apart by the suffix
+// of their plan IDs instead.
+func resumeKey(plan atc.Plan) (string, error) {
+	var stepType string
+	var step interface{}
+	if plan.Get != nil {
+		stepType, step = "get", plan.Get
+	} else {
+		stepType, step = "task", plan.Task
+	}
+
+	payload, err := json.Marshal(step)
+	if err != nil {
+		return "", err
+	}
+
+	key := fmt.Sprintf("%s:%x", stepType, sha256.Sum256(payload))
+
+	id := string(plan.ID)
+	if i := strings.Index(id, "/"); i != -1 {
+		key += id[i:]
+	}
+
+	return key, nil
+}
+
+// isFailureHook returns whether the plan with the given ID is run by an
+// on_abort, on_error, on_failure or ensure hook within the root plan.
+func isFailureHook(root atc.Plan, id atc.PlanID) bool {
+	if i := strings.Index(string(id), "/"); i != -1 {
+		id = id[:i]
+	}
+
+	found := false
+	root.Each(func(plan *atc.Plan) {
+		hooks := []*atc.Plan{}
+		if plan.OnAbort != nil {
+			hooks = append(hooks, &plan.OnAbort.Next)
+		}
+
+		if plan.OnError != nil {
+			hooks = append(hooks, &plan.OnError.Next)
+		}
+
+		if plan.OnFailure != nil {
+			hooks = append(hooks, &plan.OnFailure.Next)
+		}
+
+		if plan.Ensure != nil {
+			hooks = append(hooks, &plan.Ensure.Next)
+		}
+
+		for _, hook := range hooks {
+			hook.Each(func(hookPlan *atc.Plan) {
+				if hookPlan.ID == id {
+					found = true
+				}
+			})
+		}
+	})
+
+	return found
+}
+
 func (builder *stepBuilder) containerMetadata(
 	build db.Build,
 	containerType db.ContainerType,
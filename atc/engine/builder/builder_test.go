@@ -1,18 +1,22 @@
 package builder_test
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
 )
 
 type StepBuilder interface {
@@ -75,6 +79,8 @@ var _ = Describe("Builder", func() {
 
 				expectedPlan     atc.Plan
 				expectedMetadata exec.StepMetadata
+
+				builtStep exec.Step
 			)
 
 			BeforeEach(func() {
@@ -109,7 +115,7 @@ var _ = Describe("Builder", func() {
 			JustBeforeEach(func() {
 				fakeBuild.PrivatePlanReturns(expectedPlan)
 
-				_, err = stepBuilder.BuildStep(logger, fakeBuild)
+				builtStep, err = stepBuilder.BuildStep(logger, fakeBuild)
 			})
 
 			Context("when the build has the wrong schema", func() {
@@ -131,6 +137,16 @@ var _ = Describe("Builder", func() {
 					Expect(err).NotTo(HaveOccurred())
 				})
 
+				It("resolves the pipeline's vars for the build", func() {
+					Expect(fakePipeline.VariablesCallCount()).To(Equal(1))
+					_, _, _, scope := fakePipeline.VariablesArgsForCall(0)
+					Expect(scope).To(Equal(creds.BuildScope{
+						JobName:   "some-job",
+						BuildID:   4444,
+						BuildName: "42",
+					}))
+				})
+
 				Context("with a putget in an aggregate", func() {
 					var (
 						putPlan               atc.Plan
@@ -533,6 +549,20 @@ var _ = Describe("Builder", func() {
 								BuildName:    "42",
 							}))
 						})
+
+						It("makes the task resumable", func() {
+							Expect(builtStep).To(BeAssignableToTypeOf(&exec.ResumeStep{}))
+						})
+
+						Context("when the build is a one-off build", func() {
+							BeforeEach(func() {
+								fakeBuild.JobIDReturns(0)
+							})
+
+							It("does not make the task resumable", func() {
+								Expect(builtStep).ToNot(BeAssignableToTypeOf(&exec.ResumeStep{}))
+							})
+						})
 					})
 
 					Context("that contains a set_pipeline step", func() {
@@ -567,6 +597,48 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains an approve step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.ApprovePlan{
+								Name:  "ship-it",
+								Roles: []string{"owner"},
+							})
+						})
+
+						It("constructs approve correctly", func() {
+							plan, _ := fakeStepFactory.ApproveStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+
+							Expect(fakeDelegateFactory.ApproveDelegateCallCount()).To(Equal(1))
+							_, planID, _ := fakeDelegateFactory.ApproveDelegateArgsForCall(0)
+							Expect(planID).To(Equal(expectedPlan.ID))
+						})
+					})
+
+					Context("that contains an across step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+								Vars: []atc.AcrossVar{
+									{Var: "some-var", Values: []interface{}{"a", "b"}},
+								},
+								Step: planFactory.NewPlan(atc.LoadVarPlan{
+									Name: "some-var",
+									File: "some-input/data.yml",
+								}),
+							})
+						})
+
+						It("constructs an across delegate for the plan", func() {
+							Expect(fakeDelegateFactory.AcrossDelegateCallCount()).To(Equal(1))
+							_, planID, _ := fakeDelegateFactory.AcrossDelegateArgsForCall(0)
+							Expect(planID).To(Equal(expectedPlan.ID))
+						})
+
+						It("does not construct the iterations until the step runs", func() {
+							Expect(fakeStepFactory.LoadVarStepCallCount()).To(BeZero())
+						})
+					})
+
 					Context("that contains outputs", func() {
 						var (
 							putPlan          atc.Plan
@@ -679,6 +751,30 @@ var _ = Describe("Builder", func() {
 							})
 						})
 
+						Context("when resuming a build in which every step succeeded", func() {
+							var fakeStep *execfakes.FakeStep
+
+							BeforeEach(func() {
+								fakeStep = new(execfakes.FakeStep)
+								fakeStep.SucceededReturns(true)
+								fakeStepFactory.GetStepReturns(fakeStep)
+								fakeStepFactory.TaskStepReturns(fakeStep)
+
+								fakeDelegateFactory.GetDelegateReturns(new(execfakes.FakeGetDelegate))
+								fakeDelegateFactory.TaskDelegateReturns(new(execfakes.FakeTaskDelegate))
+
+								fakeBuild.ResumedStepOutputsReturns(db.BuildStepOutputs{BuildID: 1}, true, nil)
+							})
+
+							It("only runs the ensure hook", func() {
+								state := exec.NewRunState()
+								Expect(builtStep.Run(context.Background(), state)).To(Succeed())
+
+								Expect(fakeStep.RunCallCount()).To(Equal(1))
+								Expect(fakeBuild.ResumedStepOutputsCallCount()).To(Equal(3))
+							})
+						})
+
 						It("constructs the step correctly", func() {
 							Expect(fakeStepFactory.GetStepCallCount()).To(Equal(1))
 							plan, stepMetadata, containerMetadata, _ := fakeStepFactory.GetStepArgsForCall(0)
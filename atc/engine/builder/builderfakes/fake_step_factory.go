@@ -11,6 +11,18 @@ import (
 )
 
 type FakeStepFactory struct {
+	ApproveStepStub        func(atc.Plan, exec.ApproveDelegate) exec.Step
+	approveStepMutex       sync.RWMutex
+	approveStepArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 exec.ApproveDelegate
+	}
+	approveStepReturns struct {
+		result1 exec.Step
+	}
+	approveStepReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	ArtifactInputStepStub        func(atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
 	artifactInputStepMutex       sync.RWMutex
 	artifactInputStepArgsForCall []struct {
@@ -123,6 +135,67 @@ type FakeStepFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStepFactory) ApproveStep(arg1 atc.Plan, arg2 exec.ApproveDelegate) exec.Step {
+	fake.approveStepMutex.Lock()
+	ret, specificReturn := fake.approveStepReturnsOnCall[len(fake.approveStepArgsForCall)]
+	fake.approveStepArgsForCall = append(fake.approveStepArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 exec.ApproveDelegate
+	}{arg1, arg2})
+	fake.recordInvocation("ApproveStep", []interface{}{arg1, arg2})
+	fake.approveStepMutex.Unlock()
+	if fake.ApproveStepStub != nil {
+		return fake.ApproveStepStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.approveStepReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStepFactory) ApproveStepCallCount() int {
+	fake.approveStepMutex.RLock()
+	defer fake.approveStepMutex.RUnlock()
+	return len(fake.approveStepArgsForCall)
+}
+
+func (fake *FakeStepFactory) ApproveStepCalls(stub func(atc.Plan, exec.ApproveDelegate) exec.Step) {
+	fake.approveStepMutex.Lock()
+	defer fake.approveStepMutex.Unlock()
+	fake.ApproveStepStub = stub
+}
+
+func (fake *FakeStepFactory) ApproveStepArgsForCall(i int) (atc.Plan, exec.ApproveDelegate) {
+	fake.approveStepMutex.RLock()
+	defer fake.approveStepMutex.RUnlock()
+	argsForCall := fake.approveStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStepFactory) ApproveStepReturns(result1 exec.Step) {
+	fake.approveStepMutex.Lock()
+	defer fake.approveStepMutex.Unlock()
+	fake.ApproveStepStub = nil
+	fake.approveStepReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeStepFactory) ApproveStepReturnsOnCall(i int, result1 exec.Step) {
+	fake.approveStepMutex.Lock()
+	defer fake.approveStepMutex.Unlock()
+	fake.ApproveStepStub = nil
+	if fake.approveStepReturnsOnCall == nil {
+		fake.approveStepReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.approveStepReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeStepFactory) ArtifactInputStep(arg1 atc.Plan, arg2 db.Build, arg3 exec.BuildStepDelegate) exec.Step {
 	fake.artifactInputStepMutex.Lock()
 	ret, specificReturn := fake.artifactInputStepReturnsOnCall[len(fake.artifactInputStepArgsForCall)]
@@ -626,6 +699,8 @@ func (fake *FakeStepFactory) TaskStepReturnsOnCall(i int, result1 exec.Step) {
 func (fake *FakeStepFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.approveStepMutex.RLock()
+	defer fake.approveStepMutex.RUnlock()
 	fake.artifactInputStepMutex.RLock()
 	defer fake.artifactInputStepMutex.RUnlock()
 	fake.artifactOutputStepMutex.RLock()
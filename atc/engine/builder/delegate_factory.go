@@ -43,6 +43,14 @@ func (delegate *delegateFactory) BuildStepDelegate(build db.Build, planID atc.Pl
 	return NewBuildStepDelegate(build, planID, credVarsTracker, clock.NewClock())
 }
 
+func (delegate *delegateFactory) AcrossDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.AcrossDelegate {
+	return NewAcrossDelegate(build, planID, credVarsTracker, clock.NewClock())
+}
+
+func (delegate *delegateFactory) ApproveDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.ApproveDelegate {
+	return NewApproveDelegate(build, planID, credVarsTracker, clock.NewClock())
+}
+
 func NewGetDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.GetDelegate {
 	return &getDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
@@ -107,6 +115,10 @@ func (d *getDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus,
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *getDelegate) Resumed(logger lager.Logger, outputs db.BuildStepOutputs) {
+	saveResumedStepEvent(logger, d.build, d.eventOrigin, outputs)
+}
+
 func (d *getDelegate) UpdateVersion(log lager.Logger, plan atc.GetPlan, info runtime.VersionResult) {
 	logger := log.WithData(lager.Data{
 		"pipeline-name": d.build.PipelineName(),
@@ -298,6 +310,62 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *taskDelegate) Memoized(logger lager.Logger, memo db.TaskMemo) {
+	err := d.build.SaveEvent(event.MemoizedTask{
+		Origin:    d.eventOrigin,
+		Time:      time.Now().Unix(),
+		BuildID:   memo.BuildID,
+		BuildName: memo.BuildName,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-memoized-task-event", err)
+		return
+	}
+
+	logger.Info("memoized", lager.Data{"build": memo.BuildID})
+}
+
+func (d *taskDelegate) Resumed(logger lager.Logger, outputs db.BuildStepOutputs) {
+	saveResumedStepEvent(logger, d.build, d.eventOrigin, outputs)
+}
+
+func (d *taskDelegate) SaveTestResults(logger lager.Logger, results []atc.TestResult) error {
+	err := d.build.SaveTestResults(results)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("saved-test-results", lager.Data{"tests": len(results)})
+
+	return nil
+}
+
+func (d *taskDelegate) SaveAnnotations(logger lager.Logger, annotations map[string]string) error {
+	err := d.build.SaveAnnotations(annotations)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("saved-annotations", lager.Data{"annotations": len(annotations)})
+
+	return nil
+}
+
+func saveResumedStepEvent(logger lager.Logger, build db.Build, origin event.Origin, outputs db.BuildStepOutputs) {
+	err := build.SaveEvent(event.ResumedStep{
+		Origin:    origin,
+		Time:      time.Now().Unix(),
+		BuildID:   outputs.BuildID,
+		BuildName: outputs.BuildName,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-resumed-step-event", err)
+		return
+	}
+
+	logger.Info("resumed", lager.Data{"build": outputs.BuildID})
+}
+
 func NewCheckDelegate(check db.Check, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.CheckDelegate {
 	return &checkDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(nil, planID, credVarsTracker, clock),
@@ -504,6 +572,112 @@ func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
+func NewAcrossDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.AcrossDelegate {
+	return &acrossDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type acrossDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func (d *acrossDelegate) StartingIteration(logger lager.Logger, stepID atc.PlanID, vars map[string]interface{}) {
+	err := d.build.SaveEvent(event.AcrossIteration{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+		StepID: event.OriginID(stepID),
+		Vars:   vars,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-across-iteration-event", err)
+		return
+	}
+
+	logger.Debug("starting-iteration", lager.Data{"step-id": stepID})
+}
+
+func NewApproveDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.ApproveDelegate {
+	return &approveDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+
+		planID:      planID,
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type approveDelegate struct {
+	exec.BuildStepDelegate
+
+	planID      atc.PlanID
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func (d *approveDelegate) RequestApproval(logger lager.Logger, approval atc.BuildStepApproval) error {
+	err := d.build.RequestStepApproval(approval)
+	if err != nil {
+		logger.Error("failed-to-request-step-approval", err)
+		return err
+	}
+
+	err = d.build.SaveEvent(event.WaitingForApproval{
+		Origin:  d.eventOrigin,
+		Time:    d.clock.Now().Unix(),
+		Name:    approval.Name,
+		Message: approval.Message,
+		Roles:   approval.Roles,
+		Users:   approval.Users,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-waiting-for-approval-event", err)
+		return err
+	}
+
+	logger.Info("waiting-for-approval")
+
+	return nil
+}
+
+func (d *approveDelegate) Approval(logger lager.Logger) (atc.BuildStepApproval, bool, error) {
+	approval, found, err := d.build.StepApproval(d.planID)
+	if err != nil {
+		logger.Error("failed-to-find-step-approval", err)
+		return atc.BuildStepApproval{}, false, err
+	}
+
+	return approval, found, nil
+}
+
+func (d *approveDelegate) ApprovalDecided(logger lager.Logger, approval atc.BuildStepApproval) {
+	approved := approval.Approved != nil && *approval.Approved
+
+	err := d.build.SaveEvent(event.ApprovalDecided{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Approved:  approved,
+		DecidedBy: approval.DecidedBy,
+		Comment:   approval.Comment,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-approval-decided-event", err)
+		return
+	}
+
+	logger.Info("approval-decided", lager.Data{"approved": approved, "decided-by": approval.DecidedBy})
+}
+
 func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.WriteCloser {
 	return &dbEventWriter{
 		build:  build,
@@ -79,6 +79,19 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("Resumed", func() {
+			JustBeforeEach(func() {
+				delegate.Resumed(logger, db.BuildStepOutputs{BuildID: 12, BuildName: "3"})
+			})
+
+			It("saves an event with the resumed build", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				event := fakeBuild.SaveEventArgsForCall(0)
+				Expect(event.EventType()).To(Equal(atc.EventType("resumed-step")))
+				Expect(json.Marshal(event)).To(MatchRegexp(`"origin":{"id":"some-plan-id"},"build_id":12,"build_name":"3"`))
+			})
+		})
+
 		Describe("UpdateVersion", func() {
 			JustBeforeEach(func() {
 				plan := atc.GetPlan{Resource: "some-resource"}
@@ -288,6 +301,199 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
 		})
+
+		Describe("Memoized", func() {
+			JustBeforeEach(func() {
+				delegate.Memoized(logger, db.TaskMemo{BuildID: 12, BuildName: "3"})
+			})
+
+			It("saves an event with the build of the memo", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				event := fakeBuild.SaveEventArgsForCall(0)
+				Expect(event.EventType()).To(Equal(atc.EventType("memoized-task")))
+				Expect(json.Marshal(event)).To(MatchRegexp(`"build_id":12,"build_name":"3"`))
+			})
+		})
+
+		Describe("Resumed", func() {
+			JustBeforeEach(func() {
+				delegate.Resumed(logger, db.BuildStepOutputs{BuildID: 12, BuildName: "3"})
+			})
+
+			It("saves an event with the resumed build", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				event := fakeBuild.SaveEventArgsForCall(0)
+				Expect(event.EventType()).To(Equal(atc.EventType("resumed-step")))
+			})
+		})
+
+		Describe("SaveTestResults", func() {
+			var (
+				results []atc.TestResult
+				saveErr error
+			)
+
+			BeforeEach(func() {
+				results = []atc.TestResult{
+					{Task: "some-task", Suite: "some-suite", Name: "some-test", Status: atc.TestStatusPassed},
+				}
+			})
+
+			JustBeforeEach(func() {
+				saveErr = delegate.SaveTestResults(logger, results)
+			})
+
+			It("saves the results to the build", func() {
+				Expect(saveErr).ToNot(HaveOccurred())
+				Expect(fakeBuild.SaveTestResultsCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveTestResultsArgsForCall(0)).To(Equal(results))
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeBuild.SaveTestResultsReturns(errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(saveErr).To(MatchError("nope"))
+				})
+			})
+		})
+
+		Describe("SaveAnnotations", func() {
+			var saveErr error
+
+			JustBeforeEach(func() {
+				saveErr = delegate.SaveAnnotations(logger, map[string]string{"some": "annotation"})
+			})
+
+			It("saves the annotations to the build", func() {
+				Expect(saveErr).ToNot(HaveOccurred())
+				Expect(fakeBuild.SaveAnnotationsCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveAnnotationsArgsForCall(0)).To(Equal(map[string]string{"some": "annotation"}))
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeBuild.SaveAnnotationsReturns(errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(saveErr).To(MatchError("nope"))
+				})
+			})
+		})
+	})
+
+	Describe("AcrossDelegate", func() {
+		var delegate exec.AcrossDelegate
+
+		BeforeEach(func() {
+			delegate = builder.NewAcrossDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+		})
+
+		Describe("StartingIteration", func() {
+			JustBeforeEach(func() {
+				delegate.StartingIteration(logger, "some-plan-id/1", map[string]interface{}{"some-var": "some-value"})
+			})
+
+			It("saves an event labelling the iteration", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.AcrossIteration{
+					Origin: event.Origin{ID: "some-plan-id"},
+					Time:   123456789,
+					StepID: "some-plan-id/1",
+					Vars:   map[string]interface{}{"some-var": "some-value"},
+				}))
+			})
+		})
+	})
+
+	Describe("ApproveDelegate", func() {
+		var delegate exec.ApproveDelegate
+
+		BeforeEach(func() {
+			delegate = builder.NewApproveDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+		})
+
+		Describe("RequestApproval", func() {
+			var (
+				approval atc.BuildStepApproval
+				err      error
+			)
+
+			BeforeEach(func() {
+				approval = atc.BuildStepApproval{
+					PlanID:  "some-plan-id",
+					Name:    "ship-it",
+					Message: "ready?",
+					Roles:   []string{"owner"},
+				}
+			})
+
+			JustBeforeEach(func() {
+				err = delegate.RequestApproval(logger, approval)
+			})
+
+			It("records the approval and saves an event", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeBuild.RequestStepApprovalCallCount()).To(Equal(1))
+				Expect(fakeBuild.RequestStepApprovalArgsForCall(0)).To(Equal(approval))
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.WaitingForApproval{
+					Origin:  event.Origin{ID: "some-plan-id"},
+					Time:    123456789,
+					Name:    "ship-it",
+					Message: "ready?",
+					Roles:   []string{"owner"},
+				}))
+			})
+
+			Context("when recording the approval fails", func() {
+				BeforeEach(func() {
+					fakeBuild.RequestStepApprovalReturns(errors.New("nope"))
+				})
+
+				It("returns the error without saving an event", func() {
+					Expect(err).To(MatchError("nope"))
+					Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Describe("Approval", func() {
+			It("finds the approval for the plan", func() {
+				fakeBuild.StepApprovalReturns(atc.BuildStepApproval{Name: "ship-it"}, true, nil)
+
+				approval, found, err := delegate.Approval(logger)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approval.Name).To(Equal("ship-it"))
+				Expect(fakeBuild.StepApprovalArgsForCall(0)).To(Equal(atc.PlanID("some-plan-id")))
+			})
+		})
+
+		Describe("ApprovalDecided", func() {
+			It("saves an event with the decision", func() {
+				approved := true
+				delegate.ApprovalDecided(logger, atc.BuildStepApproval{
+					Approved:  &approved,
+					DecidedBy: "some-user",
+					Comment:   "lgtm",
+				})
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.ApprovalDecided{
+					Origin:    event.Origin{ID: "some-plan-id"},
+					Time:      123456789,
+					Approved:  true,
+					DecidedBy: "some-user",
+					Comment:   "lgtm",
+				}))
+			})
+		})
 	})
 
 	Describe("CheckDelegate", func() {
@@ -4,11 +4,15 @@ import (
 	"crypto/sha1"
 	"fmt"
 	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/clock"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -21,8 +25,12 @@ type stepFactory struct {
 	resourceCacheFactory  db.ResourceCacheFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	defaultLimits         atc.ContainerLimits
+	defaultIdleTimeout    time.Duration
 	strategy              worker.ContainerPlacementStrategy
 	lockFactory           lock.LockFactory
+	policyChecker         policy.Checker
+	taskMemoFactory       db.TaskMemoFactory
+	stepTemplates         db.StepTemplateRepository
 }
 
 func NewStepFactory(
@@ -33,8 +41,12 @@ func NewStepFactory(
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	defaultLimits atc.ContainerLimits,
+	defaultIdleTimeout time.Duration,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	policyChecker policy.Checker,
+	taskMemoFactory db.TaskMemoFactory,
+	stepTemplates db.StepTemplateRepository,
 ) *stepFactory {
 	return &stepFactory{
 		pool:                  pool,
@@ -44,8 +56,12 @@ func NewStepFactory(
 		resourceCacheFactory:  resourceCacheFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		defaultLimits:         defaultLimits,
+		defaultIdleTimeout:    defaultIdleTimeout,
 		strategy:              strategy,
 		lockFactory:           lockFactory,
+		policyChecker:         policyChecker,
+		taskMemoFactory:       taskMemoFactory,
+		stepTemplates:         stepTemplates,
 	}
 }
 
@@ -69,7 +85,7 @@ func (factory *stepFactory) GetStep(
 		factory.client,
 	)
 
-	return exec.LogError(getStep, delegate)
+	return exec.LogError(factory.idleTimeout(getStep, plan.Get.IdleTimeout), delegate)
 }
 
 func (factory *stepFactory) PutStep(
@@ -90,9 +106,10 @@ func (factory *stepFactory) PutStep(
 		factory.strategy,
 		factory.client,
 		delegate,
+		factory.policyChecker,
 	)
 
-	return exec.LogError(putStep, delegate)
+	return exec.LogError(factory.idleTimeout(putStep, plan.Put.IdleTimeout), delegate)
 }
 
 func (factory *stepFactory) CheckStep(
@@ -136,9 +153,11 @@ func (factory *stepFactory) TaskStep(
 		factory.client,
 		delegate,
 		factory.lockFactory,
+		factory.policyChecker,
+		factory.taskMemoFactory,
 	)
 
-	return exec.LogError(taskStep, delegate)
+	return exec.LogError(factory.idleTimeout(taskStep, plan.Task.IdleTimeout), delegate)
 }
 
 func (factory *stepFactory) SetPipelineStep(
@@ -152,6 +171,7 @@ func (factory *stepFactory) SetPipelineStep(
 		stepMetadata,
 		delegate,
 		factory.teamFactory,
+		factory.stepTemplates,
 		factory.client,
 	)
 
@@ -174,6 +194,20 @@ func (factory *stepFactory) LoadVarStep(
 	return exec.LogError(loadVarStep, delegate)
 }
 
+func (factory *stepFactory) ApproveStep(
+	plan atc.Plan,
+	delegate exec.ApproveDelegate,
+) exec.Step {
+	approveStep := exec.NewApproveStep(
+		plan.ID,
+		*plan.Approve,
+		delegate,
+		clock.NewClock(),
+	)
+
+	return exec.LogError(approveStep, delegate)
+}
+
 func (factory *stepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
@@ -189,3 +223,17 @@ func (factory *stepFactory) ArtifactOutputStep(
 ) exec.Step {
 	return exec.NewArtifactOutputStep(plan, build, factory.client, delegate)
 }
+
+// idleTimeout interrupts the step once it has not produced any output for the
+// duration configured on the step, falling back to the cluster default.
+func (factory *stepFactory) idleTimeout(step exec.Step, duration string) exec.Step {
+	if duration == "" {
+		if factory.defaultIdleTimeout == 0 {
+			return step
+		}
+
+		duration = factory.defaultIdleTimeout.String()
+	}
+
+	return exec.IdleTimeout(step, duration)
+}
@@ -181,7 +181,10 @@ func (b *engineBuild) Run(logger lager.Logger) {
 
 	defer notifier.Close()
 
-	ctx, span := tracing.StartSpan(b.ctx, "build", tracing.Attrs{
+	// continue the trace the build was created under, if any
+	ctx := tracing.Extract(b.ctx, b.build.SpanContext())
+
+	ctx, span := tracing.StartSpan(ctx, "build", tracing.Attrs{
 		"team":     b.build.TeamName(),
 		"pipeline": b.build.PipelineName(),
 		"job":      b.build.JobName(),
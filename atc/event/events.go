@@ -22,6 +22,16 @@ type FinishTask struct {
 func (FinishTask) EventType() atc.EventType  { return EventTypeFinishTask }
 func (FinishTask) Version() atc.EventVersion { return "4.0" }
 
+type MemoizedTask struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name"`
+}
+
+func (MemoizedTask) EventType() atc.EventType  { return EventTypeMemoizedTask }
+func (MemoizedTask) Version() atc.EventVersion { return "1.0" }
+
 type InitializeTask struct {
 	Time       int64      `json:"time"`
 	Origin     Origin     `json:"origin"`
@@ -191,3 +201,55 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type AcrossIteration struct {
+	Origin Origin                 `json:"origin"`
+	Time   int64                  `json:"time"`
+	StepID OriginID               `json:"step_id"`
+	Vars   map[string]interface{} `json:"vars"`
+}
+
+func (AcrossIteration) EventType() atc.EventType  { return EventTypeAcrossIteration }
+func (AcrossIteration) Version() atc.EventVersion { return "1.0" }
+
+type WaitingForApproval struct {
+	Origin  Origin   `json:"origin"`
+	Time    int64    `json:"time"`
+	Name    string   `json:"name"`
+	Message string   `json:"message,omitempty"`
+	Roles   []string `json:"roles,omitempty"`
+	Users   []string `json:"users,omitempty"`
+}
+
+func (WaitingForApproval) EventType() atc.EventType  { return EventTypeWaitingForApproval }
+func (WaitingForApproval) Version() atc.EventVersion { return "1.0" }
+
+type ApprovalDecided struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Approved  bool   `json:"approved"`
+	DecidedBy string `json:"decided_by"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+func (ApprovalDecided) EventType() atc.EventType  { return EventTypeApprovalDecided }
+func (ApprovalDecided) Version() atc.EventVersion { return "1.0" }
+
+type Superseded struct {
+	Time      int64  `json:"time"`
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name"`
+}
+
+func (Superseded) EventType() atc.EventType  { return EventTypeSuperseded }
+func (Superseded) Version() atc.EventVersion { return "1.0" }
+
+type ResumedStep struct {
+	Time      int64  `json:"time"`
+	Origin    Origin `json:"origin"`
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name"`
+}
+
+func (ResumedStep) EventType() atc.EventType  { return EventTypeResumedStep }
+func (ResumedStep) Version() atc.EventVersion { return "1.0" }
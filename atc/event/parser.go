@@ -37,6 +37,7 @@ func init() {
 	RegisterEvent(InitializeTask{})
 	RegisterEvent(StartTask{})
 	RegisterEvent(FinishTask{})
+	RegisterEvent(MemoizedTask{})
 	RegisterEvent(InitializeGet{})
 	RegisterEvent(StartGet{})
 	RegisterEvent(FinishGet{})
@@ -46,6 +47,11 @@ func init() {
 	RegisterEvent(Status{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
+	RegisterEvent(AcrossIteration{})
+	RegisterEvent(WaitingForApproval{})
+	RegisterEvent(ApprovalDecided{})
+	RegisterEvent(Superseded{})
+	RegisterEvent(ResumedStep{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
@@ -68,5 +68,11 @@ var _ = Describe("ParseEvent", func() {
 		Entry("Status", event.Status{}),
 		Entry("Log", event.Log{}),
 		Entry("Error", event.Error{}),
+		Entry("AcrossIteration", event.AcrossIteration{}),
+		Entry("WaitingForApproval", event.WaitingForApproval{}),
+		Entry("ApprovalDecided", event.ApprovalDecided{}),
+		Entry("Superseded", event.Superseded{}),
+		Entry("MemoizedTask", event.MemoizedTask{}),
+		Entry("ResumedStep", event.ResumedStep{}),
 	)
 })
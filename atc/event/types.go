@@ -18,6 +18,9 @@ const (
 	// task execution finished
 	EventTypeFinishTask atc.EventType = "finish-task"
 
+	// task skipped as it succeeded before with the same config and inputs
+	EventTypeMemoizedTask atc.EventType = "memoized-task"
+
 	// initialize getting something
 	EventTypeInitializeGet atc.EventType = "initialize-get"
 
@@ -45,6 +48,21 @@ const (
 	// finished step
 	EventTypeFinish atc.EventType = "finish"
 
+	// started an iteration of an across step
+	EventTypeAcrossIteration atc.EventType = "across-iteration"
+
+	// an approve step is waiting for someone to approve or reject the build
+	EventTypeWaitingForApproval atc.EventType = "waiting-for-approval"
+
+	// someone approved or rejected the build at an approve step
+	EventTypeApprovalDecided atc.EventType = "approval-decided"
+
+	// the build was aborted as a newer build of its job superseded it
+	EventTypeSuperseded atc.EventType = "superseded"
+
+	// step skipped as it succeeded in the build being resumed
+	EventTypeResumedStep atc.EventType = "resumed-step"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
 )
@@ -0,0 +1,271 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+//go:generate counterfeiter . AcrossDelegate
+
+type AcrossDelegate interface {
+	BuildStepDelegate
+
+	StartingIteration(lager.Logger, atc.PlanID, map[string]interface{})
+}
+
+// AcrossSubstepBuilder constructs the step to run for a single iteration of
+// an AcrossStep, using the given vars for interpolation.
+type AcrossSubstepBuilder func(atc.Plan, vars.CredVarsTracker) Step
+
+// AcrossStep runs a step once for each combination of values of its vars.
+type AcrossStep struct {
+	planID       atc.PlanID
+	plan         atc.AcrossPlan
+	delegate     AcrossDelegate
+	buildSubstep AcrossSubstepBuilder
+
+	succeeded bool
+}
+
+// Across constructs an AcrossStep.
+func Across(
+	planID atc.PlanID,
+	plan atc.AcrossPlan,
+	delegate AcrossDelegate,
+	buildSubstep AcrossSubstepBuilder,
+) *AcrossStep {
+	return &AcrossStep{
+		planID:       planID,
+		plan:         plan,
+		delegate:     delegate,
+		buildSubstep: buildSubstep,
+	}
+}
+
+type InvalidAcrossValuesError struct {
+	Var    string
+	Values interface{}
+}
+
+// Error returns a human-friendly error message.
+func (err InvalidAcrossValuesError) Error() string {
+	return fmt.Sprintf("values for across var '%s' must be a list, got %T", err.Var, err.Values)
+}
+
+// Run resolves the values of each var and then runs the step for every
+// combination of them. The vars are iterated in order, with the first var
+// being the outermost. Each var's max_in_flight limits how many of its values
+// are run at once, defaulting to 1.
+//
+// Each iteration runs in a local scope of the RunState with its own copy of
+// the step plan, whose plan IDs are suffixed with the iteration's indices so
+// that their events and results are kept apart.
+func (step *AcrossStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("across-step", lager.Data{
+		"plan-id": step.planID,
+	})
+
+	step.delegate.Initializing(logger)
+
+	acrossVars, err := step.resolveVars()
+	if err != nil {
+		return err
+	}
+
+	step.delegate.Starting(logger)
+
+	succeeded, err := step.runVar(ctx, logger, state, acrossVars, iteration{})
+	if err != nil {
+		return err
+	}
+
+	step.succeeded = succeeded
+	step.delegate.Finished(logger, step.succeeded)
+
+	return nil
+}
+
+// Succeeded is true if every iteration of the step succeeded.
+func (step *AcrossStep) Succeeded() bool {
+	return step.succeeded
+}
+
+type iteration struct {
+	values  []interface{}
+	indices []int
+}
+
+// resolveVars returns the step's vars with any var references in their values
+// replaced by the lists they refer to.
+func (step *AcrossStep) resolveVars() ([]atc.AcrossVar, error) {
+	resolved := make([]atc.AcrossVar, len(step.plan.Vars))
+
+	for i, v := range step.plan.Vars {
+		values := v.Values
+
+		if ref, ok := values.(string); ok {
+			payload, err := vars.NewTemplate([]byte(ref)).Evaluate(
+				step.delegate.Variables(),
+				vars.EvaluateOpts{ExpectAllKeys: true},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			err = yaml.Unmarshal(payload, &values)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		list, ok := values.([]interface{})
+		if !ok {
+			return nil, InvalidAcrossValuesError{Var: v.Var, Values: values}
+		}
+
+		v.Values = list
+		resolved[i] = v
+	}
+
+	return resolved, nil
+}
+
+func (step *AcrossStep) runVar(ctx context.Context, logger lager.Logger, state RunState, acrossVars []atc.AcrossVar, it iteration) (bool, error) {
+	depth := len(it.values)
+	if depth == len(acrossVars) {
+		return step.runIteration(ctx, logger, state, acrossVars, it)
+	}
+
+	acrossVar := acrossVars[depth]
+	values := acrossVar.Values.([]interface{})
+
+	limit := acrossVar.MaxInFlight
+	if limit < 1 {
+		limit = 1
+	}
+
+	type result struct {
+		succeeded bool
+		err       error
+	}
+
+	var (
+		results = make(chan result, len(values))
+		sem     = make(chan bool, limit)
+		started int
+	)
+
+	for i, value := range values {
+		sem <- true
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		next := iteration{
+			values:  append(append([]interface{}{}, it.values...), value),
+			indices: append(append([]int{}, it.indices...), i),
+		}
+
+		go func() {
+			defer func() {
+				<-sem
+			}()
+
+			succeeded, err := step.runVar(ctx, logger, state, acrossVars, next)
+			results <- result{succeeded, err}
+		}()
+		started++
+	}
+
+	succeeded := true
+	var errorMessages []string
+	for i := 0; i < started; i++ {
+		res := <-results
+		if res.err != nil && !errors.Is(res.err, context.Canceled) {
+			errorMessages = append(errorMessages, res.err.Error())
+		}
+
+		if !res.succeeded {
+			succeeded = false
+		}
+	}
+
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if len(errorMessages) > 0 {
+		return false, fmt.Errorf("one or more across steps errored:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return succeeded, nil
+}
+
+func (step *AcrossStep) runIteration(ctx context.Context, logger lager.Logger, state RunState, acrossVars []atc.AcrossVar, it iteration) (bool, error) {
+	scope := step.delegate.Variables().NewLocalScope()
+
+	iterationVars := map[string]interface{}{}
+	for i, v := range acrossVars {
+		scope.AddLocalVar(v.Var, it.values[i], false)
+		iterationVars[v.Var] = it.values[i]
+	}
+
+	plan, err := scopedPlan(step.plan.Step, it.indices)
+	if err != nil {
+		return false, err
+	}
+
+	step.delegate.StartingIteration(logger, plan.ID, iterationVars)
+
+	substep := step.buildSubstep(plan, scope)
+
+	err = substep.Run(ctx, state.NewLocalScope())
+	if err != nil {
+		return false, err
+	}
+
+	return substep.Succeeded(), nil
+}
+
+// scopedPlan returns a copy of the plan with every plan ID suffixed with the
+// given indices.
+func scopedPlan(plan atc.Plan, indices []int) (atc.Plan, error) {
+	var suffix string
+	for _, i := range indices {
+		suffix += fmt.Sprintf("/%d", i)
+	}
+
+	// plans are plain data, so a JSON round-trip makes a complete copy
+	payload, err := json.Marshal(plan)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	var scoped atc.Plan
+	err = json.Unmarshal(payload, &scoped)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	scoped.Each(func(p *atc.Plan) {
+		p.ID = atc.PlanID(string(p.ID) + suffix)
+
+		if p.Get != nil && p.Get.VersionFrom != nil {
+			versionFrom := atc.PlanID(string(*p.Get.VersionFrom) + suffix)
+			p.Get.VersionFrom = &versionFrom
+		}
+	})
+
+	return scoped, nil
+}
@@ -0,0 +1,199 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AcrossStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		plan         atc.AcrossPlan
+		fakeDelegate *execfakes.FakeAcrossDelegate
+		tracker      vars.CredVarsTracker
+
+		lock          sync.Mutex
+		builtPlans    []atc.Plan
+		builtTrackers []vars.CredVarsTracker
+		substeps      []*execfakes.FakeStep
+		substepErr    error
+		substepFails  bool
+
+		state   RunState
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		plan = atc.AcrossPlan{
+			Vars: []atc.AcrossVar{
+				{Var: "go", Values: []interface{}{"1.13", "1.14"}},
+				{Var: "os", Values: []interface{}{"linux", "darwin"}},
+			},
+			Step: atc.Plan{
+				ID:   "2",
+				Task: &atc.TaskPlan{Name: "some-task"},
+			},
+		}
+
+		tracker = vars.NewCredVarsTracker(vars.StaticVariables{}, true)
+
+		fakeDelegate = new(execfakes.FakeAcrossDelegate)
+		fakeDelegate.VariablesReturns(tracker)
+
+		builtPlans = nil
+		builtTrackers = nil
+		substeps = nil
+		substepErr = nil
+		substepFails = false
+
+		state = NewRunState()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Across("1", plan, fakeDelegate, func(p atc.Plan, t vars.CredVarsTracker) Step {
+			lock.Lock()
+			defer lock.Unlock()
+
+			substep := new(execfakes.FakeStep)
+			substep.RunReturns(substepErr)
+			substep.SucceededReturns(!substepFails)
+
+			builtPlans = append(builtPlans, p)
+			builtTrackers = append(builtTrackers, t)
+			substeps = append(substeps, substep)
+
+			return substep
+		})
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs the step for every combination of values", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(substeps).To(HaveLen(4))
+
+		for _, substep := range substeps {
+			Expect(substep.RunCallCount()).To(Equal(1))
+		}
+
+		var combinations [][]interface{}
+		for _, t := range builtTrackers {
+			goVersion, _, _ := t.Get(vars.VariableDefinition{Name: ".:go"})
+			os, _, _ := t.Get(vars.VariableDefinition{Name: ".:os"})
+			combinations = append(combinations, []interface{}{goVersion, os})
+		}
+
+		Expect(combinations).To(Equal([][]interface{}{
+			{"1.13", "linux"},
+			{"1.13", "darwin"},
+			{"1.14", "linux"},
+			{"1.14", "darwin"},
+		}))
+	})
+
+	It("does not leak the vars into the parent scope", func() {
+		_, found, _ := tracker.Get(vars.VariableDefinition{Name: ".:go"})
+		Expect(found).To(BeFalse())
+	})
+
+	It("scopes the plan IDs of each iteration", func() {
+		var ids []atc.PlanID
+		for _, p := range builtPlans {
+			ids = append(ids, p.ID)
+		}
+
+		Expect(ids).To(Equal([]atc.PlanID{"2/0/0", "2/0/1", "2/1/0", "2/1/1"}))
+	})
+
+	It("runs each iteration in a local scope", func() {
+		_, iterationState := substeps[0].RunArgsForCall(0)
+		Expect(iterationState).ToNot(Equal(state))
+	})
+
+	It("labels each iteration", func() {
+		Expect(fakeDelegate.StartingIterationCallCount()).To(Equal(4))
+
+		_, stepID, iterationVars := fakeDelegate.StartingIterationArgsForCall(1)
+		Expect(stepID).To(Equal(atc.PlanID("2/0/1")))
+		Expect(iterationVars).To(Equal(map[string]interface{}{
+			"go": "1.13",
+			"os": "darwin",
+		}))
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when the values come from a var", func() {
+		BeforeEach(func() {
+			tracker.AddLocalVar("versions", []interface{}{"1.13", "1.14", "1.15"}, false)
+
+			plan.Vars = []atc.AcrossVar{
+				{Var: "go", Values: "((.:versions))", MaxInFlight: 3},
+			}
+		})
+
+		It("runs the step for every value of the var", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(substeps).To(HaveLen(3))
+		})
+	})
+
+	Context("when the var is not a list", func() {
+		BeforeEach(func() {
+			tracker.AddLocalVar("versions", "1.13", false)
+
+			plan.Vars = []atc.AcrossVar{
+				{Var: "go", Values: "((.:versions))"},
+			}
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(Equal(InvalidAcrossValuesError{Var: "go", Values: "1.13"}))
+			Expect(substeps).To(BeEmpty())
+		})
+	})
+
+	Context("when an iteration fails", func() {
+		BeforeEach(func() {
+			substepFails = true
+		})
+
+		It("fails", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when an iteration errors", func() {
+		BeforeEach(func() {
+			substepErr = errors.New("nope")
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package exec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	// BuildMetadataDirEnv is the environment variable pointing tasks to the
+	// directory to which they may write metadata about the build.
+	BuildMetadataDirEnv = "BUILD_METADATA_DIR"
+
+	// BuildMetadataDir is the directory in task containers to which tasks may
+	// write a metadata.json file containing annotations for the build.
+	BuildMetadataDir = "/tmp/build/metadata/"
+
+	buildMetadataOutput = atc.BuildMetadataOutputName
+	buildMetadataFile   = "metadata.json"
+
+	maxAnnotationsSize       = 64 * 1024
+	maxAnnotations           = 50
+	maxAnnotationValueLength = 1024
+)
+
+var annotationKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+
+// parseAnnotations reads the annotations from a task's metadata.json, which
+// must be a flat JSON object. Numbers and booleans are kept as they were
+// written, e.g. {"coverage": 87.5} becomes "87.5".
+func parseAnnotations(r io.Reader) (map[string]string, error) {
+	payload, err := ioutil.ReadAll(io.LimitReader(r, maxAnnotationsSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payload) > maxAnnotationsSize {
+		return nil, fmt.Errorf("%s exceeds %d bytes", buildMetadataFile, maxAnnotationsSize)
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(payload, &fields)
+	if err != nil {
+		return nil, fmt.Errorf("%s must contain a JSON object: %s", buildMetadataFile, err)
+	}
+
+	if len(fields) > maxAnnotations {
+		return nil, fmt.Errorf("%s has more than %d keys", buildMetadataFile, maxAnnotations)
+	}
+
+	annotations := map[string]string{}
+	for key, raw := range fields {
+		if !annotationKeyRegex.MatchString(key) {
+			return nil, fmt.Errorf("invalid key '%s': must only contain letters, numbers, '_', '.' and '-'", key)
+		}
+
+		var value string
+		switch {
+		case bytes.HasPrefix(raw, []byte(`"`)):
+			err = json.Unmarshal(raw, &value)
+			if err != nil {
+				return nil, err
+			}
+		case bytes.HasPrefix(raw, []byte(`{`)), bytes.HasPrefix(raw, []byte(`[`)), bytes.Equal(raw, []byte(`null`)):
+			return nil, fmt.Errorf("invalid value for key '%s': must be a string, number or boolean", key)
+		default:
+			value = string(raw)
+		}
+
+		if len(value) > maxAnnotationValueLength {
+			return nil, fmt.Errorf("value for key '%s' exceeds %d characters", key, maxAnnotationValueLength)
+		}
+
+		annotations[key] = value
+	}
+
+	return annotations, nil
+}
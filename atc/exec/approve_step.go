@@ -0,0 +1,115 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// ApprovalPollInterval is how often an ApproveStep checks whether it has been
+// decided.
+const ApprovalPollInterval = 5 * time.Second
+
+//go:generate counterfeiter . ApproveDelegate
+
+type ApproveDelegate interface {
+	BuildStepDelegate
+
+	RequestApproval(lager.Logger, atc.BuildStepApproval) error
+	Approval(lager.Logger) (atc.BuildStepApproval, bool, error)
+	ApprovalDecided(lager.Logger, atc.BuildStepApproval)
+}
+
+// ApproveStep waits for someone to approve or reject the build, succeeding
+// if they approve it.
+type ApproveStep struct {
+	planID    atc.PlanID
+	plan      atc.ApprovePlan
+	delegate  ApproveDelegate
+	clock     clock.Clock
+	succeeded bool
+}
+
+func NewApproveStep(
+	planID atc.PlanID,
+	plan atc.ApprovePlan,
+	delegate ApproveDelegate,
+	clock clock.Clock,
+) Step {
+	return &ApproveStep{
+		planID:   planID,
+		plan:     plan,
+		delegate: delegate,
+		clock:    clock,
+	}
+}
+
+type ApprovalNotFoundError struct {
+	Name string
+}
+
+// Error returns a human-friendly error message.
+func (err ApprovalNotFoundError) Error() string {
+	return fmt.Sprintf("approval for step '%s' disappeared", err.Name)
+}
+
+// Run requests the approval and then polls for its decision until the build
+// is aborted or the step times out.
+func (step *ApproveStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("approve-step", lager.Data{
+		"plan-id":   step.planID,
+		"step-name": step.plan.Name,
+	})
+
+	step.delegate.Initializing(logger)
+
+	err := step.delegate.RequestApproval(logger, atc.BuildStepApproval{
+		PlanID:  step.planID,
+		Name:    step.plan.Name,
+		Message: step.plan.Message,
+		Roles:   step.plan.Roles,
+		Users:   step.plan.Users,
+	})
+	if err != nil {
+		return err
+	}
+
+	step.delegate.Starting(logger)
+
+	ticker := step.clock.NewTicker(ApprovalPollInterval)
+	defer ticker.Stop()
+
+	for {
+		approval, found, err := step.delegate.Approval(logger)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return ApprovalNotFoundError{Name: step.plan.Name}
+		}
+
+		if !approval.Pending() {
+			step.succeeded = *approval.Approved
+			step.delegate.ApprovalDecided(logger, approval)
+			step.delegate.Finished(logger, step.succeeded)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
+
+func (step *ApproveStep) Succeeded() bool {
+	return step.succeeded
+}
@@ -0,0 +1,171 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApproveStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate *execfakes.FakeApproveDelegate
+		fakeClock    *fakeclock.FakeClock
+		state        *execfakes.FakeRunState
+
+		approvePlan atc.ApprovePlan
+
+		step    exec.Step
+		stepErr error
+		done    chan struct{}
+
+		approved = true
+		rejected = false
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("approve-step-test"))
+
+		fakeDelegate = new(execfakes.FakeApproveDelegate)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+		state = new(execfakes.FakeRunState)
+
+		approvePlan = atc.ApprovePlan{
+			Name:    "ship-it",
+			Message: "ready?",
+			Roles:   []string{"owner"},
+			Users:   []string{"some-user"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewApproveStep(atc.PlanID("42"), approvePlan, fakeDelegate, fakeClock)
+
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			stepErr = step.Run(ctx, state)
+		}()
+	})
+
+	It("requests the approval", func() {
+		Eventually(fakeDelegate.RequestApprovalCallCount).Should(Equal(1))
+
+		_, approval := fakeDelegate.RequestApprovalArgsForCall(0)
+		Expect(approval).To(Equal(atc.BuildStepApproval{
+			PlanID:  "42",
+			Name:    "ship-it",
+			Message: "ready?",
+			Roles:   []string{"owner"},
+			Users:   []string{"some-user"},
+		}))
+
+		Eventually(fakeDelegate.StartingCallCount).Should(Equal(1))
+	})
+
+	Context("when requesting the approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeDelegate.RequestApprovalReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Eventually(done).Should(BeClosed())
+			Expect(stepErr).To(Equal(disaster))
+			Expect(fakeDelegate.StartingCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("while the approval is pending", func() {
+		BeforeEach(func() {
+			fakeDelegate.ApprovalReturns(atc.BuildStepApproval{Name: "ship-it"}, true, nil)
+		})
+
+		It("polls until the build is aborted", func() {
+			Eventually(fakeDelegate.ApprovalCallCount).Should(Equal(1))
+
+			fakeClock.WaitForWatcherAndIncrement(exec.ApprovalPollInterval)
+			Eventually(fakeDelegate.ApprovalCallCount).Should(Equal(2))
+			Consistently(done).ShouldNot(BeClosed())
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+			Expect(stepErr).To(Equal(context.Canceled))
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
+		})
+
+		Context("when it is approved", func() {
+			It("succeeds", func() {
+				Eventually(fakeDelegate.ApprovalCallCount).Should(Equal(1))
+
+				fakeDelegate.ApprovalReturns(atc.BuildStepApproval{
+					Name:      "ship-it",
+					Approved:  &approved,
+					DecidedBy: "some-user",
+				}, true, nil)
+				fakeClock.WaitForWatcherAndIncrement(exec.ApprovalPollInterval)
+
+				Eventually(done).Should(BeClosed())
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(step.Succeeded()).To(BeTrue())
+
+				Expect(fakeDelegate.ApprovalDecidedCallCount()).To(Equal(1))
+				_, decided := fakeDelegate.ApprovalDecidedArgsForCall(0)
+				Expect(decided.DecidedBy).To(Equal("some-user"))
+
+				Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+				_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+				Expect(succeeded).To(BeTrue())
+			})
+		})
+
+		Context("when it is rejected", func() {
+			It("fails", func() {
+				Eventually(fakeDelegate.ApprovalCallCount).Should(Equal(1))
+
+				fakeDelegate.ApprovalReturns(atc.BuildStepApproval{
+					Name:     "ship-it",
+					Approved: &rejected,
+				}, true, nil)
+				fakeClock.WaitForWatcherAndIncrement(exec.ApprovalPollInterval)
+
+				Eventually(done).Should(BeClosed())
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(step.Succeeded()).To(BeFalse())
+
+				_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+				Expect(succeeded).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when the approval cannot be found", func() {
+		BeforeEach(func() {
+			fakeDelegate.ApprovalReturns(atc.BuildStepApproval{}, false, nil)
+		})
+
+		It("returns an error", func() {
+			Eventually(done).Should(BeClosed())
+			Expect(stepErr).To(Equal(exec.ApprovalNotFoundError{Name: "ship-it"}))
+		})
+	})
+})
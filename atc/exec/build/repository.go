@@ -16,11 +16,13 @@ type ArtifactName string
 // configured for a Task step).
 //
 // There is only one ArtifactRepository for the duration of a build plan's
-// execution.
+// execution, though steps may run in a local scope created from it.
 //
 type Repository struct {
 	repo  map[ArtifactName]runtime.Artifact
 	repoL sync.RWMutex
+
+	parent *Repository
 }
 
 // NewArtifactRepository constructs a new repository.
@@ -30,6 +32,15 @@ func NewRepository() *Repository {
 	}
 }
 
+// NewLocalScope constructs a repository whose artifacts shadow those of this
+// repository. Artifacts registered in the local scope are not visible to the
+// parent.
+func (repo *Repository) NewLocalScope() *Repository {
+	child := NewRepository()
+	child.parent = repo
+	return child
+}
+
 //go:generate counterfeiter . RegisterableArtifact
 // A RegisterableArtifact is an Artifact which can be added to the registry
 type RegisterableArtifact interface {
@@ -51,6 +62,11 @@ func (repo *Repository) ArtifactFor(name ArtifactName) (runtime.Artifact, bool)
 	repo.repoL.RLock()
 	artifact, found := repo.repo[name]
 	repo.repoL.RUnlock()
+
+	if !found && repo.parent != nil {
+		return repo.parent.ArtifactFor(name)
+	}
+
 	return artifact, found
 }
 
@@ -60,6 +76,24 @@ func (repo *Repository) ArtifactFor(name ArtifactName) (runtime.Artifact, bool)
 func (repo *Repository) AsMap() map[ArtifactName]runtime.Artifact {
 	result := make(map[ArtifactName]runtime.Artifact)
 
+	if repo.parent != nil {
+		result = repo.parent.AsMap()
+	}
+
+	repo.repoL.RLock()
+	for name, artifact := range repo.repo {
+		result[name] = artifact
+	}
+	repo.repoL.RUnlock()
+
+	return result
+}
+
+// LocalMap is like AsMap, but only includes the artifacts registered in this
+// scope and not those of its parent.
+func (repo *Repository) LocalMap() map[ArtifactName]runtime.Artifact {
+	result := make(map[ArtifactName]runtime.Artifact)
+
 	repo.repoL.RLock()
 	for name, artifact := range repo.repo {
 		result[name] = artifact
@@ -2,6 +2,7 @@ package build_test
 
 import (
 	. "github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 
 	. "github.com/onsi/ginkgo"
@@ -77,5 +78,43 @@ var _ = Describe("ArtifactRepository", func() {
 			})
 		})
 
+		Describe("NewLocalScope", func() {
+			var (
+				child         *Repository
+				childArtifact *runtimefakes.FakeArtifact
+			)
+
+			BeforeEach(func() {
+				child = repo.NewLocalScope()
+
+				childArtifact = new(runtimefakes.FakeArtifact)
+				childArtifact.IDReturns("some-child")
+				child.RegisterArtifact("child-artifact", childArtifact)
+			})
+
+			It("yields artifacts from the parent", func() {
+				actualArtifact, found := child.ArtifactFor("first-artifact")
+				Expect(actualArtifact).To(Equal(firstArtifact))
+				Expect(found).To(BeTrue())
+			})
+
+			It("does not register artifacts with the parent", func() {
+				_, found := repo.ArtifactFor("child-artifact")
+				Expect(found).To(BeFalse())
+			})
+
+			It("includes the parent's artifacts in AsMap", func() {
+				Expect(child.AsMap()).To(Equal(map[ArtifactName]runtime.Artifact{
+					"first-artifact": firstArtifact,
+					"child-artifact": childArtifact,
+				}))
+			})
+
+			It("includes only its own artifacts in LocalMap", func() {
+				Expect(child.LocalMap()).To(Equal(map[ArtifactName]runtime.Artifact{
+					"child-artifact": childArtifact,
+				}))
+			})
+		})
 	})
 })
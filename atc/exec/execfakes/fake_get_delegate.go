@@ -43,6 +43,12 @@ type FakeGetDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	ResumedStub        func(lager.Logger, db.BuildStepOutputs)
+	resumedMutex       sync.RWMutex
+	resumedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.BuildStepOutputs
+	}
 	StartingStub        func(lager.Logger)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -245,6 +251,38 @@ func (fake *FakeGetDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGetDelegate) Resumed(arg1 lager.Logger, arg2 db.BuildStepOutputs) {
+	fake.resumedMutex.Lock()
+	fake.resumedArgsForCall = append(fake.resumedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.BuildStepOutputs
+	}{arg1, arg2})
+	fake.recordInvocation("Resumed", []interface{}{arg1, arg2})
+	fake.resumedMutex.Unlock()
+	if fake.ResumedStub != nil {
+		fake.ResumedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeGetDelegate) ResumedCallCount() int {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	return len(fake.resumedArgsForCall)
+}
+
+func (fake *FakeGetDelegate) ResumedCalls(stub func(lager.Logger, db.BuildStepOutputs)) {
+	fake.resumedMutex.Lock()
+	defer fake.resumedMutex.Unlock()
+	fake.ResumedStub = stub
+}
+
+func (fake *FakeGetDelegate) ResumedArgsForCall(i int) (lager.Logger, db.BuildStepOutputs) {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	argsForCall := fake.resumedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeGetDelegate) Starting(arg1 lager.Logger) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -476,6 +514,8 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
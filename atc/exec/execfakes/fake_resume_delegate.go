@@ -0,0 +1,79 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package execfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type FakeResumeDelegate struct {
+	ResumedStub        func(lager.Logger, db.BuildStepOutputs)
+	resumedMutex       sync.RWMutex
+	resumedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.BuildStepOutputs
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResumeDelegate) Resumed(arg1 lager.Logger, arg2 db.BuildStepOutputs) {
+	fake.resumedMutex.Lock()
+	fake.resumedArgsForCall = append(fake.resumedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.BuildStepOutputs
+	}{arg1, arg2})
+	fake.recordInvocation("Resumed", []interface{}{arg1, arg2})
+	fake.resumedMutex.Unlock()
+	if fake.ResumedStub != nil {
+		fake.ResumedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeResumeDelegate) ResumedCallCount() int {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	return len(fake.resumedArgsForCall)
+}
+
+func (fake *FakeResumeDelegate) ResumedCalls(stub func(lager.Logger, db.BuildStepOutputs)) {
+	fake.resumedMutex.Lock()
+	defer fake.resumedMutex.Unlock()
+	fake.ResumedStub = stub
+}
+
+func (fake *FakeResumeDelegate) ResumedArgsForCall(i int) (lager.Logger, db.BuildStepOutputs) {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	argsForCall := fake.resumedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResumeDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResumeDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.ResumeDelegate = new(FakeResumeDelegate)
@@ -41,6 +41,42 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	MemoizedStub        func(lager.Logger, db.TaskMemo)
+	memoizedMutex       sync.RWMutex
+	memoizedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.TaskMemo
+	}
+	ResumedStub        func(lager.Logger, db.BuildStepOutputs)
+	resumedMutex       sync.RWMutex
+	resumedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.BuildStepOutputs
+	}
+	SaveAnnotationsStub        func(lager.Logger, map[string]string) error
+	saveAnnotationsMutex       sync.RWMutex
+	saveAnnotationsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 map[string]string
+	}
+	saveAnnotationsReturns struct {
+		result1 error
+	}
+	saveAnnotationsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTestResultsStub        func(lager.Logger, []atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetTaskConfigStub        func(atc.TaskConfig)
 	setTaskConfigMutex       sync.RWMutex
 	setTaskConfigArgsForCall []struct {
@@ -240,6 +276,197 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) Memoized(arg1 lager.Logger, arg2 db.TaskMemo) {
+	fake.memoizedMutex.Lock()
+	fake.memoizedArgsForCall = append(fake.memoizedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.TaskMemo
+	}{arg1, arg2})
+	fake.recordInvocation("Memoized", []interface{}{arg1, arg2})
+	fake.memoizedMutex.Unlock()
+	if fake.MemoizedStub != nil {
+		fake.MemoizedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) MemoizedCallCount() int {
+	fake.memoizedMutex.RLock()
+	defer fake.memoizedMutex.RUnlock()
+	return len(fake.memoizedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) MemoizedCalls(stub func(lager.Logger, db.TaskMemo)) {
+	fake.memoizedMutex.Lock()
+	defer fake.memoizedMutex.Unlock()
+	fake.MemoizedStub = stub
+}
+
+func (fake *FakeTaskDelegate) MemoizedArgsForCall(i int) (lager.Logger, db.TaskMemo) {
+	fake.memoizedMutex.RLock()
+	defer fake.memoizedMutex.RUnlock()
+	argsForCall := fake.memoizedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) Resumed(arg1 lager.Logger, arg2 db.BuildStepOutputs) {
+	fake.resumedMutex.Lock()
+	fake.resumedArgsForCall = append(fake.resumedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.BuildStepOutputs
+	}{arg1, arg2})
+	fake.recordInvocation("Resumed", []interface{}{arg1, arg2})
+	fake.resumedMutex.Unlock()
+	if fake.ResumedStub != nil {
+		fake.ResumedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) ResumedCallCount() int {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	return len(fake.resumedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ResumedCalls(stub func(lager.Logger, db.BuildStepOutputs)) {
+	fake.resumedMutex.Lock()
+	defer fake.resumedMutex.Unlock()
+	fake.ResumedStub = stub
+}
+
+func (fake *FakeTaskDelegate) ResumedArgsForCall(i int) (lager.Logger, db.BuildStepOutputs) {
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	argsForCall := fake.resumedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) SaveAnnotations(arg1 lager.Logger, arg2 map[string]string) error {
+	fake.saveAnnotationsMutex.Lock()
+	ret, specificReturn := fake.saveAnnotationsReturnsOnCall[len(fake.saveAnnotationsArgsForCall)]
+	fake.saveAnnotationsArgsForCall = append(fake.saveAnnotationsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 map[string]string
+	}{arg1, arg2})
+	fake.recordInvocation("SaveAnnotations", []interface{}{arg1, arg2})
+	fake.saveAnnotationsMutex.Unlock()
+	if fake.SaveAnnotationsStub != nil {
+		return fake.SaveAnnotationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveAnnotationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) SaveAnnotationsCallCount() int {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	return len(fake.saveAnnotationsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveAnnotationsCalls(stub func(lager.Logger, map[string]string) error) {
+	fake.saveAnnotationsMutex.Lock()
+	defer fake.saveAnnotationsMutex.Unlock()
+	fake.SaveAnnotationsStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveAnnotationsArgsForCall(i int) (lager.Logger, map[string]string) {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	argsForCall := fake.saveAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) SaveAnnotationsReturns(result1 error) {
+	fake.saveAnnotationsMutex.Lock()
+	defer fake.saveAnnotationsMutex.Unlock()
+	fake.SaveAnnotationsStub = nil
+	fake.saveAnnotationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveAnnotationsReturnsOnCall(i int, result1 error) {
+	fake.saveAnnotationsMutex.Lock()
+	defer fake.saveAnnotationsMutex.Unlock()
+	fake.SaveAnnotationsStub = nil
+	if fake.saveAnnotationsReturnsOnCall == nil {
+		fake.saveAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAnnotationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveTestResults(arg1 lager.Logger, arg2 []atc.TestResult) error {
+	var arg2Copy []atc.TestResult
+	if arg2 != nil {
+		arg2Copy = make([]atc.TestResult, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 []atc.TestResult
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1, arg2Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if fake.SaveTestResultsStub != nil {
+		return fake.SaveTestResultsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveTestResultsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsCalls(stub func(lager.Logger, []atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsArgsForCall(i int) (lager.Logger, []atc.TestResult) {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) SetTaskConfig(arg1 atc.TaskConfig) {
 	fake.setTaskConfigMutex.Lock()
 	fake.setTaskConfigArgsForCall = append(fake.setTaskConfigArgsForCall, struct {
@@ -469,6 +696,14 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.memoizedMutex.RLock()
+	defer fake.memoizedMutex.RUnlock()
+	fake.resumedMutex.RLock()
+	defer fake.resumedMutex.RUnlock()
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
 	defer fake.setTaskConfigMutex.RUnlock()
 	fake.startingMutex.RLock()
@@ -47,6 +47,7 @@ type GetDelegate interface {
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
+	Resumed(lager.Logger, db.BuildStepOutputs)
 	Errored(lager.Logger, string)
 
 	UpdateVersion(lager.Logger, atc.GetPlan, runtime.VersionResult)
@@ -173,8 +174,8 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 	processSpec := runtime.ProcessSpec{
 		Path:         "/opt/resource/in",
 		Args:         []string{resource.ResourcesDir("get")},
-		StdoutWriter: step.delegate.Stdout(),
-		StderrWriter: step.delegate.Stderr(),
+		StdoutWriter: IdleWriter(ctx, step.delegate.Stdout()),
+		StderrWriter: IdleWriter(ctx, step.delegate.Stderr()),
 	}
 
 	resourceToGet := step.resourceFactory.NewResource(
@@ -195,7 +196,7 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 		step.containerMetadata,
 		imageSpec,
 		processSpec,
-		step.delegate,
+		IdleStarting(ctx, step.delegate),
 		resourceCache,
 		resourceToGet,
 	)
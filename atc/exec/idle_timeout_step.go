@@ -0,0 +1,191 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// ErrIdleTimeout is returned by an IdleTimeoutStep when its nested step was
+// interrupted for not producing any output.
+var ErrIdleTimeout = errors.New("idle timeout exceeded")
+
+// IdleTimeoutStep interrupts a step which has not written anything to the
+// writers wrapped with IdleWriter for a given duration after its process
+// started.
+type IdleTimeoutStep struct {
+	step     Step
+	duration string
+}
+
+// IdleTimeout constructs an IdleTimeoutStep.
+func IdleTimeout(step Step, duration string) *IdleTimeoutStep {
+	return &IdleTimeoutStep{
+		step:     step,
+		duration: duration,
+	}
+}
+
+// Run parses the idle timeout duration and invokes the nested step.
+//
+// The idle timer is armed once the nested step's process starts, as reported
+// to a delegate wrapped with IdleStarting, so that choosing a worker, fetching
+// the image, creating the container and streaming inputs do not count as idle
+// time. A process which was already running and is re-attached to is only
+// watched from its first write.
+//
+// Every write to an IdleWriter made within the nested step resets the idle
+// timer. If the timer fires, the nested step is interrupted and ErrIdleTimeout
+// is returned once it exits, regardless of the nested step's error.
+func (ts *IdleTimeoutStep) Run(ctx context.Context, state RunState) error {
+	parsedDuration, err := time.ParseDuration(ts.duration)
+	if err != nil {
+		return err
+	}
+
+	idleCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watcher := &idleWatcher{
+		started:  make(chan struct{}),
+		activity: make(chan struct{}, 1),
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go watcher.watch(parsedDuration, cancel, done)
+
+	err = ts.step.Run(context.WithValue(idleCtx, idleWatcherKey{}, watcher), state)
+	if watcher.timedOut() {
+		return ErrIdleTimeout
+	}
+
+	return err
+}
+
+// Succeeded is true if the nested step completed successfully.
+func (ts *IdleTimeoutStep) Succeeded() bool {
+	return ts.step.Succeeded()
+}
+
+// IdleWriter wraps a writer given to a step's process so that writing to it
+// counts as activity for the enclosing IdleTimeoutStep, if there is one.
+func IdleWriter(ctx context.Context, writer io.Writer) io.Writer {
+	watcher, ok := ctx.Value(idleWatcherKey{}).(*idleWatcher)
+	if !ok {
+		return writer
+	}
+
+	return idleWriter{
+		Writer:  writer,
+		watcher: watcher,
+	}
+}
+
+// IdleStarting wraps the delegate notified when a step's process starts so
+// that the enclosing IdleTimeoutStep, if there is one, arms its idle timer.
+func IdleStarting(ctx context.Context, delegate runtime.StartingEventDelegate) runtime.StartingEventDelegate {
+	watcher, ok := ctx.Value(idleWatcherKey{}).(*idleWatcher)
+	if !ok {
+		return delegate
+	}
+
+	return idleStartingDelegate{
+		StartingEventDelegate: delegate,
+		watcher:               watcher,
+	}
+}
+
+type idleWatcherKey struct{}
+
+type idleWatcher struct {
+	started   chan struct{}
+	startOnce sync.Once
+
+	activity chan struct{}
+
+	expired  bool
+	expiredL sync.Mutex
+}
+
+func (watcher *idleWatcher) start() {
+	watcher.startOnce.Do(func() {
+		close(watcher.started)
+	})
+}
+
+func (watcher *idleWatcher) touch() {
+	watcher.start()
+
+	select {
+	case watcher.activity <- struct{}{}:
+	default:
+	}
+}
+
+func (watcher *idleWatcher) watch(duration time.Duration, cancel func(), done <-chan struct{}) {
+	select {
+	case <-watcher.started:
+	case <-done:
+		return
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-watcher.activity:
+			if !timer.Stop() {
+				<-timer.C
+			}
+
+			timer.Reset(duration)
+
+		case <-timer.C:
+			watcher.expiredL.Lock()
+			watcher.expired = true
+			watcher.expiredL.Unlock()
+
+			cancel()
+			return
+
+		case <-done:
+			return
+		}
+	}
+}
+
+func (watcher *idleWatcher) timedOut() bool {
+	watcher.expiredL.Lock()
+	defer watcher.expiredL.Unlock()
+	return watcher.expired
+}
+
+type idleWriter struct {
+	io.Writer
+
+	watcher *idleWatcher
+}
+
+func (writer idleWriter) Write(p []byte) (int, error) {
+	writer.watcher.touch()
+	return writer.Writer.Write(p)
+}
+
+type idleStartingDelegate struct {
+	runtime.StartingEventDelegate
+
+	watcher *idleWatcher
+}
+
+func (delegate idleStartingDelegate) Starting(logger lager.Logger) {
+	delegate.StartingEventDelegate.Starting(logger)
+	delegate.watcher.start()
+}
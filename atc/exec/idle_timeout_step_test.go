@@ -0,0 +1,210 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime/runtimefakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("IdleTimeout Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStep     *execfakes.FakeStep
+		fakeDelegate *runtimefakes.FakeStartingEventDelegate
+
+		repo  *build.Repository
+		state *execfakes.FakeRunState
+
+		step Step
+
+		idleTimeoutDuration string
+
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeDelegate = new(runtimefakes.FakeStartingEventDelegate)
+
+		repo = build.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactRepositoryReturns(repo)
+
+		idleTimeoutDuration = "100ms"
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = IdleTimeout(fakeStep, idleTimeoutDuration)
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the step writes output within the duration", func() {
+		var output *gbytes.Buffer
+
+		BeforeEach(func() {
+			output = gbytes.NewBuffer()
+
+			fakeStep.RunStub = func(ctx context.Context, state RunState) error {
+				stdout := IdleWriter(ctx, output)
+
+				for i := 0; i < 5; i++ {
+					time.Sleep(50 * time.Millisecond)
+					fmt.Fprintln(stdout, "still alive")
+
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+				}
+
+				return nil
+			}
+
+			fakeStep.SucceededReturns(true)
+		})
+
+		It("runs the step to completion", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		It("passes the output through", func() {
+			Expect(output.Contents()).To(ContainSubstring("still alive"))
+		})
+	})
+
+	Context("when the step does not write output within the duration", func() {
+		BeforeEach(func() {
+			fakeStep.RunStub = func(ctx context.Context, state RunState) error {
+				IdleStarting(ctx, fakeDelegate).Starting(lagertest.NewTestLogger("test"))
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(10 * time.Second):
+					return nil
+				}
+			}
+		})
+
+		It("interrupts the step and returns ErrIdleTimeout", func() {
+			Expect(stepErr).To(Equal(ErrIdleTimeout))
+		})
+
+		It("is not successful", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		It("notifies the wrapped delegate that the process is starting", func() {
+			Expect(fakeDelegate.StartingCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the step takes longer than the duration to start its process", func() {
+		BeforeEach(func() {
+			fakeStep.RunStub = func(ctx context.Context, state RunState) error {
+				// choosing a worker, fetching the image, creating the container...
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(300 * time.Millisecond):
+				}
+
+				IdleStarting(ctx, fakeDelegate).Starting(lagertest.NewTestLogger("test"))
+
+				stdout := IdleWriter(ctx, gbytes.NewBuffer())
+				for i := 0; i < 3; i++ {
+					time.Sleep(50 * time.Millisecond)
+					fmt.Fprintln(stdout, "still alive")
+
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+				}
+
+				return nil
+			}
+
+			fakeStep.SucceededReturns(true)
+		})
+
+		It("does not count the setup as idle time", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when the started process does not write output within the duration", func() {
+		BeforeEach(func() {
+			fakeStep.RunStub = func(ctx context.Context, state RunState) error {
+				time.Sleep(300 * time.Millisecond)
+
+				IdleStarting(ctx, fakeDelegate).Starting(lagertest.NewTestLogger("test"))
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(10 * time.Second):
+					return nil
+				}
+			}
+		})
+
+		It("interrupts the step and returns ErrIdleTimeout", func() {
+			Expect(stepErr).To(Equal(ErrIdleTimeout))
+		})
+	})
+
+	Context("when the step returns an error", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeStep.RunReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+	})
+
+	Context("when the duration is invalid", func() {
+		BeforeEach(func() {
+			idleTimeoutDuration = "nope"
+		})
+
+		It("errors immediately without running the step", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("IdleWriter", func() {
+		It("returns the writer as-is outside of an idle timeout step", func() {
+			output := gbytes.NewBuffer()
+			Expect(IdleWriter(context.Background(), output)).To(BeIdenticalTo(output))
+		})
+	})
+
+	Describe("IdleStarting", func() {
+		It("returns the delegate as-is outside of an idle timeout step", func() {
+			Expect(IdleStarting(context.Background(), fakeDelegate)).To(BeIdenticalTo(fakeDelegate))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 
 const AbortedLogMessage = "interrupted"
 const TimeoutLogMessage = "timeout exceeded"
+const IdleTimeoutLogMessage = "idle timeout exceeded"
 
 type LogErrorStepDelegate interface {
 	Errored(lager.Logger, string)
@@ -41,6 +42,8 @@ func (step LogErrorStep) Run(ctx context.Context, state RunState) error {
 		message = AbortedLogMessage
 	case context.DeadlineExceeded:
 		message = TimeoutLogMessage
+	case ErrIdleTimeout:
+		message = IdleTimeoutLogMessage
 	default:
 		message = runErr.Error()
 	}
@@ -95,6 +95,22 @@ var _ = Describe("LogErrorStep", func() {
 			})
 		})
 
+		Context("when idle for too long", func() {
+			BeforeEach(func() {
+				fakeStep.RunReturns(ErrIdleTimeout)
+			})
+
+			It("propagates the error", func() {
+				Expect(runErr).To(Equal(ErrIdleTimeout))
+			})
+
+			It("logs 'idle timeout exceeded'", func() {
+				Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+				_, message := fakeDelegate.ErroredArgsForCall(0)
+				Expect(message).To(Equal("idle timeout exceeded"))
+			})
+		})
+
 		Context("when the inner step returns any other error", func() {
 			disaster := errors.New("disaster")
 
@@ -0,0 +1,33 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc/policy"
+)
+
+// checkPolicy asks the policy checker whether the step may perform the given
+// action. It returns a policy.PolicyCheckNotPassedError if the policy denies
+// it, and does nothing if no checker is configured or the action is not
+// subject to policy checks.
+func checkPolicy(checker policy.Checker, metadata StepMetadata, action string, data interface{}) error {
+	if checker == nil || !checker.ShouldCheckAction(action) {
+		return nil
+	}
+
+	result, err := checker.Check(policy.PolicyCheckInput{
+		Action:   action,
+		Team:     metadata.TeamName,
+		Pipeline: metadata.PipelineName,
+		Data:     data,
+	})
+	if err != nil {
+		return fmt.Errorf("policy check error: %w", err)
+	}
+
+	if !result.Allowed {
+		return policy.PolicyCheckNotPassedError{Reasons: result.Reasons}
+	}
+
+	return nil
+}
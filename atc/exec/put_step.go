@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
@@ -46,6 +47,7 @@ type PutStep struct {
 	strategy              worker.ContainerPlacementStrategy
 	workerClient          worker.Client
 	delegate              PutDelegate
+	policyChecker         policy.Checker
 	succeeded             bool
 }
 
@@ -59,6 +61,7 @@ func NewPutStep(
 	strategy worker.ContainerPlacementStrategy,
 	workerClient worker.Client,
 	delegate PutDelegate,
+	policyChecker policy.Checker,
 ) *PutStep {
 	return &PutStep{
 		planID:                planID,
@@ -70,6 +73,7 @@ func NewPutStep(
 		workerClient:          workerClient,
 		strategy:              strategy,
 		delegate:              delegate,
+		policyChecker:         policyChecker,
 	}
 }
 
@@ -143,6 +147,16 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	err = checkPolicy(step.policyChecker, step.metadata, policy.ActionRunPut, map[string]interface{}{
+		"resource": step.plan.Resource,
+		"type":     step.plan.Type,
+		"tags":     step.plan.Tags,
+		"params":   step.plan.Params,
+	})
+	if err != nil {
+		return err
+	}
+
 	containerSpec := worker.ContainerSpec{
 		ImageSpec: worker.ImageSpec{
 			ResourceType: step.plan.Type,
@@ -178,8 +192,8 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 	processSpec := runtime.ProcessSpec{
 		Path:         "/opt/resource/out",
 		Args:         []string{resource.ResourcesDir("put")},
-		StdoutWriter: step.delegate.Stdout(),
-		StderrWriter: step.delegate.Stderr(),
+		StdoutWriter: IdleWriter(ctx, step.delegate.Stdout()),
+		StderrWriter: IdleWriter(ctx, step.delegate.Stderr()),
 	}
 
 	resourceToPut := step.resourceFactory.NewResource(source, params, nil)
@@ -194,7 +208,7 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 		step.containerMetadata,
 		imageSpec,
 		processSpec,
-		step.delegate,
+		IdleStarting(ctx, step.delegate),
 		resourceToPut,
 	)
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/runtime"
@@ -35,6 +37,7 @@ var _ = Describe("PutStep", func() {
 		fakeResource              *resourcefakes.FakeResource
 		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
 		fakeDelegate              *execfakes.FakePutDelegate
+		fakePolicyChecker         *policyfakes.FakeChecker
 		putPlan                   *atc.PutPlan
 
 		fakeArtifact        *runtimefakes.FakeArtifact
@@ -90,6 +93,8 @@ var _ = Describe("PutStep", func() {
 		credVars := vars.StaticVariables{"custom-param": "source", "source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
 
+		fakePolicyChecker = new(policyfakes.FakeChecker)
+
 		fakeDelegate = new(execfakes.FakePutDelegate)
 		stdoutBuf = gbytes.NewBuffer()
 		stderrBuf = gbytes.NewBuffer()
@@ -180,6 +185,7 @@ var _ = Describe("PutStep", func() {
 			fakeStrategy,
 			fakeClient,
 			fakeDelegate,
+			fakePolicyChecker,
 		)
 
 		stepErr = putStep.Run(ctx, state)
@@ -437,4 +443,52 @@ var _ = Describe("PutStep", func() {
 			Expect(putStep.Succeeded()).To(BeFalse())
 		})
 	})
+
+	Context("when the put is subject to policy checks", func() {
+		BeforeEach(func() {
+			fakePolicyChecker.ShouldCheckActionReturns(true)
+		})
+
+		It("checks the put before running it", func() {
+			Expect(fakePolicyChecker.ShouldCheckActionArgsForCall(0)).To(Equal(policy.ActionRunPut))
+			Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+			input := fakePolicyChecker.CheckArgsForCall(0)
+			Expect(input.Action).To(Equal(policy.ActionRunPut))
+			Expect(input.Data).To(Equal(map[string]interface{}{
+				"resource": "some-resource",
+				"type":     "some-resource-type",
+				"tags":     atc.Tags{"some", "tags"},
+				"params":   atc.Params{"some-param": "some-value"},
+			}))
+		})
+
+		Context("when the policy denies it", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+					Allowed: false,
+					Reasons: []string{"no puts on fridays"},
+				}, nil)
+			})
+
+			It("returns a policy check error", func() {
+				Expect(stepErr).To(Equal(policy.PolicyCheckNotPassedError{Reasons: []string{"no puts on fridays"}}))
+			})
+
+			It("does not run the put", func() {
+				Expect(fakeClient.RunPutStepCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the policy allows it", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+				clientErr = nil
+			})
+
+			It("runs the put", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(fakeClient.RunPutStepCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -0,0 +1,105 @@
+package exec
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+//go:generate counterfeiter . ResumeDelegate
+
+type ResumeDelegate interface {
+	Resumed(lager.Logger, db.BuildStepOutputs)
+}
+
+// ResumeStep records the artifacts produced by the step it wraps once the
+// step succeeds. When the build resumes a build in which the step succeeded,
+// the step is skipped and the artifacts it produced there are registered
+// instead.
+type ResumeStep struct {
+	step     Step
+	stepKey  string
+	build    db.Build
+	delegate ResumeDelegate
+
+	succeeded bool
+}
+
+// Resume constructs a ResumeStep. The step key must identify the step across
+// builds of the same job, i.e. it must not depend on the plan ID.
+func Resume(step Step, stepKey string, build db.Build, delegate ResumeDelegate) Step {
+	return &ResumeStep{
+		step:     step,
+		stepKey:  stepKey,
+		build:    build,
+		delegate: delegate,
+	}
+}
+
+func (step *ResumeStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("resume", lager.Data{
+		"step-key": step.stepKey,
+	})
+
+	resumed, found, err := step.build.ResumedStepOutputs(step.stepKey)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		for name, handle := range resumed.Outputs {
+			state.ArtifactRepository().RegisterArtifact(build.ArtifactName(name), &runtime.TaskArtifact{
+				VolumeHandle: handle,
+			})
+		}
+
+		// carry the outputs over so that this build can be resumed in turn
+		step.saveOutputs(logger, resumed.Outputs)
+
+		step.delegate.Resumed(logger, resumed)
+
+		step.succeeded = true
+
+		return nil
+	}
+
+	// run the step in its own scope to tell which artifacts it produced
+	scope := state.NewLocalScope()
+
+	runErr := step.step.Run(ctx, scope)
+
+	outputs := map[string]string{}
+	for name, artifact := range scope.ArtifactRepository().LocalMap() {
+		state.ArtifactRepository().RegisterArtifact(name, artifact)
+		outputs[string(name)] = artifact.ID()
+	}
+
+	if runErr != nil {
+		return runErr
+	}
+
+	step.succeeded = step.step.Succeeded()
+
+	if step.succeeded {
+		step.saveOutputs(logger, outputs)
+	}
+
+	return nil
+}
+
+func (step *ResumeStep) Succeeded() bool {
+	return step.succeeded
+}
+
+// saveOutputs only logs errors, as failing to save the outputs merely means
+// the step will not be skipped when resuming the build.
+func (step *ResumeStep) saveOutputs(logger lager.Logger, outputs map[string]string) {
+	err := step.build.SaveStepOutputs(step.stepKey, outputs)
+	if err != nil {
+		logger.Error("failed-to-save-step-outputs", err)
+	}
+}
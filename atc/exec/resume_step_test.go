@@ -0,0 +1,183 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResumeStep", func() {
+	var (
+		ctx context.Context
+
+		fakeStep     *execfakes.FakeStep
+		fakeBuild    *dbfakes.FakeBuild
+		fakeDelegate *execfakes.FakeResumeDelegate
+
+		state exec.RunState
+
+		step    exec.Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+			state.ArtifactRepository().RegisterArtifact("some-output", &runtime.TaskArtifact{
+				VolumeHandle: "some-output-handle",
+			})
+			return nil
+		}
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeDelegate = new(execfakes.FakeResumeDelegate)
+
+		state = exec.NewRunState()
+
+		step = exec.Resume(fakeStep, "some-step-key", fakeBuild, fakeDelegate)
+	})
+
+	JustBeforeEach(func() {
+		stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the step did not succeed in the resumed build", func() {
+		It("looks up the step by its key", func() {
+			Expect(fakeBuild.ResumedStepOutputsCallCount()).To(Equal(1))
+			Expect(fakeBuild.ResumedStepOutputsArgsForCall(0)).To(Equal("some-step-key"))
+		})
+
+		It("runs the step", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			Expect(fakeDelegate.ResumedCallCount()).To(BeZero())
+		})
+
+		It("registers the artifacts of the step", func() {
+			artifact, found := state.ArtifactRepository().ArtifactFor("some-output")
+			Expect(found).To(BeTrue())
+			Expect(artifact.ID()).To(Equal("some-output-handle"))
+		})
+
+		Context("when the step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(true)
+			})
+
+			It("succeeds", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+
+			It("saves the artifacts of the step", func() {
+				Expect(fakeBuild.SaveStepOutputsCallCount()).To(Equal(1))
+				stepKey, outputs := fakeBuild.SaveStepOutputsArgsForCall(0)
+				Expect(stepKey).To(Equal("some-step-key"))
+				Expect(outputs).To(Equal(map[string]string{"some-output": "some-output-handle"}))
+			})
+
+			Context("when saving the artifacts fails", func() {
+				BeforeEach(func() {
+					fakeBuild.SaveStepOutputsReturns(errors.New("nope"))
+				})
+
+				It("still succeeds", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(step.Succeeded()).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the step fails", func() {
+			BeforeEach(func() {
+				fakeStep.SucceededReturns(false)
+			})
+
+			It("fails", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+
+			It("does not save the artifacts of the step", func() {
+				Expect(fakeBuild.SaveStepOutputsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(disaster)
+				fakeStep.RunStub = nil
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+
+			It("does not save the artifacts of the step", func() {
+				Expect(fakeBuild.SaveStepOutputsCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the step succeeded in the resumed build", func() {
+		resumed := db.BuildStepOutputs{
+			BuildID:   12,
+			BuildName: "3",
+			Outputs:   map[string]string{"some-output": "resumed-output-handle"},
+		}
+
+		BeforeEach(func() {
+			fakeBuild.ResumedStepOutputsReturns(resumed, true, nil)
+		})
+
+		It("does not run the step", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+
+		It("succeeds", func() {
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		It("registers the artifacts of the resumed build", func() {
+			artifact, found := state.ArtifactRepository().ArtifactFor("some-output")
+			Expect(found).To(BeTrue())
+			Expect(artifact.ID()).To(Equal("resumed-output-handle"))
+		})
+
+		It("saves the artifacts so that the build can be resumed in turn", func() {
+			Expect(fakeBuild.SaveStepOutputsCallCount()).To(Equal(1))
+			stepKey, outputs := fakeBuild.SaveStepOutputsArgsForCall(0)
+			Expect(stepKey).To(Equal("some-step-key"))
+			Expect(outputs).To(Equal(resumed.Outputs))
+		})
+
+		It("notifies the delegate", func() {
+			Expect(fakeDelegate.ResumedCallCount()).To(Equal(1))
+			_, outputs := fakeDelegate.ResumedArgsForCall(0)
+			Expect(outputs).To(Equal(resumed))
+		})
+	})
+
+	Context("when looking up the resumed build fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.ResumedStepOutputsReturns(db.BuildStepOutputs{}, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(fakeStep.RunCallCount()).To(BeZero())
+		})
+	})
+})
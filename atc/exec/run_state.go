@@ -41,3 +41,10 @@ func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 func (state *runState) StoreResult(id atc.PlanID, val interface{}) {
 	state.results.Store(id, val)
 }
+
+func (state *runState) NewLocalScope() RunState {
+	return &runState{
+		artifacts: state.artifacts.NewLocalScope(),
+		results:   state.results,
+	}
+}
@@ -93,4 +93,24 @@ var _ = Describe("RunState", func() {
 			})
 		})
 	})
+
+	Describe("NewLocalScope", func() {
+		var child exec.RunState
+
+		BeforeEach(func() {
+			child = state.NewLocalScope()
+		})
+
+		It("shares results with the parent", func() {
+			child.StoreResult("some-id", 123)
+
+			var v int
+			Expect(state.Result("some-id", &v)).To(BeTrue())
+			Expect(v).To(Equal(123))
+		})
+
+		It("has its own artifact repository", func() {
+			Expect(child.ArtifactRepository()).ToNot(BeIdenticalTo(state.ArtifactRepository()))
+		})
+	})
 })
@@ -25,13 +25,14 @@ import (
 // SetPipelineStep sets a pipeline to current team. This step takes pipeline
 // configure file and var files from some resource in the pipeline, like git.
 type SetPipelineStep struct {
-	planID      atc.PlanID
-	plan        atc.SetPipelinePlan
-	metadata    StepMetadata
-	delegate    BuildStepDelegate
-	teamFactory db.TeamFactory
-	client      worker.Client
-	succeeded   bool
+	planID        atc.PlanID
+	plan          atc.SetPipelinePlan
+	metadata      StepMetadata
+	delegate      BuildStepDelegate
+	teamFactory   db.TeamFactory
+	stepTemplates db.StepTemplateRepository
+	client        worker.Client
+	succeeded     bool
 }
 
 func NewSetPipelineStep(
@@ -40,15 +41,17 @@ func NewSetPipelineStep(
 	metadata StepMetadata,
 	delegate BuildStepDelegate,
 	teamFactory db.TeamFactory,
+	stepTemplates db.StepTemplateRepository,
 	client worker.Client,
 ) Step {
 	return &SetPipelineStep{
-		planID:      planID,
-		plan:        plan,
-		metadata:    metadata,
-		delegate:    delegate,
-		teamFactory: teamFactory,
-		client:      client,
+		planID:        planID,
+		plan:          plan,
+		metadata:      metadata,
+		delegate:      delegate,
+		teamFactory:   teamFactory,
+		stepTemplates: stepTemplates,
+		client:        client,
 	}
 }
 
@@ -89,9 +92,18 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 
 	step.delegate.Starting(logger)
 
-	warnings, errors := configvalidate.Validate(atcConfig)
-	for _, warning := range warnings {
-		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	atcConfig, errors, err := db.ExpandStepTemplates(step.stepTemplates, step.metadata.TeamID, atcConfig)
+	if err != nil {
+		return err
+	}
+
+	if len(errors) == 0 {
+		warnings, validationErrors := configvalidate.Validate(atcConfig)
+		for _, warning := range warnings {
+			fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+		}
+
+		errors = validationErrors
 	}
 
 	if len(errors) > 0 {
@@ -107,8 +119,13 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 
 	team := step.teamFactory.GetByID(step.metadata.TeamID)
 
+	pipelineRef := atc.PipelineRef{
+		Name:         step.plan.Name,
+		InstanceVars: step.plan.InstanceVars,
+	}
+
 	fromVersion := db.ConfigVersion(0)
-	pipeline, found, err := team.Pipeline(step.plan.Name)
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -134,8 +151,8 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 		return nil
 	}
 
-	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
-	pipeline, _, err = team.SavePipeline(step.plan.Name, atcConfig, fromVersion, false)
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", pipelineRef.String())
+	pipeline, _, err = team.SavePipeline(pipelineRef, atcConfig, fromVersion, false)
 	if err != nil {
 		return err
 	}
@@ -177,6 +194,9 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 	}
 
 	staticVars := []vars.Variables{}
+	if len(s.step.plan.InstanceVars) > 0 {
+		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.InstanceVars))
+	}
 	if len(s.step.plan.Vars) > 0 {
 		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.Vars))
 	}
@@ -76,10 +76,11 @@ jobs:
 		cancel     func()
 		testLogger *lagertest.TestLogger
 
-		fakeDelegate    *execfakes.FakeBuildStepDelegate
-		fakeTeamFactory *dbfakes.FakeTeamFactory
-		fakeTeam        *dbfakes.FakeTeam
-		fakePipeline    *dbfakes.FakePipeline
+		fakeDelegate      *execfakes.FakeBuildStepDelegate
+		fakeTeamFactory   *dbfakes.FakeTeamFactory
+		fakeTeam          *dbfakes.FakeTeam
+		fakePipeline      *dbfakes.FakePipeline
+		fakeStepTemplates *dbfakes.FakeStepTemplateRepository
 
 		fakeWorkerClient *workerfakes.FakeClient
 
@@ -138,6 +139,8 @@ jobs:
 		fakePipeline.NameReturns("some-pipeline")
 		fakeTeamFactory.GetByIDReturns(fakeTeam)
 
+		fakeStepTemplates = new(dbfakes.FakeStepTemplateRepository)
+
 		fakeWorkerClient = new(workerfakes.FakeClient)
 
 		spPlan = &atc.SetPipelinePlan{
@@ -162,6 +165,7 @@ jobs:
 			stepMetadata,
 			fakeDelegate,
 			fakeTeamFactory,
+			fakeStepTemplates,
 			fakeWorkerClient,
 		)
 
@@ -239,7 +243,7 @@ jobs:
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
 					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					Expect(name).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -289,7 +293,7 @@ jobs:
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
 					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					Expect(name).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -305,6 +309,78 @@ jobs:
 				})
 			})
 		})
+
+		Context("when the pipeline refers to step templates", func() {
+			BeforeEach(func() {
+				fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: `
+---
+resources:
+- name: deploy-staging
+  type: some-type
+jobs:
+- name: some-job
+  plan:
+  - template: deploy
+    vars: {env: staging}
+`}, nil)
+
+				fakeTeam.PipelineReturns(nil, false, nil)
+				fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+			})
+
+			Context("when the templates exist", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.VersionsReturns(atc.StepTemplates{
+						{
+							Name:    "deploy",
+							Version: 1,
+							Params:  []string{"env"},
+							Step:    "put: deploy-((env))",
+						},
+					}, nil)
+				})
+
+				It("saves the pipeline with the templates expanded", func() {
+					Expect(fakeStepTemplates.VersionsCallCount()).To(Equal(1))
+					teamID, names := fakeStepTemplates.VersionsArgsForCall(0)
+					Expect(teamID).To(Equal(123))
+					Expect(names).To(Equal([]string{"deploy"}))
+
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+					_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(config.Jobs[0].Plan).To(Equal(atc.PlanSequence{
+						{Put: "deploy-staging"},
+					}))
+				})
+			})
+
+			Context("when a template does not exist", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.VersionsReturns(nil, nil)
+				})
+
+				It("finishes unsuccessfully without saving the pipeline", func() {
+					Expect(stepErr).NotTo(HaveOccurred())
+					Expect(stderr).To(gbytes.Say("invalid pipeline:"))
+					Expect(stderr).To(gbytes.Say("deploy"))
+
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(0))
+					_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+					Expect(succeeded).To(BeFalse())
+				})
+			})
+
+			Context("when finding the templates fails", func() {
+				BeforeEach(func() {
+					fakeStepTemplates.VersionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(stepErr).To(MatchError("nope"))
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
 
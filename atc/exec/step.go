@@ -40,6 +40,10 @@ type RunState interface {
 
 	Result(atc.PlanID, interface{}) bool
 	StoreResult(atc.PlanID, interface{})
+
+	// NewLocalScope returns a RunState whose artifacts are local to it but
+	// which can still see the artifacts of this RunState.
+	NewLocalScope() RunState
 }
 
 // ExitStatus is the resulting exit code from the process that the step ran.
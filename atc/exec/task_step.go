@@ -2,20 +2,27 @@ package exec
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"path"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/testreport"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
@@ -65,7 +72,12 @@ type TaskDelegate interface {
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus)
+	Memoized(lager.Logger, db.TaskMemo)
+	Resumed(lager.Logger, db.BuildStepOutputs)
 	Errored(lager.Logger, string)
+
+	SaveTestResults(lager.Logger, []atc.TestResult) error
+	SaveAnnotations(lager.Logger, map[string]string) error
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -80,6 +92,8 @@ type TaskStep struct {
 	workerClient      worker.Client
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
+	policyChecker     policy.Checker
+	taskMemoFactory   db.TaskMemoFactory
 	succeeded         bool
 }
 
@@ -93,6 +107,8 @@ func NewTaskStep(
 	workerClient worker.Client,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
+	policyChecker policy.Checker,
+	taskMemoFactory db.TaskMemoFactory,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -104,6 +120,8 @@ func NewTaskStep(
 		workerClient:      workerClient,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
+		policyChecker:     policyChecker,
+		taskMemoFactory:   taskMemoFactory,
 	}
 }
 
@@ -122,6 +140,10 @@ func NewTaskStep(
 // are registered with the artifact.Repository. If no outputs are specified, the
 // task's entire working directory is registered as an StreamableArtifactSource under the
 // name of the task.
+//
+// If the task is memoized and a previous run of it succeeded with the same
+// config, image and inputs, the outputs of that run are registered instead of
+// running the task.
 func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
 		"team":     step.metadata.TeamName,
@@ -170,15 +192,21 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	// override params
 	taskConfigSource = &OverrideParamsConfigSource{ConfigSource: taskConfigSource, Params: step.plan.Params}
 
-	// interpolate template vars
-	taskConfigSource = InterpolateTemplateConfigSource{ConfigSource: taskConfigSource, Vars: taskVars}
+	repository := state.ArtifactRepository()
 
-	// validate
-	taskConfigSource = ValidatingConfigSource{ConfigSource: taskConfigSource}
+	// keep the config from before interpolation around, so that credentials
+	// are not handed to the policy checker
+	var config atc.TaskConfig
+	uninterpolatedConfig, err := taskConfigSource.FetchConfig(ctx, logger, repository)
+	if err == nil {
+		// interpolate template vars
+		taskConfigSource = InterpolateTemplateConfigSource{ConfigSource: StaticConfigSource{Config: &uninterpolatedConfig}, Vars: taskVars}
 
-	repository := state.ArtifactRepository()
+		// validate
+		taskConfigSource = ValidatingConfigSource{ConfigSource: taskConfigSource}
 
-	config, err := taskConfigSource.FetchConfig(ctx, logger, repository)
+		config, err = taskConfigSource.FetchConfig(ctx, logger, repository)
+	}
 
 	step.delegate.SetTaskConfig(config)
 
@@ -190,6 +218,14 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	err = checkPolicy(step.policyChecker, step.metadata, policy.ActionRunTask, map[string]interface{}{
+		"privileged": bool(step.plan.Privileged),
+		"config":     uninterpolatedConfig,
+	})
+	if err != nil {
+		return err
+	}
+
 	if config.Limits.CPU == nil {
 		config.Limits.CPU = step.defaultLimits.CPU
 	}
@@ -197,6 +233,44 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		config.Limits.Memory = step.defaultLimits.Memory
 	}
 
+	// Do not memoize one-off builds
+	var memoKey string
+	if step.plan.Memoize && step.metadata.JobID != 0 {
+		memoKey, err = step.memoKey(logger, repository, config)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the version of an unpinned image_resource is only determined while the
+	// container is being created, so its memo can only be looked up then
+	var imageFetchingDelegate worker.ImageFetchingDelegate = step.delegate
+	var memoizingDelegate *memoizingImageDelegate
+	if memoKey != "" && step.plan.ImageArtifactName == "" && config.ImageResource != nil && config.ImageResource.Version == nil {
+		memoizingDelegate = &memoizingImageDelegate{
+			ImageFetchingDelegate: step.delegate,
+			step:                  step,
+			imageType:             config.ImageResource.Type,
+			resourceTypes:         resourceTypes,
+			configKey:             memoKey,
+		}
+
+		imageFetchingDelegate = memoizingDelegate
+		memoKey = ""
+	}
+
+	if memoKey != "" {
+		memo, found, err := step.taskMemoFactory.Find(step.metadata.JobID, step.memoStepName(), memoKey)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			step.memoized(logger, repository, config, memo)
+			return nil
+		}
+	}
+
 	step.delegate.Initializing(logger)
 
 	workerSpec, err := step.workerSpec(logger, resourceTypes, repository, config)
@@ -209,17 +283,20 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	// let the task attach its own spans to the trace
+	containerSpec.Env = append(containerSpec.Env, tracing.Env(ctx)...)
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
 		Dir:          config.Run.Dir,
-		StdoutWriter: step.delegate.Stdout(),
-		StderrWriter: step.delegate.Stderr(),
+		StdoutWriter: IdleWriter(ctx, step.delegate.Stdout()),
+		StderrWriter: IdleWriter(ctx, step.delegate.Stderr()),
 	}
 
 	imageSpec := worker.ImageFetcherSpec{
 		ResourceTypes: resourceTypes,
-		Delegate:      step.delegate,
+		Delegate:      imageFetchingDelegate,
 	}
 
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
@@ -234,10 +311,19 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		step.containerMetadata,
 		imageSpec,
 		processSpec,
-		step.delegate,
+		IdleStarting(ctx, step.delegate),
 		step.lockFactory,
 	)
 
+	if memoizingDelegate != nil {
+		if memoizingDelegate.memo != nil {
+			step.memoized(logger, repository, config, *memoizingDelegate.memo)
+			return nil
+		}
+
+		memoKey = memoizingDelegate.key
+	}
+
 	if err != nil {
 		if err == context.Canceled || err == context.DeadlineExceeded {
 			step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
@@ -250,6 +336,16 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 
 	step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
 
+	if len(config.Reports) > 0 {
+		step.saveReports(ctx, logger, repository, config)
+	}
+
+	step.saveAnnotations(ctx, logger, result.VolumeMounts)
+
+	if step.succeeded && memoKey != "" {
+		step.saveMemo(logger, memoKey, config, result.VolumeMounts, step.containerMetadata)
+	}
+
 	// Do not initialize caches for one-off builds
 	if step.metadata.JobID != 0 {
 		err = step.registerCaches(logger, repository, config, result.VolumeMounts, step.containerMetadata)
@@ -375,6 +471,9 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		containerSpec.Outputs[output.Name] = path
 	}
 
+	containerSpec.Outputs[buildMetadataOutput] = BuildMetadataDir
+	containerSpec.Env = append(containerSpec.Env, BuildMetadataDirEnv+"="+BuildMetadataDir)
+
 	return containerSpec, nil
 }
 
@@ -445,6 +544,313 @@ func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repo
 	return nil
 }
 
+// memoKey identifies a run of the task by its config, image and the contents
+// of its inputs. The image of an image_resource is identified by its config,
+// which is only enough if it specifies a version; otherwise the key is
+// completed by a memoizingImageDelegate once the version is determined.
+//
+// The key is empty if the contents of an input are not known, e.g. as it is
+// the output of a task which is not memoized; such a task is not memoized.
+func (step *TaskStep) memoKey(logger lager.Logger, repository *build.Repository, config atc.TaskConfig) (string, error) {
+	inputs := map[string]string{}
+	for _, input := range config.Inputs {
+		inputName := input.Name
+		if sourceName, ok := step.plan.InputMapping[inputName]; ok {
+			inputName = sourceName
+		}
+
+		art, found := repository.ArtifactFor(build.ArtifactName(inputName))
+		if !found {
+			continue
+		}
+
+		key, known, err := step.taskMemoFactory.VolumeKey(art.ID())
+		if err != nil {
+			return "", err
+		}
+
+		if !known {
+			logger.Info("not-memoizing-input-of-unknown-contents", lager.Data{"input": input.Name})
+			return "", nil
+		}
+
+		inputs[input.Name] = key
+	}
+
+	var image string
+	if step.plan.ImageArtifactName != "" {
+		art, found := repository.ArtifactFor(build.ArtifactName(step.plan.ImageArtifactName))
+		if !found {
+			return "", MissingTaskImageSourceError{step.plan.ImageArtifactName}
+		}
+
+		key, known, err := step.taskMemoFactory.VolumeKey(art.ID())
+		if err != nil {
+			return "", err
+		}
+
+		if !known {
+			logger.Info("not-memoizing-image-of-unknown-contents")
+			return "", nil
+		}
+
+		image = key
+	}
+
+	payload, err := json.Marshal(struct {
+		Config     atc.TaskConfig    `json:"config"`
+		Privileged bool              `json:"privileged"`
+		Image      string            `json:"image"`
+		Inputs     map[string]string `json:"inputs"`
+	}{
+		Config:     config,
+		Privileged: bool(step.plan.Privileged),
+		Image:      image,
+		Inputs:     inputs,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
+
+// memoStepName tells apart the memos of the steps of an across step, which
+// share a name, by the suffix of their plan IDs.
+func (step *TaskStep) memoStepName() string {
+	id := string(step.planID)
+	if i := strings.Index(id, "/"); i != -1 {
+		return step.plan.Name + id[i:]
+	}
+
+	return step.plan.Name
+}
+
+func (step *TaskStep) memoized(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, memo db.TaskMemo) {
+	logger.Info("memoized", lager.Data{"build": memo.BuildID})
+
+	step.registerMemoizedOutputs(logger, repository, config, memo)
+
+	step.succeeded = true
+	step.delegate.Memoized(logger, memo)
+	step.delegate.Finished(logger, ExitStatus(0))
+}
+
+func (step *TaskStep) registerMemoizedOutputs(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, memo db.TaskMemo) {
+	logger.Debug("registering-memoized-outputs", lager.Data{"outputs": memo.Outputs})
+
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		handle, found := memo.Outputs[output.Name]
+		if !found {
+			continue
+		}
+
+		repository.RegisterArtifact(build.ArtifactName(outputName), &runtime.TaskArtifact{
+			VolumeHandle: handle,
+		})
+	}
+}
+
+func (step *TaskStep) saveMemo(logger lager.Logger, memoKey string, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) {
+	outputs := map[string]string{}
+	for _, output := range config.Outputs {
+		outputPath := artifactsPath(output, metadata.WorkingDirectory)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				outputs[output.Name] = mount.Volume.Handle()
+			}
+		}
+	}
+
+	if len(outputs) != len(config.Outputs) {
+		logger.Info("not-memoizing-missing-outputs")
+		return
+	}
+
+	// the memo only saves the next run some time; the task itself succeeded
+	err := step.taskMemoFactory.Save(step.metadata.JobID, step.memoStepName(), memoKey, step.metadata.BuildID, outputs)
+	if err != nil {
+		logger.Error("failed-to-save-task-memo", err)
+	}
+}
+
+var errMemoized = errors.New("task is memoized")
+
+// memoizingImageDelegate completes the memo key of a task with an unpinned
+// image_resource with the resource cache of the image's version, once it has
+// been determined. If a memo is found for the key, it stops the container
+// from being created by failing.
+//
+// The images of custom resource types, which may be needed to check the
+// image_resource, are determined through the same delegate, and are told
+// apart by the resource configs of their caches.
+type memoizingImageDelegate struct {
+	worker.ImageFetchingDelegate
+
+	step          *TaskStep
+	imageType     string
+	resourceTypes atc.VersionedResourceTypes
+	configKey     string
+
+	key  string
+	memo *db.TaskMemo
+}
+
+func (delegate *memoizingImageDelegate) ImageVersionDetermined(resourceCache db.UsedResourceCache) error {
+	err := delegate.ImageFetchingDelegate.ImageVersionDetermined(resourceCache)
+	if err != nil {
+		return err
+	}
+
+	if delegate.key != "" || !delegate.isImage(resourceCache) {
+		return nil
+	}
+
+	delegate.key = fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%s:resource-cache:%d", delegate.configKey, resourceCache.ID()))))
+
+	step := delegate.step
+	memo, found, err := step.taskMemoFactory.Find(step.metadata.JobID, step.memoStepName(), delegate.key)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		delegate.memo = &memo
+		return errMemoized
+	}
+
+	return nil
+}
+
+func (delegate *memoizingImageDelegate) isImage(resourceCache db.UsedResourceCache) bool {
+	resourceConfig := resourceCache.ResourceConfig()
+	if resourceConfig == nil {
+		return false
+	}
+
+	customType, found := delegate.resourceTypes.Lookup(delegate.imageType)
+	if !found {
+		baseType := resourceConfig.CreatedByBaseResourceType()
+		return baseType != nil && baseType.Name == delegate.imageType
+	}
+
+	parentCache := resourceConfig.CreatedByResourceCache()
+	return parentCache != nil && reflect.DeepEqual(parentCache.Version(), customType.Version)
+}
+
+// saveReports reads the test reports declared by the task from its outputs.
+// Reports which are missing or can't be parsed only result in a warning, as
+// they don't change the outcome of the task.
+func (step *TaskStep) saveReports(ctx context.Context, logger lager.Logger, repository *build.Repository, config atc.TaskConfig) {
+	results := []atc.TestResult{}
+
+	for _, report := range config.Reports {
+		reportResults, err := step.readReport(ctx, logger, repository, report)
+		if err != nil {
+			logger.Error("failed-to-read-report", err, lager.Data{"path": report.Path})
+			fmt.Fprintf(step.delegate.Stderr(), "[WARNING] failed to read report '%s': %s\n", report.Path, err)
+			continue
+		}
+
+		for _, result := range reportResults {
+			result.Task = step.plan.Name
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return
+	}
+
+	err := step.delegate.SaveTestResults(logger, results)
+	if err != nil {
+		logger.Error("failed-to-save-test-results", err)
+	}
+}
+
+func (step *TaskStep) readReport(ctx context.Context, logger lager.Logger, repository *build.Repository, report atc.TaskReportConfig) ([]atc.TestResult, error) {
+	segs := strings.SplitN(report.Path, "/", 2)
+
+	outputName := segs[0]
+	if destinationName, ok := step.plan.OutputMapping[outputName]; ok {
+		outputName = destinationName
+	}
+
+	artifact, found := repository.ArtifactFor(build.ArtifactName(outputName))
+	if !found {
+		return nil, fmt.Errorf("output '%s' not found", segs[0])
+	}
+
+	stream, err := step.workerClient.StreamFileFromArtifact(ctx, logger, artifact, segs[1])
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, fmt.Errorf("file not found")
+		}
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	switch report.Type {
+	case atc.TaskReportTypeJUnit:
+		return testreport.ParseJUnit(stream)
+	default:
+		return nil, fmt.Errorf("unknown report type '%s'", report.Type)
+	}
+}
+
+// saveAnnotations reads the annotations written by the task to the build
+// metadata directory and saves them to the build. Annotations which can't be
+// read only result in a warning, as they don't change the outcome of the
+// task.
+func (step *TaskStep) saveAnnotations(ctx context.Context, logger lager.Logger, volumeMounts []worker.VolumeMount) {
+	var artifact runtime.Artifact
+	for _, mount := range volumeMounts {
+		if filepath.Clean(mount.MountPath) == filepath.Clean(BuildMetadataDir) {
+			artifact = &runtime.TaskArtifact{
+				VolumeHandle: mount.Volume.Handle(),
+			}
+		}
+	}
+
+	if artifact == nil {
+		return
+	}
+
+	stream, err := step.workerClient.StreamFileFromArtifact(ctx, logger, artifact, buildMetadataFile)
+	if err != nil {
+		if err != baggageclaim.ErrFileNotFound {
+			logger.Error("failed-to-stream-build-metadata", err)
+			fmt.Fprintf(step.delegate.Stderr(), "[WARNING] failed to read build metadata: %s\n", err)
+		}
+		return
+	}
+
+	defer stream.Close()
+
+	annotations, err := parseAnnotations(stream)
+	if err != nil {
+		logger.Error("failed-to-parse-build-metadata", err)
+		fmt.Fprintf(step.delegate.Stderr(), "[WARNING] failed to read build metadata: %s\n", err)
+		return
+	}
+
+	if len(annotations) == 0 {
+		return
+	}
+
+	err = step.delegate.SaveAnnotations(logger, annotations)
+	if err != nil {
+		logger.Error("failed-to-save-annotations", err)
+	}
+}
+
 type taskInput struct {
 	config        atc.TaskInputConfig
 	artifact      runtime.Artifact
@@ -3,14 +3,22 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
@@ -35,8 +43,10 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory *lockfakes.FakeLockFactory
 
-		fakeDelegate *execfakes.FakeTaskDelegate
-		taskPlan     *atc.TaskPlan
+		fakeDelegate        *execfakes.FakeTaskDelegate
+		fakePolicyChecker   *policyfakes.FakeChecker
+		fakeTaskMemoFactory *dbfakes.FakeTaskMemoFactory
+		taskPlan            *atc.TaskPlan
 
 		interpolatedResourceTypes atc.VersionedResourceTypes
 
@@ -54,18 +64,16 @@ var _ = Describe("TaskStep", func() {
 			StepName:         "some-step",
 		}
 
-		stepMetadata = exec.StepMetadata{
-			TeamID:  123,
-			BuildID: 1234,
-			JobID:   12345,
-		}
+		stepMetadata exec.StepMetadata
 
-		planID = atc.PlanID(42)
+		planID atc.PlanID
 	)
 
 	BeforeEach(func() {
 		ctx, cancel = context.WithCancel(context.Background())
 
+		planID = "42"
+
 		stdoutBuf = gbytes.NewBuffer()
 		stderrBuf = gbytes.NewBuffer()
 
@@ -77,6 +85,16 @@ var _ = Describe("TaskStep", func() {
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
 
+		fakePolicyChecker = new(policyfakes.FakeChecker)
+
+		fakeTaskMemoFactory = new(dbfakes.FakeTaskMemoFactory)
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:  123,
+			BuildID: 1234,
+			JobID:   12345,
+		}
+
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
 		fakeDelegate.VariablesReturns(credVarsTracker)
 		fakeDelegate.StdoutReturns(stdoutBuf)
@@ -135,6 +153,8 @@ var _ = Describe("TaskStep", func() {
 			fakeClient,
 			fakeDelegate,
 			fakeLockFactory,
+			fakePolicyChecker,
+			fakeTaskMemoFactory,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -207,10 +227,12 @@ var _ = Describe("TaskStep", func() {
 						},
 						Type: "task",
 						Dir:  "some-artifact-root",
-						Env:  []string{"SOME=params"},
+						Env:  []string{"SOME=params", "BUILD_METADATA_DIR=/tmp/build/metadata/"},
 
 						ArtifactByPath: map[string]runtime.Artifact{},
-						Outputs:        worker.OutputPaths{},
+						Outputs: worker.OutputPaths{
+							"build-metadata": "/tmp/build/metadata/",
+						},
 					}))
 
 				})
@@ -507,10 +529,24 @@ var _ = Describe("TaskStep", func() {
 					"some-output":                "some-artifact-root/some-output-configured-path/",
 					"some-other-output":          "some-artifact-root/some-other-output/",
 					"some-trailing-slash-output": "some-artifact-root/some-output-configured-path-with-trailing-slash/",
+					"build-metadata":             "/tmp/build/metadata/",
 				}))
 			})
 		})
 
+		Context("when an output is named after the build metadata directory", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{
+					{Name: "build-metadata"},
+				}
+			})
+
+			It("returns the error without running the task", func() {
+				Expect(stepErr).To(MatchError(ContainSubstring("reserved for the build metadata directory")))
+				Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when missing the platform", func() {
 
 			BeforeEach(func() {
@@ -867,6 +903,7 @@ var _ = Describe("TaskStep", func() {
 							"some-output":                "some-artifact-root/some-output-configured-path/",
 							"some-other-output":          "some-artifact-root/some-other-output/",
 							"some-trailing-slash-output": "some-artifact-root/some-output-configured-path-with-trailing-slash/",
+							"build-metadata":             "/tmp/build/metadata/",
 						}))
 					})
 				})
@@ -1089,5 +1126,517 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the task has reports", func() {
+			var report string
+
+			BeforeEach(func() {
+				taskPlan.OutputMapping = map[string]string{"test-results": "remapped-results"}
+				taskPlan.Config = &atc.TaskConfig{
+					Platform: "some-platform",
+					Run: atc.TaskRunConfig{
+						Path: "ls",
+					},
+					Outputs: []atc.TaskOutputConfig{
+						{Name: "test-results"},
+					},
+					Reports: []atc.TaskReportConfig{
+						{Type: atc.TaskReportTypeJUnit, Path: "test-results/junit.xml"},
+					},
+				}
+
+				fakeVolume := new(workerfakes.FakeVolume)
+				fakeVolume.HandleReturns("some-handle")
+
+				fakeClient.RunTaskStepReturns(worker.TaskResult{
+					ExitStatus: 1,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeVolume,
+							MountPath: "some-artifact-root/test-results/",
+						},
+					},
+				}, nil)
+
+				report = `<testsuite name="some-suite"><testcase name="some-test"><failure message="nope"/></testcase></testsuite>`
+			})
+
+			JustBeforeEach(func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			Context("when the report can be read", func() {
+				BeforeEach(func() {
+					fakeClient.StreamFileFromArtifactStub = func(context.Context, lager.Logger, runtime.Artifact, string) (io.ReadCloser, error) {
+						return ioutil.NopCloser(strings.NewReader(report)), nil
+					}
+				})
+
+				It("reads the report from the output", func() {
+					Expect(fakeClient.StreamFileFromArtifactCallCount()).To(Equal(1))
+					_, _, artifact, path := fakeClient.StreamFileFromArtifactArgsForCall(0)
+					remapped, found := repo.ArtifactFor("remapped-results")
+					Expect(found).To(BeTrue())
+					Expect(artifact).To(Equal(remapped))
+					Expect(path).To(Equal("junit.xml"))
+				})
+
+				It("saves the results of the tests", func() {
+					Expect(fakeDelegate.SaveTestResultsCallCount()).To(Equal(1))
+					_, results := fakeDelegate.SaveTestResultsArgsForCall(0)
+					Expect(results).To(Equal([]atc.TestResult{
+						{
+							Task:    "some-task",
+							Suite:   "some-suite",
+							Name:    "some-test",
+							Status:  atc.TestStatusFailed,
+							Message: "nope",
+						},
+					}))
+				})
+			})
+
+			Context("when the report cannot be parsed", func() {
+				BeforeEach(func() {
+					fakeClient.StreamFileFromArtifactStub = func(context.Context, lager.Logger, runtime.Artifact, string) (io.ReadCloser, error) {
+						return ioutil.NopCloser(strings.NewReader("not xml")), nil
+					}
+				})
+
+				It("warns without failing the step", func() {
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] failed to read report 'test-results/junit.xml'`))
+					Expect(fakeDelegate.SaveTestResultsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the report is missing", func() {
+				BeforeEach(func() {
+					fakeClient.StreamFileFromArtifactReturns(nil, baggageclaim.ErrFileNotFound)
+				})
+
+				It("warns without failing the step", func() {
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] failed to read report 'test-results/junit.xml': file not found`))
+					Expect(fakeDelegate.SaveTestResultsCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the task writes build metadata", func() {
+			var metadata string
+
+			BeforeEach(func() {
+				fakeVolume := new(workerfakes.FakeVolume)
+				fakeVolume.HandleReturns("some-metadata-handle")
+
+				fakeClient.RunTaskStepReturns(worker.TaskResult{
+					ExitStatus: 0,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeVolume,
+							MountPath: exec.BuildMetadataDir,
+						},
+					},
+				}, nil)
+
+				fakeClient.StreamFileFromArtifactStub = func(context.Context, lager.Logger, runtime.Artifact, string) (io.ReadCloser, error) {
+					return ioutil.NopCloser(strings.NewReader(metadata)), nil
+				}
+			})
+
+			JustBeforeEach(func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			Context("when the metadata is valid", func() {
+				BeforeEach(func() {
+					metadata = `{"url": "https://example.com", "coverage": 87.5, "deployed": true}`
+				})
+
+				It("reads the metadata file from the metadata volume", func() {
+					Expect(fakeClient.StreamFileFromArtifactCallCount()).To(Equal(1))
+					_, _, artifact, path := fakeClient.StreamFileFromArtifactArgsForCall(0)
+					Expect(artifact).To(Equal(&runtime.TaskArtifact{VolumeHandle: "some-metadata-handle"}))
+					Expect(path).To(Equal("metadata.json"))
+				})
+
+				It("saves the annotations", func() {
+					Expect(fakeDelegate.SaveAnnotationsCallCount()).To(Equal(1))
+					_, annotations := fakeDelegate.SaveAnnotationsArgsForCall(0)
+					Expect(annotations).To(Equal(map[string]string{
+						"url":      "https://example.com",
+						"coverage": "87.5",
+						"deployed": "true",
+					}))
+				})
+			})
+
+			Context("when the metadata is not an object", func() {
+				BeforeEach(func() {
+					metadata = `["nope"]`
+				})
+
+				It("warns without saving any annotations", func() {
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] failed to read build metadata: metadata.json must contain a JSON object`))
+					Expect(fakeDelegate.SaveAnnotationsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a value is nested", func() {
+				BeforeEach(func() {
+					metadata = `{"nested": {"key": "value"}}`
+				})
+
+				It("warns without saving any annotations", func() {
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] failed to read build metadata: invalid value for key 'nested'`))
+					Expect(fakeDelegate.SaveAnnotationsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a key is invalid", func() {
+				BeforeEach(func() {
+					metadata = `{"some:key": "value"}`
+				})
+
+				It("warns without saving any annotations", func() {
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] failed to read build metadata: invalid key 'some:key'`))
+					Expect(fakeDelegate.SaveAnnotationsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the task did not write any metadata", func() {
+				BeforeEach(func() {
+					fakeClient.StreamFileFromArtifactStub = nil
+					fakeClient.StreamFileFromArtifactReturns(nil, baggageclaim.ErrFileNotFound)
+				})
+
+				It("does not warn or save any annotations", func() {
+					Expect(stderrBuf.Contents()).ToNot(ContainSubstring("WARNING"))
+					Expect(fakeDelegate.SaveAnnotationsCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the task is memoized", func() {
+			var taskResult worker.TaskResult
+
+			BeforeEach(func() {
+				taskPlan.Memoize = true
+				taskPlan.Config.Inputs = []atc.TaskInputConfig{
+					{Name: "some-input"},
+				}
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{
+					{Name: "some-output"},
+				}
+
+				repo.RegisterArtifact("some-input", &runtime.GetArtifact{VolumeHandle: "some-input-handle"})
+
+				fakeTaskMemoFactory.VolumeKeyReturns("resource-cache:1", true, nil)
+
+				fakeVolume := new(workerfakes.FakeVolume)
+				fakeVolume.HandleReturns("some-output-handle")
+
+				taskResult = worker.TaskResult{
+					ExitStatus: 0,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeVolume,
+							MountPath: "some-artifact-root/some-output/",
+						},
+					},
+				}
+				fakeClient.RunTaskStepReturns(taskResult, nil)
+			})
+
+			It("looks up the memo of the task by a key of its inputs", func() {
+				Expect(fakeTaskMemoFactory.VolumeKeyCallCount()).To(Equal(1))
+				Expect(fakeTaskMemoFactory.VolumeKeyArgsForCall(0)).To(Equal("some-input-handle"))
+
+				Expect(fakeTaskMemoFactory.FindCallCount()).To(Equal(1))
+				jobID, stepName, key := fakeTaskMemoFactory.FindArgsForCall(0)
+				Expect(jobID).To(Equal(12345))
+				Expect(stepName).To(Equal("some-task"))
+				Expect(key).ToNot(BeEmpty())
+			})
+
+			It("keys the task by the contents of its inputs", func() {
+				_, _, key := fakeTaskMemoFactory.FindArgsForCall(0)
+
+				fakeTaskMemoFactory.VolumeKeyReturns("resource-cache:2", true, nil)
+
+				otherRepo := build.NewRepository()
+				otherRepo.RegisterArtifact("some-input", &runtime.GetArtifact{VolumeHandle: "some-other-input-handle"})
+
+				otherState := new(execfakes.FakeRunState)
+				otherState.ArtifactRepositoryReturns(otherRepo)
+
+				Expect(taskStep.Run(ctx, otherState)).To(Succeed())
+
+				_, _, otherKey := fakeTaskMemoFactory.FindArgsForCall(1)
+				Expect(otherKey).ToNot(Equal(key))
+			})
+
+			Context("when the task succeeded before with the same key", func() {
+				memo := db.TaskMemo{
+					BuildID:   12,
+					BuildName: "3",
+					Outputs:   map[string]string{"some-output": "memoized-output-handle"},
+				}
+
+				BeforeEach(func() {
+					fakeTaskMemoFactory.FindReturns(memo, true, nil)
+				})
+
+				It("does not run the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+					Expect(fakeDelegate.InitializingCallCount()).To(BeZero())
+				})
+
+				It("registers the outputs of the memo", func() {
+					artifact, found := repo.ArtifactFor("some-output")
+					Expect(found).To(BeTrue())
+					Expect(artifact).To(Equal(&runtime.TaskArtifact{VolumeHandle: "memoized-output-handle"}))
+				})
+
+				It("finishes as memoized", func() {
+					Expect(fakeDelegate.MemoizedCallCount()).To(Equal(1))
+					_, memoized := fakeDelegate.MemoizedArgsForCall(0)
+					Expect(memoized).To(Equal(memo))
+
+					Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+					_, status := fakeDelegate.FinishedArgsForCall(0)
+					Expect(status).To(Equal(exec.ExitStatus(0)))
+
+					Expect(taskStep.Succeeded()).To(BeTrue())
+				})
+
+				It("does not save a new memo", func() {
+					Expect(fakeTaskMemoFactory.SaveCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the task did not succeed before with the same key", func() {
+				It("runs the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeDelegate.MemoizedCallCount()).To(BeZero())
+				})
+
+				It("saves a memo of the outputs", func() {
+					_, _, key := fakeTaskMemoFactory.FindArgsForCall(0)
+
+					Expect(fakeTaskMemoFactory.SaveCallCount()).To(Equal(1))
+					jobID, stepName, savedKey, buildID, outputs := fakeTaskMemoFactory.SaveArgsForCall(0)
+					Expect(jobID).To(Equal(12345))
+					Expect(stepName).To(Equal("some-task"))
+					Expect(savedKey).To(Equal(key))
+					Expect(buildID).To(Equal(1234))
+					Expect(outputs).To(Equal(map[string]string{"some-output": "some-output-handle"}))
+				})
+
+				Context("when the task fails", func() {
+					BeforeEach(func() {
+						taskResult.ExitStatus = 1
+						fakeClient.RunTaskStepReturns(taskResult, nil)
+					})
+
+					It("does not save a memo", func() {
+						Expect(fakeTaskMemoFactory.SaveCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the contents of an input are not known", func() {
+				BeforeEach(func() {
+					fakeTaskMemoFactory.VolumeKeyReturns("", false, nil)
+				})
+
+				It("runs the task without memoizing it", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeTaskMemoFactory.FindCallCount()).To(BeZero())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeTaskMemoFactory.SaveCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the task is run by an across step", func() {
+				BeforeEach(func() {
+					planID = "some-plan/1/2"
+				})
+
+				It("keeps the memos of the iterations apart", func() {
+					Expect(fakeTaskMemoFactory.FindCallCount()).To(Equal(1))
+					_, stepName, _ := fakeTaskMemoFactory.FindArgsForCall(0)
+					Expect(stepName).To(Equal("some-task/1/2"))
+
+					Expect(fakeTaskMemoFactory.SaveCallCount()).To(Equal(1))
+					_, stepName, _, _, _ = fakeTaskMemoFactory.SaveArgsForCall(0)
+					Expect(stepName).To(Equal("some-task/1/2"))
+				})
+			})
+
+			Context("when the image_resource does not specify a version", func() {
+				var (
+					imageCache    *dbfakes.FakeUsedResourceCache
+					imageCacheErr error
+				)
+
+				BeforeEach(func() {
+					taskPlan.Config.ImageResource.Version = nil
+
+					imageConfig := new(dbfakes.FakeResourceConfig)
+					imageConfig.CreatedByBaseResourceTypeReturns(&db.UsedBaseResourceType{Name: "docker"})
+
+					imageCache = new(dbfakes.FakeUsedResourceCache)
+					imageCache.IDReturns(7)
+					imageCache.ResourceConfigReturns(imageConfig)
+
+					fakeClient.RunTaskStepStub = func(_ context.Context, _ lager.Logger, _ db.ContainerOwner, _ worker.ContainerSpec, _ worker.WorkerSpec, _ worker.ContainerPlacementStrategy, _ db.ContainerMetadata, imageSpec worker.ImageFetcherSpec, _ runtime.ProcessSpec, _ runtime.StartingEventDelegate, _ lock.LockFactory) (worker.TaskResult, error) {
+						// the image of a custom resource type is determined
+						// through the same delegate
+						customTypeConfig := new(dbfakes.FakeResourceConfig)
+						customTypeConfig.CreatedByBaseResourceTypeReturns(&db.UsedBaseResourceType{Name: "registry-image"})
+
+						customTypeCache := new(dbfakes.FakeUsedResourceCache)
+						customTypeCache.IDReturns(3)
+						customTypeCache.ResourceConfigReturns(customTypeConfig)
+
+						err := imageSpec.Delegate.ImageVersionDetermined(customTypeCache)
+						if err != nil {
+							return worker.TaskResult{}, err
+						}
+
+						imageCacheErr = imageSpec.Delegate.ImageVersionDetermined(imageCache)
+						if imageCacheErr != nil {
+							return worker.TaskResult{}, imageCacheErr
+						}
+
+						return taskResult, nil
+					}
+				})
+
+				It("looks up the memo once the version of the image is determined", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(imageCacheErr).ToNot(HaveOccurred())
+
+					Expect(fakeDelegate.ImageVersionDeterminedCallCount()).To(Equal(2))
+
+					Expect(fakeTaskMemoFactory.FindCallCount()).To(Equal(1))
+					_, _, key := fakeTaskMemoFactory.FindArgsForCall(0)
+
+					Expect(fakeTaskMemoFactory.SaveCallCount()).To(Equal(1))
+					_, _, savedKey, _, _ := fakeTaskMemoFactory.SaveArgsForCall(0)
+					Expect(savedKey).To(Equal(key))
+				})
+
+				It("keys the task by the version of its image", func() {
+					_, _, key := fakeTaskMemoFactory.FindArgsForCall(0)
+
+					imageCache.IDReturns(8)
+					Expect(taskStep.Run(ctx, state)).To(Succeed())
+
+					_, _, otherKey := fakeTaskMemoFactory.FindArgsForCall(1)
+					Expect(otherKey).ToNot(Equal(key))
+				})
+
+				Context("when the task succeeded before with the same image", func() {
+					memo := db.TaskMemo{
+						BuildID:   12,
+						BuildName: "3",
+						Outputs:   map[string]string{"some-output": "memoized-output-handle"},
+					}
+
+					BeforeEach(func() {
+						fakeTaskMemoFactory.FindReturns(memo, true, nil)
+					})
+
+					It("stops creating the container and finishes as memoized", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(imageCacheErr).To(HaveOccurred())
+
+						Expect(fakeDelegate.MemoizedCallCount()).To(Equal(1))
+						_, memoized := fakeDelegate.MemoizedArgsForCall(0)
+						Expect(memoized).To(Equal(memo))
+
+						artifact, found := repo.ArtifactFor("some-output")
+						Expect(found).To(BeTrue())
+						Expect(artifact).To(Equal(&runtime.TaskArtifact{VolumeHandle: "memoized-output-handle"}))
+
+						Expect(taskStep.Succeeded()).To(BeTrue())
+						Expect(fakeTaskMemoFactory.SaveCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the build is a one-off build", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 0
+				})
+
+				It("runs the task without memoizing it", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeTaskMemoFactory.VolumeKeyCallCount()).To(BeZero())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(fakeTaskMemoFactory.SaveCallCount()).To(BeZero())
+				})
+			})
+		})
+
+	})
+
+	Context("when the task is subject to policy checks", func() {
+		BeforeEach(func() {
+			fakePolicyChecker.ShouldCheckActionReturns(true)
+
+			taskPlan.Privileged = true
+			taskPlan.Config = &atc.TaskConfig{
+				Platform:  "some-platform",
+				RootfsURI: "some-image",
+				Params: atc.TaskEnv{
+					"SECRET": "((source-param))",
+				},
+				Run: atc.TaskRunConfig{
+					Path: "ls",
+				},
+			}
+		})
+
+		It("checks the fetched config without interpolating it", func() {
+			Expect(fakePolicyChecker.ShouldCheckActionArgsForCall(0)).To(Equal(policy.ActionRunTask))
+			Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+			input := fakePolicyChecker.CheckArgsForCall(0)
+			Expect(input.Action).To(Equal(policy.ActionRunTask))
+			Expect(input.Data).To(Equal(map[string]interface{}{
+				"privileged": true,
+				"config":     *taskPlan.Config,
+			}))
+		})
+
+		Context("when the policy denies it", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{
+					Allowed: false,
+					Reasons: []string{"privileged tasks are not allowed"},
+				}, nil)
+			})
+
+			It("returns a policy check error", func() {
+				Expect(stepErr).To(Equal(policy.PolicyCheckNotPassedError{Reasons: []string{"privileged tasks are not allowed"}}))
+			})
+
+			It("does not run the task", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the check errors", func() {
+			BeforeEach(func() {
+				fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
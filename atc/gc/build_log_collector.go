@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/logarchive"
 )
 
 type buildLogCollector struct {
@@ -16,19 +17,26 @@ type buildLogCollector struct {
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	archiver                    logarchive.Archiver
 }
 
+// NewBuildLogCollector returns a collector which reaps the events of builds
+// that are no longer retained.
+//
+// If archiver is non-nil, builds are archived before their events are reaped.
 func NewBuildLogCollector(
 	pipelineFactory db.PipelineFactory,
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	archiver logarchive.Archiver,
 ) *buildLogCollector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		archiver:                    archiver,
 	}
 }
 
@@ -56,7 +64,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 		}
 
 		for _, job := range jobs {
-			err = br.reapLogsOfJob(pipeline, job, logger)
+			err = br.reapLogsOfJob(ctx, pipeline, job, logger)
 			if err != nil {
 				return err
 			}
@@ -66,7 +74,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 	return nil
 }
 
-func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
+func (br *buildLogCollector) reapLogsOfJob(ctx context.Context,
+	pipeline db.Pipeline,
 	job db.Job,
 	logger lager.Logger) error {
 
@@ -206,6 +215,13 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 		"build-ids": buildIDsToDelete,
 	})
 
+	err = br.archiveBuilds(ctx, buildsToConsiderDeleting, buildIDsToDelete, logger)
+	if err != nil {
+		// the job's logs are reaped by a later run once they can be archived,
+		// without holding up the jobs of other pipelines
+		return nil
+	}
+
 	err = pipeline.DeleteBuildEventsByBuildIDs(buildIDsToDelete)
 	if err != nil {
 		logger.Error("failed-to-delete-build-events", err)
@@ -222,3 +238,34 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 
 	return nil
 }
+
+func (br *buildLogCollector) archiveBuilds(ctx context.Context,
+	builds []db.Build,
+	buildIDs []int,
+	logger lager.Logger) error {
+
+	if br.archiver == nil {
+		return nil
+	}
+
+	toArchive := map[int]bool{}
+	for _, id := range buildIDs {
+		toArchive[id] = true
+	}
+
+	for _, build := range builds {
+		if !toArchive[build.ID()] || build.LogArchive() != "" {
+			continue
+		}
+
+		// if a build can't be archived, leave reaping the job's batch to the
+		// next run rather than losing its logs
+		err := br.archiver.Archive(ctx, build)
+		if err != nil {
+			logger.Error("failed-to-archive-build-events", err, lager.Data{"build_id": build.ID()})
+			return err
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -21,12 +23,14 @@ var _ = Describe("BuildLogCollector", func() {
 		fakePipelineFactory *dbfakes.FakePipelineFactory
 		batchSize           int
 		buildLogRetainCalc  BuildLogRetentionCalculator
+		archiver            logarchive.Archiver
 	)
 
 	BeforeEach(func() {
 		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
 		batchSize = 5
 		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0, 0, 0)
+		archiver = nil
 	})
 
 	JustBeforeEach(func() {
@@ -35,6 +39,7 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			archiver,
 		)
 	})
 
@@ -76,6 +81,90 @@ var _ = Describe("BuildLogCollector", func() {
 				fakePipeline.JobsReturns([]db.Job{fakeJob}, nil)
 			})
 
+			Context("when an archiver is configured", func() {
+				var fakeArchiver *logarchivefakes.FakeArchiver
+				var archivedBuild *dbfakes.FakeBuild
+
+				BeforeEach(func() {
+					fakeArchiver = new(logarchivefakes.FakeArchiver)
+					archiver = fakeArchiver
+
+					archivedBuild = new(dbfakes.FakeBuild)
+					archivedBuild.IDReturns(5)
+					archivedBuild.LogArchiveReturns("builds/5.ndjson.gz")
+
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if page == (db.Page{Until: 4, Limit: 5}) {
+							return []db.Build{sb(9), sb(8), sb(7), sb(6), archivedBuild}, db.Pagination{}, nil
+						}
+						return []db.Build{}, db.Pagination{}, nil
+					}
+				})
+
+				It("archives the builds being reaped before reaping them", func() {
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeArchiver.ArchiveCallCount()).To(Equal(2))
+
+					_, build := fakeArchiver.ArchiveArgsForCall(0)
+					Expect(build.ID()).To(Equal(7))
+					_, build = fakeArchiver.ArchiveArgsForCall(1)
+					Expect(build.ID()).To(Equal(6))
+
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(5, 6, 7))
+				})
+
+				Context("when archiving fails", func() {
+					var otherPipeline *dbfakes.FakePipeline
+
+					BeforeEach(func() {
+						otherJob := new(dbfakes.FakeJob)
+						otherJob.NameReturns("job-2")
+						otherJob.FirstLoggedBuildIDReturns(15)
+						otherJob.ConfigReturns(atc.JobConfig{
+							BuildLogsToRetain: 2,
+						}, nil)
+						otherJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+							if page.Until == 14 && page.Limit == 5 {
+								return []db.Build{sb(19), sb(18), sb(17), sb(16), sb(15)}, db.Pagination{}, nil
+							}
+							return []db.Build{}, db.Pagination{}, nil
+						}
+
+						otherPipeline = new(dbfakes.FakePipeline)
+						otherPipeline.IDReturns(43)
+						otherPipeline.JobsReturns([]db.Job{otherJob}, nil)
+
+						fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline, otherPipeline}, nil)
+
+						fakeArchiver.ArchiveStub = func(ctx context.Context, build db.Build) error {
+							if build.ID() < 10 {
+								return errors.New("disk full")
+							}
+							return nil
+						}
+					})
+
+					It("does not reap the job whose builds could not be archived", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(0))
+						Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(Equal(0))
+					})
+
+					It("still reaps the other jobs", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(otherPipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+						Expect(otherPipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(15, 16, 17))
+					})
+				})
+			})
+
 			Context("drain handling", func() {
 				JustBeforeEach(func() {
 					buildLogCollector = NewBuildLogCollector(
@@ -83,6 +172,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						nil,
 					)
 				})
 				BeforeEach(func() {
@@ -135,6 +225,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						false,
+						nil,
 					)
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Until: 4, Limit: 5}) {
@@ -0,0 +1,134 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type resourceConfigVersionCollector struct {
+	pipelineFactory  db.PipelineFactory
+	lifecycle        db.ResourceConfigVersionLifecycle
+	defaultRetention atc.VersionHistory
+}
+
+// NewResourceConfigVersionCollector returns a collector which trims the
+// version history of resources according to their version_history, falling
+// back on defaultRetention for any limit they don't configure.
+func NewResourceConfigVersionCollector(
+	pipelineFactory db.PipelineFactory,
+	lifecycle db.ResourceConfigVersionLifecycle,
+	defaultRetention atc.VersionHistory,
+) *resourceConfigVersionCollector {
+	return &resourceConfigVersionCollector{
+		pipelineFactory:  pipelineFactory,
+		lifecycle:        lifecycle,
+		defaultRetention: defaultRetention,
+	}
+}
+
+// scopeRetention is the retention of a resource config scope, which may be
+// shared by many resources.
+type scopeRetention struct {
+	retention atc.VersionHistory
+	keep      []atc.Version
+}
+
+func (c *resourceConfigVersionCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("resource-config-version-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	pipelines, err := c.pipelineFactory.AllPipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return err
+	}
+
+	scopes := map[int]*scopeRetention{}
+	var scopeIDs []int
+
+	for _, pipeline := range pipelines {
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			return err
+		}
+
+		for _, resource := range resources {
+			if resource.ResourceConfigScopeID() == 0 {
+				continue
+			}
+
+			retention := c.retention(resource)
+
+			scope, found := scopes[resource.ResourceConfigScopeID()]
+			if !found {
+				scope = &scopeRetention{retention: retention}
+				scopes[resource.ResourceConfigScopeID()] = scope
+				scopeIDs = append(scopeIDs, resource.ResourceConfigScopeID())
+			} else {
+				// resources sharing a version history keep whatever any of them
+				// wants to keep
+				scope.retention.Versions = lenientLimit(scope.retention.Versions, retention.Versions)
+				scope.retention.Days = lenientLimit(scope.retention.Days, retention.Days)
+			}
+
+			if resource.ConfigPinnedVersion() != nil {
+				scope.keep = append(scope.keep, resource.ConfigPinnedVersion())
+			}
+		}
+	}
+
+	for _, scopeID := range scopeIDs {
+		scope := scopes[scopeID]
+		if scope.retention.Versions == 0 && scope.retention.Days == 0 {
+			continue
+		}
+
+		deleted, err := c.lifecycle.RemoveUnretainedVersions(scopeID, scope.retention, scope.keep)
+		if err != nil {
+			logger.Error("failed-to-remove-unretained-versions", err, lager.Data{"scope": scopeID})
+			return err
+		}
+
+		metric.ResourceConfigVersionsDeleted.IncDelta(deleted)
+	}
+
+	return nil
+}
+
+func (c *resourceConfigVersionCollector) retention(resource db.Resource) atc.VersionHistory {
+	var retention atc.VersionHistory
+	if resource.VersionHistory() != nil {
+		retention = *resource.VersionHistory()
+	}
+
+	if retention.Versions == 0 {
+		retention.Versions = c.defaultRetention.Versions
+	}
+
+	if retention.Days == 0 {
+		retention.Days = c.defaultRetention.Days
+	}
+
+	return retention
+}
+
+// lenientLimit returns the larger of two limits, where 0 means no limit.
+func lenientLimit(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	if a > b {
+		return a
+	}
+
+	return b
+}
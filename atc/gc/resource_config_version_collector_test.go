@@ -0,0 +1,148 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceConfigVersionCollector", func() {
+	var (
+		collector           GcCollector
+		fakePipelineFactory *dbfakes.FakePipelineFactory
+		fakeLifecycle       *dbfakes.FakeResourceConfigVersionLifecycle
+		fakePipeline        *dbfakes.FakePipeline
+		defaultRetention    atc.VersionHistory
+
+		runErr error
+	)
+
+	newResource := func(scopeID int, versionHistory *atc.VersionHistory) *dbfakes.FakeResource {
+		resource := new(dbfakes.FakeResource)
+		resource.ResourceConfigScopeIDReturns(scopeID)
+		resource.VersionHistoryReturns(versionHistory)
+		return resource
+	}
+
+	BeforeEach(func() {
+		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
+		fakeLifecycle = new(dbfakes.FakeResourceConfigVersionLifecycle)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+
+		defaultRetention = atc.VersionHistory{}
+	})
+
+	JustBeforeEach(func() {
+		collector = gc.NewResourceConfigVersionCollector(fakePipelineFactory, fakeLifecycle, defaultRetention)
+		runErr = collector.Run(context.TODO())
+	})
+
+	Context("when resources have no version history and there is no default", func() {
+		BeforeEach(func() {
+			fakePipeline.ResourcesReturns(db.Resources{newResource(1, nil)}, nil)
+		})
+
+		It("does not remove any versions", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeLifecycle.RemoveUnretainedVersionsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a resource has a version history", func() {
+		BeforeEach(func() {
+			resource := newResource(1, &atc.VersionHistory{Versions: 10})
+			resource.ConfigPinnedVersionReturns(atc.Version{"ref": "pinned"})
+
+			fakePipeline.ResourcesReturns(db.Resources{
+				resource,
+				newResource(0, &atc.VersionHistory{Versions: 10}),
+			}, nil)
+		})
+
+		It("removes the unretained versions of its scope, keeping its pinned version", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeLifecycle.RemoveUnretainedVersionsCallCount()).To(Equal(1))
+
+			scopeID, retention, keep := fakeLifecycle.RemoveUnretainedVersionsArgsForCall(0)
+			Expect(scopeID).To(Equal(1))
+			Expect(retention).To(Equal(atc.VersionHistory{Versions: 10}))
+			Expect(keep).To(Equal([]atc.Version{{"ref": "pinned"}}))
+		})
+
+		Context("when there is a default", func() {
+			BeforeEach(func() {
+				defaultRetention = atc.VersionHistory{Versions: 100, Days: 30}
+			})
+
+			It("uses it for the limits the resource does not set", func() {
+				_, retention, _ := fakeLifecycle.RemoveUnretainedVersionsArgsForCall(0)
+				Expect(retention).To(Equal(atc.VersionHistory{Versions: 10, Days: 30}))
+			})
+		})
+
+		Context("when removing the versions fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeLifecycle.RemoveUnretainedVersionsReturns(0, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when resources share a scope", func() {
+		BeforeEach(func() {
+			otherPipeline := new(dbfakes.FakePipeline)
+			otherPipeline.ResourcesReturns(db.Resources{
+				newResource(1, &atc.VersionHistory{Versions: 20, Days: 7}),
+			}, nil)
+
+			fakePipeline.ResourcesReturns(db.Resources{
+				newResource(1, &atc.VersionHistory{Versions: 10, Days: 14}),
+			}, nil)
+
+			fakePipelineFactory.AllPipelinesReturns([]db.Pipeline{fakePipeline, otherPipeline}, nil)
+		})
+
+		It("uses the most lenient limits", func() {
+			Expect(fakeLifecycle.RemoveUnretainedVersionsCallCount()).To(Equal(1))
+
+			_, retention, _ := fakeLifecycle.RemoveUnretainedVersionsArgsForCall(0)
+			Expect(retention).To(Equal(atc.VersionHistory{Versions: 20, Days: 14}))
+		})
+
+		Context("when one of them has no limit", func() {
+			BeforeEach(func() {
+				fakePipeline.ResourcesReturns(db.Resources{newResource(1, nil)}, nil)
+			})
+
+			It("does not remove any versions", func() {
+				Expect(fakeLifecycle.RemoveUnretainedVersionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when getting the resources fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakePipeline.ResourcesReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
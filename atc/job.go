@@ -13,6 +13,7 @@ type Job struct {
 	FinishedBuild        *Build `json:"finished_build"`
 	TransitionBuild      *Build `json:"transition_build,omitempty"`
 	HasNewInputs         bool   `json:"has_new_inputs,omitempty"`
+	NextScheduledBuild   int64  `json:"next_scheduled_build,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs,omitempty"`
 	Outputs []JobOutput `json:"outputs,omitempty"`
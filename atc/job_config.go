@@ -8,12 +8,16 @@ type JobConfig struct {
 	DisableManualTrigger bool     `json:"disable_manual_trigger,omitempty"`
 	Serial               bool     `json:"serial,omitempty"`
 	Interruptible        bool     `json:"interruptible,omitempty"`
+	AbortSuperseded      bool     `json:"abort_superseded,omitempty"`
 	SerialGroups         []string `json:"serial_groups,omitempty"`
 	RawMaxInFlight       int      `json:"max_in_flight,omitempty"`
 	BuildLogsToRetain    int      `json:"build_logs_to_retain,omitempty"`
+	Priority             int      `json:"priority,omitempty"`
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Schedule *JobSchedule `json:"schedule,omitempty"`
+
 	Abort   *PlanConfig `json:"on_abort,omitempty"`
 	Error   *PlanConfig `json:"on_error,omitempty"`
 	Failure *PlanConfig `json:"on_failure,omitempty"`
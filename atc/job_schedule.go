@@ -0,0 +1,59 @@
+package atc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// JobSchedule triggers builds of a job on a cron schedule, without the need
+// for a time resource.
+type JobSchedule struct {
+	// Cron is a standard five-field cron expression, e.g. `0 2 * * 1-5`, or
+	// a descriptor such as `@daily`.
+	Cron string `json:"cron"`
+
+	// Location is the IANA time zone the expression is evaluated in, e.g.
+	// `Europe/Berlin`. Defaults to UTC.
+	Location string `json:"location,omitempty"`
+}
+
+// Validate returns an error if the cron expression or location is invalid.
+func (schedule JobSchedule) Validate() error {
+	_, err := schedule.parse()
+	return err
+}
+
+// Next returns the first time after the given time at which the schedule
+// fires.
+func (schedule JobSchedule) Next(after time.Time) (time.Time, error) {
+	sched, err := schedule.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return sched.Next(after), nil
+}
+
+func (schedule JobSchedule) parse() (cron.Schedule, error) {
+	location := time.UTC
+	if schedule.Location != "" {
+		var err error
+		location, err = time.LoadLocation(schedule.Location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid location '%s': %w", schedule.Location, err)
+		}
+	}
+
+	sched, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': %w", schedule.Cron, err)
+	}
+
+	if spec, ok := sched.(*cron.SpecSchedule); ok {
+		spec.Location = location
+	}
+
+	return sched, nil
+}
@@ -0,0 +1,47 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobSchedule", func() {
+	var after = time.Date(2020, 3, 16, 12, 30, 0, 0, time.UTC)
+
+	Describe("Next", func() {
+		It("returns the next time the expression matches in UTC", func() {
+			next, err := atc.JobSchedule{Cron: "0 2 * * *"}.Next(after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2020, 3, 17, 2, 0, 0, 0, time.UTC)))
+		})
+
+		It("evaluates the expression in the location", func() {
+			next, err := atc.JobSchedule{Cron: "0 2 * * *", Location: "America/New_York"}.Next(after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2020, 3, 17, 6, 0, 0, 0, time.UTC)))
+		})
+
+		It("supports descriptors", func() {
+			next, err := atc.JobSchedule{Cron: "@hourly"}.Next(after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2020, 3, 16, 13, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid schedule", func() {
+			Expect(atc.JobSchedule{Cron: "*/15 9-17 * * 1-5", Location: "Europe/Berlin"}.Validate()).To(Succeed())
+		})
+
+		It("rejects an invalid cron expression", func() {
+			Expect(atc.JobSchedule{Cron: "every day"}.Validate()).To(MatchError(ContainSubstring("invalid cron expression 'every day'")))
+		})
+
+		It("rejects an unknown location", func() {
+			Expect(atc.JobSchedule{Cron: "@daily", Location: "Mars/Olympus_Mons"}.Validate()).To(MatchError(ContainSubstring("invalid location 'Mars/Olympus_Mons'")))
+		})
+	})
+})
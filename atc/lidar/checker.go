@@ -2,8 +2,11 @@ package lidar
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
@@ -14,13 +17,25 @@ func NewChecker(
 	logger lager.Logger,
 	checkFactory db.CheckFactory,
 	engine engine.Engine,
+	rateLimits CheckRateLimits,
+	clock clock.Clock,
 ) *checker {
-	return &checker{
+	checker := &checker{
 		logger:       logger,
 		checkFactory: checkFactory,
 		engine:       engine,
 		running:      &sync.Map{},
+
+		clock:      clock,
+		rateLimits: rateLimits,
+		limiters:   map[string]*tokenBucket{},
+	}
+
+	if rateLimits.MaxChecksPerSecond > 0 {
+		checker.globalLimiter = newTokenBucket(clock, rateLimits.MaxChecksPerSecond, rateLimits.Burst)
 	}
+
+	return checker
 }
 
 type checker struct {
@@ -30,6 +45,11 @@ type checker struct {
 	engine       engine.Engine
 
 	running *sync.Map
+
+	clock         clock.Clock
+	rateLimits    CheckRateLimits
+	globalLimiter *tokenBucket
+	limiters      map[string]*tokenBucket
 }
 
 func (c *checker) Run(ctx context.Context) error {
@@ -46,18 +66,98 @@ func (c *checker) Run(ctx context.Context) error {
 		Checks: len(checks),
 	}.Emit(c.logger)
 
+	// manual and webhook checks go first so that they are never deferred in
+	// favour of interval checks
+	sort.SliceStable(checks, func(i, j int) bool {
+		return checks[i].IsManuallyTriggered() && !checks[j].IsManuallyTriggered()
+	})
+
+	var deferred int
 	for _, ck := range checks {
-		if _, exists := c.running.LoadOrStore(ck.ID(), true); !exists {
-			go func(check db.Check) {
-				defer c.running.Delete(check.ID())
-
-				engineCheck := c.engine.NewCheck(check)
-				engineCheck.Run(c.logger.WithData(lager.Data{
-					"check": check.ID(),
-				}))
-			}(ck)
+		if _, exists := c.running.Load(ck.ID()); exists {
+			continue
+		}
+
+		if !c.allow(ck) {
+			deferred++
+			continue
 		}
+
+		c.running.Store(ck.ID(), true)
+
+		go func(check db.Check) {
+			defer c.running.Delete(check.ID())
+
+			engineCheck := c.engine.NewCheck(check)
+			engineCheck.Run(c.logger.WithData(lager.Data{
+				"check": check.ID(),
+			}))
+		}(ck)
+	}
+
+	if deferred > 0 {
+		c.logger.Debug("deferred-checks", lager.Data{"checks": deferred})
+
+		metric.ChecksDeferred{
+			Checks: deferred,
+		}.Emit(c.logger)
 	}
 
 	return nil
 }
+
+// allow takes a token from every limiter applying to the check, or defers the
+// check if any of them has run out. Manually triggered checks are never
+// deferred, but still use up the tokens available.
+func (c *checker) allow(check db.Check) bool {
+	limiters := []*tokenBucket{}
+	if c.globalLimiter != nil {
+		limiters = append(limiters, c.globalLimiter)
+	}
+
+	if limiter := c.resourceTypeLimiter(check); limiter != nil {
+		limiters = append(limiters, limiter)
+	}
+
+	if !check.IsManuallyTriggered() {
+		for _, limiter := range limiters {
+			if !limiter.Ready() {
+				return false
+			}
+		}
+	}
+
+	for _, limiter := range limiters {
+		limiter.Take()
+	}
+
+	return true
+}
+
+func (c *checker) resourceTypeLimiter(check db.Check) *tokenBucket {
+	plan := check.Plan().Check
+	if plan == nil {
+		return nil
+	}
+
+	key := plan.Type
+	rate := c.rateLimits.ResourceTypeMaxChecksPerSecond[plan.Type]
+
+	if resourceType, found := plan.VersionedResourceTypes.Lookup(plan.Type); found {
+		key = fmt.Sprintf("%d/%s", check.PipelineID(), plan.Type)
+		rate = resourceType.MaxChecksPerSecond
+	}
+
+	if rate <= 0 {
+		delete(c.limiters, key)
+		return nil
+	}
+
+	limiter, found := c.limiters[key]
+	if !found || limiter.rate != rate {
+		limiter = newTokenBucket(c.clock, rate, c.rateLimits.Burst)
+		c.limiters[key] = limiter
+	}
+
+	return limiter
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
@@ -26,6 +28,8 @@ var _ = Describe("Checker", func() {
 
 		fakeCheckFactory *dbfakes.FakeCheckFactory
 		fakeEngine       *enginefakes.FakeEngine
+		fakeClock        *fakeclock.FakeClock
+		rateLimits       lidar.CheckRateLimits
 
 		checker Checker
 		logger  *lagertest.TestLogger
@@ -34,16 +38,21 @@ var _ = Describe("Checker", func() {
 	BeforeEach(func() {
 		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
 		fakeEngine = new(enginefakes.FakeEngine)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rateLimits = lidar.CheckRateLimits{}
 
 		logger = lagertest.NewTestLogger("test")
+	})
+
+	JustBeforeEach(func() {
 		checker = lidar.NewChecker(
 			logger,
 			fakeCheckFactory,
 			fakeEngine,
+			rateLimits,
+			fakeClock,
 		)
-	})
 
-	JustBeforeEach(func() {
 		err = checker.Run(context.TODO())
 	})
 
@@ -115,5 +124,106 @@ var _ = Describe("Checker", func() {
 				Eventually(fakeEngine.NewCheckCallCount).Should(Equal(1))
 			})
 		})
+
+		Context("when checks are rate limited", func() {
+			var fakeCheck1, fakeCheck2, fakeCheck3 *dbfakes.FakeCheck
+
+			checkedIDs := func() []int {
+				ids := []int{}
+				for i := 0; i < fakeEngine.NewCheckCallCount(); i++ {
+					ids = append(ids, fakeEngine.NewCheckArgsForCall(i).ID())
+				}
+				return ids
+			}
+
+			BeforeEach(func() {
+				fakeCheck1 = new(dbfakes.FakeCheck)
+				fakeCheck1.IDReturns(1)
+				fakeCheck1.PlanReturns(atc.Plan{Check: &atc.CheckPlan{Type: "git"}})
+				fakeCheck2 = new(dbfakes.FakeCheck)
+				fakeCheck2.IDReturns(2)
+				fakeCheck2.PlanReturns(atc.Plan{Check: &atc.CheckPlan{Type: "git"}})
+				fakeCheck3 = new(dbfakes.FakeCheck)
+				fakeCheck3.IDReturns(3)
+				fakeCheck3.PlanReturns(atc.Plan{Check: &atc.CheckPlan{Type: "s3"}})
+
+				fakeCheckFactory.StartedChecksReturns([]db.Check{
+					fakeCheck1,
+					fakeCheck2,
+					fakeCheck3,
+				}, nil)
+
+				fakeEngine.NewCheckReturns(new(enginefakes.FakeRunnable))
+			})
+
+			Context("globally", func() {
+				BeforeEach(func() {
+					rateLimits.MaxChecksPerSecond = 1
+					rateLimits.Burst = 2 * time.Second
+				})
+
+				It("defers the checks over the limit", func() {
+					Eventually(checkedIDs).Should(ConsistOf(1, 2))
+					Consistently(checkedIDs).Should(ConsistOf(1, 2))
+				})
+
+				It("runs the deferred checks once tokens are available", func() {
+					Eventually(checkedIDs).Should(ConsistOf(1, 2))
+
+					fakeClock.Increment(time.Second)
+					fakeCheckFactory.StartedChecksReturns([]db.Check{fakeCheck3}, nil)
+
+					Expect(checker.Run(context.TODO())).To(Succeed())
+					Eventually(checkedIDs).Should(ConsistOf(1, 2, 3))
+				})
+
+				Context("when a check is manually triggered", func() {
+					BeforeEach(func() {
+						fakeCheck3.IsManuallyTriggeredReturns(true)
+					})
+
+					It("runs it before the other checks", func() {
+						Eventually(checkedIDs).Should(ConsistOf(3, 1))
+						Consistently(checkedIDs).Should(ConsistOf(3, 1))
+					})
+				})
+			})
+
+			Context("per base resource type", func() {
+				BeforeEach(func() {
+					rateLimits.ResourceTypeMaxChecksPerSecond = map[string]float64{"git": 1}
+				})
+
+				It("defers the checks of that type over the limit", func() {
+					Eventually(checkedIDs).Should(ConsistOf(1, 3))
+					Consistently(checkedIDs).Should(ConsistOf(1, 3))
+				})
+			})
+
+			Context("per custom resource type", func() {
+				BeforeEach(func() {
+					customTypePlan := atc.Plan{Check: &atc.CheckPlan{
+						Type: "custom",
+						VersionedResourceTypes: atc.VersionedResourceTypes{
+							{
+								ResourceType: atc.ResourceType{
+									Name:               "custom",
+									Type:               "registry-image",
+									MaxChecksPerSecond: 1,
+								},
+							},
+						},
+					}}
+
+					fakeCheck1.PlanReturns(customTypePlan)
+					fakeCheck2.PlanReturns(customTypePlan)
+				})
+
+				It("defers the checks of that type over the limit", func() {
+					Eventually(checkedIDs).Should(ConsistOf(1, 3))
+					Consistently(checkedIDs).Should(ConsistOf(1, 3))
+				})
+			})
+		})
 	})
 })
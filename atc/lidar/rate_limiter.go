@@ -0,0 +1,79 @@
+package lidar
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+)
+
+// CheckRateLimits configures how many checks per second the checker starts.
+// A rate of zero means no limit.
+type CheckRateLimits struct {
+	// MaxChecksPerSecond applies to all checks.
+	MaxChecksPerSecond float64
+
+	// ResourceTypeMaxChecksPerSecond applies to the checks of each base
+	// resource type, e.g. git. The checks of a custom resource type are
+	// limited by its max_checks_per_second instead.
+	ResourceTypeMaxChecksPerSecond map[string]float64
+
+	// Burst is how long the checks may accumulate while the checker is not
+	// running, i.e. the checker interval.
+	Burst time.Duration
+}
+
+// tokenBucket is a token bucket refilled at the given rate, holding up to
+// burst tokens.
+type tokenBucket struct {
+	clock clock.Clock
+
+	rate  float64
+	burst float64
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(clock clock.Clock, rate float64, burst time.Duration) *tokenBucket {
+	capacity := math.Max(1, rate*burst.Seconds())
+
+	return &tokenBucket{
+		clock:  clock,
+		rate:   rate,
+		burst:  capacity,
+		tokens: capacity,
+		last:   clock.Now(),
+	}
+}
+
+// Ready returns whether there is a token to take.
+func (b *tokenBucket) Ready() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refill()
+
+	return b.tokens >= 1
+}
+
+// Take takes a token, if any.
+func (b *tokenBucket) Take() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refill()
+
+	if b.tokens >= 1 {
+		b.tokens--
+	}
+}
+
+func (b *tokenBucket) refill() {
+	now := b.clock.Now()
+
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
@@ -2,6 +2,8 @@ package lidar
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
 	"sync"
 	"time"
 
@@ -100,7 +102,7 @@ func (s *scanner) check(checkable db.Checkable, resourceTypes db.ResourceTypes,
 		}
 	}
 
-	if time.Now().Before(checkable.LastCheckEndTime().Add(interval)) {
+	if time.Now().Before(checkable.LastCheckEndTime().Add(interval + checkJitter(checkable, interval))) {
 		s.logger.Debug("interval-not-reached", lager.Data{"interval": interval})
 		return nil
 	}
@@ -125,6 +127,21 @@ func (s *scanner) check(checkable db.Checkable, resourceTypes db.ResourceTypes,
 	return nil
 }
 
+// checkJitter delays the checks of a checkable by up to a tenth of its
+// interval, consistently across runs, so that checkables sharing the same
+// interval are spread out instead of becoming due at once.
+func checkJitter(checkable db.Checkable, interval time.Duration) time.Duration {
+	max := int64(interval / 10)
+	if max <= 0 {
+		return 0
+	}
+
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%d/%s", checkable.PipelineID(), checkable.Name())
+
+	return time.Duration(hash.Sum64() % uint64(max))
+}
+
 func (s *scanner) setCheckError(logger lager.Logger, checkable db.Checkable, err error) {
 	setErr := checkable.SetCheckSetupError(err)
 	if setErr != nil {
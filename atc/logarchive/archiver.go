@@ -0,0 +1,153 @@
+package logarchive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+//go:generate counterfeiter . Archiver
+
+// Archiver copies the events of finished builds to a Store, so that they can
+// still be read after they have been reaped from the database.
+//
+// Events are archived as gzipped, newline-delimited JSON envelopes, in the same
+// form as they are streamed by the API.
+type Archiver interface {
+	Archive(ctx context.Context, build db.Build) error
+	Events(ctx context.Context, build db.Build, from uint) (db.EventSource, error)
+}
+
+func NewArchiver(store Store) Archiver {
+	return &archiver{store: store}
+}
+
+type archiver struct {
+	store Store
+}
+
+func (a *archiver) Archive(ctx context.Context, build db.Build) error {
+	events, err := build.Events(0)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close(events)
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(writeEvents(writer, events))
+	}()
+
+	key := Key(build)
+
+	err = a.store.Put(ctx, key, reader)
+
+	// unblock the writer if the store gave up early
+	_ = reader.Close()
+
+	if err != nil {
+		return fmt.Errorf("archive build %d: %w", build.ID(), err)
+	}
+
+	return build.SetLogArchive(key)
+}
+
+func (a *archiver) Events(ctx context.Context, build db.Build, from uint) (db.EventSource, error) {
+	blob, err := a.store.Get(ctx, build.LogArchive())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := newEventSource(blob)
+	if err != nil {
+		_ = blob.Close()
+		return nil, err
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			_ = source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+// Key is where a build's events are archived to within a Store.
+func Key(build db.Build) string {
+	return fmt.Sprintf("builds/%d.ndjson.gz", build.ID())
+}
+
+func writeEvents(w io.Writer, events db.EventSource) error {
+	zw := gzip.NewWriter(w)
+	enc := json.NewEncoder(zw)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = enc.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+type eventSource struct {
+	blob io.ReadCloser
+	zr   *gzip.Reader
+	dec  *json.Decoder
+}
+
+func newEventSource(blob io.ReadCloser) (*eventSource, error) {
+	zr, err := gzip.NewReader(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventSource{
+		blob: blob,
+		zr:   zr,
+		dec:  json.NewDecoder(zr),
+	}, nil
+}
+
+func (s *eventSource) Next() (event.Envelope, error) {
+	var ev event.Envelope
+	err := s.dec.Decode(&ev)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return ev, nil
+}
+
+func (s *eventSource) Close() error {
+	_ = s.zr.Close()
+	return s.blob.Close()
+}
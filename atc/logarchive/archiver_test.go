@@ -0,0 +1,154 @@
+package logarchive_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func envelope(payload string) event.Envelope {
+	msg := json.RawMessage(payload)
+	return event.Envelope{
+		Data:    &msg,
+		Event:   "log",
+		Version: "5.1",
+	}
+}
+
+var _ = Describe("Archiver", func() {
+	var (
+		dir      string
+		store    logarchive.Store
+		archiver logarchive.Archiver
+
+		build  *dbfakes.FakeBuild
+		events []event.Envelope
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "log-archive")
+		Expect(err).NotTo(HaveOccurred())
+
+		store = logarchive.NewLocalStore(dir)
+		archiver = logarchive.NewArchiver(store)
+
+		events = []event.Envelope{
+			envelope(`{"payload":"hello"}`),
+			envelope(`{"payload":"world"}`),
+		}
+
+		build = new(dbfakes.FakeBuild)
+		build.IDReturns(42)
+		build.EventsStub = func(from uint) (db.EventSource, error) {
+			source := new(dbfakes.FakeEventSource)
+			source.NextStub = func() (event.Envelope, error) {
+				if from >= uint(len(events)) {
+					return event.Envelope{}, db.ErrEndOfBuildEventStream
+				}
+
+				from++
+				return events[from-1], nil
+			}
+
+			return source, nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Archive", func() {
+		It("records where the events were archived to", func() {
+			err := archiver.Archive(context.TODO(), build)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.SetLogArchiveCallCount()).To(Equal(1))
+			Expect(build.SetLogArchiveArgsForCall(0)).To(Equal("builds/42.ndjson.gz"))
+		})
+
+		Context("when reading the events fails", func() {
+			BeforeEach(func() {
+				build.EventsReturns(nil, errors.New("nope"))
+				build.EventsStub = nil
+			})
+
+			It("returns the error without recording anything", func() {
+				err := archiver.Archive(context.TODO(), build)
+				Expect(err).To(MatchError("nope"))
+				Expect(build.SetLogArchiveCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the store fails", func() {
+			var fakeStore *logarchivefakes.FakeStore
+
+			BeforeEach(func() {
+				fakeStore = new(logarchivefakes.FakeStore)
+				fakeStore.PutReturns(errors.New("disk full"))
+				archiver = logarchive.NewArchiver(fakeStore)
+			})
+
+			It("returns the error without recording anything", func() {
+				err := archiver.Archive(context.TODO(), build)
+				Expect(err).To(MatchError(ContainSubstring("disk full")))
+				Expect(build.SetLogArchiveCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Events", func() {
+		BeforeEach(func() {
+			err := archiver.Archive(context.TODO(), build)
+			Expect(err).NotTo(HaveOccurred())
+
+			build.LogArchiveReturns(build.SetLogArchiveArgsForCall(0))
+		})
+
+		It("streams the archived events", func() {
+			source, err := archiver.Events(context.TODO(), build, 0)
+			Expect(err).NotTo(HaveOccurred())
+			defer source.Close()
+
+			Expect(source.Next()).To(Equal(events[0]))
+			Expect(source.Next()).To(Equal(events[1]))
+
+			_, err = source.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("can start from a later event", func() {
+			source, err := archiver.Events(context.TODO(), build, 1)
+			Expect(err).NotTo(HaveOccurred())
+			defer source.Close()
+
+			Expect(source.Next()).To(Equal(events[1]))
+
+			_, err = source.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		Context("when the archive is missing", func() {
+			BeforeEach(func() {
+				build.LogArchiveReturns("bogus")
+			})
+
+			It("returns ErrNotFound", func() {
+				_, err := archiver.Events(context.TODO(), build, 0)
+				Expect(err).To(Equal(logarchive.ErrNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package logarchive
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+type Local struct {
+	Dir string `long:"dir" description:"Directory to archive build logs to."`
+}
+
+func (l Local) IsConfigured() bool {
+	return l.Dir != ""
+}
+
+func (l Local) Store() (Store, error) {
+	err := os.MkdirAll(l.Dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocalStore(l.Dir), nil
+}
+
+// NewLocalStore returns a Store which keeps archives as files under dir.
+func NewLocalStore(dir string) Store {
+	return localStore{dir: dir}
+}
+
+type localStore struct {
+	dir string
+}
+
+func (s localStore) Put(_ context.Context, key string, contents io.Reader) error {
+	path := s.path(key)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a failed write never leaves a
+	// partial archive behind
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".archive")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, contents)
+	if err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s localStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s localStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
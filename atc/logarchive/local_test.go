@@ -0,0 +1,60 @@
+package logarchive_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/logarchive"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalStore", func() {
+	var (
+		dir   string
+		store logarchive.Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "log-archive")
+		Expect(err).NotTo(HaveOccurred())
+
+		store = logarchive.NewLocalStore(dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("writes blobs to files under the directory", func() {
+		err := store.Put(context.TODO(), "builds/1.ndjson.gz", strings.NewReader("some-contents"))
+		Expect(err).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadFile(filepath.Join(dir, "builds", "1.ndjson.gz"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("reads back what was written", func() {
+		err := store.Put(context.TODO(), "some-key", strings.NewReader("some-contents"))
+		Expect(err).NotTo(HaveOccurred())
+
+		blob, err := store.Get(context.TODO(), "some-key")
+		Expect(err).NotTo(HaveOccurred())
+		defer blob.Close()
+
+		contents, err := ioutil.ReadAll(blob)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(Equal("some-contents"))
+	})
+
+	It("returns ErrNotFound for missing keys", func() {
+		_, err := store.Get(context.TODO(), "bogus")
+		Expect(err).To(Equal(logarchive.ErrNotFound))
+	})
+})
@@ -0,0 +1,89 @@
+package logarchive
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type S3 struct {
+	Bucket          string `long:"s3-bucket"           description:"S3 bucket to archive build logs to."`
+	Prefix          string `long:"s3-prefix"           description:"Prefix for the keys of archived build logs."`
+	Region          string `long:"s3-region"           description:"AWS region of the bucket."`
+	Endpoint        string `long:"s3-endpoint"         description:"Endpoint of an S3-compatible API, if not using AWS."`
+	ForcePathStyle  bool   `long:"s3-force-path-style" description:"Address the bucket by path rather than by subdomain. Required by most S3-compatible stores."`
+	AccessKeyID     string `long:"s3-access-key"       description:"Access key ID. Defaults to the standard AWS credential chain."`
+	SecretAccessKey string `long:"s3-secret-key"       description:"Secret access key."`
+	SessionToken    string `long:"s3-session-token"    description:"Session token."`
+}
+
+func (s S3) IsConfigured() bool {
+	return s.Bucket != ""
+}
+
+func (s S3) Store() (Store, error) {
+	config := &aws.Config{
+		Region:           aws.String(s.Region),
+		S3ForcePathStyle: aws.Bool(s.ForcePathStyle),
+	}
+
+	if s.Endpoint != "" {
+		config.Endpoint = aws.String(s.Endpoint)
+	}
+
+	if s.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(s.AccessKeyID, s.SecretAccessKey, s.SessionToken)
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3Store{
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   s.Bucket,
+		prefix:   s.Prefix,
+	}, nil
+}
+
+type s3Store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+
+	bucket string
+	prefix string
+}
+
+func (s s3Store) Put(ctx context.Context, key string, contents io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path.Join(s.prefix, key)),
+		Body:   contents,
+	})
+	return err
+}
+
+func (s s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(path.Join(s.prefix, key)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
@@ -0,0 +1,19 @@
+package logarchive
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by a Store when nothing has been archived under the
+// given key.
+var ErrNotFound = errors.New("archive not found")
+
+//go:generate counterfeiter . Store
+
+// Store is a blob store that build logs are archived to.
+type Store interface {
+	Put(ctx context.Context, key string, contents io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
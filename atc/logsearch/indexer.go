@@ -0,0 +1,152 @@
+package logsearch
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// maxLineLength bounds the length of an indexed line, as a single line of
+// output can be arbitrarily large (e.g. a progress bar without newlines).
+const maxLineLength = 4096
+
+//go:generate counterfeiter . Indexer
+
+type Indexer interface {
+	Run(context.Context) error
+}
+
+type indexer struct {
+	buildFactory db.BuildFactory
+	batchSize    int
+}
+
+// NewIndexer returns an Indexer which indexes the logs of finished builds so
+// that they can be searched. Each run indexes at most batchSize builds, so
+// that a backlog of builds is worked through incrementally.
+func NewIndexer(buildFactory db.BuildFactory, batchSize int) Indexer {
+	return &indexer{
+		buildFactory: buildFactory,
+		batchSize:    batchSize,
+	}
+}
+
+func (i *indexer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("log-indexer")
+
+	builds, err := i.buildFactory.GetLogIndexableBuilds(i.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-indexable-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		err := i.indexBuild(logger, build)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *indexer) indexBuild(logger lager.Logger, build db.Build) error {
+	logger = logger.Session("index-build", lager.Data{
+		"build": build.ID(),
+	})
+
+	events, err := build.Events(0)
+	if err != nil {
+		logger.Error("failed-to-get-events", err)
+		return err
+	}
+
+	// ignore any errors coming from events.Close()
+	defer db.Close(events)
+
+	lines := []db.BuildLogLine{}
+
+	// output is written in arbitrary chunks, so keep the trailing partial line
+	// of each step until the rest of it is written
+	partial := map[string]string{}
+	origins := []string{}
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			logger.Error("failed-to-get-next-event", err)
+			return err
+		}
+
+		if ev.Event != event.EventTypeLog {
+			continue
+		}
+
+		var log event.Log
+		err = json.Unmarshal(*ev.Data, &log)
+		if err != nil {
+			logger.Error("failed-to-unmarshal", err)
+			return err
+		}
+
+		origin := string(log.Origin.ID)
+
+		pending, found := partial[origin]
+		if !found {
+			origins = append(origins, origin)
+		}
+
+		chunks := strings.Split(pending+log.Payload, "\n")
+		for _, chunk := range chunks[:len(chunks)-1] {
+			lines = appendLine(lines, origin, chunk)
+		}
+
+		partial[origin] = chunks[len(chunks)-1]
+	}
+
+	for _, origin := range origins {
+		lines = appendLine(lines, origin, partial[origin])
+	}
+
+	err = build.IndexLogs(lines)
+	if err != nil {
+		logger.Error("failed-to-index-logs", err)
+		return err
+	}
+
+	return nil
+}
+
+func appendLine(lines []db.BuildLogLine, origin string, line string) []db.BuildLogLine {
+	// carriage returns redraw the line, so only the last version is visible
+	if i := strings.LastIndex(strings.TrimRight(line, "\r"), "\r"); i != -1 {
+		line = line[i+1:]
+	}
+
+	if len(line) > maxLineLength {
+		line = line[:maxLineLength]
+	}
+
+	// postgres only stores valid UTF-8 text, without NUL characters
+	line = strings.ToValidUTF8(line, "")
+	line = strings.Replace(line, "\x00", "", -1)
+
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return lines
+	}
+
+	return append(lines, db.BuildLogLine{
+		Origin: origin,
+		Line:   line,
+	})
+}
@@ -0,0 +1,136 @@
+package logsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logsearch"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func newFakeEventSource(events ...atc.Event) *dbfakes.FakeEventSource {
+	fakeEventSource := new(dbfakes.FakeEventSource)
+
+	for i, ev := range events {
+		payload, err := json.Marshal(ev)
+		Expect(err).ToNot(HaveOccurred())
+
+		data := json.RawMessage(payload)
+		fakeEventSource.NextReturnsOnCall(i, event.Envelope{
+			Data:  &data,
+			Event: ev.EventType(),
+		}, nil)
+	}
+
+	fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+	return fakeEventSource
+}
+
+var _ = Describe("Indexer", func() {
+	var (
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+		fakeBuild        *dbfakes.FakeBuild
+
+		indexer logsearch.Indexer
+		runErr  error
+	)
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(123)
+		fakeBuild.EventsReturns(newFakeEventSource(
+			event.Log{Origin: event.Origin{ID: "1"}, Payload: "fetching dependencies\n"},
+			event.Status{Status: atc.StatusStarted},
+			event.Log{Origin: event.Origin{ID: "2"}, Payload: "panic: runtime "},
+			event.Log{Origin: event.Origin{ID: "1"}, Payload: "\n\ndownloading...\r50%\r100%\n"},
+			event.Log{Origin: event.Origin{ID: "2"}, Payload: "error\n\tgoroutine 1"},
+		), nil)
+
+		fakeBuildFactory.GetLogIndexableBuildsReturns([]db.Build{fakeBuild}, nil)
+
+		indexer = logsearch.NewIndexer(fakeBuildFactory, 42)
+	})
+
+	JustBeforeEach(func() {
+		runErr = indexer.Run(context.TODO())
+	})
+
+	It("indexes a batch of builds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeBuildFactory.GetLogIndexableBuildsCallCount()).To(Equal(1))
+		Expect(fakeBuildFactory.GetLogIndexableBuildsArgsForCall(0)).To(Equal(42))
+	})
+
+	It("indexes the log lines of each step", func() {
+		Expect(fakeBuild.EventsCallCount()).To(Equal(1))
+		Expect(fakeBuild.EventsArgsForCall(0)).To(BeZero())
+
+		Expect(fakeBuild.IndexLogsCallCount()).To(Equal(1))
+		Expect(fakeBuild.IndexLogsArgsForCall(0)).To(Equal([]db.BuildLogLine{
+			{Origin: "1", Line: "fetching dependencies"},
+			{Origin: "1", Line: "100%"},
+			{Origin: "2", Line: "panic: runtime error"},
+			{Origin: "2", Line: "goroutine 1"},
+		}))
+	})
+
+	Context("when the build has no logs", func() {
+		BeforeEach(func() {
+			fakeBuild.EventsReturns(newFakeEventSource(
+				event.Status{Status: atc.StatusSucceeded},
+			), nil)
+		})
+
+		It("still marks the build as indexed", func() {
+			Expect(fakeBuild.IndexLogsCallCount()).To(Equal(1))
+			Expect(fakeBuild.IndexLogsArgsForCall(0)).To(BeEmpty())
+		})
+	})
+
+	Context("when a line contains characters postgres can't store", func() {
+		BeforeEach(func() {
+			fakeBuild.EventsReturns(newFakeEventSource(
+				event.Log{Origin: event.Origin{ID: "1"}, Payload: "bad\x00 byte\n"},
+			), nil)
+		})
+
+		It("strips them", func() {
+			Expect(fakeBuild.IndexLogsArgsForCall(0)).To(Equal([]db.BuildLogLine{
+				{Origin: "1", Line: "bad byte"},
+			}))
+		})
+	})
+
+	Context("when getting the builds fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuildFactory.GetLogIndexableBuildsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+
+	Context("when indexing a build fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.IndexLogsReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
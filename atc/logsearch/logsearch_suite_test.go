@@ -0,0 +1,13 @@
+package logsearch_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLogSearch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log Search Suite")
+}
@@ -89,6 +89,7 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 		"resource checked",
 		"check enqueue",
 		"check queue size",
+		"checks deferred",
 		"check started",
 		"check finished",
 		"worker containers",
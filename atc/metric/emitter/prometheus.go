@@ -46,6 +46,7 @@ type PrometheusEmitter struct {
 	checksVec       *prometheus.HistogramVec
 	checkEnqueueVec *prometheus.CounterVec
 	checkQueueSize  prometheus.Gauge
+	checksDeferred  prometheus.Counter
 
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
@@ -357,6 +358,16 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checkQueueSize)
 
+	checksDeferred := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "checks_deferred_total",
+			Help:      "Total number of checks deferred by the check rate limits",
+		},
+	)
+	prometheus.MustRegister(checksDeferred)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -393,6 +404,7 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checksVec:       checksVec,
 		checkEnqueueVec: checkEnqueueVec,
 		checkQueueSize:  checkQueueSize,
+		checksDeferred:  checksDeferred,
 
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
@@ -458,6 +470,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checkEnqueueMetric(logger, event)
 	case "check queue size":
 		emitter.checkQueueSizeMetric(logger, event)
+	case "checks deferred":
+		emitter.checksDeferredMetric(logger, event)
 	case "check started":
 		emitter.checkMetric(logger, event)
 	case "check finished":
@@ -736,6 +750,10 @@ func (emitter *PrometheusEmitter) checkQueueSizeMetric(logger lager.Logger, even
 	emitter.checkQueueSize.Set(event.Value)
 }
 
+func (emitter *PrometheusEmitter) checksDeferredMetric(logger lager.Logger, event metric.Event) {
+	emitter.checksDeferred.Add(event.Value)
+}
+
 func (emitter *PrometheusEmitter) checkEnqueueMetric(logger lager.Logger, event metric.Event) {
 	scopeID, exists := event.Attributes["scope_id"]
 	if !exists {
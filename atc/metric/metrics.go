@@ -23,6 +23,7 @@ var FailedVolumes = &Counter{}
 var ContainersDeleted = &Counter{}
 var VolumesDeleted = &Counter{}
 var ChecksDeleted = &Counter{}
+var ResourceConfigVersionsDeleted = &Counter{}
 
 var JobsScheduled = &Counter{}
 var JobsScheduling = &Gauge{}
@@ -618,6 +619,21 @@ func (event CheckQueueSize) Emit(logger lager.Logger) {
 	)
 }
 
+type ChecksDeferred struct {
+	Checks int
+}
+
+func (event ChecksDeferred) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("checks-deferred"),
+		Event{
+			Name:       "checks deferred",
+			Value:      float64(event.Checks),
+			Attributes: map[string]string{},
+		},
+	)
+}
+
 var lockTypeNames = map[int]string{
 	lock.LockTypeResourceConfigChecking: "ResourceConfigChecking",
 	lock.LockTypeBuildTracking:          "BuildTracking",
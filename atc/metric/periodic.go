@@ -75,6 +75,14 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("resource-config-versions-deleted"),
+		Event{
+			Name:  "resource config versions deleted",
+			Value: ResourceConfigVersionsDeleted.Delta(),
+		},
+	)
+
 	emit(
 		logger.Session("containers-created"),
 		Event{
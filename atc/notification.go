@@ -0,0 +1,89 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// A NotificationSubscription configures a URL to which the builds of a team
+// are POSTed as a BuildNotification once they finish.
+type NotificationSubscription struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+
+	// Secret is used to sign the payloads with HMAC-SHA256. It is never
+	// returned by the API.
+	Secret string `json:"secret,omitempty"`
+
+	// Statuses limits the notifications to builds finishing with one of the
+	// given statuses. All finished builds are notified if it is empty.
+	Statuses []BuildStatus `json:"statuses,omitempty"`
+}
+
+func (subscription NotificationSubscription) Validate() error {
+	var errorMessages []string
+
+	if subscription.Name == "" {
+		errorMessages = append(errorMessages, "notification has no name")
+	}
+
+	if subscription.URL == "" {
+		errorMessages = append(errorMessages, "notification has no url")
+	} else {
+		u, err := url.Parse(subscription.URL)
+		if err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("notification has a malformed url: %s", err))
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errorMessages = append(errorMessages, fmt.Sprintf("notification url '%s' is not an absolute http(s) url", subscription.URL))
+		}
+	}
+
+	for _, status := range subscription.Statuses {
+		switch status {
+		case StatusSucceeded, StatusFailed, StatusErrored, StatusAborted:
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("notification has unknown build status '%s'", status))
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New(strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "pending"
+	NotificationDeliveryDelivered NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "failed"
+)
+
+// A NotificationDelivery records the delivery of a build's notification to
+// the URL of a subscription, along with the outcome of its latest attempt.
+type NotificationDelivery struct {
+	ID           int                        `json:"id"`
+	Notification string                     `json:"notification"`
+	BuildID      int                        `json:"build_id"`
+	BuildStatus  BuildStatus                `json:"build_status"`
+	Status       NotificationDeliveryStatus `json:"status"`
+	Attempts     int                        `json:"attempts"`
+
+	ResponseStatus int    `json:"response_status,omitempty"`
+	Error          string `json:"error,omitempty"`
+
+	CreatedAt     int64 `json:"created_at"`
+	LastAttemptAt int64 `json:"last_attempt_at,omitempty"`
+}
+
+// A BuildNotification is the payload sent to subscriptions when a build
+// finishes.
+type BuildNotification struct {
+	Build  Build              `json:"build"`
+	URL    string             `json:"url"`
+	Inputs []PublicBuildInput `json:"inputs"`
+}
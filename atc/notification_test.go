@@ -0,0 +1,59 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationSubscription", func() {
+	var subscription atc.NotificationSubscription
+
+	BeforeEach(func() {
+		subscription = atc.NotificationSubscription{
+			Name:     "some-notification",
+			URL:      "https://example.com/hooks/concourse",
+			Secret:   "some-secret",
+			Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid subscription", func() {
+			Expect(subscription.Validate()).To(Succeed())
+		})
+
+		It("requires a name", func() {
+			subscription.Name = ""
+			Expect(subscription.Validate()).To(MatchError(ContainSubstring("notification has no name")))
+		})
+
+		It("requires a url", func() {
+			subscription.URL = ""
+			Expect(subscription.Validate()).To(MatchError(ContainSubstring("notification has no url")))
+		})
+
+		It("requires an absolute http(s) url", func() {
+			subscription.URL = "ftp://example.com"
+			Expect(subscription.Validate()).To(MatchError(ContainSubstring("notification url 'ftp://example.com' is not an absolute http(s) url")))
+
+			subscription.URL = "/hooks/concourse"
+			Expect(subscription.Validate()).To(MatchError(ContainSubstring("is not an absolute http(s) url")))
+		})
+
+		It("rejects statuses of builds that have not finished", func() {
+			subscription.Statuses = []atc.BuildStatus{atc.StatusStarted}
+			Expect(subscription.Validate()).To(MatchError(ContainSubstring("notification has unknown build status 'started'")))
+		})
+
+		It("reports every error", func() {
+			subscription.Name = ""
+			subscription.URL = ""
+
+			err := subscription.Validate()
+			Expect(err).To(MatchError(ContainSubstring("notification has no name")))
+			Expect(err).To(MatchError(ContainSubstring("notification has no url")))
+		})
+	})
+})
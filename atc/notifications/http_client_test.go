@@ -0,0 +1,44 @@
+package notifications_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/notifications"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewHTTPClient", func() {
+	var server *ghttp.Server
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		server.RouteToHandler("POST", "/hooks", ghttp.RespondWith(http.StatusOK, nil))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("refuses to connect to private addresses", func() {
+		client := notifications.NewHTTPClient(time.Second, false)
+
+		_, err := client.Post(server.URL()+"/hooks", "application/json", nil)
+		Expect(err).To(MatchError(ContainSubstring("refusing to deliver notification to private address 127.0.0.1")))
+		Expect(server.ReceivedRequests()).To(BeEmpty())
+	})
+
+	Context("when private networks are allowed", func() {
+		It("connects to them", func() {
+			client := notifications.NewHTTPClient(time.Second, true)
+
+			resp, err := client.Post(server.URL()+"/hooks", "application/json", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
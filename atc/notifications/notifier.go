@@ -0,0 +1,207 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	// MaxAttempts is the number of times a notification is delivered before
+	// its delivery is considered failed.
+	MaxAttempts = 5
+
+	// RetryInterval is the delay before the first retry of a delivery. It is
+	// doubled for every subsequent retry.
+	RetryInterval = 30 * time.Second
+
+	deliveriesPerRun = 100
+
+	// maxDeliveriesInFlight bounds the number of deliveries made at once, so
+	// that a few slow URLs do not hold up the notifications of every other
+	// subscription until they time out.
+	maxDeliveriesInFlight = 10
+
+	EventHeader        = "X-Concourse-Event"
+	DeliveryHeader     = "X-Concourse-Delivery"
+	Signature256Header = "X-Concourse-Signature-256"
+
+	buildStatusEvent = "build-status"
+)
+
+// Notifier POSTs the notifications of finished builds to the URLs of the
+// subscriptions they were queued for.
+type Notifier struct {
+	logger        lager.Logger
+	notifications db.NotificationRepository
+	buildFactory  db.BuildFactory
+	httpClient    *http.Client
+	externalURL   string
+	clock         clock.Clock
+}
+
+func NewNotifier(
+	logger lager.Logger,
+	notifications db.NotificationRepository,
+	buildFactory db.BuildFactory,
+	httpClient *http.Client,
+	externalURL string,
+	clock clock.Clock,
+) *Notifier {
+	return &Notifier{
+		logger:        logger,
+		notifications: notifications,
+		buildFactory:  buildFactory,
+		httpClient:    httpClient,
+		externalURL:   externalURL,
+		clock:         clock,
+	}
+}
+
+func (n *Notifier) Run(ctx context.Context) error {
+	deliveries, err := n.notifications.PendingDeliveries(deliveriesPerRun)
+	if err != nil {
+		return fmt.Errorf("find pending deliveries: %w", err)
+	}
+
+	guard := make(chan struct{}, maxDeliveriesInFlight)
+	waitGroup := new(sync.WaitGroup)
+
+	for _, delivery := range deliveries {
+		guard <- struct{}{}
+		waitGroup.Add(1)
+
+		go func(delivery db.PendingNotificationDelivery) {
+			defer func() {
+				<-guard
+				waitGroup.Done()
+			}()
+
+			n.deliver(ctx, n.logger.Session("deliver", lager.Data{
+				"delivery":     delivery.ID,
+				"notification": delivery.Subscription.Name,
+				"build":        delivery.BuildID,
+			}), delivery)
+		}(delivery)
+	}
+
+	// the deliveries are still pending until they are finished, so they must
+	// not be picked up again by the next run
+	waitGroup.Wait()
+
+	return nil
+}
+
+func (n *Notifier) deliver(ctx context.Context, logger lager.Logger, delivery db.PendingNotificationDelivery) {
+	build, found, err := n.buildFactory.Build(delivery.BuildID)
+	if err != nil {
+		logger.Error("failed-to-find-build", err)
+		return
+	}
+
+	if !found {
+		logger.Info("build-not-found")
+		return
+	}
+
+	attempt := n.post(ctx, delivery, build)
+
+	if attempt.Error != "" {
+		logger.Info("delivery-attempt-failed", lager.Data{
+			"attempt": delivery.Attempts + 1,
+			"error":   attempt.Error,
+		})
+
+		if delivery.Attempts+1 < MaxAttempts {
+			attempt.Status = atc.NotificationDeliveryPending
+			attempt.RetryAt = n.clock.Now().Add(RetryInterval << uint(delivery.Attempts))
+		} else {
+			attempt.Status = atc.NotificationDeliveryFailed
+		}
+	}
+
+	err = n.notifications.FinishDeliveryAttempt(delivery.ID, attempt)
+	if err != nil {
+		logger.Error("failed-to-finish-delivery-attempt", err)
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, delivery db.PendingNotificationDelivery, build db.Build) db.NotificationDeliveryAttempt {
+	payload, err := n.payload(build)
+	if err != nil {
+		return db.NotificationDeliveryAttempt{Error: err.Error()}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return db.NotificationDeliveryAttempt{Error: err.Error()}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, buildStatusEvent)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+
+	if delivery.Subscription.Secret != "" {
+		req.Header.Set(Signature256Header, Sign(delivery.Subscription.Secret, payload))
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return db.NotificationDeliveryAttempt{Error: err.Error()}
+	}
+
+	_ = resp.Body.Close()
+
+	attempt := db.NotificationDeliveryAttempt{
+		Status:         atc.NotificationDeliveryDelivered,
+		ResponseStatus: resp.StatusCode,
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		attempt.Error = fmt.Sprintf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return attempt
+}
+
+func (n *Notifier) payload(build db.Build) ([]byte, error) {
+	inputs, _, err := build.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("get build resources: %w", err)
+	}
+
+	notification := atc.BuildNotification{
+		Build:  present.Build(build),
+		URL:    fmt.Sprintf("%s/builds/%d", n.externalURL, build.ID()),
+		Inputs: []atc.PublicBuildInput{},
+	}
+
+	for _, input := range inputs {
+		notification.Inputs = append(notification.Inputs, present.PublicBuildInput(input, build.PipelineID()))
+	}
+
+	return json.Marshal(notification)
+}
+
+// Sign returns the value of the signature header for a payload, i.e. its
+// hex-encoded HMAC-SHA256 prefixed with "sha256=".
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,272 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifications"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		fakeNotifications *dbfakes.FakeNotificationRepository
+		fakeBuildFactory  *dbfakes.FakeBuildFactory
+		fakeBuild         *dbfakes.FakeBuild
+		fakeClock         *fakeclock.FakeClock
+		server            *ghttp.Server
+
+		delivery        db.PendingNotificationDelivery
+		otherDeliveries []db.PendingNotificationDelivery
+		body            []byte
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeNotifications = new(dbfakes.FakeNotificationRepository)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+		server = ghttp.NewServer()
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.NameReturns("7")
+		fakeBuild.TeamNameReturns("some-team")
+		fakeBuild.PipelineIDReturns(1)
+		fakeBuild.PipelineNameReturns("some-pipeline")
+		fakeBuild.JobNameReturns("some-job")
+		fakeBuild.StatusReturns(db.BuildStatusFailed)
+		fakeBuild.ResourcesReturns([]db.BuildInput{
+			{
+				Name:    "some-input",
+				Version: atc.Version{"ref": "abc"},
+			},
+		}, nil, nil)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		otherDeliveries = nil
+
+		delivery = db.PendingNotificationDelivery{
+			ID:      3,
+			BuildID: 42,
+			Subscription: atc.NotificationSubscription{
+				Name:   "some-notification",
+				URL:    server.URL() + "/hooks",
+				Secret: "some-secret",
+			},
+		}
+
+		server.RouteToHandler("POST", "/hooks", func(w http.ResponseWriter, r *http.Request) {
+			var err error
+			body, err = ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(r.Header.Get(notifications.EventHeader)).To(Equal("build-status"))
+			Expect(r.Header.Get(notifications.DeliveryHeader)).To(Equal("3"))
+			Expect(r.Header.Get(notifications.Signature256Header)).To(Equal(notifications.Sign("some-secret", body)))
+		})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		fakeNotifications.PendingDeliveriesReturns(append([]db.PendingNotificationDelivery{delivery}, otherDeliveries...), nil)
+
+		runErr = notifications.NewNotifier(
+			lagertest.NewTestLogger("test"),
+			fakeNotifications,
+			fakeBuildFactory,
+			http.DefaultClient,
+			"https://ci.example.com",
+			fakeClock,
+		).Run(context.Background())
+	})
+
+	It("posts the signed notification of the build", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+		Expect(body).To(MatchJSON(`{
+			"build": {
+				"id": 42,
+				"name": "7",
+				"status": "failed",
+				"team_name": "some-team",
+				"pipeline_name": "some-pipeline",
+				"job_name": "some-job",
+				"api_url": "/api/v1/builds/42"
+			},
+			"url": "https://ci.example.com/builds/42",
+			"inputs": [
+				{
+					"name": "some-input",
+					"version": {"ref": "abc"},
+					"pipeline_id": 1,
+					"first_occurrence": false
+				}
+			]
+		}`))
+	})
+
+	It("records the delivery", func() {
+		Expect(fakeNotifications.FinishDeliveryAttemptCallCount()).To(Equal(1))
+
+		id, attempt := fakeNotifications.FinishDeliveryAttemptArgsForCall(0)
+		Expect(id).To(Equal(3))
+		Expect(attempt).To(Equal(db.NotificationDeliveryAttempt{
+			Status:         atc.NotificationDeliveryDelivered,
+			ResponseStatus: http.StatusOK,
+		}))
+	})
+
+	Context("when the subscription has no secret", func() {
+		BeforeEach(func() {
+			delivery.Subscription.Secret = ""
+
+			server.RouteToHandler("POST", "/hooks", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get(notifications.Signature256Header)).To(BeEmpty())
+			})
+		})
+
+		It("does not sign the notification", func() {
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the url responds with an error", func() {
+		BeforeEach(func() {
+			server.RouteToHandler("POST", "/hooks", ghttp.RespondWith(http.StatusServiceUnavailable, nil))
+		})
+
+		It("retries the delivery later", func() {
+			_, attempt := fakeNotifications.FinishDeliveryAttemptArgsForCall(0)
+			Expect(attempt).To(Equal(db.NotificationDeliveryAttempt{
+				Status:         atc.NotificationDeliveryPending,
+				ResponseStatus: http.StatusServiceUnavailable,
+				Error:          "unexpected response status 503",
+				RetryAt:        fakeClock.Now().Add(notifications.RetryInterval),
+			}))
+		})
+
+		Context("when the delivery has been retried before", func() {
+			BeforeEach(func() {
+				delivery.Attempts = 2
+			})
+
+			It("backs off exponentially", func() {
+				_, attempt := fakeNotifications.FinishDeliveryAttemptArgsForCall(0)
+				Expect(attempt.RetryAt).To(Equal(fakeClock.Now().Add(4 * notifications.RetryInterval)))
+			})
+		})
+
+		Context("when it was the last attempt", func() {
+			BeforeEach(func() {
+				delivery.Attempts = notifications.MaxAttempts - 1
+			})
+
+			It("fails the delivery", func() {
+				_, attempt := fakeNotifications.FinishDeliveryAttemptArgsForCall(0)
+				Expect(attempt.Status).To(Equal(atc.NotificationDeliveryFailed))
+				Expect(attempt.Error).To(Equal("unexpected response status 503"))
+			})
+		})
+	})
+
+	Context("when the url cannot be reached", func() {
+		BeforeEach(func() {
+			delivery.Subscription.URL = "http://127.0.0.1:1/hooks"
+		})
+
+		It("retries the delivery later", func() {
+			_, attempt := fakeNotifications.FinishDeliveryAttemptArgsForCall(0)
+			Expect(attempt.Status).To(Equal(atc.NotificationDeliveryPending))
+			Expect(attempt.ResponseStatus).To(BeZero())
+			Expect(attempt.Error).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Context("when there are several deliveries", func() {
+		BeforeEach(func() {
+			for _, id := range []int{4, 5} {
+				other := delivery
+				other.ID = id
+				otherDeliveries = append(otherDeliveries, other)
+			}
+
+			arrived := new(sync.WaitGroup)
+			arrived.Add(3)
+
+			server.RouteToHandler("POST", "/hooks", func(w http.ResponseWriter, r *http.Request) {
+				arrived.Done()
+
+				allArrived := make(chan struct{})
+				go func() {
+					arrived.Wait()
+					close(allArrived)
+				}()
+
+				select {
+				case <-allArrived:
+				case <-time.After(time.Second):
+					w.WriteHeader(http.StatusGatewayTimeout)
+				}
+			})
+		})
+
+		It("delivers them concurrently", func() {
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+			Expect(fakeNotifications.FinishDeliveryAttemptCallCount()).To(Equal(3))
+
+			for i := 0; i < 3; i++ {
+				_, attempt := fakeNotifications.FinishDeliveryAttemptArgsForCall(i)
+				Expect(attempt.Status).To(Equal(atc.NotificationDeliveryDelivered))
+			}
+		})
+	})
+
+	Context("when the build no longer exists", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.BuildReturns(nil, false, nil)
+		})
+
+		It("does not deliver anything", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeNotifications.FinishDeliveryAttemptCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when finding the pending deliveries fails", func() {
+		JustBeforeEach(func() {
+			fakeNotifications.PendingDeliveriesReturns(nil, errors.New("nope"))
+
+			runErr = notifications.NewNotifier(
+				lagertest.NewTestLogger("test"),
+				fakeNotifications,
+				fakeBuildFactory,
+				http.DefaultClient,
+				"https://ci.example.com",
+				fakeClock,
+			).Run(context.Background())
+		})
+
+		It("returns an error", func() {
+			Expect(runErr).To(MatchError(ContainSubstring("nope")))
+		})
+	})
+})
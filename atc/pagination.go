@@ -12,4 +12,8 @@ const (
 	PaginationQueryLimit      = "limit"
 	PaginationWebLimit        = 100
 	PaginationAPIDefaultLimit = 100
+
+	// BuildsQueryAnnotation filters builds by an annotation, given as
+	// 'key:value'. It may be given more than once.
+	BuildsQueryAnnotation = "annotation"
 )
@@ -1,14 +1,161 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	Paused       bool         `json:"paused"`
+	Public       bool         `json:"public"`
+	Groups       GroupConfigs `json:"groups,omitempty"`
+	TeamName     string       `json:"team_name"`
+}
+
+func (p Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         p.Name,
+		InstanceVars: p.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// InstanceVars are the vars that distinguish one instance of a pipeline from
+// the other instances sharing the same name.
+type InstanceVars map[string]interface{}
+
+// PipelineRef identifies a pipeline within a team. Pipelines without instance
+// vars are identified by their name alone.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+// String renders the ref in the same name/key:value,key:value form accepted
+// by ParsePipelineRef. Keys are sorted so the output is stable.
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	keys := make([]string, 0, len(ref.InstanceVars))
+	for k := range ref.InstanceVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s:%s", k, formatInstanceVar(ref.InstanceVars[k]))
+	}
+
+	return ref.Name + "/" + strings.Join(pairs, ",")
+}
+
+func formatInstanceVar(v interface{}) string {
+	if str, ok := v.(string); ok {
+		var parsed interface{}
+		if json.Unmarshal([]byte(str), &parsed) != nil && !strings.ContainsAny(str, ",:/") {
+			return str
+		}
+	}
+
+	payload, _ := json.Marshal(v)
+	return string(payload)
+}
+
+// QueryParams returns the query params that must accompany pipeline-scoped
+// API requests in order to address this instance.
+func (ref PipelineRef) QueryParams() url.Values {
+	query := url.Values{}
+	if len(ref.InstanceVars) == 0 {
+		return query
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+	query.Set(InstanceVarsQueryParam, string(payload))
+
+	return query
+}
+
+// ParsePipelineRef parses a reference of the form name or
+// name/key:value,key:value. Values are parsed as JSON where possible and fall
+// back to plain strings otherwise.
+func ParsePipelineRef(s string) (PipelineRef, error) {
+	name, rawVars := s, ""
+	if i := strings.Index(s, "/"); i != -1 {
+		name, rawVars = s[:i], s[i+1:]
+	}
+
+	if name == "" {
+		return PipelineRef{}, fmt.Errorf("invalid pipeline ref '%s': missing name", s)
+	}
+
+	ref := PipelineRef{Name: name}
+	if rawVars == "" {
+		return ref, nil
+	}
+
+	ref.InstanceVars = InstanceVars{}
+	for _, pair := range strings.Split(rawVars, ",") {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return PipelineRef{}, fmt.Errorf("invalid instance var '%s': must be of the form key:value", pair)
+		}
+
+		var value interface{}
+		err := json.Unmarshal([]byte(kv[1]), &value)
+		if err != nil {
+			value = kv[1]
+		}
+
+		ref.InstanceVars[kv[0]] = value
+	}
+
+	return ref, nil
+}
+
+// InstanceVarsFromQueryParams reads the instance vars encoded by
+// PipelineRef.QueryParams. A missing param yields nil instance vars.
+func InstanceVarsFromQueryParams(query url.Values) (InstanceVars, error) {
+	payload := query.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var instanceVars InstanceVars
+	err := json.Unmarshal([]byte(payload), &instanceVars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %w", err)
+	}
+
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	return instanceVars, nil
+}
+
+// GetPipelineRefFromRequest builds the ref for a pipeline-scoped API request
+// from the :pipeline_name route param and the instance vars query param.
+func GetPipelineRefFromRequest(r *http.Request) (PipelineRef, error) {
+	instanceVars, err := InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		return PipelineRef{}, err
+	}
+
+	return PipelineRef{
+		Name:         r.FormValue(":pipeline_name"),
+		InstanceVars: instanceVars,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package atc_test
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("renders just the name when there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("renders sorted instance vars after the name", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature",
+					"version": float64(2),
+					"debug":   true,
+				},
+			}
+			Expect(ref.String()).To(Equal("some-pipeline/branch:feature,debug:true,version:2"))
+		})
+
+		It("quotes strings that would otherwise be ambiguous", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"version": "2", "path": "a/b"},
+			}
+			Expect(ref.String()).To(Equal(`some-pipeline/path:"a/b",version:"2"`))
+		})
+	})
+
+	Describe("ParsePipelineRef", func() {
+		It("parses a plain name", func() {
+			ref, err := atc.ParsePipelineRef("some-pipeline")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ref).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+
+		It("parses instance vars", func() {
+			ref, err := atc.ParsePipelineRef(`some-pipeline/branch:feature,version:2,tag:"2"`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ref).To(Equal(atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":  "feature",
+					"version": float64(2),
+					"tag":     "2",
+				},
+			}))
+		})
+
+		It("round-trips through String", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature", "tag": "2"},
+			}
+
+			parsed, err := atc.ParsePipelineRef(ref.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed).To(Equal(ref))
+		})
+
+		It("errors when the name is missing", func() {
+			_, err := atc.ParsePipelineRef("/branch:feature")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("errors when an instance var is malformed", func() {
+			_, err := atc.ParsePipelineRef("some-pipeline/branch")
+			Expect(err).To(MatchError(ContainSubstring("key:value")))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("is empty when there are no instance vars", func() {
+			Expect(atc.PipelineRef{Name: "some-pipeline"}.QueryParams()).To(BeEmpty())
+		})
+
+		It("round-trips through InstanceVarsFromQueryParams", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature"},
+			}
+
+			instanceVars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(Equal(ref.InstanceVars))
+		})
+	})
+
+	Describe("InstanceVarsFromQueryParams", func() {
+		It("errors on malformed json", func() {
+			_, err := atc.InstanceVarsFromQueryParams(url.Values{"instance_vars": {"{"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -13,6 +13,8 @@ type Plan struct {
 	Task        *TaskPlan        `json:"task,omitempty"`
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Approve     *ApprovePlan     `json:"approve,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 	OnAbort     *OnAbortPlan     `json:"on_abort,omitempty"`
 	OnError     *OnErrorPlan     `json:"on_error,omitempty"`
 	Ensure      *EnsurePlan      `json:"ensure,omitempty"`
@@ -94,18 +96,20 @@ type GetPlan struct {
 	Version     *Version `json:"version,omitempty"`
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
+	IdleTimeout string   `json:"idle_timeout,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type PutPlan struct {
-	Type     string        `json:"type"`
-	Name     string        `json:"name,omitempty"`
-	Resource string        `json:"resource"`
-	Source   Source        `json:"source"`
-	Params   Params        `json:"params,omitempty"`
-	Tags     Tags          `json:"tags,omitempty"`
-	Inputs   *InputsConfig `json:"inputs,omitempty"`
+	Type        string        `json:"type"`
+	Name        string        `json:"name,omitempty"`
+	Resource    string        `json:"resource"`
+	Source      Source        `json:"source"`
+	Params      Params        `json:"params,omitempty"`
+	Tags        Tags          `json:"tags,omitempty"`
+	Inputs      *InputsConfig `json:"inputs,omitempty"`
+	IdleTimeout string        `json:"idle_timeout,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
@@ -136,14 +140,18 @@ type TaskPlan struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 
+	Memoize     bool   `json:"memoize,omitempty"`
+	IdleTimeout string `json:"idle_timeout,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type SetPipelinePlan struct {
-	Name     string                 `json:"name"`
-	File     string                 `json:"file"`
-	Vars     map[string]interface{} `json:"vars,omitempty"`
-	VarFiles []string               `json:"var_files,omitempty"`
+	Name         string                 `json:"name"`
+	File         string                 `json:"file"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	VarFiles     []string               `json:"var_files,omitempty"`
+	InstanceVars InstanceVars           `json:"instance_vars,omitempty"`
 }
 
 type LoadVarPlan struct {
@@ -153,6 +161,27 @@ type LoadVarPlan struct {
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+type ApprovePlan struct {
+	Name    string   `json:"name"`
+	Message string   `json:"message,omitempty"`
+	Roles   []string `json:"roles,omitempty"`
+	Users   []string `json:"users,omitempty"`
+}
+
+type AcrossPlan struct {
+	Vars []AcrossVar `json:"vars"`
+
+	// the step to run for each combination of values; its plan IDs are
+	// scoped per iteration when the step runs
+	Step Plan `json:"step"`
+}
+
+type AcrossVar struct {
+	Var         string      `json:"name"`
+	Values      interface{} `json:"values"`
+	MaxInFlight int         `json:"max_in_flight,omitempty"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
@@ -160,3 +189,70 @@ type DependentGetPlan struct {
 	Name     string `json:"name,omitempty"`
 	Resource string `json:"resource"`
 }
+
+// Each calls f for the plan and then for every plan nested within it, in
+// depth-first order. Modifications made by f are retained.
+func (plan *Plan) Each(f func(*Plan)) {
+	f(plan)
+
+	if plan.Aggregate != nil {
+		for i := range *plan.Aggregate {
+			(*plan.Aggregate)[i].Each(f)
+		}
+	}
+
+	if plan.InParallel != nil {
+		for i := range plan.InParallel.Steps {
+			plan.InParallel.Steps[i].Each(f)
+		}
+	}
+
+	if plan.Do != nil {
+		for i := range *plan.Do {
+			(*plan.Do)[i].Each(f)
+		}
+	}
+
+	if plan.Across != nil {
+		plan.Across.Step.Each(f)
+	}
+
+	if plan.OnAbort != nil {
+		plan.OnAbort.Step.Each(f)
+		plan.OnAbort.Next.Each(f)
+	}
+
+	if plan.OnError != nil {
+		plan.OnError.Step.Each(f)
+		plan.OnError.Next.Each(f)
+	}
+
+	if plan.Ensure != nil {
+		plan.Ensure.Step.Each(f)
+		plan.Ensure.Next.Each(f)
+	}
+
+	if plan.OnSuccess != nil {
+		plan.OnSuccess.Step.Each(f)
+		plan.OnSuccess.Next.Each(f)
+	}
+
+	if plan.OnFailure != nil {
+		plan.OnFailure.Step.Each(f)
+		plan.OnFailure.Next.Each(f)
+	}
+
+	if plan.Try != nil {
+		plan.Try.Step.Each(f)
+	}
+
+	if plan.Timeout != nil {
+		plan.Timeout.Step.Each(f)
+	}
+
+	if plan.Retry != nil {
+		for i := range *plan.Retry {
+			(*plan.Retry)[i].Each(f)
+		}
+	}
+}
@@ -41,6 +41,10 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.SetPipeline = &t
 	case LoadVarPlan:
 		plan.LoadVar = &t
+	case ApprovePlan:
+		plan.Approve = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case CheckPlan:
 		plan.Check = &t
 	case OnAbortPlan:
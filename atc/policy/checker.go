@@ -0,0 +1,163 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	ActionRunTask = "RunTask"
+	ActionRunPut  = "RunPut"
+)
+
+type PolicyCheckInput struct {
+	Service        string           `json:"service"`
+	ClusterName    string           `json:"cluster_name"`
+	ClusterVersion string           `json:"cluster_version"`
+	HttpMethod     string           `json:"http_method,omitempty"`
+	Action         string           `json:"action"`
+	User           string           `json:"user,omitempty"`
+	Team           string           `json:"team,omitempty"`
+	Pipeline       string           `json:"pipeline,omitempty"`
+	InstanceVars   atc.InstanceVars `json:"instance_vars,omitempty"`
+	Data           interface{}      `json:"data,omitempty"`
+}
+
+type PolicyCheckOutput struct {
+	Allowed bool     `json:"allowed"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+type PolicyCheckNotPassedError struct {
+	Reasons []string
+}
+
+func (err PolicyCheckNotPassedError) Error() string {
+	if len(err.Reasons) == 0 {
+		return "policy check failed"
+	}
+
+	return fmt.Sprintf("policy check failed: %s", strings.Join(err.Reasons, ", "))
+}
+
+//go:generate counterfeiter . Agent
+
+// Agent talks to a policy engine on behalf of the Checker.
+type Agent interface {
+	Check(PolicyCheckInput) (PolicyCheckOutput, error)
+}
+
+//go:generate counterfeiter . AgentFactory
+
+type AgentFactory interface {
+	Description() string
+	IsConfigured() bool
+	NewAgent(lager.Logger) (Agent, error)
+}
+
+var agentFactories []AgentFactory
+
+func RegisterAgent(factory AgentFactory) {
+	agentFactories = append(agentFactories, factory)
+}
+
+func WireCheckers(group *flags.Group) {
+	for _, factory := range agentFactories {
+		_, err := group.AddGroup(fmt.Sprintf("Policy Check Agent (%s)", factory.Description()), "", factory)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+type Filter struct {
+	HttpMethods   []string `long:"policy-check-filter-http-method" description:"API http method to go through policy check"`
+	Actions       []string `long:"policy-check-filter-action" description:"Actions in the list will go through policy check"`
+	ActionsToSkip []string `long:"policy-check-filter-action-skip" description:"Actions the list will not go through policy check"`
+}
+
+//go:generate counterfeiter . Checker
+
+type Checker interface {
+	ShouldCheckHttpMethod(string) bool
+	ShouldCheckAction(string) bool
+	ShouldSkipAction(string) bool
+
+	Check(PolicyCheckInput) (PolicyCheckOutput, error)
+}
+
+// Initialize returns a Checker backed by the configured agent, or nil if no
+// agent has been configured.
+func Initialize(logger lager.Logger, cluster string, version string, filter Filter) (Checker, error) {
+	logger.Debug("policy-checker-initialize")
+
+	var checkerDescriptions []string
+	for _, factory := range agentFactories {
+		if factory.IsConfigured() {
+			checkerDescriptions = append(checkerDescriptions, factory.Description())
+		}
+	}
+	if len(checkerDescriptions) > 1 {
+		return nil, fmt.Errorf("Multiple policy checker configured: %s", strings.Join(checkerDescriptions, ", "))
+	}
+
+	for _, factory := range agentFactories {
+		if factory.IsConfigured() {
+			agent, err := factory.NewAgent(logger.Session("policy-checker"))
+			if err != nil {
+				return nil, err
+			}
+
+			logger.Info("policy-checker-configured", lager.Data{"agent": factory.Description()})
+
+			return &checker{
+				filter:         filter,
+				agent:          agent,
+				clusterName:    cluster,
+				clusterVersion: version,
+			}, nil
+		}
+	}
+
+	// No policy checker configured.
+	return nil, nil
+}
+
+type checker struct {
+	filter         Filter
+	agent          Agent
+	clusterName    string
+	clusterVersion string
+}
+
+func (c *checker) ShouldCheckHttpMethod(method string) bool {
+	return inArray(c.filter.HttpMethods, method)
+}
+
+func (c *checker) ShouldCheckAction(action string) bool {
+	return inArray(c.filter.Actions, action)
+}
+
+func (c *checker) ShouldSkipAction(action string) bool {
+	return inArray(c.filter.ActionsToSkip, action)
+}
+
+func inArray(array []string, target string) bool {
+	for _, ele := range array {
+		if strings.EqualFold(ele, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) Check(input PolicyCheckInput) (PolicyCheckOutput, error) {
+	input.Service = "concourse"
+	input.ClusterName = c.clusterName
+	input.ClusterVersion = c.clusterVersion
+	return c.agent.Check(input)
+}
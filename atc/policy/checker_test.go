@@ -0,0 +1,112 @@
+package policy_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		fakeAgent *policyfakes.FakeAgent
+		filter    policy.Filter
+
+		checker policy.Checker
+		initErr error
+	)
+
+	BeforeEach(func() {
+		fakeAgent = new(policyfakes.FakeAgent)
+		fakeAgentFactory.NewAgentReturns(fakeAgent, nil)
+
+		filter = policy.Filter{
+			HttpMethods:   []string{"PUT", "POST"},
+			Actions:       []string{"GetPipeline"},
+			ActionsToSkip: []string{"PausePipeline"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		checker, initErr = policy.Initialize(lagertest.NewTestLogger("test"), "some-cluster", "1.2.3", filter)
+	})
+
+	Context("when no agent is configured", func() {
+		BeforeEach(func() {
+			fakeAgentFactory.IsConfiguredReturns(false)
+		})
+
+		It("returns a nil checker", func() {
+			Expect(initErr).ToNot(HaveOccurred())
+			Expect(checker).To(BeNil())
+		})
+	})
+
+	Context("when an agent is configured", func() {
+		BeforeEach(func() {
+			fakeAgentFactory.IsConfiguredReturns(true)
+		})
+
+		It("returns a checker", func() {
+			Expect(initErr).ToNot(HaveOccurred())
+			Expect(checker).ToNot(BeNil())
+		})
+
+		It("filters http methods", func() {
+			Expect(checker.ShouldCheckHttpMethod("PUT")).To(BeTrue())
+			Expect(checker.ShouldCheckHttpMethod("post")).To(BeTrue())
+			Expect(checker.ShouldCheckHttpMethod("GET")).To(BeFalse())
+		})
+
+		It("filters actions", func() {
+			Expect(checker.ShouldCheckAction("GetPipeline")).To(BeTrue())
+			Expect(checker.ShouldCheckAction("ListPipelines")).To(BeFalse())
+		})
+
+		It("filters skipped actions", func() {
+			Expect(checker.ShouldSkipAction("PausePipeline")).To(BeTrue())
+			Expect(checker.ShouldSkipAction("GetPipeline")).To(BeFalse())
+		})
+
+		It("adds the cluster details to the input", func() {
+			fakeAgent.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+
+			output, err := checker.Check(policy.PolicyCheckInput{Action: "GetPipeline", Team: "some-team"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+
+			Expect(fakeAgent.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+				Service:        "concourse",
+				ClusterName:    "some-cluster",
+				ClusterVersion: "1.2.3",
+				Action:         "GetPipeline",
+				Team:           "some-team",
+			}))
+		})
+
+		Context("when the agent cannot be created", func() {
+			BeforeEach(func() {
+				fakeAgentFactory.NewAgentReturns(nil, errors.New("nope"))
+			})
+
+			It("errors", func() {
+				Expect(initErr).To(MatchError("nope"))
+			})
+		})
+	})
+})
+
+var _ = Describe("PolicyCheckNotPassedError", func() {
+	It("lists the reasons", func() {
+		err := policy.PolicyCheckNotPassedError{Reasons: []string{"a", "b"}}
+		Expect(err.Error()).To(Equal("policy check failed: a, b"))
+	})
+
+	It("has a generic message without reasons", func() {
+		Expect(policy.PolicyCheckNotPassedError{}.Error()).To(Equal("policy check failed"))
+	})
+})
@@ -0,0 +1,98 @@
+package opa_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/opa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("OPA Policy Checker", func() {
+	var (
+		fakeOpa *ghttp.Server
+		agent   policy.Agent
+		output  policy.PolicyCheckOutput
+		err     error
+	)
+
+	BeforeEach(func() {
+		fakeOpa = ghttp.NewServer()
+
+		agent, err = (&opa.OpaConfig{URL: fakeOpa.URL(), Timeout: time.Second}).NewAgent(lagertest.NewTestLogger("test"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		fakeOpa.Close()
+	})
+
+	JustBeforeEach(func() {
+		output, err = agent.Check(policy.PolicyCheckInput{
+			Action: "SaveConfig",
+			Team:   "some-team",
+		})
+	})
+
+	Context("when the policy allows the action", func() {
+		BeforeEach(func() {
+			fakeOpa.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/"),
+				ghttp.VerifyJSON(`{"input": {"service": "", "cluster_name": "", "cluster_version": "", "action": "SaveConfig", "team": "some-team"}}`),
+				ghttp.RespondWith(http.StatusOK, `{"result": {"allowed": true}}`),
+			))
+		})
+
+		It("allows it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: true}))
+		})
+	})
+
+	Context("when the policy denies the action", func() {
+		BeforeEach(func() {
+			fakeOpa.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"result": {"allowed": false, "reasons": ["nope"]}}`))
+		})
+
+		It("denies it with the reasons", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: false, Reasons: []string{"nope"}}))
+		})
+	})
+
+	Context("when the decision is undefined", func() {
+		BeforeEach(func() {
+			fakeOpa.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{}`))
+		})
+
+		It("allows it", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when opa returns an error status", func() {
+		BeforeEach(func() {
+			fakeOpa.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, ""))
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError("opa returned status: 500"))
+		})
+	})
+
+	Context("when opa returns a bad response", func() {
+		BeforeEach(func() {
+			fakeOpa.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{`))
+		})
+
+		It("errors", func() {
+			Expect(err).To(MatchError(ContainSubstring("opa returned bad response")))
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package policy_test
+
+import (
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Policy Suite")
+}
+
+var fakeAgentFactory *policyfakes.FakeAgentFactory
+
+var _ = BeforeSuite(func() {
+	fakeAgentFactory = new(policyfakes.FakeAgentFactory)
+	fakeAgentFactory.DescriptionReturns("fake-agent")
+	policy.RegisterAgent(fakeAgentFactory)
+})
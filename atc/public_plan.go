@@ -15,6 +15,8 @@ func (plan Plan) Public() *json.RawMessage {
 		Task           *json.RawMessage `json:"task,omitempty"`
 		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Approve        *json.RawMessage `json:"approve,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
@@ -66,6 +68,14 @@ func (plan Plan) Public() *json.RawMessage {
 		public.LoadVar = plan.LoadVar.Public()
 	}
 
+	if plan.Approve != nil {
+		public.Approve = plan.Approve.Public()
+	}
+
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -141,6 +151,16 @@ func (plan InParallelPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan AcrossPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Vars []AcrossVar      `json:"vars"`
+		Step *json.RawMessage `json:"step"`
+	}{
+		Vars: plan.Vars,
+		Step: plan.Step.Public(),
+	})
+}
+
 func (plan DoPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
@@ -261,9 +281,11 @@ func (plan TaskPlan) Public() *json.RawMessage {
 
 func (plan SetPipelinePlan) Public() *json.RawMessage {
 	return enc(struct {
-		Name string `json:"name"`
+		Name         string       `json:"name"`
+		InstanceVars InstanceVars `json:"instance_vars,omitempty"`
 	}{
-		Name: plan.Name,
+		Name:         plan.Name,
+		InstanceVars: plan.InstanceVars,
 	})
 }
 
@@ -275,6 +297,16 @@ func (plan LoadVarPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan ApprovePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name    string `json:"name"`
+		Message string `json:"message,omitempty"`
+	}{
+		Name:    plan.Name,
+		Message: plan.Message,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
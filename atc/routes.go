@@ -15,6 +15,12 @@ const (
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
+	ListBuildStepApprovals = "ListBuildStepApprovals"
+	ApproveBuildStep       = "ApproveBuildStep"
+	RejectBuildStep        = "RejectBuildStep"
+
+	GetBuildTestResults = "GetBuildTestResults"
+
 	GetCheck = "GetCheck"
 
 	GetJob         = "GetJob"
@@ -34,6 +40,9 @@ const (
 
 	ClearTaskCache = "ClearTaskCache"
 
+	GetJobSchedulingExplanation = "GetJobSchedulingExplanation"
+	GetJobTestHistory           = "GetJobTestHistory"
+
 	ListAllResources     = "ListAllResources"
 	ListResources        = "ListResources"
 	ListResourceTypes    = "ListResourceTypes"
@@ -101,6 +110,25 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	SearchBuildLogs = "SearchBuildLogs"
+
+	ListTeamAuditEvents = "ListTeamAuditEvents"
+
+	ListStepTemplates   = "ListStepTemplates"
+	GetStepTemplate     = "GetStepTemplate"
+	SaveStepTemplate    = "SaveStepTemplate"
+	DestroyStepTemplate = "DestroyStepTemplate"
+
+	ListNotifications          = "ListNotifications"
+	SaveNotification           = "SaveNotification"
+	DestroyNotification        = "DestroyNotification"
+	ListNotificationDeliveries = "ListNotificationDeliveries"
+
+	ListAPITokens         = "ListAPITokens"
+	CreateAPIToken        = "CreateAPIToken"
+	RevokeAPIToken        = "RevokeAPIToken"
+	DestroyServiceAccount = "DestroyServiceAccount"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -113,8 +141,14 @@ const (
 )
 
 const (
-	ClearTaskCacheQueryPath = "cache_path"
-	SaveConfigCheckCreds    = "check_creds"
+	ClearTaskCacheQueryPath  = "cache_path"
+	SaveConfigCheckCreds     = "check_creds"
+	InstanceVarsQueryParam   = "instance_vars"
+	StepTemplateQueryVersion = "version"
+
+	SearchBuildLogsQuery         = "q"
+	SearchBuildLogsQueryPipeline = "pipeline_name"
+	SearchBuildLogsQueryJob      = "job_name"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -131,6 +165,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/approvals", Method: "GET", Name: ListBuildStepApprovals},
+	{Path: "/api/v1/builds/:build_id/steps/:plan_id/approve", Method: "PUT", Name: ApproveBuildStep},
+	{Path: "/api/v1/builds/:build_id/steps/:plan_id/reject", Method: "PUT", Name: RejectBuildStep},
+	{Path: "/api/v1/builds/:build_id/test_results", Method: "GET", Name: GetBuildTestResults},
 
 	{Path: "/api/v1/checks/:check_id", Method: "GET", Name: GetCheck},
 
@@ -141,6 +179,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/scheduling_explanation", Method: "GET", Name: GetJobSchedulingExplanation},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/test_history", Method: "GET", Name: GetJobTestHistory},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -219,6 +259,23 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/builds/search", Method: "GET", Name: SearchBuildLogs},
+	{Path: "/api/v1/teams/:team_name/audit_events", Method: "GET", Name: ListTeamAuditEvents},
+
+	{Path: "/api/v1/teams/:team_name/step_templates", Method: "GET", Name: ListStepTemplates},
+	{Path: "/api/v1/teams/:team_name/step_templates/:step_template_name", Method: "GET", Name: GetStepTemplate},
+	{Path: "/api/v1/teams/:team_name/step_templates/:step_template_name", Method: "PUT", Name: SaveStepTemplate},
+	{Path: "/api/v1/teams/:team_name/step_templates/:step_template_name", Method: "DELETE", Name: DestroyStepTemplate},
+
+	{Path: "/api/v1/teams/:team_name/notifications", Method: "GET", Name: ListNotifications},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name", Method: "PUT", Name: SaveNotification},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name", Method: "DELETE", Name: DestroyNotification},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name/deliveries", Method: "GET", Name: ListNotificationDeliveries},
+
+	{Path: "/api/v1/teams/:team_name/api_tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/teams/:team_name/api_tokens/:api_token_id", Method: "DELETE", Name: RevokeAPIToken},
+	{Path: "/api/v1/teams/:team_name/service_accounts/:service_account_name/api_tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/teams/:team_name/service_accounts/:service_account_name", Method: "DELETE", Name: DestroyServiceAccount},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
@@ -1,6 +1,7 @@
 package algorithm_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/onsi/ginkgo/extensions/table"
 )
 
@@ -335,6 +336,39 @@ var _ = DescribeTable("Input resolving",
 		},
 	}),
 
+	Entry("returns a missing input reason when all versions of an input are disabled", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Disabled: true},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2, Disabled: true},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+			},
+			{
+				Name:     "resource-x-every",
+				Resource: "resource-x",
+				Version:  Version{Every: true},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x":       "all versions of resource are disabled",
+				"resource-x-every": "all versions of resource are disabled",
+			},
+			Reasons: map[string]atc.InputSchedulingReason{
+				"resource-x":       atc.InputSchedulingVersionDisabled,
+				"resource-x-every": atc.InputSchedulingVersionDisabled,
+			},
+		},
+	}),
+
 	Entry("returns a missing input reason when no input version satisfies the passed constraint", Example{
 		DB: DB{
 			BuildInputs: []DBRow{
@@ -373,6 +407,43 @@ var _ = DescribeTable("Input resolving",
 				"resource-x": "no satisfiable builds from passed jobs found for set of inputs",
 				"resource-y": "no satisfiable builds from passed jobs found for set of inputs",
 			},
+			Reasons: map[string]atc.InputSchedulingReason{
+				"resource-x": atc.InputSchedulingNoVersionPassedUpstream,
+				"resource-y": atc.InputSchedulingNoVersionPassedUpstream,
+			},
+		},
+	}),
+
+	Entry("returns a disabled reason when all versions passed by the passed jobs are disabled", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Disabled: true},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2, Disabled: true},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "all versions of resource from passed jobs are disabled",
+			},
+			Reasons: map[string]atc.InputSchedulingReason{
+				"resource-x": atc.InputSchedulingVersionDisabled,
+			},
 		},
 	}),
 
@@ -1854,6 +1925,38 @@ var _ = DescribeTable("Input resolving",
 		Result: Result{
 			OK:     false,
 			Errors: map[string]string{"resource-x": "pinned version ver:rxv2 not found"},
+			Reasons: map[string]atc.InputSchedulingReason{
+				"resource-x": atc.InputSchedulingPinnedVersionNotFound,
+			},
+		},
+	}),
+
+	Entry("does not resolve a version when the pinned version with passed is not in Versions DB", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "some-job", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Pinned: "rxv2"},
+				Passed:   []string{"some-job"},
+			},
+		},
+
+		Result: Result{
+			OK:     false,
+			Errors: map[string]string{"resource-x": "pinned version ver:rxv2 not found"},
+			Reasons: map[string]atc.InputSchedulingReason{
+				"resource-x": atc.InputSchedulingPinnedVersionNotFound,
+			},
 		},
 	}),
 
@@ -10,7 +10,7 @@ import (
 )
 
 type Resolver interface {
-	Resolve(context.Context) (map[string]*versionCandidate, db.ResolutionFailure, error)
+	Resolve(context.Context) (map[string]*versionCandidate, db.ResolutionFailure, atc.InputSchedulingReason, error)
 	InputConfigs() InputConfigs
 }
 
@@ -60,7 +60,7 @@ func (a *Algorithm) computeResolvers(
 	finalMapping := db.InputMapping{}
 
 	for _, resolver := range resolvers {
-		versionCandidates, resolveErr, err := resolver.Resolve(ctx)
+		versionCandidates, resolveErr, resolveReason, err := resolver.Resolve(ctx)
 		if err != nil {
 			return nil, false, false, fmt.Errorf("resolve: %w", err)
 		}
@@ -72,7 +72,7 @@ func (a *Algorithm) computeResolvers(
 		// converts the version candidates into an object that is recognizable by
 		// other components. also computes the first occurrence for all satisfiable
 		// inputs
-		finalMapping = inputMapper.candidatesToInputMapping(finalMapping, resolver.InputConfigs(), versionCandidates, resolveErr)
+		finalMapping = inputMapper.candidatesToInputMapping(finalMapping, resolver.InputConfigs(), versionCandidates, resolveErr, resolveReason)
 
 		// if any one of the resolvers has a version candidate that has an unused
 		// next every version, the algorithm should return true for being able to
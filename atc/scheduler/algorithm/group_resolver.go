@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/tracing"
 	"go.opentelemetry.io/otel/api/key"
@@ -37,6 +38,11 @@ type groupResolver struct {
 
 	doomedCandidates []*versionCandidate
 
+	// track which inputs were offered disabled versions by the passed jobs and
+	// which were ever vouched for, to explain why resolving failed
+	offeredDisabled []bool
+	vouched         []bool
+
 	lastUsedPassedBuilds map[int]db.BuildCursor
 }
 
@@ -48,6 +54,8 @@ func NewGroupResolver(vdb db.VersionsDB, inputConfigs InputConfigs) Resolver {
 		orderedJobs:      make([][]int, len(inputConfigs)),
 		candidates:       make([]*versionCandidate, len(inputConfigs)),
 		doomedCandidates: make([]*versionCandidate, len(inputConfigs)),
+		offeredDisabled:  make([]bool, len(inputConfigs)),
+		vouched:          make([]bool, len(inputConfigs)),
 	}
 }
 
@@ -55,7 +63,7 @@ func (r *groupResolver) InputConfigs() InputConfigs {
 	return r.inputConfigs
 }
 
-func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, error) {
+func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, atc.InputSchedulingReason, error) {
 	ctx, span := tracing.StartSpan(ctx, "groupResolver.Resolve", tracing.Attrs{
 		"inputs": r.inputConfigs.String(),
 	})
@@ -69,13 +77,13 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 		version, found, err := r.vdb.FindVersionOfResource(ctx, cfg.ResourceID, cfg.PinnedVersion)
 		if err != nil {
 			tracing.End(span, err)
-			return nil, "", err
+			return nil, "", "", err
 		}
 
 		if !found {
 			notFoundErr := db.PinnedVersionNotFound{PinnedVersion: cfg.PinnedVersion}
 			span.SetStatus(codes.InvalidArgument)
-			return nil, notFoundErr.String(), nil
+			return nil, notFoundErr.String(), atc.InputSchedulingPinnedVersionNotFound, nil
 		}
 
 		r.pins[i] = version
@@ -84,13 +92,19 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 	resolved, failure, err := r.tryResolve(ctx)
 	if err != nil {
 		tracing.End(span, err)
-		return nil, "", err
+		return nil, "", "", err
 	}
 
 	if !resolved {
+		reason := atc.InputSchedulingNoVersionPassedUpstream
+		if r.onlyDisabledVersionsPassed() {
+			failure = db.AllPassedVersionsDisabled
+			reason = atc.InputSchedulingVersionDisabled
+		}
+
 		span.SetAttributes(key.New("failure").String(string(failure)))
 		span.SetStatus(codes.NotFound)
-		return nil, failure, nil
+		return nil, failure, reason, nil
 	}
 
 	finalCandidates := map[string]*versionCandidate{}
@@ -99,7 +113,19 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 	}
 
 	span.SetStatus(codes.OK)
-	return finalCandidates, "", nil
+	return finalCandidates, "", "", nil
+}
+
+// onlyDisabledVersionsPassed returns whether any input was only ever offered
+// disabled versions by the passed jobs.
+func (r *groupResolver) onlyDisabledVersionsPassed() bool {
+	for i := range r.inputConfigs {
+		if r.offeredDisabled[i] && !r.vouched[i] {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (r *groupResolver) tryResolve(ctx context.Context) (bool, db.ResolutionFailure, error) {
@@ -268,6 +294,7 @@ outputs:
 			)
 
 			r.candidates[c] = r.vouchForCandidate(candidate, output.Version, jobID, buildID, hasNext)
+			r.vouched[c] = true
 		}
 	}
 
@@ -438,6 +465,8 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 
 	if disabled {
 		// this version is disabled so it cannot be used
+		r.offeredDisabled[candidateIdx] = true
+
 		span.AddEvent(
 			ctx,
 			"version disabled",
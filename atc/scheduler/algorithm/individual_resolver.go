@@ -3,6 +3,7 @@ package algorithm
 import (
 	"context"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/tracing"
 	"go.opentelemetry.io/otel/api/key"
@@ -27,7 +28,7 @@ func (r *individualResolver) InputConfigs() InputConfigs {
 
 // Handles two different configurations of a resource without passed
 // constraints: every and latest
-func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, error) {
+func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, atc.InputSchedulingReason, error) {
 	ctx, span := tracing.StartSpan(ctx, "individualResolver.Resolve", tracing.Attrs{
 		"input": r.inputConfig.Name,
 	})
@@ -41,13 +42,20 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 		version, hasNext, found, err = r.vdb.NextEveryVersion(ctx, r.inputConfig.JobID, r.inputConfig.ResourceID)
 		if err != nil {
 			tracing.End(span, err)
-			return nil, "", err
+			return nil, "", "", err
 		}
 
 		if !found {
 			span.AddEvent(ctx, "next every version not found")
 			span.SetStatus(codes.NotFound)
-			return nil, db.VersionNotFound, nil
+
+			failure, reason, err := r.notFound(ctx, db.VersionNotFound)
+			if err != nil {
+				tracing.End(span, err)
+				return nil, "", "", err
+			}
+
+			return nil, failure, reason, nil
 		}
 
 		span.AddEvent(ctx, "found via every", key.New("version").String(string(version)))
@@ -58,13 +66,20 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 		version, found, err = r.vdb.LatestVersionOfResource(ctx, r.inputConfig.ResourceID)
 		if err != nil {
 			tracing.End(span, err)
-			return nil, "", err
+			return nil, "", "", err
 		}
 
 		if !found {
 			span.AddEvent(ctx, "latest version not found")
 			span.SetStatus(codes.NotFound)
-			return nil, db.LatestVersionNotFound, nil
+
+			failure, reason, err := r.notFound(ctx, db.LatestVersionNotFound)
+			if err != nil {
+				tracing.End(span, err)
+				return nil, "", "", err
+			}
+
+			return nil, failure, reason, nil
 		}
 
 		span.AddEvent(ctx, "found via latest", key.New("version").String(string(version)))
@@ -78,5 +93,20 @@ func (r *individualResolver) Resolve(ctx context.Context) (map[string]*versionCa
 	}
 
 	span.SetStatus(codes.OK)
-	return versionCandidates, "", nil
+	return versionCandidates, "", "", nil
+}
+
+// notFound distinguishes a resource without any versions from a resource whose
+// versions are all disabled.
+func (r *individualResolver) notFound(ctx context.Context, failure db.ResolutionFailure) (db.ResolutionFailure, atc.InputSchedulingReason, error) {
+	hasVersions, err := r.vdb.ResourceHasVersions(ctx, r.inputConfig.ResourceID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if hasVersions {
+		return db.AllVersionsDisabled, atc.InputSchedulingVersionDisabled, nil
+	}
+
+	return failure, atc.InputSchedulingNoVersions, nil
 }
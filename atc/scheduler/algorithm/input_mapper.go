@@ -3,6 +3,7 @@ package algorithm
 import (
 	"context"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -34,11 +35,12 @@ func newInputMapper(ctx context.Context, vdb db.VersionsDB, currentJobID int) (i
 	}, nil
 }
 
-func (m *inputMapper) candidatesToInputMapping(mapping db.InputMapping, inputConfigs InputConfigs, candidates map[string]*versionCandidate, resolveErr db.ResolutionFailure) db.InputMapping {
+func (m *inputMapper) candidatesToInputMapping(mapping db.InputMapping, inputConfigs InputConfigs, candidates map[string]*versionCandidate, resolveErr db.ResolutionFailure, resolveReason atc.InputSchedulingReason) db.InputMapping {
 	for _, input := range inputConfigs {
 		if resolveErr != "" {
 			mapping[input.Name] = db.InputResult{
-				ResolveError: resolveErr,
+				ResolveError:  resolveErr,
+				ResolveReason: resolveReason,
 			}
 		} else {
 			mapping[input.Name] = db.InputResult{
@@ -3,6 +3,7 @@ package algorithm
 import (
 	"context"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/tracing"
 	"go.opentelemetry.io/otel/api/key"
@@ -25,7 +26,7 @@ func (r *pinnedResolver) InputConfigs() InputConfigs {
 	return InputConfigs{r.inputConfig}
 }
 
-func (r *pinnedResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, error) {
+func (r *pinnedResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, atc.InputSchedulingReason, error) {
 	ctx, span := tracing.StartSpan(ctx, "pinnedResolver.Resolve", tracing.Attrs{
 		"input": r.inputConfig.Name,
 	})
@@ -34,13 +35,13 @@ func (r *pinnedResolver) Resolve(ctx context.Context) (map[string]*versionCandid
 	version, found, err := r.vdb.FindVersionOfResource(ctx, r.inputConfig.ResourceID, r.inputConfig.PinnedVersion)
 	if err != nil {
 		tracing.End(span, err)
-		return nil, "", err
+		return nil, "", "", err
 	}
 
 	if !found {
 		span.AddEvent(ctx, "pinned version not found")
 		span.SetStatus(codes.NotFound)
-		return nil, db.PinnedVersionNotFound{PinnedVersion: r.inputConfig.PinnedVersion}.String(), nil
+		return nil, db.PinnedVersionNotFound{PinnedVersion: r.inputConfig.PinnedVersion}.String(), atc.InputSchedulingPinnedVersionNotFound, nil
 	}
 
 	span.AddEvent(ctx, "found via pin", key.New("version").String(string(version)))
@@ -50,5 +51,5 @@ func (r *pinnedResolver) Resolve(ctx context.Context) (map[string]*versionCandid
 	}
 
 	span.SetStatus(codes.OK)
-	return versionCandidate, "", nil
+	return versionCandidate, "", "", nil
 }
@@ -77,6 +77,7 @@ type Result struct {
 	Values           map[string]string
 	PassedBuildIDs   map[string][]int
 	Errors           map[string]string
+	Reasons          map[string]atc.InputSchedulingReason
 	ExpectedMigrated map[int]map[int][]string
 	HasNext          bool
 	NoNext           bool
@@ -124,7 +125,7 @@ func (example Example) Run() {
 	team, err := teamFactory.CreateTeam(atc.Team{Name: "algorithm"})
 	Expect(err).NotTo(HaveOccurred())
 
-	pipeline, _, err := team.SavePipeline("algorithm", atc.Config{}, db.ConfigVersion(0), false)
+	pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "algorithm"}, atc.Config{}, db.ConfigVersion(0), false)
 	Expect(err).NotTo(HaveOccurred())
 
 	setupTx, err := dbConn.Begin()
@@ -221,7 +222,7 @@ func (example Example) Run() {
 
 	setup.insertJob("current")
 
-	pipeline, _, err = team.SavePipeline("algorithm", atc.Config{
+	pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "algorithm"}, atc.Config{
 		Jobs:      jobs,
 		Resources: resourceConfigs,
 	}, db.ConfigVersion(1), false)
@@ -622,10 +623,12 @@ func (example Example) assert(
 
 		prettyValues := map[string]string{}
 		erroredValues := map[string]string{}
+		erroredReasons := map[string]atc.InputSchedulingReason{}
 		passedJobs := map[string][]int{}
 		for name, inputSource := range resolved {
 			if inputSource.ResolveError != "" {
 				erroredValues[name] = string(inputSource.ResolveError)
+				erroredReasons[name] = inputSource.ResolveReason
 			} else {
 				if ok {
 					var versionID int
@@ -656,6 +659,10 @@ func (example Example) assert(
 			actualResult.PassedBuildIDs = passedJobs
 		}
 
+		if example.Result.Reasons != nil {
+			actualResult.Reasons = erroredReasons
+		}
+
 		if ok {
 			actualResult.Values = prettyValues
 		}
@@ -40,16 +40,19 @@ type Build interface {
 func NewBuildStarter(
 	factory BuildFactory,
 	algorithm Algorithm,
+	queue db.BuildQueue,
 ) BuildStarter {
 	return &buildStarter{
 		factory:   factory,
 		algorithm: algorithm,
+		queue:     queue,
 	}
 }
 
 type buildStarter struct {
 	factory   BuildFactory
 	algorithm Algorithm
+	queue     db.BuildQueue
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -239,6 +242,19 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return startResults{}, fmt.Errorf("config: %w", err)
 	}
 
+	admitted, position, err := s.queue.Admit(nextPendingBuild, config.Priority)
+	if err != nil {
+		return startResults{}, fmt.Errorf("admit build: %w", err)
+	}
+
+	if !admitted {
+		logger.Debug("build-queued", lager.Data{"position": position})
+		return startResults{
+			started:    false,
+			needsRetry: true,
+		}, nil
+	}
+
 	plan, err := s.factory.Create(config, resourceConfigs, resourceTypes.Deserialize(), buildInputs)
 	if err != nil {
 		logger.Error("failed-to-create-build-plan", err)
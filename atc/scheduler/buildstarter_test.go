@@ -23,6 +23,7 @@ var _ = Describe("BuildStarter", func() {
 		fakeFactory   *schedulerfakes.FakeBuildFactory
 		pendingBuilds []db.Build
 		fakeAlgorithm *schedulerfakes.FakeAlgorithm
+		fakeQueue     *dbfakes.FakeBuildQueue
 
 		buildStarter scheduler.BuildStarter
 
@@ -36,7 +37,10 @@ var _ = Describe("BuildStarter", func() {
 		fakeFactory = new(schedulerfakes.FakeBuildFactory)
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
 
-		buildStarter = scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm)
+		fakeQueue = new(dbfakes.FakeBuildQueue)
+		fakeQueue.AdmitReturns(true, 0, nil)
+
+		buildStarter = scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm, fakeQueue)
 
 		disaster = errors.New("bad thing")
 	})
@@ -685,6 +689,57 @@ var _ = Describe("BuildStarter", func() {
 										Expect(actualBuildInputs).To(Equal([]db.BuildInput{{Name: "some-input"}}))
 									})
 
+									It("admits the builds with the priority of the job", func() {
+										Expect(fakeQueue.AdmitCallCount()).To(Equal(3))
+										actualBuild, actualPriority := fakeQueue.AdmitArgsForCall(0)
+										Expect(actualBuild.ID()).To(Equal(rerunBuild.ID()))
+										Expect(actualPriority).To(Equal(0))
+									})
+
+									Context("when the job has a priority", func() {
+										BeforeEach(func() {
+											job.ConfigReturns(atc.JobConfig{Name: "some-job", Priority: 10}, nil)
+										})
+
+										It("admits the builds with that priority", func() {
+											_, actualPriority := fakeQueue.AdmitArgsForCall(0)
+											Expect(actualPriority).To(Equal(10))
+										})
+									})
+
+									Context("when the build is not admitted", func() {
+										BeforeEach(func() {
+											fakeQueue.AdmitReturns(false, 3, nil)
+										})
+
+										It("does not create the build plan", func() {
+											Expect(fakeFactory.CreateCallCount()).To(BeZero())
+										})
+
+										It("does not start the builds and needs to be rescheduled", func() {
+											Expect(rerunBuild.StartCallCount()).To(BeZero())
+											Expect(pendingBuild1.StartCallCount()).To(BeZero())
+											Expect(pendingBuild2.StartCallCount()).To(BeZero())
+											Expect(tryStartErr).NotTo(HaveOccurred())
+											Expect(needsReschedule).To(BeTrue())
+										})
+									})
+
+									Context("when admitting the build fails", func() {
+										BeforeEach(func() {
+											fakeQueue.AdmitReturns(false, 0, disaster)
+										})
+
+										It("returns the error", func() {
+											Expect(tryStartErr).To(Equal(fmt.Errorf("admit build: %w", disaster)))
+											Expect(needsReschedule).To(BeFalse())
+										})
+
+										It("does not start the builds", func() {
+											Expect(rerunBuild.StartCallCount()).To(BeZero())
+										})
+									})
+
 									Context("when starting the build fails", func() {
 										BeforeEach(func() {
 											pendingBuild1.StartReturns(false, disaster)
@@ -110,6 +110,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(job, planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -142,6 +146,43 @@ func (factory *buildFactory) constructPlanFromConfig(
 	})
 }
 
+func (factory *buildFactory) across(
+	job atc.JobConfig,
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	acrossVars := make([]atc.AcrossVar, len(planConfig.Across))
+	for i, v := range planConfig.Across {
+		acrossVars[i] = atc.AcrossVar{
+			Var:         v.Var,
+			Values:      v.Values,
+			MaxInFlight: v.MaxInFlight,
+		}
+	}
+
+	// the step, including its hooks and attempts, is run for each
+	// combination of values
+	planConfig.Across = nil
+
+	step, err := factory.constructPlanFromConfig(
+		job,
+		planConfig,
+		resources,
+		resourceTypes,
+		inputs,
+	)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	return factory.planFactory.NewPlan(atc.AcrossPlan{
+		Vars: acrossVars,
+		Step: step,
+	}), nil
+}
+
 func (factory *buildFactory) constructUnhookedPlan(
 	job atc.JobConfig,
 	planConfig atc.PlanConfig,
@@ -187,6 +228,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Tags:     planConfig.Tags,
 			Inputs:   planConfig.Inputs,
 
+			IdleTimeout: planConfig.IdleTimeout,
+
 			VersionedResourceTypes: resourceTypes,
 		}
 
@@ -202,6 +245,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Tags:   planConfig.Tags,
 			Source: resource.Source,
 
+			IdleTimeout: planConfig.IdleTimeout,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -243,6 +288,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Version:  &version,
 			Tags:     planConfig.Tags,
 
+			IdleTimeout: planConfig.IdleTimeout,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -258,6 +305,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
+			Memoize:           planConfig.Memoize,
+			IdleTimeout:       planConfig.IdleTimeout,
 
 			VersionedResourceTypes: resourceTypes,
 		})
@@ -265,10 +314,11 @@ func (factory *buildFactory) constructUnhookedPlan(
 	case planConfig.SetPipeline != "":
 		name := planConfig.SetPipeline
 		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
-			Name:     name,
-			File:     planConfig.File,
-			Vars:     planConfig.Vars,
-			VarFiles: planConfig.VarFiles,
+			Name:         name,
+			File:         planConfig.File,
+			Vars:         planConfig.Vars,
+			VarFiles:     planConfig.VarFiles,
+			InstanceVars: planConfig.InstanceVars,
 		})
 
 	case planConfig.LoadVar != "":
@@ -280,6 +330,24 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Reveal: planConfig.Reveal,
 		})
 
+	case planConfig.Approve != "":
+		approvePlan := atc.ApprovePlan{
+			Name:    planConfig.Approve,
+			Message: planConfig.Message,
+		}
+
+		if planConfig.Approvers != nil {
+			approvePlan.Roles = planConfig.Approvers.Roles
+			approvePlan.Users = planConfig.Approvers.Users
+		}
+
+		plan = factory.planFactory.NewPlan(approvePlan)
+
+	case planConfig.Template != "":
+		// steps referring to step templates are replaced whenever a pipeline
+		// is set, so there is no step to run for one that is left
+		return atc.Plan{}, fmt.Errorf("step template '%s' was not expanded", planConfig.Template)
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			job,
@@ -0,0 +1,90 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across Step", func() {
+	var (
+		resourceTypes atc.VersionedResourceTypes
+
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+		input               atc.JobConfig
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+	})
+
+	Context("when a step has across vars", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:       "some-task",
+						TaskConfig: &atc.TaskConfig{Platform: "linux"},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "go_version",
+								Values:      []interface{}{"1.13", "1.14"},
+								MaxInFlight: 2,
+							},
+							{
+								Var:    "os",
+								Values: "((.:platforms))",
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Task:       "some-failure-task",
+							TaskConfig: &atc.TaskConfig{Platform: "linux"},
+						},
+					},
+				},
+			}
+		})
+
+		It("wraps the hooked step in an across plan", func() {
+			actual, err := buildFactory.Create(input, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:   "some-task",
+				Config: &atc.TaskConfig{Platform: "linux"},
+			})
+
+			failurePlan := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:   "some-failure-task",
+				Config: &atc.TaskConfig{Platform: "linux"},
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "go_version",
+						Values:      []interface{}{"1.13", "1.14"},
+						MaxInFlight: 2,
+					},
+					{
+						Var:    "os",
+						Values: "((.:platforms))",
+					},
+				},
+				Step: expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+					Step: taskPlan,
+					Next: failurePlan,
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Approve Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+		input               atc.JobConfig
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+	})
+
+	Context("when approve", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Approve: "ship-it",
+						Message: "ship it to prod?",
+						Approvers: &atc.ApproversConfig{
+							Roles: []string{"owner"},
+							Users: []string{"some-user"},
+						},
+					},
+				},
+			}
+		})
+
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(input, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.ApprovePlan{
+				Name:    "ship-it",
+				Message: "ship it to prod?",
+				Roles:   []string{"owner"},
+				Users:   []string{"some-user"},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
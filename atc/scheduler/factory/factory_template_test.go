@@ -0,0 +1,34 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Template Step", func() {
+	var (
+		buildFactory factory.BuildFactory
+		input        atc.JobConfig
+	)
+
+	BeforeEach(func() {
+		buildFactory = factory.NewBuildFactory(atc.NewPlanFactory(123))
+
+		input = atc.JobConfig{
+			Plan: atc.PlanSequence{
+				{
+					Template: "deploy",
+					Vars:     atc.Params{"env": "staging"},
+				},
+			},
+		}
+	})
+
+	It("fails rather than running nothing for a step template that was not expanded", func() {
+		_, err := buildFactory.Create(input, nil, nil, nil)
+		Expect(err).To(MatchError("step template 'deploy' was not expanded"))
+	})
+})
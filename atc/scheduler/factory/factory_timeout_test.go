@@ -57,4 +57,26 @@ var _ = Describe("Factory Timeout Step", func() {
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("When there is a task with an idle timeout", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:        "first task",
+						IdleTimeout: "10m",
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "first task",
+				IdleTimeout:            "10m",
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			Expect(actual).To(Equal(expected))
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
@@ -27,6 +28,7 @@ type BuildScheduler interface {
 
 type schedulerRunner struct {
 	logger     lager.Logger
+	clock      clock.Clock
 	jobFactory db.JobFactory
 	scheduler  BuildScheduler
 
@@ -34,10 +36,11 @@ type schedulerRunner struct {
 	running            *sync.Map
 }
 
-func NewRunner(logger lager.Logger, jobFactory db.JobFactory, scheduler BuildScheduler, maxJobs uint64) Runner {
+func NewRunner(logger lager.Logger, clock clock.Clock, jobFactory db.JobFactory, scheduler BuildScheduler, maxJobs uint64) Runner {
 	newGuardJobScheduling := make(chan struct{}, maxJobs)
 	return &schedulerRunner{
 		logger:     logger,
+		clock:      clock,
 		jobFactory: jobFactory,
 		scheduler:  scheduler,
 
@@ -52,6 +55,11 @@ func (s *schedulerRunner) Run(ctx context.Context) error {
 	sLog.Debug("start")
 	defer sLog.Debug("done")
 
+	err := s.fireSchedules(ctx, sLog)
+	if err != nil {
+		sLog.Error("failed-to-fire-schedules", err)
+	}
+
 	jobs, err := s.jobFactory.JobsToSchedule()
 	if err != nil {
 		return fmt.Errorf("find jobs to schedule: %w", err)
@@ -76,6 +84,56 @@ func (s *schedulerRunner) Run(ctx context.Context) error {
 	return nil
 }
 
+// fireSchedules creates a build of each job whose schedule has come due. The
+// builds are then scheduled like manually triggered ones, using the latest
+// versions of their inputs.
+func (s *schedulerRunner) fireSchedules(ctx context.Context, logger lager.Logger) error {
+	jobs, err := s.jobFactory.JobsWithSchedules()
+	if err != nil {
+		return fmt.Errorf("find jobs with schedules: %w", err)
+	}
+
+	now := s.clock.Now()
+
+	for _, job := range jobs {
+		jLog := logger.Session("fire-schedule", lager.Data{
+			"pipeline": job.PipelineName(),
+			"job":      job.Name(),
+		})
+
+		next, err := job.Schedule().Next(job.ScheduleLastFired())
+		if err != nil {
+			jLog.Error("failed-to-evaluate-schedule", err)
+			continue
+		}
+
+		if now.Before(next) {
+			continue
+		}
+
+		fired, err := job.FireSchedule(now)
+		if err != nil {
+			jLog.Error("failed-to-fire-schedule", err)
+			continue
+		}
+
+		if !fired {
+			jLog.Debug("schedule-already-fired")
+			continue
+		}
+
+		build, err := job.CreateBuild(ctx)
+		if err != nil {
+			jLog.Error("failed-to-create-build", err)
+			continue
+		}
+
+		jLog.Info("created-build", lager.Data{"build": build.Name(), "due": next})
+	}
+
+	return nil
+}
+
 func (s *schedulerRunner) schedulePipeline(ctx context.Context, logger lager.Logger, pipeline db.Pipeline, jobsToSchedule db.Jobs) error {
 	resources, err := pipeline.Resources()
 	if err != nil {
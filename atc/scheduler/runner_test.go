@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
@@ -42,11 +43,14 @@ var _ = Describe("Runner", func() {
 		job2RequestedTime time.Time
 		job3RequestedTime time.Time
 
+		fakeClock *fakeclock.FakeClock
+
 		schedulerRunner Runner
 		schedulerErr    error
 	)
 
 	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Date(2020, 3, 18, 12, 30, 0, 0, time.UTC))
 		fakeScheduler = new(schedulerfakes.FakeBuildScheduler)
 		fakeJobFactory = new(dbfakes.FakeJobFactory)
 		maxInFlight = 1
@@ -72,6 +76,7 @@ var _ = Describe("Runner", func() {
 	JustBeforeEach(func() {
 		schedulerRunner = NewRunner(
 			lagertest.NewTestLogger("test"),
+			fakeClock,
 			fakeJobFactory,
 			fakeScheduler,
 			maxInFlight,
@@ -514,4 +519,101 @@ var _ = Describe("Runner", func() {
 			Expect(schedulerErr).To(Equal(fmt.Errorf("find jobs to schedule: %w", errors.New("disaster"))))
 		})
 	})
+
+	Describe("firing job schedules", func() {
+		var fakeScheduledJob *dbfakes.FakeJob
+
+		BeforeEach(func() {
+			fakeScheduledJob = new(dbfakes.FakeJob)
+			fakeScheduledJob.NameReturns("scheduled-job")
+			fakeScheduledJob.PipelineNameReturns("some-pipeline")
+			fakeScheduledJob.ScheduleReturns(&atc.JobSchedule{Cron: "0 * * * *"})
+
+			fakeJobFactory.JobsWithSchedulesReturns(db.Jobs{fakeScheduledJob}, nil)
+		})
+
+		It("loads up the jobs with schedules", func() {
+			Expect(fakeJobFactory.JobsWithSchedulesCallCount()).To(Equal(1))
+		})
+
+		Context("when the schedule is not yet due", func() {
+			BeforeEach(func() {
+				fakeScheduledJob.ScheduleLastFiredReturns(fakeClock.Now().Add(-time.Minute))
+			})
+
+			It("does not fire the schedule", func() {
+				Expect(fakeScheduledJob.FireScheduleCallCount()).To(Equal(0))
+				Expect(fakeScheduledJob.CreateBuildCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the schedule is due", func() {
+			BeforeEach(func() {
+				fakeScheduledJob.ScheduleLastFiredReturns(fakeClock.Now().Add(-61 * time.Minute))
+			})
+
+			Context("when firing the schedule succeeds", func() {
+				BeforeEach(func() {
+					fakeScheduledJob.FireScheduleReturns(true, nil)
+					fakeScheduledJob.CreateBuildReturns(new(dbfakes.FakeBuild), nil)
+				})
+
+				It("fires it at the current time", func() {
+					Expect(fakeScheduledJob.FireScheduleCallCount()).To(Equal(1))
+					Expect(fakeScheduledJob.FireScheduleArgsForCall(0)).To(Equal(fakeClock.Now()))
+				})
+
+				It("creates a build", func() {
+					Expect(fakeScheduledJob.CreateBuildCallCount()).To(Equal(1))
+				})
+
+				It("still schedules the pending builds", func() {
+					Expect(fakeJobFactory.JobsToScheduleCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the schedule was already fired elsewhere", func() {
+				BeforeEach(func() {
+					fakeScheduledJob.FireScheduleReturns(false, nil)
+				})
+
+				It("does not create a build", func() {
+					Expect(fakeScheduledJob.CreateBuildCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when firing the schedule fails", func() {
+				BeforeEach(func() {
+					fakeScheduledJob.FireScheduleReturns(false, errors.New("disaster"))
+				})
+
+				It("does not create a build or error", func() {
+					Expect(fakeScheduledJob.CreateBuildCallCount()).To(Equal(0))
+					Expect(schedulerErr).ToNot(HaveOccurred())
+				})
+			})
+
+			Context("when creating the build fails", func() {
+				BeforeEach(func() {
+					fakeScheduledJob.FireScheduleReturns(true, nil)
+					fakeScheduledJob.CreateBuildReturns(nil, errors.New("disaster"))
+				})
+
+				It("does not error", func() {
+					Expect(schedulerErr).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		Context("when finding jobs with schedules fails", func() {
+			BeforeEach(func() {
+				fakeJobFactory.JobsWithSchedulesReturns(nil, errors.New("disaster"))
+			})
+
+			It("still schedules the pending builds", func() {
+				Expect(schedulerErr).ToNot(HaveOccurred())
+				Expect(fakeJobFactory.JobsToScheduleCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -3,10 +3,12 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 )
 
@@ -99,6 +101,11 @@ func (s *Scheduler) ensurePendingBuildExists(
 					return fmt.Errorf("ensure pending build exists: %w", err)
 				}
 
+				err = s.abortSupersededBuilds(logger, job, jobInputs, buildInputs)
+				if err != nil {
+					return err
+				}
+
 				break
 			}
 		}
@@ -112,3 +119,79 @@ func (s *Scheduler) ensurePendingBuildExists(
 
 	return nil
 }
+
+func (s *Scheduler) abortSupersededBuilds(
+	logger lager.Logger,
+	job db.Job,
+	jobInputs []atc.JobInput,
+	buildInputs []db.BuildInput,
+) error {
+	config, err := job.Config()
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+
+	if !config.AbortSuperseded {
+		return nil
+	}
+
+	triggers := map[string]bool{}
+	for _, inputConfig := range jobInputs {
+		triggers[inputConfig.Name] = inputConfig.Trigger
+	}
+
+	newInputs := []db.BuildInput{}
+	for _, input := range buildInputs {
+		if triggers[input.Name] && input.FirstOccurrence {
+			newInputs = append(newInputs, input)
+		}
+	}
+
+	pendingBuilds, err := job.GetPendingBuilds()
+	if err != nil {
+		return fmt.Errorf("get pending builds: %w", err)
+	}
+
+	var newBuild db.Build
+	for _, build := range pendingBuilds {
+		if build.RerunOf() == 0 {
+			newBuild = build
+		}
+	}
+
+	if newBuild == nil {
+		return nil
+	}
+
+	supersededBuilds, err := job.GetSupersededBuilds(newInputs)
+	if err != nil {
+		return fmt.Errorf("get superseded builds: %w", err)
+	}
+
+	for _, build := range supersededBuilds {
+		if build.ID() == newBuild.ID() {
+			continue
+		}
+
+		logger.Info("aborting-superseded-build", lager.Data{
+			"build":       build.Name(),
+			"superseding": newBuild.Name(),
+		})
+
+		err = build.SaveEvent(event.Superseded{
+			Time:      time.Now().Unix(),
+			BuildID:   newBuild.ID(),
+			BuildName: newBuild.Name(),
+		})
+		if err != nil {
+			return fmt.Errorf("save superseded event: %w", err)
+		}
+
+		err = build.MarkAsAborted()
+		if err != nil {
+			return fmt.Errorf("abort superseded build: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	. "github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
@@ -372,6 +373,79 @@ var _ = Describe("Scheduler", func() {
 						Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
 						Expect(scheduleErr).NotTo(HaveOccurred())
 					})
+
+					It("does not abort any builds", func() {
+						Expect(fakeJob.GetSupersededBuildsCallCount()).To(BeZero())
+					})
+
+					Context("when the job aborts superseded builds", func() {
+						var (
+							newBuild        *dbfakes.FakeBuild
+							supersededBuild *dbfakes.FakeBuild
+						)
+
+						BeforeEach(func() {
+							fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job", AbortSuperseded: true}, nil)
+
+							rerunBuild := new(dbfakes.FakeBuild)
+							rerunBuild.IDReturns(3)
+							rerunBuild.RerunOfReturns(1)
+
+							newBuild = new(dbfakes.FakeBuild)
+							newBuild.IDReturns(4)
+							newBuild.NameReturns("4")
+							fakeJob.GetPendingBuildsReturns([]db.Build{rerunBuild, newBuild}, nil)
+
+							supersededBuild = new(dbfakes.FakeBuild)
+							supersededBuild.IDReturns(2)
+							fakeJob.GetSupersededBuildsReturns([]db.Build{supersededBuild}, nil)
+						})
+
+						It("finds the builds superseded by the new trigger versions", func() {
+							Expect(fakeJob.GetSupersededBuildsCallCount()).To(Equal(1))
+							Expect(fakeJob.GetSupersededBuildsArgsForCall(0)).To(Equal([]db.BuildInput{
+								{
+									Name:            "a",
+									Version:         atc.Version{"ref": "v1"},
+									ResourceID:      11,
+									FirstOccurrence: true,
+								},
+							}))
+						})
+
+						It("aborts them saying which build superseded them", func() {
+							Expect(scheduleErr).NotTo(HaveOccurred())
+
+							Expect(supersededBuild.SaveEventCallCount()).To(Equal(1))
+							superseded, ok := supersededBuild.SaveEventArgsForCall(0).(event.Superseded)
+							Expect(ok).To(BeTrue())
+							Expect(superseded.BuildID).To(Equal(4))
+							Expect(superseded.BuildName).To(Equal("4"))
+
+							Expect(supersededBuild.MarkAsAbortedCallCount()).To(Equal(1))
+						})
+
+						Context("when there is no new pending build", func() {
+							BeforeEach(func() {
+								fakeJob.GetPendingBuildsReturns(nil, nil)
+							})
+
+							It("does not abort any builds", func() {
+								Expect(scheduleErr).NotTo(HaveOccurred())
+								Expect(supersededBuild.MarkAsAbortedCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when aborting a superseded build fails", func() {
+							BeforeEach(func() {
+								supersededBuild.MarkAsAbortedReturns(disaster)
+							})
+
+							It("returns the error", func() {
+								Expect(scheduleErr).To(Equal(fmt.Errorf("abort superseded build: %w", disaster)))
+							})
+						})
+					})
 				})
 			})
 
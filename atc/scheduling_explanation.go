@@ -0,0 +1,35 @@
+package atc
+
+// InputSchedulingReason is why the scheduler could not determine a version for
+// an input of a job.
+type InputSchedulingReason string
+
+const (
+	InputSchedulingNotDetermined           InputSchedulingReason = "not-determined"
+	InputSchedulingNoVersions              InputSchedulingReason = "no-versions"
+	InputSchedulingPinnedVersionNotFound   InputSchedulingReason = "pinned-version-not-found"
+	InputSchedulingNoVersionPassedUpstream InputSchedulingReason = "no-version-passed-upstream"
+	InputSchedulingVersionDisabled         InputSchedulingReason = "version-disabled"
+	InputSchedulingUnknown                 InputSchedulingReason = "unknown"
+)
+
+// SchedulingExplanation explains why the scheduler does or does not start a
+// new build of a job.
+type SchedulingExplanation struct {
+	PipelinePaused   bool                         `json:"pipeline_paused"`
+	JobPaused        bool                         `json:"job_paused"`
+	InputsDetermined bool                         `json:"inputs_determined"`
+	Inputs           []InputSchedulingExplanation `json:"inputs"`
+	PendingBuild     *BuildPreparation            `json:"pending_build,omitempty"`
+}
+
+type InputSchedulingExplanation struct {
+	Name            string                `json:"name"`
+	Resource        string                `json:"resource"`
+	Trigger         bool                  `json:"trigger"`
+	Passed          []string              `json:"passed,omitempty"`
+	Version         Version               `json:"version,omitempty"`
+	FirstOccurrence bool                  `json:"first_occurrence"`
+	Reason          InputSchedulingReason `json:"reason,omitempty"`
+	Message         string                `json:"message,omitempty"`
+}
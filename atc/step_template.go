@@ -0,0 +1,288 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/vars"
+)
+
+// A StepTemplate is a named step shared by the pipelines of a team. Saving a
+// template with an existing name creates a new version of it.
+//
+// The step may refer to the template's params as ((vars)), which are
+// substituted with the vars of each step using the template when its
+// pipeline is set.
+type StepTemplate struct {
+	Name    string   `json:"name"`
+	Version int      `json:"version,omitempty"`
+	Params  []string `json:"params,omitempty"`
+
+	// Step is the YAML config of the step. It may only be a valid step once
+	// its params have been substituted, e.g. for `attempts: ((attempts))`.
+	Step string `json:"step"`
+
+	CreatedAt int64 `json:"created_at,omitempty"`
+}
+
+func (template StepTemplate) Validate() error {
+	var errorMessages []string
+
+	if template.Name == "" {
+		errorMessages = append(errorMessages, "step template has no name")
+	}
+
+	seen := map[string]bool{}
+	for _, param := range template.Params {
+		if param == "" {
+			errorMessages = append(errorMessages, "step template has a param with no name")
+		} else if seen[param] {
+			errorMessages = append(errorMessages, fmt.Sprintf("step template repeats param '%s'", param))
+		}
+
+		seen[param] = true
+	}
+
+	var step map[string]interface{}
+	err := yaml.Unmarshal([]byte(template.Step), &step)
+	if err != nil {
+		errorMessages = append(errorMessages, fmt.Sprintf("step template has a malformed step: %s", err))
+	} else if len(step) == 0 {
+		errorMessages = append(errorMessages, "step template has no step")
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New(strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// MissingParams returns the params of the template which are not given a
+// value by the vars.
+func (template StepTemplate) MissingParams(params Params) []string {
+	var missing []string
+	for _, param := range template.Params {
+		if _, found := params[param]; !found {
+			missing = append(missing, param)
+		}
+	}
+
+	return missing
+}
+
+// Expand returns the template's step with the given vars substituted for its
+// params. Any other vars, e.g. credentials, are left in place to be resolved
+// when the step runs.
+func (template StepTemplate) Expand(params Params) (PlanConfig, error) {
+	variables := vars.StaticVariables{}
+	for _, param := range template.Params {
+		if value, found := params[param]; found {
+			variables[param] = value
+		}
+	}
+
+	stepBytes, err := vars.NewTemplate([]byte(template.Step)).Evaluate(variables, vars.EvaluateOpts{})
+	if err != nil {
+		return PlanConfig{}, err
+	}
+
+	var plan PlanConfig
+	err = yaml.UnmarshalStrict(stepBytes, &plan)
+	if err != nil {
+		return PlanConfig{}, fmt.Errorf("malformed step in step template '%s': %s", template.Name, err)
+	}
+
+	for _, step := range collectPlans(plan) {
+		if step.Template != "" {
+			return PlanConfig{}, fmt.Errorf("step template '%s' refers to another step template ('%s')", template.Name, step.Template)
+		}
+	}
+
+	return plan, nil
+}
+
+type StepTemplates []StepTemplate
+
+// Lookup finds the given version of a template, or its latest version if the
+// version is 0.
+func (templates StepTemplates) Lookup(name string, version int) (StepTemplate, bool) {
+	var latest StepTemplate
+	found := false
+
+	for _, template := range templates {
+		if template.Name != name {
+			continue
+		}
+
+		if template.Version == version {
+			return template, true
+		}
+
+		if version == 0 && template.Version > latest.Version {
+			latest = template
+			found = true
+		}
+	}
+
+	return latest, found
+}
+
+// StepTemplateNames returns the names of the step templates the config's
+// steps refer to.
+func (c Config) StepTemplateNames() []string {
+	names := map[string]bool{}
+	for _, job := range c.Jobs {
+		for _, plan := range job.Plans() {
+			if plan.Template != "" {
+				names[plan.Template] = true
+			}
+		}
+	}
+
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// ExpandStepTemplates returns the config with every step referring to a step
+// template replaced by the template's step. Hooks and modifiers configured on
+// the referring step are kept, overriding those of the template's step.
+func (c Config) ExpandStepTemplates(templates StepTemplates) (Config, error) {
+	expanded := c
+	expanded.Jobs = make(JobConfigs, len(c.Jobs))
+
+	for i, job := range c.Jobs {
+		plan, err := expandPlan(PlanConfig{
+			Do:      &job.Plan,
+			Abort:   job.Abort,
+			Error:   job.Error,
+			Failure: job.Failure,
+			Ensure:  job.Ensure,
+			Success: job.Success,
+		}, templates)
+		if err != nil {
+			return Config{}, fmt.Errorf("job '%s': %s", job.Name, err)
+		}
+
+		job.Plan = *plan.Do
+		job.Abort = plan.Abort
+		job.Error = plan.Error
+		job.Failure = plan.Failure
+		job.Ensure = plan.Ensure
+		job.Success = plan.Success
+
+		expanded.Jobs[i] = job
+	}
+
+	return expanded, nil
+}
+
+func expandPlan(plan PlanConfig, templates StepTemplates) (PlanConfig, error) {
+	if plan.Template != "" {
+		template, found := templates.Lookup(plan.Template, plan.TemplateVersion)
+		if !found {
+			return PlanConfig{}, fmt.Errorf("unknown step template '%s'", plan.Template)
+		}
+
+		step, err := template.Expand(plan.Vars)
+		if err != nil {
+			return PlanConfig{}, err
+		}
+
+		plan = applyStepModifiers(step, plan)
+	}
+
+	var err error
+
+	if plan.Do != nil {
+		plan.Do, err = expandSequence(*plan.Do, templates)
+		if err != nil {
+			return PlanConfig{}, err
+		}
+	}
+
+	if plan.Aggregate != nil {
+		plan.Aggregate, err = expandSequence(*plan.Aggregate, templates)
+		if err != nil {
+			return PlanConfig{}, err
+		}
+	}
+
+	if plan.InParallel != nil {
+		inParallel := *plan.InParallel
+
+		steps, err := expandSequence(inParallel.Steps, templates)
+		if err != nil {
+			return PlanConfig{}, err
+		}
+
+		inParallel.Steps = *steps
+		plan.InParallel = &inParallel
+	}
+
+	for _, hook := range []**PlanConfig{
+		&plan.Try,
+		&plan.Abort,
+		&plan.Error,
+		&plan.Failure,
+		&plan.Ensure,
+		&plan.Success,
+	} {
+		if *hook == nil {
+			continue
+		}
+
+		expanded, err := expandPlan(**hook, templates)
+		if err != nil {
+			return PlanConfig{}, err
+		}
+
+		*hook = &expanded
+	}
+
+	return plan, nil
+}
+
+func expandSequence(sequence PlanSequence, templates StepTemplates) (*PlanSequence, error) {
+	expanded := make(PlanSequence, len(sequence))
+	for i, plan := range sequence {
+		var err error
+		expanded[i], err = expandPlan(plan, templates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &expanded, nil
+}
+
+// applyStepModifiers sets every field given on the step referring to the
+// template, e.g. its hooks, timeout or attempts, on the template's step. The
+// fields naming the template and its params are not carried over.
+func applyStepModifiers(step PlanConfig, ref PlanConfig) PlanConfig {
+	ref.Template = ""
+	ref.TemplateVersion = 0
+	ref.Vars = nil
+
+	stepValue := reflect.ValueOf(&step).Elem()
+	refValue := reflect.ValueOf(ref)
+
+	for i := 0; i < refValue.NumField(); i++ {
+		if field := refValue.Field(i); !field.IsZero() {
+			stepValue.Field(i).Set(field)
+		}
+	}
+
+	return step
+}
@@ -0,0 +1,224 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StepTemplate", func() {
+	var template atc.StepTemplate
+
+	BeforeEach(func() {
+		template = atc.StepTemplate{
+			Name:    "some-template",
+			Version: 1,
+			Params:  []string{"resource", "attempts"},
+			Step: `
+put: ((resource))
+attempts: ((attempts))
+params:
+  token: ((some-secret))
+`,
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid template", func() {
+			Expect(template.Validate()).To(Succeed())
+		})
+
+		It("requires a name", func() {
+			template.Name = ""
+			Expect(template.Validate()).To(MatchError(ContainSubstring("step template has no name")))
+		})
+
+		It("requires a step", func() {
+			template.Step = ""
+			Expect(template.Validate()).To(MatchError(ContainSubstring("step template has no step")))
+		})
+
+		It("requires the step to be YAML", func() {
+			template.Step = "{"
+			Expect(template.Validate()).To(MatchError(ContainSubstring("step template has a malformed step")))
+		})
+
+		It("rejects repeated params", func() {
+			template.Params = []string{"resource", "resource"}
+			Expect(template.Validate()).To(MatchError(ContainSubstring("step template repeats param 'resource'")))
+		})
+	})
+
+	Describe("MissingParams", func() {
+		It("returns the params which are not given a value", func() {
+			Expect(template.MissingParams(atc.Params{"resource": "some-resource"})).To(Equal([]string{"attempts"}))
+			Expect(template.MissingParams(atc.Params{"resource": "some-resource", "attempts": 2})).To(BeEmpty())
+		})
+	})
+
+	Describe("Expand", func() {
+		It("substitutes the params, preserving their types", func() {
+			plan, err := template.Expand(atc.Params{
+				"resource": "some-resource",
+				"attempts": 3,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Put).To(Equal("some-resource"))
+			Expect(plan.Attempts).To(Equal(3))
+		})
+
+		It("leaves other vars to be resolved when the step runs", func() {
+			plan, err := template.Expand(atc.Params{
+				"resource":    "some-resource",
+				"attempts":    3,
+				"some-secret": "not-a-param",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Params).To(Equal(atc.Params{"token": "((some-secret))"}))
+		})
+
+		It("fails when the result is not a valid step", func() {
+			_, err := template.Expand(atc.Params{
+				"resource": "some-resource",
+				"attempts": "lots",
+			})
+			Expect(err).To(MatchError(ContainSubstring("malformed step in step template 'some-template'")))
+		})
+
+		It("does not allow templates to refer to other templates", func() {
+			template.Params = nil
+			template.Step = `
+do:
+- template: other-template
+`
+			_, err := template.Expand(nil)
+			Expect(err).To(MatchError("step template 'some-template' refers to another step template ('other-template')"))
+		})
+	})
+})
+
+var _ = Describe("StepTemplates", func() {
+	templates := atc.StepTemplates{
+		{Name: "some-template", Version: 1},
+		{Name: "some-template", Version: 3},
+		{Name: "some-template", Version: 2},
+		{Name: "other-template", Version: 4},
+	}
+
+	Describe("Lookup", func() {
+		It("finds the latest version when no version is given", func() {
+			template, found := templates.Lookup("some-template", 0)
+			Expect(found).To(BeTrue())
+			Expect(template.Version).To(Equal(3))
+		})
+
+		It("finds the given version", func() {
+			template, found := templates.Lookup("some-template", 2)
+			Expect(found).To(BeTrue())
+			Expect(template.Version).To(Equal(2))
+		})
+
+		It("does not find unknown templates or versions", func() {
+			_, found := templates.Lookup("some-template", 4)
+			Expect(found).To(BeFalse())
+
+			_, found = templates.Lookup("bogus-template", 0)
+			Expect(found).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("Config", func() {
+	Describe("ExpandStepTemplates", func() {
+		var (
+			config    atc.Config
+			templates atc.StepTemplates
+		)
+
+		BeforeEach(func() {
+			templates = atc.StepTemplates{
+				{
+					Name:    "deploy",
+					Version: 1,
+					Params:  []string{"env"},
+					Step:    "put: ((env))\ntimeout: 1h",
+				},
+				{
+					Name:    "deploy",
+					Version: 2,
+					Params:  []string{"env"},
+					Step:    "put: deploy-((env))\ntimeout: 1h",
+				},
+			}
+
+			config = atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{Get: "some-input"},
+							{
+								Template:    "deploy",
+								Vars:        atc.Params{"env": "staging"},
+								Timeout:     "5m",
+								IdleTimeout: "10m",
+								Attempts:    2,
+							},
+							{
+								InParallel: &atc.InParallelConfig{
+									Steps: atc.PlanSequence{
+										{
+											Template:        "deploy",
+											TemplateVersion: 1,
+											Vars:            atc.Params{"env": "prod"},
+										},
+									},
+								},
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Template: "deploy",
+							Vars:     atc.Params{"env": "rollback"},
+						},
+					},
+				},
+			}
+		})
+
+		It("replaces steps referring to templates with the templates' steps", func() {
+			expanded, err := config.ExpandStepTemplates(templates)
+			Expect(err).ToNot(HaveOccurred())
+
+			job := expanded.Jobs[0]
+			Expect(job.Plan[0]).To(Equal(atc.PlanConfig{Get: "some-input"}))
+			Expect(job.Plan[1]).To(Equal(atc.PlanConfig{Put: "deploy-staging", Timeout: "5m", IdleTimeout: "10m", Attempts: 2}))
+			Expect(job.Plan[2].InParallel.Steps[0]).To(Equal(atc.PlanConfig{Put: "prod", Timeout: "1h"}))
+			Expect(job.Failure).To(Equal(&atc.PlanConfig{Put: "deploy-rollback", Timeout: "1h"}))
+		})
+
+		It("does not modify the original config", func() {
+			_, err := config.ExpandStepTemplates(templates)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.Jobs[0].Plan[1].Template).To(Equal("deploy"))
+			Expect(config.Jobs[0].Plan[2].InParallel.Steps[0].Template).To(Equal("deploy"))
+			Expect(config.Jobs[0].Failure.Template).To(Equal("deploy"))
+		})
+
+		It("returns the names of the templates referred to", func() {
+			Expect(config.StepTemplateNames()).To(Equal([]string{"deploy"}))
+		})
+
+		Context("when a template does not exist", func() {
+			BeforeEach(func() {
+				templates = nil
+			})
+
+			It("returns an error", func() {
+				_, err := config.ExpandStepTemplates(templates)
+				Expect(err).To(MatchError("job 'some-job': unknown step template 'deploy'"))
+			})
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 )
@@ -23,18 +24,26 @@ type drainer struct {
 	address      string
 	caCerts      []string
 	buildFactory db.BuildFactory
+	auditEvents  db.AuditEventRepository
 }
 
-func NewDrainer(transport string, address string, hostname string, caCerts []string, buildFactory db.BuildFactory) Drainer {
+// NewDrainer returns a Drainer that forwards the logs of finished builds to
+// the syslog server. If auditEvents is non-nil, audit events are forwarded
+// too.
+//
+func NewDrainer(transport string, address string, hostname string, caCerts []string, buildFactory db.BuildFactory, auditEvents db.AuditEventRepository) Drainer {
 	return &drainer{
 		hostname:     hostname,
 		transport:    transport,
 		address:      address,
 		buildFactory: buildFactory,
 		caCerts:      caCerts,
+		auditEvents:  auditEvents,
 	}
 }
 
+const auditEventsBatchSize = 1000
+
 func (d *drainer) Run(ctx context.Context) error {
 	logger := lagerctx.FromContext(ctx).Session("syslog")
 
@@ -44,7 +53,16 @@ func (d *drainer) Run(ctx context.Context) error {
 		return err
 	}
 
-	if len(builds) > 0 {
+	var auditEvents []atc.AuditEvent
+	if d.auditEvents != nil {
+		auditEvents, err = d.auditEvents.UndrainedAuditEvents(auditEventsBatchSize)
+		if err != nil {
+			logger.Error("failed-to-get-undrained-audit-events", err)
+			return err
+		}
+	}
+
+	if len(builds) > 0 || len(auditEvents) > 0 {
 		syslog, err := Dial(d.transport, d.address, d.caCerts)
 		if err != nil {
 			logger.Error("failed-to-connect", err)
@@ -60,7 +78,45 @@ func (d *drainer) Run(ctx context.Context) error {
 				return err
 			}
 		}
+
+		if len(auditEvents) > 0 {
+			err := d.drainAuditEvents(logger, auditEvents, syslog)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *drainer) drainAuditEvents(logger lager.Logger, auditEvents []atc.AuditEvent, syslog *Syslog) error {
+	logger = logger.Session("drain-audit-events")
+
+	ids := make([]int, 0, len(auditEvents))
+	for _, auditEvent := range auditEvents {
+		payload, err := json.Marshal(auditEvent)
+		if err != nil {
+			logger.Error("failed-to-marshal", err)
+			return err
+		}
+
+		tag := "audit/" + auditEvent.TeamName
+
+		err = syslog.Write(d.hostname, tag, time.Unix(auditEvent.Time, 0), string(payload))
+		if err != nil {
+			logger.Error("failed-to-write-to-server", err)
+			return err
+		}
+
+		ids = append(ids, auditEvent.ID)
 	}
+
+	err := d.auditEvents.MarkDrained(ids)
+	if err != nil {
+		logger.Error("failed-to-mark-drained", err)
+		return err
+	}
+
 	return nil
 }
 
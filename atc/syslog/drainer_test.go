@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"strconv"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
@@ -61,7 +62,7 @@ var _ = Describe("Drainer", func() {
 			})
 
 			It("drains all build events by tcp", func() {
-				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory, nil)
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
@@ -74,4 +75,64 @@ var _ = Describe("Drainer", func() {
 		})
 
 	})
+
+	Context("when there are audit events that have not been drained", func() {
+		var fakeAuditEvents *dbfakes.FakeAuditEventRepository
+
+		BeforeEach(func() {
+			server = newTestServer(nil)
+
+			fakeBuildFactory.GetDrainableBuildsReturns(nil, nil)
+
+			fakeAuditEvents = new(dbfakes.FakeAuditEventRepository)
+			fakeAuditEvents.UndrainedAuditEventsReturns([]atc.AuditEvent{
+				{
+					ID:       1,
+					Time:     1533744538,
+					Action:   atc.PausePipeline,
+					Actor:    "some-user",
+					TeamName: "some-team",
+					Targets:  map[string]string{"pipeline_name": "some-pipeline"},
+					Status:   200,
+				},
+				{
+					ID:       2,
+					Time:     1533744539,
+					Action:   atc.DestroyTeam,
+					Actor:    "some-admin",
+					TeamName: "other-team",
+					Status:   204,
+				},
+			}, nil)
+		})
+
+		It("forwards them and marks them as drained", func() {
+			testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory, fakeAuditEvents)
+			err := testDrainer.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			got := <-server.Messages
+			Expect(got).To(ContainSubstring("audit/some-team"))
+			Expect(got).To(ContainSubstring(`"actor":"some-user"`))
+			Expect(got).To(ContainSubstring("audit/other-team"))
+			Expect(got).To(ContainSubstring(`"action":"DestroyTeam"`))
+
+			Expect(fakeAuditEvents.MarkDrainedCallCount()).To(Equal(1))
+			Expect(fakeAuditEvents.MarkDrainedArgsForCall(0)).To(Equal([]int{1, 2}))
+		}, 0.2)
+
+		Context("when there is nothing to drain", func() {
+			BeforeEach(func() {
+				fakeAuditEvents.UndrainedAuditEventsReturns(nil, nil)
+			})
+
+			It("does not mark anything as drained", func() {
+				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory, fakeAuditEvents)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeAuditEvents.MarkDrainedCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -36,6 +36,10 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Test reports written to the outputs, which are stored once the task
+	// has run.
+	Reports []TaskReportConfig `json:"reports,omitempty"`
 }
 
 type ContainerLimits struct {
@@ -66,6 +70,10 @@ func NewTaskConfig(configBytes []byte) (TaskConfig, error) {
 	return config, nil
 }
 
+// BuildMetadataOutputName is the name under which the build metadata
+// directory is mounted into task containers, which no output may share.
+const BuildMetadataOutputName = "build-metadata"
+
 func (config TaskConfig) Validate() error {
 	var messages []string
 
@@ -79,6 +87,7 @@ func (config TaskConfig) Validate() error {
 
 	messages = append(messages, config.validateInputContainsNames()...)
 	messages = append(messages, config.validateOutputContainsNames()...)
+	messages = append(messages, config.validateReports()...)
 
 	if len(messages) > 0 {
 		return fmt.Errorf("invalid task configuration:\n%s", strings.Join(messages, "\n"))
@@ -94,6 +103,40 @@ func (config TaskConfig) validateOutputContainsNames() []string {
 		if output.Name == "" {
 			messages = append(messages, fmt.Sprintf("  output in position %d is missing a name", i))
 		}
+
+		if output.Name == BuildMetadataOutputName {
+			messages = append(messages, fmt.Sprintf("  output in position %d is named '%s', which is reserved for the build metadata directory", i, output.Name))
+		}
+	}
+
+	return messages
+}
+
+func (config TaskConfig) validateReports() []string {
+	var messages []string
+
+	for i, report := range config.Reports {
+		if report.Type != TaskReportTypeJUnit {
+			messages = append(messages, fmt.Sprintf("  report in position %d has an unknown type '%s'", i, report.Type))
+		}
+
+		segs := strings.SplitN(report.Path, "/", 2)
+		if len(segs) != 2 || segs[1] == "" {
+			messages = append(messages, fmt.Sprintf("  report in position %d must have a path within an output, e.g. 'output-name/report.xml'", i))
+			continue
+		}
+
+		found := false
+		for _, output := range config.Outputs {
+			if output.Name == segs[0] {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			messages = append(messages, fmt.Sprintf("  report in position %d refers to an unknown output '%s'", i, segs[0]))
+		}
 	}
 
 	return messages
@@ -135,6 +178,16 @@ type TaskCacheConfig struct {
 	Path string `json:"path,omitempty"`
 }
 
+const TaskReportTypeJUnit = "junit"
+
+type TaskReportConfig struct {
+	Type string `json:"type"`
+
+	// The path of the report, starting with the name of the output it is
+	// written to, e.g. 'test-results/junit.xml'.
+	Path string `json:"path"`
+}
+
 type TaskEnv map[string]string
 
 func (te *TaskEnv) UnmarshalJSON(p []byte) error {
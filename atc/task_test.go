@@ -384,6 +384,67 @@ run: {path: a/file}
 					Expect(err).To(MatchError(ContainSubstring("  output in position 2 is missing a name")))
 				})
 			})
+
+			Context("when an output is named after the build metadata directory", func() {
+				BeforeEach(func() {
+					invalidConfig.Outputs = append(invalidConfig.Outputs, TaskOutputConfig{Name: "concourse"}, TaskOutputConfig{Name: "build-metadata"})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  output in position 1 is named 'build-metadata', which is reserved for the build metadata directory")))
+				})
+			})
+		})
+
+		Context("when the task has reports", func() {
+			BeforeEach(func() {
+				validConfig.Outputs = []TaskOutputConfig{{Name: "test-results"}}
+				validConfig.Reports = []TaskReportConfig{
+					{Type: "junit", Path: "test-results/junit.xml"},
+				}
+
+				invalidConfig = validConfig
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when the type is unknown", func() {
+				BeforeEach(func() {
+					invalidConfig.Reports = []TaskReportConfig{
+						{Type: "tap", Path: "test-results/results.tap"},
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  report in position 0 has an unknown type 'tap'")))
+				})
+			})
+
+			Context("when the path is not within an output", func() {
+				BeforeEach(func() {
+					invalidConfig.Reports = []TaskReportConfig{
+						{Type: "junit", Path: "junit.xml"},
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  report in position 0 must have a path within an output")))
+				})
+			})
+
+			Context("when the path refers to an unknown output", func() {
+				BeforeEach(func() {
+					invalidConfig.Reports = []TaskReportConfig{
+						{Type: "junit", Path: "other-output/junit.xml"},
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("  report in position 0 refers to an unknown output 'other-output'")))
+				})
+			})
 		})
 
 		Context("when run is missing", func() {
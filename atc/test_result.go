@@ -0,0 +1,57 @@
+package atc
+
+// TestHistoryDefaultLimit is the number of builds whose test results are
+// returned by the test history of a job by default.
+const TestHistoryDefaultLimit = 10
+
+type TestStatus string
+
+const (
+	TestStatusPassed  TestStatus = "passed"
+	TestStatusFailed  TestStatus = "failed"
+	TestStatusErrored TestStatus = "errored"
+	TestStatusSkipped TestStatus = "skipped"
+)
+
+// TestResult is the result of a test case, as read from a task's report.
+type TestResult struct {
+	Task  string `json:"task"`
+	Suite string `json:"suite"`
+	Name  string `json:"name"`
+
+	Status TestStatus `json:"status"`
+
+	// Duration is the time the test took in seconds.
+	Duration float64 `json:"duration"`
+
+	Message string `json:"message,omitempty"`
+}
+
+// TestSummary is the results of the tests run by a build.
+type TestSummary struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Errored int `json:"errored"`
+	Skipped int `json:"skipped"`
+
+	Tests []TestResult `json:"tests"`
+}
+
+// TestHistory is the results of a test in the recent builds of a job, most
+// recent first.
+type TestHistory struct {
+	Task  string `json:"task"`
+	Suite string `json:"suite"`
+	Name  string `json:"name"`
+
+	// Flaky is true if the test both passed and failed with the same inputs.
+	Flaky bool `json:"flaky"`
+
+	Runs []TestRun `json:"runs"`
+}
+
+type TestRun struct {
+	BuildID   int        `json:"build_id"`
+	BuildName string     `json:"build_name"`
+	Status    TestStatus `json:"status"`
+}
@@ -0,0 +1,137 @@
+package testreport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const maxMessageLength = 1024
+
+type junitTestSuites struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name   string           `xml:"name,attr"`
+	Cases  []junitTestCase  `xml:"testcase"`
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure"`
+	Error     *junitProblem `xml:"error"`
+	Skipped   *junitProblem `xml:"skipped"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// ParseJUnit reads the test cases of a JUnit XML report. Both a <testsuites>
+// root and a single <testsuite> root are supported; nested suites are
+// flattened.
+func ParseJUnit(r io.Reader) ([]atc.TestResult, error) {
+	decoder := xml.NewDecoder(r)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("no test suites found in report")
+			}
+
+			return nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		results := []atc.TestResult{}
+
+		switch start.Name.Local {
+		case "testsuites":
+			var suites junitTestSuites
+			err = decoder.DecodeElement(&suites, &start)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, suite := range suites.Suites {
+				results = appendSuite(results, suite)
+			}
+
+		case "testsuite":
+			var suite junitTestSuite
+			err = decoder.DecodeElement(&suite, &start)
+			if err != nil {
+				return nil, err
+			}
+
+			results = appendSuite(results, suite)
+
+		default:
+			return nil, fmt.Errorf("unexpected root element '%s' in report", start.Name.Local)
+		}
+
+		return results, nil
+	}
+}
+
+func appendSuite(results []atc.TestResult, suite junitTestSuite) []atc.TestResult {
+	for _, testCase := range suite.Cases {
+		result := atc.TestResult{
+			Suite:  suite.Name,
+			Name:   testCase.Name,
+			Status: atc.TestStatusPassed,
+		}
+
+		if result.Suite == "" {
+			result.Suite = testCase.ClassName
+		}
+
+		fmt.Sscanf(testCase.Time, "%g", &result.Duration)
+
+		switch {
+		case testCase.Error != nil:
+			result.Status = atc.TestStatusErrored
+			result.Message = testCase.Error.message()
+		case testCase.Failure != nil:
+			result.Status = atc.TestStatusFailed
+			result.Message = testCase.Failure.message()
+		case testCase.Skipped != nil:
+			result.Status = atc.TestStatusSkipped
+			result.Message = testCase.Skipped.message()
+		}
+
+		results = append(results, result)
+	}
+
+	for _, nested := range suite.Suites {
+		results = appendSuite(results, nested)
+	}
+
+	return results
+}
+
+func (problem junitProblem) message() string {
+	message := strings.TrimSpace(problem.Message)
+	if message == "" {
+		message = strings.TrimSpace(problem.Body)
+	}
+
+	if len(message) > maxMessageLength {
+		message = strings.ToValidUTF8(message[:maxMessageLength], "")
+	}
+
+	return message
+}
@@ -0,0 +1,103 @@
+package testreport_test
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/testreport"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseJUnit", func() {
+	var (
+		report string
+
+		results  []atc.TestResult
+		parseErr error
+	)
+
+	JustBeforeEach(func() {
+		results, parseErr = testreport.ParseJUnit(strings.NewReader(report))
+	})
+
+	Context("with a testsuites root", func() {
+		BeforeEach(func() {
+			report = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="4">
+    <testcase name="lists builds" classname="api" time="0.5"/>
+    <testcase name="creates builds" classname="api" time="1.25">
+      <failure message="expected 201, got 500">stack trace</failure>
+    </testcase>
+    <testcase name="deletes builds" classname="api">
+      <error>panic: nil pointer</error>
+    </testcase>
+    <testcase name="hijacks builds" classname="api">
+      <skipped/>
+    </testcase>
+  </testsuite>
+  <testsuite name="db">
+    <testsuite name="db/builds">
+      <testcase name="saves builds" time="0.1"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+		})
+
+		It("returns the results of every test case", func() {
+			Expect(parseErr).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "api", Name: "lists builds", Status: atc.TestStatusPassed, Duration: 0.5},
+				{Suite: "api", Name: "creates builds", Status: atc.TestStatusFailed, Duration: 1.25, Message: "expected 201, got 500"},
+				{Suite: "api", Name: "deletes builds", Status: atc.TestStatusErrored, Message: "panic: nil pointer"},
+				{Suite: "api", Name: "hijacks builds", Status: atc.TestStatusSkipped},
+				{Suite: "db/builds", Name: "saves builds", Status: atc.TestStatusPassed, Duration: 0.1},
+			}))
+		})
+	})
+
+	Context("with a testsuite root", func() {
+		BeforeEach(func() {
+			report = `<testsuite><testcase name="works" classname="some.Class"/></testsuite>`
+		})
+
+		It("falls back to the class name for the suite", func() {
+			Expect(parseErr).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "some.Class", Name: "works", Status: atc.TestStatusPassed},
+			}))
+		})
+	})
+
+	Context("with an unexpected root element", func() {
+		BeforeEach(func() {
+			report = `<html></html>`
+		})
+
+		It("returns an error", func() {
+			Expect(parseErr).To(MatchError("unexpected root element 'html' in report"))
+		})
+	})
+
+	Context("with an empty report", func() {
+		BeforeEach(func() {
+			report = ``
+		})
+
+		It("returns an error", func() {
+			Expect(parseErr).To(HaveOccurred())
+		})
+	})
+
+	Context("with malformed XML", func() {
+		BeforeEach(func() {
+			report = `<testsuite><testcase name="works">`
+		})
+
+		It("returns an error", func() {
+			Expect(parseErr).To(HaveOccurred())
+		})
+	})
+})
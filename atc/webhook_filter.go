@@ -0,0 +1,111 @@
+package atc
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// WebhookFilter restricts which webhook payloads trigger a check of a
+// resource. Payloads are JSON and fields within them are referred to by
+// dot-separated paths, e.g. `repository.full_name` or `commits.0.id`.
+type WebhookFilter struct {
+	// Match maps payload paths to patterns their values must match for the
+	// payload to trigger a check, e.g. `ref: refs/heads/main`. Patterns use
+	// the syntax of path.Match.
+	Match map[string]string `json:"match,omitempty"`
+
+	// Version maps the fields of a version of the resource to payload paths,
+	// e.g. `ref: after`. When every path is present in the payload, the check
+	// starts from that version.
+	Version map[string]string `json:"version,omitempty"`
+}
+
+// ParseWebhookPayload decodes a JSON webhook payload so that it can be
+// filtered.
+func ParseWebhookPayload(payload []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var parsed interface{}
+	err := decoder.Decode(&parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+// Matches returns true if the payload satisfies every pattern of the filter.
+func (filter WebhookFilter) Matches(payload interface{}) bool {
+	for field, pattern := range filter.Match {
+		value, found := webhookPayloadValue(payload, field)
+		if !found {
+			return false
+		}
+
+		matched, err := path.Match(pattern, value)
+		if err != nil || !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FromVersion returns the version hinted at by the payload, or nil if the
+// filter has no version or the payload lacks any of its fields.
+func (filter WebhookFilter) FromVersion(payload interface{}) Version {
+	if len(filter.Version) == 0 {
+		return nil
+	}
+
+	version := Version{}
+	for field, payloadPath := range filter.Version {
+		value, found := webhookPayloadValue(payload, payloadPath)
+		if !found {
+			return nil
+		}
+
+		version[field] = value
+	}
+
+	return version
+}
+
+// webhookPayloadValue follows a dot-separated path into a payload. Only
+// scalar values are found, formatted as strings.
+func webhookPayloadValue(payload interface{}, payloadPath string) (string, bool) {
+	value := payload
+	for _, key := range strings.Split(payloadPath, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var found bool
+			value, found = v[key]
+			if !found {
+				return "", false
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return "", false
+			}
+			value = v[index]
+		default:
+			return "", false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,89 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookFilter", func() {
+	var (
+		filter  atc.WebhookFilter
+		payload interface{}
+	)
+
+	BeforeEach(func() {
+		filter = atc.WebhookFilter{
+			Match: map[string]string{
+				"ref":                  "refs/heads/*",
+				"repository.full_name": "concourse/concourse",
+			},
+			Version: map[string]string{
+				"ref": "after",
+			},
+		}
+
+		var err error
+		payload, err = atc.ParseWebhookPayload([]byte(`{
+			"ref": "refs/heads/main",
+			"after": "abc123",
+			"size": 2,
+			"repository": {"full_name": "concourse/concourse"},
+			"commits": [{"id": "def456"}]
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Matches", func() {
+		It("matches payloads satisfying every pattern", func() {
+			Expect(filter.Matches(payload)).To(BeTrue())
+		})
+
+		It("does not match payloads with other values", func() {
+			filter.Match["repository.full_name"] = "concourse/fly"
+			Expect(filter.Matches(payload)).To(BeFalse())
+		})
+
+		It("does not match payloads missing a path", func() {
+			filter.Match["pusher.name"] = "*"
+			Expect(filter.Matches(payload)).To(BeFalse())
+		})
+
+		It("follows array indices and formats numbers", func() {
+			filter.Match = map[string]string{
+				"commits.0.id": "def456",
+				"size":         "2",
+			}
+			Expect(filter.Matches(payload)).To(BeTrue())
+		})
+
+		It("does not match objects", func() {
+			filter.Match = map[string]string{"repository": "*"}
+			Expect(filter.Matches(payload)).To(BeFalse())
+		})
+	})
+
+	Describe("FromVersion", func() {
+		It("returns the version from the payload", func() {
+			Expect(filter.FromVersion(payload)).To(Equal(atc.Version{"ref": "abc123"}))
+		})
+
+		It("returns nil when the payload lacks a field", func() {
+			filter.Version["tag"] = "release.tag_name"
+			Expect(filter.FromVersion(payload)).To(BeNil())
+		})
+
+		It("returns nil when the filter has no version", func() {
+			filter.Version = nil
+			Expect(filter.FromVersion(payload)).To(BeNil())
+		})
+	})
+
+	Describe("ParseWebhookPayload", func() {
+		It("fails on malformed payloads", func() {
+			_, err := atc.ParseWebhookPayload([]byte("ref=refs/heads/main"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
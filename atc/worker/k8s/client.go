@@ -0,0 +1,396 @@
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc/worker/gclient"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	workerLabel          = "concourse-ci.org/worker"
+	propertiesAnnotation = "concourse-ci.org/properties"
+
+	mainContainerName    = "main"
+	sidecarContainerName = "sidecar"
+
+	stateVolumeName = "concourse-state"
+)
+
+// pauseCommand keeps a container running until its pod is deleted, leaving
+// the actual work to processes exec'd into it.
+var pauseCommand = []string{"/bin/sh", "-c", "trap 'exit 0' TERM; while true; do sleep 1; done"}
+
+var ErrImageNotSupported = errors.New("only docker images are supported by kubernetes workers")
+
+type PodFailedError struct {
+	Handle string
+	Reason string
+}
+
+func (err PodFailedError) Error() string {
+	return fmt.Sprintf("pod %s failed to start: %s", err.Handle, err.Reason)
+}
+
+// client implements gclient.Client by running each container as a pod.
+//
+// Every pod has a main container running the container's image, and a
+// sidecar container through which volumes are streamed in and out, so that
+// images don't need to provide tar. Both containers mount an emptyDir for
+// each bind mount of the container spec.
+//
+// The main container also mounts a memory-backed emptyDir at stateDir, to
+// which the container's environment is written through exec's stdin rather
+// than being put in the pod spec, where any reader of the pod could see it.
+type client struct {
+	clientset kubernetes.Interface
+	executor  Executor
+
+	namespace    string
+	workerName   string
+	sidecarImage string
+
+	pollInterval time.Duration
+	startTimeout time.Duration
+}
+
+func NewClient(
+	clientset kubernetes.Interface,
+	executor Executor,
+	namespace string,
+	workerName string,
+	sidecarImage string,
+	pollInterval time.Duration,
+	startTimeout time.Duration,
+) gclient.Client {
+	return &client{
+		clientset:    clientset,
+		executor:     executor,
+		namespace:    namespace,
+		workerName:   workerName,
+		sidecarImage: sidecarImage,
+		pollInterval: pollInterval,
+		startTimeout: startTimeout,
+	}
+}
+
+func (c *client) Ping() error {
+	_, err := c.pods().List(metav1.ListOptions{
+		LabelSelector: c.workerSelector(),
+		Limit:         1,
+	})
+	return err
+}
+
+func (c *client) Capacity() (garden.Capacity, error) {
+	return garden.Capacity{}, nil
+}
+
+func (c *client) Create(spec garden.ContainerSpec) (gclient.Container, error) {
+	image, err := imageReference(spec.Image.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := json.Marshal(spec.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		volumes []corev1.Volume
+		mounts  []corev1.VolumeMount
+	)
+
+	for i, bindMount := range spec.BindMounts {
+		name := fmt.Sprintf("volume-%d", i)
+
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: bindMount.DstPath,
+		})
+	}
+
+	stateMount := corev1.VolumeMount{
+		Name:      stateVolumeName,
+		MountPath: stateDir,
+	}
+
+	volumes = append(volumes, corev1.Volume{
+		Name: stateVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory},
+		},
+	})
+
+	privileged := spec.Privileged
+	automountServiceAccountToken := false
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.Handle,
+			Namespace: c.namespace,
+			Labels: map[string]string{
+				workerLabel: c.workerName,
+			},
+			Annotations: map[string]string{
+				propertiesAnnotation: string(properties),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			AutomountServiceAccountToken:  &automountServiceAccountToken,
+			TerminationGracePeriodSeconds: new(int64),
+			Volumes:                       volumes,
+			Containers: []corev1.Container{
+				{
+					Name:         mainContainerName,
+					Image:        image,
+					Command:      pauseCommand,
+					VolumeMounts: append([]corev1.VolumeMount{stateMount}, mounts...),
+					Resources:    resourceRequirements(spec.Limits),
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: &privileged,
+					},
+				},
+				{
+					Name:         sidecarContainerName,
+					Image:        c.sidecarImage,
+					Command:      pauseCommand,
+					VolumeMounts: mounts,
+				},
+			},
+		},
+	}
+
+	_, err = c.pods().Create(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.waitForPod(spec.Handle)
+	if err != nil {
+		_ = c.Destroy(spec.Handle)
+		return nil, err
+	}
+
+	container := c.newContainer(spec.Handle)
+
+	err = container.writeFile(containerEnvPath, envScript(spec.Env))
+	if err != nil {
+		_ = c.Destroy(spec.Handle)
+		return nil, err
+	}
+
+	return container, nil
+}
+
+func (c *client) Destroy(handle string) error {
+	err := c.pods().Delete(handle, &metav1.DeleteOptions{
+		GracePeriodSeconds: new(int64),
+	})
+	if k8serrors.IsNotFound(err) {
+		return garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	return err
+}
+
+func (c *client) Containers(properties garden.Properties) ([]gclient.Container, error) {
+	pods, err := c.pods().List(metav1.ListOptions{
+		LabelSelector: c.workerSelector(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []gclient.Container
+
+nextPod:
+	for _, pod := range pods.Items {
+		podProperties, err := podProperties(&pod)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, value := range properties {
+			if podProperties[name] != value {
+				continue nextPod
+			}
+		}
+
+		containers = append(containers, c.newContainer(pod.Name))
+	}
+
+	return containers, nil
+}
+
+func (c *client) BulkInfo(handles []string) (map[string]garden.ContainerInfoEntry, error) {
+	infos := map[string]garden.ContainerInfoEntry{}
+
+	for _, handle := range handles {
+		info, err := c.newContainer(handle).Info()
+		if err != nil {
+			infos[handle] = garden.ContainerInfoEntry{Err: garden.NewError(err.Error())}
+		} else {
+			infos[handle] = garden.ContainerInfoEntry{Info: info}
+		}
+	}
+
+	return infos, nil
+}
+
+func (c *client) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	metrics := map[string]garden.ContainerMetricsEntry{}
+
+	for _, handle := range handles {
+		metrics[handle] = garden.ContainerMetricsEntry{}
+	}
+
+	return metrics, nil
+}
+
+func (c *client) Lookup(handle string) (gclient.Container, error) {
+	_, err := c.pods().Get(handle, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, garden.ContainerNotFoundError{Handle: handle}
+		}
+
+		return nil, err
+	}
+
+	return c.newContainer(handle), nil
+}
+
+func (c *client) waitForPod(handle string) error {
+	return wait.PollImmediate(c.pollInterval, c.startTimeout, func() (bool, error) {
+		pod, err := c.pods().Get(handle, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		switch pod.Status.Phase {
+		case corev1.PodRunning:
+			return allContainersRunning(pod), nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, PodFailedError{Handle: handle, Reason: pod.Status.Reason}
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting == nil {
+				continue
+			}
+
+			switch status.State.Waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+				return false, PodFailedError{Handle: handle, Reason: status.State.Waiting.Message}
+			}
+		}
+
+		return false, nil
+	})
+}
+
+func (c *client) newContainer(handle string) *container {
+	return &container{
+		handle: handle,
+		client: c,
+	}
+}
+
+func (c *client) pods() typedcorev1.PodInterface {
+	return c.clientset.CoreV1().Pods(c.namespace)
+}
+
+func (c *client) workerSelector() string {
+	return workerLabel + "=" + c.workerName
+}
+
+func allContainersRunning(pod *corev1.Pod) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func podProperties(pod *corev1.Pod) (garden.Properties, error) {
+	properties := garden.Properties{}
+
+	encoded, found := pod.Annotations[propertiesAnnotation]
+	if !found {
+		return properties, nil
+	}
+
+	err := json.Unmarshal([]byte(encoded), &properties)
+	if err != nil {
+		return nil, err
+	}
+
+	// a container created without properties has them encoded as null
+	if properties == nil {
+		properties = garden.Properties{}
+	}
+
+	return properties, nil
+}
+
+// imageReference converts a docker:///repository#tag image URI into an image
+// reference a pod can run.
+func imageReference(uri string) (string, error) {
+	imageURL, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+
+	if imageURL.Scheme != "docker" {
+		return "", ErrImageNotSupported
+	}
+
+	image := strings.TrimPrefix(imageURL.Path, "/")
+	if imageURL.Fragment != "" {
+		image += ":" + imageURL.Fragment
+	}
+
+	return image, nil
+}
+
+func resourceRequirements(limits garden.Limits) corev1.ResourceRequirements {
+	requirements := corev1.ResourceRequirements{
+		Limits:   corev1.ResourceList{},
+		Requests: corev1.ResourceList{},
+	}
+
+	if limits.Memory.LimitInBytes != 0 {
+		requirements.Limits[corev1.ResourceMemory] = *resource.NewQuantity(int64(limits.Memory.LimitInBytes), resource.BinarySI)
+	}
+
+	// cpu shares are relative to 1024 shares per core
+	if limits.CPU.LimitInShares != 0 {
+		requirements.Requests[corev1.ResourceCPU] = *resource.NewMilliQuantity(int64(limits.CPU.LimitInShares)*1000/1024, resource.DecimalSI)
+	}
+
+	return requirements
+}
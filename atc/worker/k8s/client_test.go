@@ -0,0 +1,500 @@
+package k8s_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc/worker/gclient"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	"github.com/concourse/concourse/atc/worker/k8s/k8sfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const namespace = "some-namespace"
+
+// runPods makes pods created through the clientset start running right away.
+func runPods(clientset *fake.Clientset) {
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodRunning
+		return false, pod, nil
+	})
+}
+
+var _ = Describe("Client", func() {
+	var (
+		clientset    *fake.Clientset
+		fakeExecutor *k8sfakes.FakeExecutor
+
+		client gclient.Client
+	)
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset()
+		fakeExecutor = new(k8sfakes.FakeExecutor)
+
+		client = k8s.NewClient(
+			clientset,
+			fakeExecutor,
+			namespace,
+			"some-worker",
+			"some-sidecar-image",
+			time.Millisecond,
+			100*time.Millisecond,
+		)
+	})
+
+	getPod := func(name string) *corev1.Pod {
+		pod, err := clientset.CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod
+	}
+
+	Describe("Create", func() {
+		var (
+			spec garden.ContainerSpec
+
+			container gclient.Container
+			createErr error
+		)
+
+		BeforeEach(func() {
+			spec = garden.ContainerSpec{
+				Handle: "some-handle",
+				Image:  garden.ImageRef{URI: "docker:///some-repository#some-tag"},
+				BindMounts: []garden.BindMount{
+					{DstPath: "/scratch"},
+					{DstPath: "/tmp/build/some-input"},
+				},
+				Env:        []string{"SOME=env"},
+				Properties: garden.Properties{"some": "property"},
+				Privileged: true,
+				Limits: garden.Limits{
+					Memory: garden.MemoryLimits{LimitInBytes: 1024},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			container, createErr = client.Create(spec)
+		})
+
+		Context("when the pod starts running", func() {
+			BeforeEach(func() {
+				runPods(clientset)
+			})
+
+			It("returns the container", func() {
+				Expect(createErr).ToNot(HaveOccurred())
+				Expect(container.Handle()).To(Equal("some-handle"))
+			})
+
+			It("creates a pod for the container", func() {
+				pod := getPod("some-handle")
+				Expect(pod.Labels).To(Equal(map[string]string{"concourse-ci.org/worker": "some-worker"}))
+				Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+				Expect(pod.Spec.Volumes).To(HaveLen(3))
+				Expect(pod.Spec.Volumes[2].Name).To(Equal("concourse-state"))
+				Expect(pod.Spec.Volumes[2].EmptyDir.Medium).To(Equal(corev1.StorageMediumMemory))
+				Expect(pod.Spec.Containers).To(HaveLen(2))
+
+				main := pod.Spec.Containers[0]
+				Expect(main.Name).To(Equal("main"))
+				Expect(main.Image).To(Equal("some-repository:some-tag"))
+				Expect(main.Env).To(BeEmpty())
+				Expect(*main.SecurityContext.Privileged).To(BeTrue())
+				Expect(main.Resources.Limits.Memory().Value()).To(Equal(int64(1024)))
+
+				sidecar := pod.Spec.Containers[1]
+				Expect(sidecar.Name).To(Equal("sidecar"))
+				Expect(sidecar.Image).To(Equal("some-sidecar-image"))
+
+				Expect(main.VolumeMounts).To(Equal([]corev1.VolumeMount{
+					{Name: "concourse-state", MountPath: "/.concourse"},
+					{Name: "volume-0", MountPath: "/scratch"},
+					{Name: "volume-1", MountPath: "/tmp/build/some-input"},
+				}))
+
+				Expect(sidecar.VolumeMounts).To(Equal([]corev1.VolumeMount{
+					{Name: "volume-0", MountPath: "/scratch"},
+					{Name: "volume-1", MountPath: "/tmp/build/some-input"},
+				}))
+			})
+
+			It("writes the environment to the main container through stdin", func() {
+				Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+				_, pod, c, command, stdin, _, _, _ := fakeExecutor.ExecArgsForCall(0)
+				Expect(pod).To(Equal("some-handle"))
+				Expect(c).To(Equal("main"))
+				Expect(command).To(Equal([]string{
+					"/bin/sh", "-c", `umask 077 && mkdir -p "$(dirname "$0")" && cat > "$0"`, "/.concourse/env",
+				}))
+				Expect(ioutil.ReadAll(stdin)).To(Equal([]byte(`set -- 'SOME=env' "$@"` + "\n")))
+			})
+
+			It("stores the properties on the pod", func() {
+				properties, err := container.Properties()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(properties).To(Equal(garden.Properties{"some": "property"}))
+			})
+		})
+
+		Context("when the environment can't be written", func() {
+			BeforeEach(func() {
+				runPods(clientset)
+				fakeExecutor.ExecReturns(errors.New("nope"))
+			})
+
+			It("returns an error and deletes the pod", func() {
+				Expect(createErr).To(MatchError("nope"))
+
+				_, err := client.Lookup("some-handle")
+				Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "some-handle"}))
+			})
+		})
+
+		Context("when the image can't be pulled", func() {
+			BeforeEach(func() {
+				clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+					pod.Status.Phase = corev1.PodPending
+					pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+						{
+							Name: "main",
+							State: corev1.ContainerState{
+								Waiting: &corev1.ContainerStateWaiting{
+									Reason:  "ErrImagePull",
+									Message: "no such image",
+								},
+							},
+						},
+					}
+					return false, pod, nil
+				})
+			})
+
+			It("returns an error and deletes the pod", func() {
+				Expect(createErr).To(Equal(k8s.PodFailedError{Handle: "some-handle", Reason: "no such image"}))
+
+				_, err := client.Lookup("some-handle")
+				Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "some-handle"}))
+			})
+		})
+
+		Context("when the image is not a docker image", func() {
+			BeforeEach(func() {
+				spec.Image.URI = "raw:///some/rootfs"
+			})
+
+			It("returns an error", func() {
+				Expect(createErr).To(Equal(k8s.ErrImageNotSupported))
+			})
+		})
+	})
+
+	Describe("Lookup", func() {
+		Context("when the pod does not exist", func() {
+			It("returns a ContainerNotFoundError", func() {
+				_, err := client.Lookup("bogus")
+				Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "bogus"}))
+			})
+		})
+	})
+
+	Describe("Containers", func() {
+		BeforeEach(func() {
+			runPods(clientset)
+
+			_, err := client.Create(garden.ContainerSpec{
+				Handle:     "some-handle",
+				Image:      garden.ImageRef{URI: "docker:///some-image"},
+				Properties: garden.Properties{"some": "property"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.Create(garden.ContainerSpec{
+				Handle: "other-handle",
+				Image:  garden.ImageRef{URI: "docker:///some-image"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the containers matching the properties", func() {
+			containers, err := client.Containers(garden.Properties{"some": "property"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].Handle()).To(Equal("some-handle"))
+		})
+	})
+
+	Describe("Destroy", func() {
+		BeforeEach(func() {
+			runPods(clientset)
+
+			_, err := client.Create(garden.ContainerSpec{
+				Handle: "some-handle",
+				Image:  garden.ImageRef{URI: "docker:///some-image"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the pod", func() {
+			Expect(client.Destroy("some-handle")).To(Succeed())
+
+			_, err := client.Lookup("some-handle")
+			Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "some-handle"}))
+		})
+	})
+
+	Describe("Container", func() {
+		var container gclient.Container
+
+		BeforeEach(func() {
+			runPods(clientset)
+
+			var err error
+			container, err = client.Create(garden.ContainerSpec{
+				Handle: "some-handle",
+				Image:  garden.ImageRef{URI: "docker:///some-image"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Describe("SetProperty", func() {
+			It("updates the properties of the pod", func() {
+				Expect(container.SetProperty("some", "property")).To(Succeed())
+				Expect(container.SetProperty("other", "property")).To(Succeed())
+				Expect(container.RemoveProperty("other")).To(Succeed())
+
+				value, err := container.Property("some")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal("property"))
+
+				_, err = container.Property("other")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("Run", func() {
+			var (
+				stdin  io.Reader
+				stdout *bytes.Buffer
+				stderr *bytes.Buffer
+
+				process garden.Process
+				runErr  error
+			)
+
+			BeforeEach(func() {
+				stdin = strings.NewReader("some-input")
+				stdout = new(bytes.Buffer)
+				stderr = new(bytes.Buffer)
+			})
+
+			JustBeforeEach(func() {
+				process, runErr = container.Run(context.TODO(), garden.ProcessSpec{
+					ID:   "some-process",
+					Path: "some-path",
+					Args: []string{"some", "args"},
+					Env:  []string{"SOME=env"},
+					Dir:  "/some/dir",
+				}, garden.ProcessIO{
+					Stdin:  stdin,
+					Stdout: stdout,
+					Stderr: stderr,
+				})
+			})
+
+			Context("when the process exits", func() {
+				BeforeEach(func() {
+					fakeExecutor.ExecStub = func(_, _, _ string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) error {
+						if stdout == nil {
+							return nil
+						}
+
+						_, _ = stdout.Write([]byte("some-output"))
+						return utilexec.CodeExitError{Err: errors.New("exit 42"), Code: 42}
+					}
+				})
+
+				It("writes the process's environment through stdin", func() {
+					Expect(runErr).ToNot(HaveOccurred())
+
+					_, err := process.Wait()
+					Expect(err).ToNot(HaveOccurred())
+
+					// the first exec wrote the container's environment
+					Expect(fakeExecutor.ExecCallCount()).To(Equal(3))
+					_, pod, c, command, envStdin, _, _, _ := fakeExecutor.ExecArgsForCall(1)
+					Expect(pod).To(Equal("some-handle"))
+					Expect(c).To(Equal("main"))
+					Expect(command).To(Equal([]string{
+						"/bin/sh", "-c", `umask 077 && mkdir -p "$(dirname "$0")" && cat > "$0"`, "/.concourse/processes/some-process/env",
+					}))
+					Expect(ioutil.ReadAll(envStdin)).To(Equal([]byte(`set -- 'SOME=env' "$@"` + "\n")))
+				})
+
+				It("execs the process in the main container", func() {
+					Expect(runErr).ToNot(HaveOccurred())
+
+					exitStatus, err := process.Wait()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(exitStatus).To(Equal(42))
+					Expect(process.ID()).To(Equal("some-process"))
+
+					Expect(fakeExecutor.ExecCallCount()).To(Equal(3))
+					ns, pod, c, command, actualStdin, actualStdout, actualStderr, tty := fakeExecutor.ExecArgsForCall(2)
+					Expect(ns).To(Equal(namespace))
+					Expect(pod).To(Equal("some-handle"))
+					Expect(c).To(Equal("main"))
+					Expect(command).To(Equal([]string{
+						"/bin/sh", "-c",
+						`cd "$1" && shift && . "$0/env" && rm -f "$0/env" && . /.concourse/env && echo $$ > "$0/pid" && exec env "$@"`,
+						"/.concourse/processes/some-process", "/some/dir",
+						"some-path", "some", "args",
+					}))
+					Expect(command).ToNot(ContainElement(ContainSubstring("SOME=env")))
+					Expect(actualStdin).To(Equal(stdin))
+					Expect(actualStdout).To(Equal(stdout))
+					Expect(actualStderr).To(Equal(stderr))
+					Expect(tty).To(BeFalse())
+
+					Expect(stdout.String()).To(Equal("some-output"))
+				})
+			})
+
+			Context("when the process can't be run", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeExecutor.ExecStub = func(_, _, _ string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) error {
+						if stdout == nil {
+							return nil
+						}
+
+						return disaster
+					}
+				})
+
+				It("returns the error from Wait", func() {
+					Expect(runErr).ToNot(HaveOccurred())
+
+					_, err := process.Wait()
+					Expect(err).To(Equal(disaster))
+				})
+			})
+
+			Context("when the process is running", func() {
+				var exit chan error
+
+				BeforeEach(func() {
+					exit = make(chan error)
+
+					fakeExecutor.ExecStub = func(_, _, _ string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) error {
+						if stdout == nil {
+							return nil
+						}
+
+						return <-exit
+					}
+				})
+
+				AfterEach(func() {
+					close(exit)
+				})
+
+				It("signals it by its pid", func() {
+					Expect(runErr).ToNot(HaveOccurred())
+					Expect(process.Signal(garden.SignalKill)).To(Succeed())
+
+					// the process itself is exec'd concurrently, so its call may
+					// come either side of the kill
+					Eventually(fakeExecutor.ExecCallCount).Should(Equal(4))
+
+					var commands [][]string
+					for i := 0; i < fakeExecutor.ExecCallCount(); i++ {
+						_, pod, c, command, _, _, _, _ := fakeExecutor.ExecArgsForCall(i)
+						Expect(pod).To(Equal("some-handle"))
+						Expect(c).To(Equal("main"))
+						commands = append(commands, command)
+					}
+
+					Expect(commands).To(ContainElement([]string{
+						"/bin/sh", "-c", `kill -s "$0" "$(cat "$1")"`, "KILL", "/.concourse/processes/some-process/pid",
+					}))
+				})
+			})
+
+			Context("when the context is canceled", func() {
+				var (
+					ctx    context.Context
+					cancel context.CancelFunc
+					exit   chan error
+				)
+
+				BeforeEach(func() {
+					ctx, cancel = context.WithCancel(context.Background())
+					exit = make(chan error)
+
+					fakeExecutor.ExecStub = func(_, _, _ string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) error {
+						if stdout == nil {
+							return nil
+						}
+
+						return <-exit
+					}
+				})
+
+				AfterEach(func() {
+					close(exit)
+				})
+
+				It("returns from Wait without the process exiting", func() {
+					process, err := container.Run(ctx, garden.ProcessSpec{Path: "some-path"}, garden.ProcessIO{
+						Stdout: new(bytes.Buffer),
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					cancel()
+
+					exitStatus, err := process.Wait()
+					Expect(exitStatus).To(Equal(-1))
+					Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				})
+			})
+
+			Context("when the environment can't be written", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeExecutor.ExecReturns(disaster)
+				})
+
+				It("returns the error without running the process", func() {
+					Expect(runErr).To(Equal(disaster))
+					Expect(fakeExecutor.ExecCallCount()).To(Equal(2))
+				})
+			})
+		})
+
+		Describe("Attach", func() {
+			It("returns a ProcessNotFoundError", func() {
+				_, err := container.Attach(context.TODO(), "some-process", garden.ProcessIO{})
+				Expect(err).To(Equal(garden.ProcessNotFoundError{ProcessID: "some-process"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,74 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+type Config struct {
+	InClusterConfig bool   `long:"in-cluster" description:"Run containers as pods in the cluster the web node is running in. (experimental)"`
+	ConfigPath      string `long:"config-path" description:"Path to a kubeconfig for the cluster to run containers as pods in. (experimental)"`
+
+	Namespace string   `long:"namespace" default:"concourse-workloads" description:"Namespace in which to create pods."`
+	Name      string   `long:"name" default:"kubernetes" description:"Name of the worker representing the cluster."`
+	Tags      []string `long:"tag" description:"Tag to advertise for the worker. Can be specified multiple times."`
+
+	SidecarImage       string            `long:"sidecar-image" default:"busybox" description:"Image providing sh and tar, used to stream volumes in and out of pods."`
+	ResourceTypeImages map[string]string `long:"resource-type-image" description:"Image to run a base resource type with, e.g. git:concourse/git-resource. Can be specified multiple times."`
+
+	PodStartTimeout time.Duration `long:"pod-start-timeout" default:"5m" description:"How long to wait for a pod to start running."`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.InClusterConfig || config.ConfigPath != ""
+}
+
+func (config Config) Validate() error {
+	if config.InClusterConfig && config.ConfigPath != "" {
+		return errors.New("Either in-cluster or config-path can be used, not both.")
+	}
+
+	return nil
+}
+
+func (config Config) RestConfig() (*rest.Config, error) {
+	if config.InClusterConfig {
+		return rest.InClusterConfig()
+	}
+
+	return clientcmd.BuildConfigFromFlags("", config.ConfigPath)
+}
+
+// Worker returns the worker to register for the cluster.
+func (config Config) Worker(version string) atc.Worker {
+	var resourceTypes []atc.WorkerResourceType
+	for resourceType, image := range config.ResourceTypeImages {
+		resourceTypes = append(resourceTypes, atc.WorkerResourceType{
+			Type:  resourceType,
+			Image: image,
+		})
+	}
+
+	sort.Slice(resourceTypes, func(i, j int) bool {
+		return resourceTypes[i].Type < resourceTypes[j].Type
+	})
+
+	return atc.Worker{
+		// workers need a unique address, though nothing dials this one
+		GardenAddr:    fmt.Sprintf("kubernetes:///%s/%s", config.Namespace, config.Name),
+		ResourceTypes: resourceTypes,
+		Platform:      "linux",
+		Tags:          config.Tags,
+		Name:          config.Name,
+		Version:       version,
+		StartTime:     time.Now().Unix(),
+		State:         string(db.WorkerStateRunning),
+	}
+}
@@ -0,0 +1,390 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	uuid "github.com/nu7hatch/gouuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+var ErrNotSupported = errors.New("not supported by kubernetes workers")
+
+const (
+	// stateDir is where the main container mounts its memory-backed
+	// emptyDir, holding the environment of the container and its processes.
+	stateDir = "/.concourse"
+
+	containerEnvPath = stateDir + "/env"
+	processesDir     = stateDir + "/processes"
+)
+
+// container implements gclient.Container on top of a pod, running processes
+// in its main container through exec.
+type container struct {
+	handle string
+	client *client
+}
+
+func (c *container) Handle() string {
+	return c.handle
+}
+
+// Stop signals every process exec'd into the main container. The pause
+// process keeps the pod itself alive.
+func (c *container) Stop(kill bool) error {
+	signal := "TERM"
+	if kill {
+		signal = "KILL"
+	}
+
+	err := c.exec(mainContainerName, []string{"/bin/sh", "-c", "kill -s " + signal + " -1"}, nil, nil, nil, false)
+
+	// kill exits non-zero when there is nothing to signal
+	_, err = exitStatus(err)
+	return err
+}
+
+func (c *container) Info() (garden.ContainerInfo, error) {
+	pod, err := c.client.pods().Get(c.handle, metav1.GetOptions{})
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	properties, err := podProperties(pod)
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	return garden.ContainerInfo{
+		State:       string(pod.Status.Phase),
+		HostIP:      pod.Status.HostIP,
+		ContainerIP: pod.Status.PodIP,
+		Properties:  properties,
+	}, nil
+}
+
+func (c *container) StreamIn(spec garden.StreamInSpec) error {
+	return c.exec(mainContainerName, extractCommand(spec.Path), spec.TarStream, nil, nil, false)
+}
+
+func (c *container) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+
+	go func() {
+		writer.CloseWithError(c.exec(mainContainerName, archiveCommand(spec.Path), nil, writer, nil, false))
+	}()
+
+	return reader, nil
+}
+
+func (c *container) CurrentBandwidthLimits() (garden.BandwidthLimits, error) {
+	return garden.BandwidthLimits{}, nil
+}
+
+func (c *container) CurrentCPULimits() (garden.CPULimits, error) {
+	return garden.CPULimits{}, nil
+}
+
+func (c *container) CurrentDiskLimits() (garden.DiskLimits, error) {
+	return garden.DiskLimits{}, nil
+}
+
+func (c *container) CurrentMemoryLimits() (garden.MemoryLimits, error) {
+	return garden.MemoryLimits{}, nil
+}
+
+func (c *container) NetIn(hostPort, containerPort uint32) (uint32, uint32, error) {
+	return 0, 0, ErrNotSupported
+}
+
+func (c *container) NetOut(netOutRule garden.NetOutRule) error {
+	return ErrNotSupported
+}
+
+func (c *container) BulkNetOut(netOutRules []garden.NetOutRule) error {
+	return ErrNotSupported
+}
+
+// Run writes the process's environment to its own directory under stateDir
+// before exec'ing it, as anything in the exec command is visible to whoever
+// can see the pod's processes, as well as in the API server's audit logs. The
+// process writes its pid to the same directory, so that it can be signalled.
+func (c *container) Run(ctx context.Context, spec garden.ProcessSpec, processIO garden.ProcessIO) (garden.Process, error) {
+	id := spec.ID
+	if id == "" {
+		guid, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+
+		id = guid.String()
+	}
+
+	processDir := path.Join(processesDir, id)
+
+	err := c.writeFile(path.Join(processDir, "env"), envScript(spec.Env))
+	if err != nil {
+		return nil, err
+	}
+
+	process := newProcess(id, c, processDir)
+
+	go func() {
+		process.exited(exitStatus(c.exec(
+			mainContainerName,
+			processCommand(processDir, spec),
+			processIO.Stdin,
+			processIO.Stdout,
+			processIO.Stderr,
+			spec.TTY != nil,
+		)))
+	}()
+
+	// as with Garden, canceling the context detaches from the process rather
+	// than stopping it, which is left to the caller
+	go func() {
+		select {
+		case <-ctx.Done():
+			process.exited(-1, fmt.Errorf("stdin/stdout/stderr streams were canceled by: %w", ctx.Err()))
+		case <-process.done:
+		}
+	}()
+
+	return process, nil
+}
+
+// Attach always fails: the output of an exec session only goes to the
+// connection that started it, so a process can't be re-attached to even if
+// it is still running. Callers fall back to running the process again, which
+// means a web node restarting mid-build reruns its task steps from scratch.
+func (c *container) Attach(ctx context.Context, processID string, processIO garden.ProcessIO) (garden.Process, error) {
+	return nil, garden.ProcessNotFoundError{ProcessID: processID}
+}
+
+func (c *container) Metrics() (garden.Metrics, error) {
+	return garden.Metrics{}, nil
+}
+
+// SetGraceTime is a no-op, as pods live until they're deleted.
+func (c *container) SetGraceTime(graceTime time.Duration) error {
+	return nil
+}
+
+func (c *container) Properties() (garden.Properties, error) {
+	pod, err := c.client.pods().Get(c.handle, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return podProperties(pod)
+}
+
+func (c *container) Property(name string) (string, error) {
+	properties, err := c.Properties()
+	if err != nil {
+		return "", err
+	}
+
+	value, found := properties[name]
+	if !found {
+		return "", errors.New("property does not exist: " + name)
+	}
+
+	return value, nil
+}
+
+func (c *container) SetProperty(name string, value string) error {
+	return c.updateProperties(func(properties garden.Properties) {
+		properties[name] = value
+	})
+}
+
+func (c *container) RemoveProperty(name string) error {
+	return c.updateProperties(func(properties garden.Properties) {
+		delete(properties, name)
+	})
+}
+
+func (c *container) updateProperties(update func(garden.Properties)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pod, err := c.client.pods().Get(c.handle, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		properties, err := podProperties(pod)
+		if err != nil {
+			return err
+		}
+
+		update(properties)
+
+		encoded, err := json.Marshal(properties)
+		if err != nil {
+			return err
+		}
+
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+
+		pod.Annotations[propertiesAnnotation] = string(encoded)
+
+		_, err = c.client.pods().Update(pod)
+		return err
+	})
+}
+
+func (c *container) exec(containerName string, command []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, tty bool) error {
+	return c.client.executor.Exec(c.client.namespace, c.handle, containerName, command, stdin, stdout, stderr, tty)
+}
+
+// writeFile writes a file in the main container which only its owner can
+// read, passing the contents through stdin.
+func (c *container) writeFile(target string, contents string) error {
+	return c.exec(
+		mainContainerName,
+		[]string{"/bin/sh", "-c", `umask 077 && mkdir -p "$(dirname "$0")" && cat > "$0"`, target},
+		strings.NewReader(contents),
+		nil,
+		nil,
+		false,
+	)
+}
+
+// processCommand wraps a process so that it runs in its working directory
+// with its environment, which exec has no notion of.
+//
+// The environment is sourced from the scripts written by envScript, the
+// container's last so that the process's own variables take precedence, and
+// the process's is removed before running it. The shell then writes its pid,
+// which the process keeps when exec'd.
+func processCommand(processDir string, spec garden.ProcessSpec) []string {
+	dir := spec.Dir
+	if dir == "" {
+		dir = "/"
+	}
+
+	command := []string{
+		"/bin/sh", "-c",
+		`cd "$1" && shift && . "$0/env" && rm -f "$0/env" && . ` + containerEnvPath + ` && echo $$ > "$0/pid" && exec env "$@"`,
+		processDir,
+		dir,
+		spec.Path,
+	}
+
+	return append(command, spec.Args...)
+}
+
+// envScript renders environment variables as a script which, when sourced,
+// prepends them to the shell's positional parameters as arguments to env.
+// Variables which aren't of the form NAME=value are skipped.
+func envScript(env []string) string {
+	script := "set --"
+	for _, e := range env {
+		if !strings.Contains(e, "=") {
+			continue
+		}
+
+		script += " " + shellQuote(e)
+	}
+
+	return script + ` "$@"` + "\n"
+}
+
+// shellQuote quotes a string so that sh reads it as a single word.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// archiveCommand writes a tar of a directory's contents, or of a single
+// file, to stdout.
+func archiveCommand(target string) []string {
+	return []string{
+		"/bin/sh", "-c",
+		`if [ -d "$0" ]; then exec tar -cf - -C "$0" .; else exec tar -cf - -C "$(dirname "$0")" "$(basename "$0")"; fi`,
+		path.Clean(target),
+	}
+}
+
+// extractCommand extracts a tar read from stdin into a directory.
+func extractCommand(target string) []string {
+	return []string{
+		"/bin/sh", "-c",
+		`mkdir -p "$0" && exec tar -xf - -C "$0"`,
+		path.Clean(target),
+	}
+}
+
+type process struct {
+	id        string
+	container *container
+	dir       string
+
+	exitOnce   sync.Once
+	done       chan struct{}
+	exitStatus int
+	err        error
+}
+
+func newProcess(id string, container *container, dir string) *process {
+	return &process{
+		id:        id,
+		container: container,
+		dir:       dir,
+		done:      make(chan struct{}),
+	}
+}
+
+func (p *process) exited(exitStatus int, err error) {
+	p.exitOnce.Do(func() {
+		p.exitStatus = exitStatus
+		p.err = err
+		close(p.done)
+	})
+}
+
+func (p *process) ID() string {
+	return p.id
+}
+
+func (p *process) Wait() (int, error) {
+	<-p.done
+	return p.exitStatus, p.err
+}
+
+func (p *process) SetTTY(garden.TTYSpec) error {
+	return nil
+}
+
+// Signal kills the process by the pid it wrote when it started. Signalling a
+// process which has exited, or which has yet to write its pid, is a no-op.
+func (p *process) Signal(signal garden.Signal) error {
+	name := "TERM"
+	if signal == garden.SignalKill {
+		name = "KILL"
+	}
+
+	err := p.container.exec(
+		mainContainerName,
+		[]string{"/bin/sh", "-c", `kill -s "$0" "$(cat "$1")"`, name, path.Join(p.dir, "pid")},
+		nil,
+		nil,
+		nil,
+		false,
+	)
+
+	// kill exits non-zero when there is nothing to signal
+	_, err = exitStatus(err)
+	return err
+}
@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+//go:generate counterfeiter . Executor
+
+// Executor runs commands in the containers of a pod, i.e. `kubectl exec`.
+//
+// A command which exits non-zero results in an error satisfying
+// k8s.io/client-go/util/exec.ExitError.
+type Executor interface {
+	Exec(
+		namespace string,
+		pod string,
+		container string,
+		command []string,
+		stdin io.Reader,
+		stdout io.Writer,
+		stderr io.Writer,
+		tty bool,
+	) error
+}
+
+type spdyExecutor struct {
+	clientset kubernetes.Interface
+	config    *rest.Config
+}
+
+func NewExecutor(clientset kubernetes.Interface, config *rest.Config) Executor {
+	return &spdyExecutor{
+		clientset: clientset,
+		config:    config,
+	}
+}
+
+func (executor *spdyExecutor) Exec(
+	namespace string,
+	pod string,
+	container string,
+	command []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+) error {
+	// stderr is multiplexed onto stdout when a tty is allocated
+	if tty {
+		stderr = nil
+	}
+
+	req := executor.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(executor.config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return exec.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+		Tty:    tty,
+	})
+}
+
+// exitStatus splits the error returned by an Executor into the exit status
+// of the command and an error running it.
+func exitStatus(err error) (int, error) {
+	if err == nil {
+		return 0, nil
+	}
+
+	if exitErr, ok := err.(utilexec.ExitError); ok && exitErr.Exited() {
+		return exitErr.ExitStatus(), nil
+	}
+
+	return -1, err
+}
@@ -0,0 +1,144 @@
+package k8s
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/gclient"
+)
+
+var ErrWorkerNotFound = errors.New("kubernetes worker is not registered")
+
+// workerProvider wraps another WorkerProvider, substituting the Kubernetes
+// worker wherever it would otherwise construct a Garden worker for it.
+type workerProvider struct {
+	worker.WorkerProvider
+
+	gardenClient     gclient.Client
+	executor         Executor
+	namespace        string
+	fetcher          worker.Fetcher
+	volumeRepository db.VolumeRepository
+	dbTeamFactory    db.TeamFactory
+	dbWorkerFactory  db.WorkerFactory
+	workerName       string
+}
+
+func NewWorkerProvider(
+	provider worker.WorkerProvider,
+	gardenClient gclient.Client,
+	executor Executor,
+	namespace string,
+	fetcher worker.Fetcher,
+	volumeRepository db.VolumeRepository,
+	dbTeamFactory db.TeamFactory,
+	dbWorkerFactory db.WorkerFactory,
+	workerName string,
+) worker.WorkerProvider {
+	return &workerProvider{
+		WorkerProvider:   provider,
+		gardenClient:     gardenClient,
+		executor:         executor,
+		namespace:        namespace,
+		fetcher:          fetcher,
+		volumeRepository: volumeRepository,
+		dbTeamFactory:    dbTeamFactory,
+		dbWorkerFactory:  dbWorkerFactory,
+		workerName:       workerName,
+	}
+}
+
+func (provider *workerProvider) RunningWorkers(logger lager.Logger) ([]worker.Worker, error) {
+	workers, err := provider.WorkerProvider.RunningWorkers(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.substitute(workers)
+}
+
+func (provider *workerProvider) FindWorkerForContainer(logger lager.Logger, teamID int, handle string) (worker.Worker, bool, error) {
+	found, ok, err := provider.WorkerProvider.FindWorkerForContainer(logger, teamID, handle)
+	if err != nil || !ok {
+		return found, ok, err
+	}
+
+	substituted, err := provider.substitute([]worker.Worker{found})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return substituted[0], true, nil
+}
+
+func (provider *workerProvider) FindWorkerForVolume(logger lager.Logger, teamID int, handle string) (worker.Worker, bool, error) {
+	found, ok, err := provider.WorkerProvider.FindWorkerForVolume(logger, teamID, handle)
+	if err != nil || !ok {
+		return found, ok, err
+	}
+
+	substituted, err := provider.substitute([]worker.Worker{found})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return substituted[0], true, nil
+}
+
+func (provider *workerProvider) FindWorkersForContainerByOwner(logger lager.Logger, owner db.ContainerOwner) ([]worker.Worker, error) {
+	workers, err := provider.WorkerProvider.FindWorkersForContainerByOwner(logger, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.substitute(workers)
+}
+
+func (provider *workerProvider) NewGardenWorker(logger lager.Logger, savedWorker db.Worker, numBuildWorkers int) worker.Worker {
+	if savedWorker.Name() == provider.workerName {
+		return provider.newWorker(savedWorker, numBuildWorkers)
+	}
+
+	return provider.WorkerProvider.NewGardenWorker(logger, savedWorker, numBuildWorkers)
+}
+
+// substitute replaces the Garden worker constructed for the Kubernetes worker,
+// if any, with the real thing.
+func (provider *workerProvider) substitute(workers []worker.Worker) ([]worker.Worker, error) {
+	substituted := make([]worker.Worker, len(workers))
+
+	for i, w := range workers {
+		if w.Name() != provider.workerName {
+			substituted[i] = w
+			continue
+		}
+
+		savedWorker, found, err := provider.dbWorkerFactory.GetWorker(provider.workerName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, ErrWorkerNotFound
+		}
+
+		substituted[i] = provider.newWorker(savedWorker, w.BuildContainers())
+	}
+
+	return substituted, nil
+}
+
+func (provider *workerProvider) newWorker(savedWorker db.Worker, numBuildContainers int) worker.Worker {
+	return NewWorker(
+		provider.gardenClient,
+		provider.executor,
+		provider.namespace,
+		provider.fetcher,
+		provider.volumeRepository,
+		provider.dbTeamFactory,
+		savedWorker,
+		numBuildContainers,
+	)
+}
@@ -0,0 +1,114 @@
+package k8s_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/gclient/gclientfakes"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	"github.com/concourse/concourse/atc/worker/k8s/k8sfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerProvider", func() {
+	var (
+		logger *lagertest.TestLogger
+
+		fakeProvider      *workerfakes.FakeWorkerProvider
+		fakeGardenClient  *gclientfakes.FakeClient
+		fakeWorkerFactory *dbfakes.FakeWorkerFactory
+
+		gardenWorker *workerfakes.FakeWorker
+		k8sWorker    *workerfakes.FakeWorker
+
+		provider worker.WorkerProvider
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeGardenClient = new(gclientfakes.FakeClient)
+		fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+
+		gardenWorker = new(workerfakes.FakeWorker)
+		gardenWorker.NameReturns("some-garden-worker")
+
+		k8sWorker = new(workerfakes.FakeWorker)
+		k8sWorker.NameReturns("kubernetes")
+		k8sWorker.BuildContainersReturns(3)
+
+		provider = k8s.NewWorkerProvider(
+			fakeProvider,
+			fakeGardenClient,
+			new(k8sfakes.FakeExecutor),
+			namespace,
+			new(workerfakes.FakeFetcher),
+			new(dbfakes.FakeVolumeRepository),
+			new(dbfakes.FakeTeamFactory),
+			fakeWorkerFactory,
+			"kubernetes",
+		)
+	})
+
+	Describe("RunningWorkers", func() {
+		BeforeEach(func() {
+			fakeProvider.RunningWorkersReturns([]worker.Worker{gardenWorker, k8sWorker}, nil)
+		})
+
+		Context("when the kubernetes worker is registered", func() {
+			BeforeEach(func() {
+				savedWorker := new(dbfakes.FakeWorker)
+				savedWorker.NameReturns("kubernetes")
+				fakeWorkerFactory.GetWorkerReturns(savedWorker, true, nil)
+			})
+
+			It("substitutes the kubernetes worker", func() {
+				workers, err := provider.RunningWorkers(logger)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(workers).To(HaveLen(2))
+
+				Expect(workers[0]).To(Equal(gardenWorker))
+
+				Expect(workers[1]).ToNot(Equal(k8sWorker))
+				Expect(workers[1].Name()).To(Equal("kubernetes"))
+				Expect(workers[1].BuildContainers()).To(Equal(3))
+				Expect(workers[1].GardenClient()).To(Equal(fakeGardenClient))
+
+				Expect(fakeWorkerFactory.GetWorkerArgsForCall(0)).To(Equal("kubernetes"))
+			})
+		})
+
+		Context("when the kubernetes worker has gone away", func() {
+			BeforeEach(func() {
+				fakeWorkerFactory.GetWorkerReturns(nil, false, nil)
+			})
+
+			It("returns an error", func() {
+				_, err := provider.RunningWorkers(logger)
+				Expect(err).To(Equal(k8s.ErrWorkerNotFound))
+			})
+		})
+	})
+
+	Describe("NewGardenWorker", func() {
+		It("constructs a kubernetes worker for the kubernetes worker", func() {
+			savedWorker := new(dbfakes.FakeWorker)
+			savedWorker.NameReturns("kubernetes")
+
+			w := provider.NewGardenWorker(logger, savedWorker, 2)
+			Expect(w.GardenClient()).To(Equal(fakeGardenClient))
+			Expect(fakeProvider.NewGardenWorkerCallCount()).To(BeZero())
+		})
+
+		It("delegates for any other worker", func() {
+			savedWorker := new(dbfakes.FakeWorker)
+			savedWorker.NameReturns("some-garden-worker")
+			fakeProvider.NewGardenWorkerReturns(gardenWorker)
+
+			Expect(provider.NewGardenWorker(logger, savedWorker, 2)).To(Equal(gardenWorker))
+		})
+	})
+})
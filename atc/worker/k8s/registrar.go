@@ -0,0 +1,52 @@
+package k8s
+
+import (
+	"os"
+	"time"
+
+	c "code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/tedsuo/ifrit"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	heartbeatInterval = 10 * time.Second
+	heartbeatTTL      = 30 * time.Second
+)
+
+// NewRegistrar keeps the Kubernetes worker registered for as long as a web
+// node is running to drive it, similar to a static worker.
+func NewRegistrar(
+	logger lager.Logger,
+	workerFactory db.WorkerFactory,
+	clock c.Clock,
+	workerInfo atc.Worker,
+) ifrit.RunFunc {
+	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		_, err := workerFactory.SaveWorker(workerInfo, heartbeatTTL)
+		if err != nil {
+			logger.Error("failed-to-register-kubernetes-worker", err)
+			return err
+		}
+
+		ticker := clock.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+
+		close(ready)
+
+		for {
+			select {
+			case <-ticker.C():
+				_, err = workerFactory.SaveWorker(workerInfo, heartbeatTTL)
+				if err != nil {
+					logger.Error("failed-to-heartbeat-kubernetes-worker", err)
+				}
+			case <-signals:
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package k8s
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker/gclient"
+)
+
+// sweeper deletes the pods of containers which have been marked as
+// destroying, doing for the Kubernetes worker what the TSA's sweeps do for
+// Garden workers. Volumes go away with their pods, so destroying volumes
+// are simply forgotten.
+type sweeper struct {
+	workerName          string
+	gardenClient        gclient.Client
+	containerRepository db.ContainerRepository
+	volumeRepository    db.VolumeRepository
+}
+
+func NewSweeper(
+	workerName string,
+	gardenClient gclient.Client,
+	containerRepository db.ContainerRepository,
+	volumeRepository db.VolumeRepository,
+) *sweeper {
+	return &sweeper{
+		workerName:          workerName,
+		gardenClient:        gardenClient,
+		containerRepository: containerRepository,
+		volumeRepository:    volumeRepository,
+	}
+}
+
+func (s *sweeper) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("kubernetes-sweeper")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	handles, err := s.containerRepository.FindDestroyingContainers(s.workerName)
+	if err != nil {
+		logger.Error("failed-to-find-destroying-containers", err)
+		return err
+	}
+
+	remaining := []string{}
+	for _, handle := range handles {
+		err := s.gardenClient.Destroy(handle)
+		if err != nil {
+			if _, ok := err.(garden.ContainerNotFoundError); ok {
+				continue
+			}
+
+			logger.Error("failed-to-delete-pod", err, lager.Data{"handle": handle})
+			remaining = append(remaining, handle)
+		}
+	}
+
+	_, err = s.containerRepository.RemoveDestroyingContainers(s.workerName, remaining)
+	if err != nil {
+		logger.Error("failed-to-remove-destroying-containers", err)
+		return err
+	}
+
+	_, err = s.volumeRepository.RemoveDestroyingVolumes(s.workerName, []string{})
+	if err != nil {
+		logger.Error("failed-to-remove-destroying-volumes", err)
+		return err
+	}
+
+	return nil
+}
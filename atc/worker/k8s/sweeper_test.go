@@ -0,0 +1,78 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/worker/gclient/gclientfakes"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sweeper", func() {
+	var (
+		fakeGardenClient        *gclientfakes.FakeClient
+		fakeContainerRepository *dbfakes.FakeContainerRepository
+		fakeVolumeRepository    *dbfakes.FakeVolumeRepository
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeGardenClient = new(gclientfakes.FakeClient)
+		fakeContainerRepository = new(dbfakes.FakeContainerRepository)
+		fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
+
+		fakeContainerRepository.FindDestroyingContainersReturns([]string{"gone", "deleted", "stuck"}, nil)
+
+		fakeGardenClient.DestroyStub = func(handle string) error {
+			switch handle {
+			case "gone":
+				return garden.ContainerNotFoundError{Handle: handle}
+			case "stuck":
+				return errors.New("nope")
+			default:
+				return nil
+			}
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.TODO(), lagertest.NewTestLogger("test"))
+
+		runErr = k8s.NewSweeper(
+			"kubernetes",
+			fakeGardenClient,
+			fakeContainerRepository,
+			fakeVolumeRepository,
+		).Run(ctx)
+	})
+
+	It("deletes the pods of destroying containers", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+
+		Expect(fakeContainerRepository.FindDestroyingContainersArgsForCall(0)).To(Equal("kubernetes"))
+		Expect(fakeGardenClient.DestroyCallCount()).To(Equal(3))
+	})
+
+	It("removes the containers whose pods are gone", func() {
+		Expect(fakeContainerRepository.RemoveDestroyingContainersCallCount()).To(Equal(1))
+
+		workerName, remaining := fakeContainerRepository.RemoveDestroyingContainersArgsForCall(0)
+		Expect(workerName).To(Equal("kubernetes"))
+		Expect(remaining).To(Equal([]string{"stuck"}))
+	})
+
+	It("removes all destroying volumes", func() {
+		Expect(fakeVolumeRepository.RemoveDestroyingVolumesCallCount()).To(Equal(1))
+
+		workerName, remaining := fakeVolumeRepository.RemoveDestroyingVolumesArgsForCall(0)
+		Expect(workerName).To(Equal("kubernetes"))
+		Expect(remaining).To(BeEmpty())
+	})
+})
@@ -0,0 +1,109 @@
+package k8s
+
+import (
+	"context"
+	"io"
+	"path"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/DataDog/zstd"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// volume implements worker.Volume for an emptyDir mounted into a pod. Its
+// contents are streamed through the pod's sidecar container, and live only
+// as long as the pod does.
+type volume struct {
+	dbVolume  db.CreatedVolume
+	executor  Executor
+	namespace string
+}
+
+func (v *volume) Handle() string { return v.dbVolume.Handle() }
+
+func (v *volume) Path() string { return v.dbVolume.Path() }
+
+func (v *volume) SetProperty(key string, value string) error {
+	return nil
+}
+
+func (v *volume) Properties() (baggageclaim.VolumeProperties, error) {
+	return baggageclaim.VolumeProperties{}, nil
+}
+
+func (v *volume) SetPrivileged(privileged bool) error {
+	return nil
+}
+
+// StreamIn extracts a zstd-compressed tar, as produced by StreamOut of any
+// worker's volume, into the volume.
+func (v *volume) StreamIn(ctx context.Context, destination string, tarStream io.Reader) error {
+	zstdReader := zstd.NewReader(tarStream)
+	defer zstdReader.Close()
+
+	return v.exec(extractCommand(path.Join(v.Path(), destination)), zstdReader, nil)
+}
+
+func (v *volume) StreamOut(ctx context.Context, source string) (io.ReadCloser, error) {
+	reader, writer := io.Pipe()
+
+	go func() {
+		zstdWriter := zstd.NewWriter(writer)
+
+		err := v.exec(archiveCommand(path.Join(v.Path(), source)), nil, zstdWriter)
+		if err == nil {
+			err = zstdWriter.Close()
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
+
+func (v *volume) COWStrategy() baggageclaim.COWStrategy {
+	return baggageclaim.COWStrategy{}
+}
+
+// InitializeResourceCache is a no-op: the volume goes away with its pod, so
+// it can't be reused as a cache.
+func (v *volume) InitializeResourceCache(db.UsedResourceCache) error {
+	return nil
+}
+
+// InitializeTaskCache is a no-op for the same reason as
+// InitializeResourceCache.
+func (v *volume) InitializeTaskCache(logger lager.Logger, jobID int, stepName string, path string, privileged bool) error {
+	return nil
+}
+
+func (v *volume) InitializeArtifact(name string, buildID int) (db.WorkerArtifact, error) {
+	return v.dbVolume.InitializeArtifact(name, buildID)
+}
+
+func (v *volume) CreateChildForContainer(creatingContainer db.CreatingContainer, mountPath string) (db.CreatingVolume, error) {
+	return v.dbVolume.CreateChildForContainer(creatingContainer, mountPath)
+}
+
+func (v *volume) WorkerName() string {
+	return v.dbVolume.WorkerName()
+}
+
+// Destroy is a no-op, as the volume is removed along with its pod.
+func (v *volume) Destroy() error {
+	return nil
+}
+
+func (v *volume) exec(command []string, stdin io.Reader, stdout io.Writer) error {
+	return v.executor.Exec(
+		v.namespace,
+		v.dbVolume.ContainerHandle(),
+		sidecarContainerName,
+		command,
+		stdin,
+		stdout,
+		nil,
+		false,
+	)
+}
@@ -0,0 +1,534 @@
+// Package k8s implements worker.Worker on top of Kubernetes, running each
+// container as a pod in a namespace rather than on a Garden worker.
+//
+// The cluster is registered as a single worker which the ATC talks to
+// directly through the Kubernetes API, so it participates in placement
+// alongside workers registered through the TSA.
+//
+// The runtime is experimental, and lacks some of what a Garden worker offers:
+// processes can't be re-attached to, so builds don't survive the web node
+// restarting; volumes only exist as part of a pod, so nothing can be cached
+// or streamed to a volume on its own; and containers can't be given network
+// rules.
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/gclient"
+	"github.com/cppforlife/go-semi-semantic/version"
+	"golang.org/x/sync/errgroup"
+)
+
+const scratchPath = "/scratch"
+
+type k8sWorker struct {
+	gardenClient gclient.Client
+	executor     Executor
+	namespace    string
+
+	worker.Fetcher
+	volumeRepo      db.VolumeRepository
+	dbTeamFactory   db.TeamFactory
+	dbWorker        db.Worker
+	buildContainers int
+}
+
+// NewWorker constructs a Worker which runs containers as pods through the
+// given client, which is expected to come from NewClient.
+func NewWorker(
+	gardenClient gclient.Client,
+	executor Executor,
+	namespace string,
+	fetcher worker.Fetcher,
+	volumeRepository db.VolumeRepository,
+	dbTeamFactory db.TeamFactory,
+	dbWorker db.Worker,
+	numBuildContainers int,
+) worker.Worker {
+	return &k8sWorker{
+		gardenClient:    gardenClient,
+		executor:        executor,
+		namespace:       namespace,
+		Fetcher:         fetcher,
+		volumeRepo:      volumeRepository,
+		dbTeamFactory:   dbTeamFactory,
+		dbWorker:        dbWorker,
+		buildContainers: numBuildContainers,
+	}
+}
+
+func (w *k8sWorker) GardenClient() gclient.Client {
+	return w.gardenClient
+}
+
+func (w *k8sWorker) Name() string {
+	return w.dbWorker.Name()
+}
+
+func (w *k8sWorker) ResourceTypes() []atc.WorkerResourceType {
+	return w.dbWorker.ResourceTypes()
+}
+
+func (w *k8sWorker) Tags() atc.Tags {
+	return w.dbWorker.Tags()
+}
+
+func (w *k8sWorker) Ephemeral() bool {
+	return w.dbWorker.Ephemeral()
+}
+
+func (w *k8sWorker) BuildContainers() int {
+	return w.buildContainers
+}
+
+func (w *k8sWorker) IsOwnedByTeam() bool {
+	return w.dbWorker.TeamID() != 0
+}
+
+func (w *k8sWorker) Uptime() time.Duration {
+	return time.Since(w.dbWorker.StartTime())
+}
+
+// IsVersionCompatible is always true, as the worker is driven by the ATC
+// itself rather than by a separately deployed worker process.
+func (w *k8sWorker) IsVersionCompatible(lager.Logger, version.Version) bool {
+	return true
+}
+
+func (w *k8sWorker) Description() string {
+	messages := []string{
+		fmt.Sprintf("kubernetes namespace '%s'", w.namespace),
+	}
+
+	for _, tag := range w.dbWorker.Tags() {
+		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
+	}
+
+	return strings.Join(messages, ", ")
+}
+
+func (w *k8sWorker) Satisfies(logger lager.Logger, spec worker.WorkerSpec) bool {
+	workerTeamID := w.dbWorker.TeamID()
+	if spec.TeamID != workerTeamID && workerTeamID != 0 {
+		return false
+	}
+
+	if spec.ResourceType != "" {
+		_, found := w.resourceTypeImage(spec.ResourceType, spec.ResourceTypes)
+		if !found {
+			return false
+		}
+	}
+
+	if spec.Platform != "" && spec.Platform != w.dbWorker.Platform() {
+		return false
+	}
+
+	return w.tagsMatch(spec.Tags)
+}
+
+func (w *k8sWorker) tagsMatch(tags []string) bool {
+	workerTags := w.dbWorker.Tags()
+	if len(workerTags) > 0 && len(tags) == 0 {
+		return false
+	}
+
+nextTag:
+	for _, tag := range tags {
+		for _, workerTag := range workerTags {
+			if tag == workerTag {
+				continue nextTag
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func (w *k8sWorker) ActiveTasks() (int, error) {
+	return w.dbWorker.ActiveTasks()
+}
+
+func (w *k8sWorker) IncreaseActiveTasks() error {
+	return w.dbWorker.IncreaseActiveTasks()
+}
+
+func (w *k8sWorker) DecreaseActiveTasks() error {
+	return w.dbWorker.DecreaseActiveTasks()
+}
+
+// FindVolumeForResourceCache never finds anything, as volumes don't outlive
+// their pods.
+func (w *k8sWorker) FindVolumeForResourceCache(lager.Logger, db.UsedResourceCache) (worker.Volume, bool, error) {
+	return nil, false, nil
+}
+
+// FindVolumeForTaskCache never finds anything, as volumes don't outlive
+// their pods.
+func (w *k8sWorker) FindVolumeForTaskCache(lager.Logger, int, int, string, string) (worker.Volume, bool, error) {
+	return nil, false, nil
+}
+
+// CertsVolume never finds anything; pods use the certificates of their
+// images.
+func (w *k8sWorker) CertsVolume(lager.Logger) (worker.Volume, bool, error) {
+	return nil, false, nil
+}
+
+// CreateVolume is not supported, as every volume is an emptyDir which must
+// belong to a pod.
+func (w *k8sWorker) CreateVolume(lager.Logger, worker.VolumeSpec, int, db.VolumeType) (worker.Volume, error) {
+	return nil, ErrNotSupported
+}
+
+func (w *k8sWorker) LookupVolume(logger lager.Logger, handle string) (worker.Volume, bool, error) {
+	dbVolume, found, err := w.volumeRepo.FindCreatedVolume(handle)
+	if err != nil {
+		logger.Error("failed-to-find-volume-in-db", err)
+		return nil, false, err
+	}
+
+	if !found || dbVolume.WorkerName() != w.Name() {
+		return nil, false, nil
+	}
+
+	return w.newVolume(dbVolume), true, nil
+}
+
+func (w *k8sWorker) FindContainerByHandle(logger lager.Logger, teamID int, handle string) (worker.Container, bool, error) {
+	gardenContainer, err := w.gardenClient.Lookup(handle)
+	if err != nil {
+		if _, ok := err.(garden.ContainerNotFoundError); ok {
+			logger.Info("container-not-found")
+			return nil, false, nil
+		}
+
+		logger.Error("failed-to-lookup-pod", err)
+		return nil, false, err
+	}
+
+	createdContainer, found, err := w.dbTeamFactory.GetByID(teamID).FindCreatedContainerByHandle(handle)
+	if err != nil {
+		logger.Error("failed-to-lookup-in-db", err)
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	container, err := w.constructContainer(logger, createdContainer, gardenContainer)
+	if err != nil {
+		logger.Error("failed-to-construct-container", err)
+		return nil, false, err
+	}
+
+	return container, true, nil
+}
+
+func (w *k8sWorker) FindOrCreateContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate worker.ImageFetchingDelegate,
+	owner db.ContainerOwner,
+	metadata db.ContainerMetadata,
+	containerSpec worker.ContainerSpec,
+	resourceTypes atc.VersionedResourceTypes,
+) (worker.Container, error) {
+	creatingContainer, createdContainer, err := w.dbWorker.FindContainer(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var containerHandle string
+	if creatingContainer != nil {
+		containerHandle = creatingContainer.Handle()
+	} else if createdContainer != nil {
+		containerHandle = createdContainer.Handle()
+	} else {
+		creatingContainer, err = w.dbWorker.CreateContainer(owner, metadata)
+		if err != nil {
+			logger.Error("failed-to-create-container-in-db", err)
+			if _, ok := err.(db.ContainerOwnerDisappearedError); ok {
+				return nil, worker.ResourceConfigCheckSessionExpiredError
+			}
+
+			return nil, err
+		}
+
+		containerHandle = creatingContainer.Handle()
+	}
+
+	logger = logger.WithData(lager.Data{"container": containerHandle})
+
+	gardenContainer, err := w.gardenClient.Lookup(containerHandle)
+	if err != nil {
+		if _, ok := err.(garden.ContainerNotFoundError); !ok {
+			logger.Error("failed-to-lookup-pod", err)
+			return nil, err
+		}
+	}
+
+	if createdContainer != nil {
+		if gardenContainer == nil {
+			return nil, garden.ContainerNotFoundError{Handle: containerHandle}
+		}
+
+		return w.constructContainer(logger, createdContainer, gardenContainer)
+	}
+
+	if gardenContainer == nil {
+		gardenContainer, err = w.createPod(ctx, logger, creatingContainer, containerSpec, resourceTypes)
+		if err != nil {
+			_, failedErr := creatingContainer.Failed()
+			if failedErr != nil {
+				logger.Error("failed-to-mark-container-as-failed", failedErr)
+			}
+
+			metric.FailedContainers.Inc()
+
+			logger.Error("failed-to-create-pod", err)
+			return nil, err
+		}
+	}
+
+	metric.ContainersCreated.Inc()
+
+	createdContainer, err = creatingContainer.Created()
+	if err != nil {
+		logger.Error("failed-to-mark-container-as-created", err)
+
+		_ = w.gardenClient.Destroy(containerHandle)
+
+		return nil, err
+	}
+
+	return w.constructContainer(logger, createdContainer, gardenContainer)
+}
+
+// createPod creates a volume for each of the paths the container needs, then
+// the pod mounting them, and finally streams the inputs into it.
+func (w *k8sWorker) createPod(
+	ctx context.Context,
+	logger lager.Logger,
+	creatingContainer db.CreatingContainer,
+	containerSpec worker.ContainerSpec,
+	resourceTypes atc.VersionedResourceTypes,
+) (gclient.Container, error) {
+	imageURI, err := w.imageURI(containerSpec.ImageSpec, resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := map[string]worker.Volume{}
+	bindMounts := []garden.BindMount{}
+
+	for _, mountPath := range mountPaths(containerSpec) {
+		creatingVolume, err := w.volumeRepo.CreateContainerVolume(containerSpec.TeamID, w.Name(), creatingContainer, mountPath)
+		if err != nil {
+			return nil, err
+		}
+
+		createdVolume, err := creatingVolume.Created()
+		if err != nil {
+			return nil, err
+		}
+
+		volumes[mountPath] = w.newVolume(createdVolume)
+		bindMounts = append(bindMounts, garden.BindMount{
+			DstPath: mountPath,
+			Mode:    garden.BindMountModeRW,
+		})
+	}
+
+	gardenContainer, err := w.gardenClient.Create(garden.ContainerSpec{
+		Handle:     creatingContainer.Handle(),
+		Image:      garden.ImageRef{URI: imageURI},
+		Privileged: containerSpec.ImageSpec.Privileged,
+		BindMounts: bindMounts,
+		Limits:     containerSpec.Limits.ToGardenLimits(),
+		Env:        containerSpec.Env,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g, groupCtx := errgroup.WithContext(ctx)
+
+	for _, input := range containerSpec.Inputs {
+		streamable, ok := input.Source().(worker.StreamableArtifactSource)
+		if !ok {
+			continue
+		}
+
+		destination := volumes[filepath.Clean(input.DestinationPath())]
+
+		g.Go(func() error {
+			return streamable.StreamTo(
+				groupCtx,
+				logger.Session("stream-to", lager.Data{"dest-volume": destination.Handle()}),
+				destination,
+			)
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		_ = w.gardenClient.Destroy(creatingContainer.Handle())
+		return nil, err
+	}
+
+	return gardenContainer, nil
+}
+
+func (w *k8sWorker) constructContainer(
+	logger lager.Logger,
+	createdContainer db.CreatedContainer,
+	gardenContainer gclient.Container,
+) (worker.Container, error) {
+	createdVolumes, err := w.volumeRepo.FindVolumesForContainer(createdContainer)
+	if err != nil {
+		logger.Error("failed-to-find-container-volumes", err)
+		return nil, err
+	}
+
+	var volumeMounts []worker.VolumeMount
+	for _, createdVolume := range createdVolumes {
+		volumeMounts = append(volumeMounts, worker.VolumeMount{
+			Volume:    w.newVolume(createdVolume),
+			MountPath: createdVolume.Path(),
+		})
+	}
+
+	sort.Slice(volumeMounts, func(i, j int) bool {
+		return volumeMounts[i].MountPath < volumeMounts[j].MountPath
+	})
+
+	return &workerContainer{
+		Container:    gardenContainer,
+		dbContainer:  createdContainer,
+		gardenClient: w.gardenClient,
+		volumeMounts: volumeMounts,
+		workerName:   w.Name(),
+	}, nil
+}
+
+func (w *k8sWorker) newVolume(dbVolume db.CreatedVolume) worker.Volume {
+	return &volume{
+		dbVolume:  dbVolume,
+		executor:  w.executor,
+		namespace: w.namespace,
+	}
+}
+
+// imageURI determines the docker image to run a container with. Images
+// fetched through resources other than registry-image or docker-image, and
+// images produced by earlier steps, are not supported.
+func (w *k8sWorker) imageURI(spec worker.ImageSpec, resourceTypes atc.VersionedResourceTypes) (string, error) {
+	switch {
+	case spec.ImageArtifactSource != nil:
+		return "", ErrImageNotSupported
+
+	case spec.ImageURL != "":
+		if !strings.HasPrefix(spec.ImageURL, "docker:///") {
+			return "", ErrImageNotSupported
+		}
+
+		return spec.ImageURL, nil
+
+	case spec.ImageResource != nil:
+		return registryImageURI(spec.ImageResource.Type, spec.ImageResource.Source)
+
+	case spec.ResourceType != "":
+		image, found := w.resourceTypeImage(spec.ResourceType, resourceTypes)
+		if !found {
+			return "", ErrImageNotSupported
+		}
+
+		return image, nil
+	}
+
+	return "", ErrImageNotSupported
+}
+
+// resourceTypeImage determines the image of a resource type, which is either
+// a base resource type of the worker or a custom type using registry-image
+// or docker-image.
+func (w *k8sWorker) resourceTypeImage(name string, resourceTypes atc.VersionedResourceTypes) (string, bool) {
+	resourceType, found := resourceTypes.Lookup(name)
+	if found {
+		image, err := registryImageURI(resourceType.Type, resourceType.Source)
+		if err != nil {
+			return "", false
+		}
+
+		return image, true
+	}
+
+	for _, workerResourceType := range w.dbWorker.ResourceTypes() {
+		if workerResourceType.Type == name {
+			return "docker:///" + workerResourceType.Image, true
+		}
+	}
+
+	return "", false
+}
+
+func registryImageURI(resourceType string, source atc.Source) (string, error) {
+	if resourceType != "registry-image" && resourceType != "docker-image" {
+		return "", ErrImageNotSupported
+	}
+
+	repository, ok := source["repository"].(string)
+	if !ok || repository == "" {
+		return "", ErrImageNotSupported
+	}
+
+	tag := "latest"
+	if source["tag"] != nil {
+		tag = fmt.Sprint(source["tag"])
+	}
+
+	return "docker:///" + repository + "#" + tag, nil
+}
+
+// mountPaths returns the paths a container needs volumes for: scratch space,
+// its working directory, and its inputs and outputs.
+func mountPaths(spec worker.ContainerSpec) []string {
+	paths := map[string]bool{scratchPath: true}
+
+	if spec.Dir != "" {
+		paths[filepath.Clean(spec.Dir)] = true
+	}
+
+	for _, input := range spec.Inputs {
+		paths[filepath.Clean(input.DestinationPath())] = true
+	}
+
+	for _, output := range spec.Outputs {
+		paths[filepath.Clean(output)] = true
+	}
+
+	var sorted []string
+	for path := range paths {
+		sorted = append(sorted, path)
+	}
+
+	sort.Strings(sorted)
+
+	return sorted
+}
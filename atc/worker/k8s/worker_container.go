@@ -0,0 +1,136 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/gclient"
+)
+
+type workerContainer struct {
+	gclient.Container
+	dbContainer db.CreatedContainer
+
+	gardenClient gclient.Client
+
+	volumeMounts []worker.VolumeMount
+	workerName   string
+}
+
+func (container *workerContainer) Destroy() error {
+	return container.gardenClient.Destroy(container.Handle())
+}
+
+func (container *workerContainer) WorkerName() string {
+	return container.workerName
+}
+
+func (container *workerContainer) MarkAsHijacked() error {
+	return container.dbContainer.MarkAsHijacked()
+}
+
+func (container *workerContainer) VolumeMounts() []worker.VolumeMount {
+	return container.volumeMounts
+}
+
+// RunScript runs a resource script, parsing its stdout as JSON into output.
+//
+// As exec'd processes can't be re-attached to, a recoverable script which
+// was interrupted before its result was recorded is run again from scratch.
+func (container *workerContainer) RunScript(
+	ctx context.Context,
+	path string,
+	args []string,
+	input []byte,
+	output interface{},
+	logDest io.Writer,
+	recoverable bool,
+) error {
+	if recoverable {
+		result, _ := container.Properties()
+		code := result[runtime.ResourceResultPropertyName]
+		if code != "" {
+			return json.Unmarshal([]byte(code), &output)
+		}
+	}
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	processIO := garden.ProcessIO{
+		Stdin:  bytes.NewBuffer(input),
+		Stdout: stdout,
+		Stderr: stderr,
+	}
+
+	if logDest != nil {
+		processIO.Stderr = logDest
+	}
+
+	processSpec := garden.ProcessSpec{
+		Path: path,
+		Args: args,
+	}
+
+	if recoverable {
+		processSpec.ID = runtime.ResourceProcessID
+	}
+
+	process, err := container.Run(ctx, processSpec, processIO)
+	if err != nil {
+		return err
+	}
+
+	processExited := make(chan struct{})
+
+	var processStatus int
+	var processErr error
+
+	go func() {
+		processStatus, processErr = process.Wait()
+		close(processExited)
+	}()
+
+	select {
+	case <-processExited:
+		if processErr != nil {
+			return processErr
+		}
+
+		if processStatus != 0 {
+			return runtime.ErrResourceScriptFailed{
+				Path:       path,
+				Args:       args,
+				ExitStatus: processStatus,
+
+				Stderr: stderr.String(),
+			}
+		}
+
+		if recoverable {
+			err := container.SetProperty(runtime.ResourceResultPropertyName, stdout.String())
+			if err != nil {
+				return err
+			}
+		}
+
+		err := json.Unmarshal(stdout.Bytes(), output)
+		if err != nil {
+			return fmt.Errorf("%s\n\nwhen parsing resource response:\n\n%s", err, stdout.String())
+		}
+
+		return nil
+
+	case <-ctx.Done():
+		_ = container.Stop(false)
+		<-processExited
+		return ctx.Err()
+	}
+}
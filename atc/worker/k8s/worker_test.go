@@ -0,0 +1,375 @@
+package k8s_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/DataDog/zstd"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/gclient/gclientfakes"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	"github.com/concourse/concourse/atc/worker/k8s/k8sfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker", func() {
+	var (
+		logger *lagertest.TestLogger
+
+		fakeGardenClient     *gclientfakes.FakeClient
+		fakeExecutor         *k8sfakes.FakeExecutor
+		fakeFetcher          *workerfakes.FakeFetcher
+		fakeVolumeRepository *dbfakes.FakeVolumeRepository
+		fakeTeamFactory      *dbfakes.FakeTeamFactory
+		fakeDBWorker         *dbfakes.FakeWorker
+
+		k8sWorker worker.Worker
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeGardenClient = new(gclientfakes.FakeClient)
+		fakeExecutor = new(k8sfakes.FakeExecutor)
+		fakeFetcher = new(workerfakes.FakeFetcher)
+		fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+
+		fakeDBWorker = new(dbfakes.FakeWorker)
+		fakeDBWorker.NameReturns("kubernetes")
+		fakeDBWorker.PlatformReturns("linux")
+		fakeDBWorker.ResourceTypesReturns([]atc.WorkerResourceType{
+			{Type: "git", Image: "concourse/git-resource"},
+		})
+
+		k8sWorker = k8s.NewWorker(
+			fakeGardenClient,
+			fakeExecutor,
+			namespace,
+			fakeFetcher,
+			fakeVolumeRepository,
+			fakeTeamFactory,
+			fakeDBWorker,
+			5,
+		)
+	})
+
+	Describe("Satisfies", func() {
+		var spec worker.WorkerSpec
+
+		BeforeEach(func() {
+			spec = worker.WorkerSpec{
+				Platform: "linux",
+				TeamID:   1,
+			}
+		})
+
+		It("satisfies a spec for the worker's platform", func() {
+			Expect(k8sWorker.Satisfies(logger, spec)).To(BeTrue())
+		})
+
+		It("satisfies a spec for one of its base resource types", func() {
+			spec.ResourceType = "git"
+			Expect(k8sWorker.Satisfies(logger, spec)).To(BeTrue())
+		})
+
+		It("satisfies a spec for a custom resource type using a registry image", func() {
+			spec.ResourceType = "custom"
+			spec.ResourceTypes = atc.VersionedResourceTypes{
+				{
+					ResourceType: atc.ResourceType{
+						Name:   "custom",
+						Type:   "registry-image",
+						Source: atc.Source{"repository": "some/custom-resource"},
+					},
+				},
+			}
+
+			Expect(k8sWorker.Satisfies(logger, spec)).To(BeTrue())
+		})
+
+		It("does not satisfy a spec for an unknown resource type", func() {
+			spec.ResourceType = "bogus"
+			Expect(k8sWorker.Satisfies(logger, spec)).To(BeFalse())
+		})
+
+		It("does not satisfy a spec for another platform", func() {
+			spec.Platform = "windows"
+			Expect(k8sWorker.Satisfies(logger, spec)).To(BeFalse())
+		})
+
+		Context("when the worker has tags", func() {
+			BeforeEach(func() {
+				fakeDBWorker.TagsReturns([]string{"some-tag"})
+			})
+
+			It("only satisfies specs with matching tags", func() {
+				Expect(k8sWorker.Satisfies(logger, spec)).To(BeFalse())
+
+				spec.Tags = []string{"some-tag"}
+				Expect(k8sWorker.Satisfies(logger, spec)).To(BeTrue())
+
+				spec.Tags = []string{"some-tag", "other-tag"}
+				Expect(k8sWorker.Satisfies(logger, spec)).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("LookupVolume", func() {
+		var fakeVolume *dbfakes.FakeCreatedVolume
+
+		BeforeEach(func() {
+			fakeVolume = new(dbfakes.FakeCreatedVolume)
+			fakeVolume.HandleReturns("some-volume")
+			fakeVolumeRepository.FindCreatedVolumeReturns(fakeVolume, true, nil)
+		})
+
+		Context("when the volume is on the worker", func() {
+			BeforeEach(func() {
+				fakeVolume.WorkerNameReturns("kubernetes")
+			})
+
+			It("finds it", func() {
+				volume, found, err := k8sWorker.LookupVolume(logger, "some-volume")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(volume.Handle()).To(Equal("some-volume"))
+			})
+		})
+
+		Context("when the volume is on another worker", func() {
+			BeforeEach(func() {
+				fakeVolume.WorkerNameReturns("some-other-worker")
+			})
+
+			It("does not find it", func() {
+				_, found, err := k8sWorker.LookupVolume(logger, "some-volume")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("FindOrCreateContainer", func() {
+		var (
+			fakeCreatingContainer *dbfakes.FakeCreatingContainer
+			fakeCreatedContainer  *dbfakes.FakeCreatedContainer
+			fakeGardenContainer   *gclientfakes.FakeContainer
+
+			fakeInputSource *workerfakes.FakeInputSource
+			fakeArtifact    *workerfakes.FakeStreamableArtifactSource
+
+			volumes map[string]*dbfakes.FakeCreatedVolume
+
+			containerSpec worker.ContainerSpec
+
+			container worker.Container
+			findErr   error
+		)
+
+		BeforeEach(func() {
+			fakeCreatingContainer = new(dbfakes.FakeCreatingContainer)
+			fakeCreatingContainer.HandleReturns("some-handle")
+
+			fakeCreatedContainer = new(dbfakes.FakeCreatedContainer)
+			fakeCreatedContainer.HandleReturns("some-handle")
+			fakeCreatingContainer.CreatedReturns(fakeCreatedContainer, nil)
+
+			fakeDBWorker.CreateContainerReturns(fakeCreatingContainer, nil)
+
+			fakeGardenClient.LookupReturns(nil, garden.ContainerNotFoundError{Handle: "some-handle"})
+
+			fakeGardenContainer = new(gclientfakes.FakeContainer)
+			fakeGardenContainer.HandleReturns("some-handle")
+			fakeGardenClient.CreateReturns(fakeGardenContainer, nil)
+
+			volumes = map[string]*dbfakes.FakeCreatedVolume{}
+			fakeVolumeRepository.CreateContainerVolumeStub = func(_ int, _ string, _ db.CreatingContainer, mountPath string) (db.CreatingVolume, error) {
+				createdVolume := new(dbfakes.FakeCreatedVolume)
+				createdVolume.HandleReturns("volume-for-" + mountPath)
+				createdVolume.PathReturns(mountPath)
+				createdVolume.ContainerHandleReturns("some-handle")
+				volumes[mountPath] = createdVolume
+
+				creatingVolume := new(dbfakes.FakeCreatingVolume)
+				creatingVolume.CreatedReturns(createdVolume, nil)
+				return creatingVolume, nil
+			}
+
+			fakeArtifact = new(workerfakes.FakeStreamableArtifactSource)
+			fakeArtifact.StreamToStub = func(ctx context.Context, _ lager.Logger, dest worker.ArtifactDestination) error {
+				compressed, err := zstd.Compress(nil, []byte("some-tar"))
+				Expect(err).ToNot(HaveOccurred())
+				return dest.StreamIn(ctx, ".", bytes.NewBuffer(compressed))
+			}
+
+			fakeInputSource = new(workerfakes.FakeInputSource)
+			fakeInputSource.SourceReturns(fakeArtifact)
+			fakeInputSource.DestinationPathReturns("/tmp/build/some-input")
+
+			fakeExecutor.ExecStub = func(_, _, _ string, _ []string, stdin io.Reader, _, _ io.Writer, _ bool) error {
+				if stdin != nil {
+					_, err := ioutil.ReadAll(stdin)
+					return err
+				}
+
+				return nil
+			}
+
+			containerSpec = worker.ContainerSpec{
+				TeamID: 1,
+				ImageSpec: worker.ImageSpec{
+					ImageResource: &worker.ImageResource{
+						Type:   "registry-image",
+						Source: atc.Source{"repository": "some/image", "tag": "some-tag"},
+					},
+					Privileged: true,
+				},
+				Env:     []string{"SOME=env"},
+				Dir:     "/tmp/build/some-dir",
+				Inputs:  []worker.InputSource{fakeInputSource},
+				Outputs: worker.OutputPaths{"some-output": "/tmp/build/some-output/"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			container, findErr = k8sWorker.FindOrCreateContainer(
+				context.TODO(),
+				logger,
+				new(workerfakes.FakeImageFetchingDelegate),
+				new(dbfakes.FakeContainerOwner),
+				db.ContainerMetadata{},
+				containerSpec,
+				atc.VersionedResourceTypes{},
+			)
+		})
+
+		It("creates a volume for each path the container needs", func() {
+			Expect(findErr).ToNot(HaveOccurred())
+			Expect(fakeVolumeRepository.CreateContainerVolumeCallCount()).To(Equal(4))
+
+			var paths []string
+			for i := 0; i < fakeVolumeRepository.CreateContainerVolumeCallCount(); i++ {
+				teamID, workerName, creatingContainer, mountPath := fakeVolumeRepository.CreateContainerVolumeArgsForCall(i)
+				Expect(teamID).To(Equal(1))
+				Expect(workerName).To(Equal("kubernetes"))
+				Expect(creatingContainer).To(Equal(fakeCreatingContainer))
+				paths = append(paths, mountPath)
+			}
+
+			Expect(paths).To(Equal([]string{
+				"/scratch",
+				"/tmp/build/some-dir",
+				"/tmp/build/some-input",
+				"/tmp/build/some-output",
+			}))
+		})
+
+		It("creates a pod mounting the volumes", func() {
+			Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+
+			spec := fakeGardenClient.CreateArgsForCall(0)
+			Expect(spec.Handle).To(Equal("some-handle"))
+			Expect(spec.Image.URI).To(Equal("docker:///some/image#some-tag"))
+			Expect(spec.Privileged).To(BeTrue())
+			Expect(spec.Env).To(Equal([]string{"SOME=env"}))
+			Expect(spec.BindMounts).To(HaveLen(4))
+			Expect(spec.BindMounts[2]).To(Equal(garden.BindMount{
+				DstPath: "/tmp/build/some-input",
+				Mode:    garden.BindMountModeRW,
+			}))
+		})
+
+		It("streams the inputs into their volumes through the sidecar", func() {
+			Expect(fakeArtifact.StreamToCallCount()).To(Equal(1))
+			_, _, dest := fakeArtifact.StreamToArgsForCall(0)
+			Expect(dest.(worker.Volume).Handle()).To(Equal("volume-for-/tmp/build/some-input"))
+
+			Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+			ns, pod, c, command, _, _, _, _ := fakeExecutor.ExecArgsForCall(0)
+			Expect(ns).To(Equal(namespace))
+			Expect(pod).To(Equal("some-handle"))
+			Expect(c).To(Equal("sidecar"))
+			Expect(command).To(ContainElement("/tmp/build/some-input"))
+		})
+
+		It("marks the container as created", func() {
+			Expect(fakeCreatingContainer.CreatedCallCount()).To(Equal(1))
+			Expect(container.Handle()).To(Equal("some-handle"))
+		})
+
+		Context("when streaming an input fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeArtifact.StreamToReturns(disaster)
+			})
+
+			It("deletes the pod and marks the container as failed", func() {
+				Expect(findErr).To(Equal(disaster))
+				Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+				Expect(fakeGardenClient.DestroyArgsForCall(0)).To(Equal("some-handle"))
+				Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the image is a docker image URL", func() {
+			BeforeEach(func() {
+				containerSpec.ImageSpec = worker.ImageSpec{ImageURL: "docker:///some/image"}
+			})
+
+			It("runs the pod with it", func() {
+				Expect(fakeGardenClient.CreateArgsForCall(0).Image.URI).To(Equal("docker:///some/image"))
+			})
+		})
+
+		Context("when the container is for a base resource type", func() {
+			BeforeEach(func() {
+				containerSpec.ImageSpec = worker.ImageSpec{ResourceType: "git"}
+			})
+
+			It("runs the pod with the image configured for it", func() {
+				Expect(fakeGardenClient.CreateArgsForCall(0).Image.URI).To(Equal("docker:///concourse/git-resource"))
+			})
+		})
+
+		Context("when the image is produced by an earlier step", func() {
+			BeforeEach(func() {
+				containerSpec.ImageSpec = worker.ImageSpec{
+					ImageArtifactSource: new(workerfakes.FakeStreamableArtifactSource),
+				}
+			})
+
+			It("fails without creating a pod", func() {
+				Expect(findErr).To(Equal(k8s.ErrImageNotSupported))
+				Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+				Expect(fakeCreatingContainer.FailedCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the pod already exists", func() {
+			BeforeEach(func() {
+				fakeDBWorker.FindContainerReturns(nil, fakeCreatedContainer, nil)
+				fakeGardenClient.LookupReturns(fakeGardenContainer, nil)
+			})
+
+			It("returns it without creating anything", func() {
+				Expect(findErr).ToNot(HaveOccurred())
+				Expect(container.Handle()).To(Equal("some-handle"))
+				Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+				Expect(fakeVolumeRepository.CreateContainerVolumeCallCount()).To(BeZero())
+			})
+		})
+	})
+})
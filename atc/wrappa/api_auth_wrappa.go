@@ -45,11 +45,15 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
-			atc.ListBuildArtifacts:
+			atc.ListBuildArtifacts,
+			atc.ListBuildStepApprovals,
+			atc.GetBuildTestResults:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.ApproveBuildStep,
+			atc.RejectBuildStep:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -93,6 +97,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetTeam,
 			atc.SetTeam,
 			atc.ListTeamBuilds,
+			atc.SearchBuildLogs,
+			atc.ListTeamAuditEvents,
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.ListVolumes:
@@ -137,6 +143,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.GetJobSchedulingExplanation,
+			atc.GetJobTestHistory,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
@@ -149,7 +157,19 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListStepTemplates,
+			atc.GetStepTemplate,
+			atc.SaveStepTemplate,
+			atc.DestroyStepTemplate,
+			atc.ListNotifications,
+			atc.SaveNotification,
+			atc.DestroyNotification,
+			atc.ListNotificationDeliveries,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.RevokeAPIToken,
+			atc.DestroyServiceAccount:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
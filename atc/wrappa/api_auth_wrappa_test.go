@@ -153,8 +153,13 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
 
+				atc.ListBuildStepApprovals: checksIfPrivateJob(inputHandlers[atc.ListBuildStepApprovals]),
+				atc.GetBuildTestResults:    checksIfPrivateJob(inputHandlers[atc.GetBuildTestResults]),
+
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:       checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.ApproveBuildStep: checkWritePermissionForBuild(inputHandlers[atc.ApproveBuildStep]),
+				atc.RejectBuildStep:  checkWritePermissionForBuild(inputHandlers[atc.RejectBuildStep]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -184,20 +189,22 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
 				// authenticated
-				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
-				atc.GetContainer:    authenticated(inputHandlers[atc.GetContainer]),
-				atc.HijackContainer: authenticated(inputHandlers[atc.HijackContainer]),
-				atc.ListContainers:  authenticated(inputHandlers[atc.ListContainers]),
-				atc.ListVolumes:     authenticated(inputHandlers[atc.ListVolumes]),
-				atc.ListTeamBuilds:  authenticated(inputHandlers[atc.ListTeamBuilds]),
-				atc.ListWorkers:     authenticated(inputHandlers[atc.ListWorkers]),
-				atc.RegisterWorker:  authenticated(inputHandlers[atc.RegisterWorker]),
-				atc.HeartbeatWorker: authenticated(inputHandlers[atc.HeartbeatWorker]),
-				atc.DeleteWorker:    authenticated(inputHandlers[atc.DeleteWorker]),
-				atc.GetTeam:         authenticated(inputHandlers[atc.GetTeam]),
-				atc.SetTeam:         authenticated(inputHandlers[atc.SetTeam]),
-				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
-				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
+				atc.CreateBuild:         authenticated(inputHandlers[atc.CreateBuild]),
+				atc.GetContainer:        authenticated(inputHandlers[atc.GetContainer]),
+				atc.HijackContainer:     authenticated(inputHandlers[atc.HijackContainer]),
+				atc.ListContainers:      authenticated(inputHandlers[atc.ListContainers]),
+				atc.ListVolumes:         authenticated(inputHandlers[atc.ListVolumes]),
+				atc.ListTeamBuilds:      authenticated(inputHandlers[atc.ListTeamBuilds]),
+				atc.SearchBuildLogs:     authenticated(inputHandlers[atc.SearchBuildLogs]),
+				atc.ListTeamAuditEvents: authenticated(inputHandlers[atc.ListTeamAuditEvents]),
+				atc.ListWorkers:         authenticated(inputHandlers[atc.ListWorkers]),
+				atc.RegisterWorker:      authenticated(inputHandlers[atc.RegisterWorker]),
+				atc.HeartbeatWorker:     authenticated(inputHandlers[atc.HeartbeatWorker]),
+				atc.DeleteWorker:        authenticated(inputHandlers[atc.DeleteWorker]),
+				atc.GetTeam:             authenticated(inputHandlers[atc.GetTeam]),
+				atc.SetTeam:             authenticated(inputHandlers[atc.SetTeam]),
+				atc.RenameTeam:          authenticated(inputHandlers[atc.RenameTeam]),
+				atc.DestroyTeam:         authenticated(inputHandlers[atc.DestroyTeam]),
 
 				//authenticateIfTokenProvided / delegating to handler
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
@@ -222,34 +229,48 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
 
 				// authorized (requested team matches resource team)
-				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
-				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
-				atc.CreateJobBuild:          authorized(inputHandlers[atc.CreateJobBuild]),
-				atc.RerunJobBuild:           authorized(inputHandlers[atc.RerunJobBuild]),
-				atc.DeletePipeline:          authorized(inputHandlers[atc.DeletePipeline]),
-				atc.DisableResourceVersion:  authorized(inputHandlers[atc.DisableResourceVersion]),
-				atc.EnableResourceVersion:   authorized(inputHandlers[atc.EnableResourceVersion]),
-				atc.PinResourceVersion:      authorized(inputHandlers[atc.PinResourceVersion]),
-				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
-				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
-				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
-				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
-				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
-				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
-				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
-				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
-				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
-				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
-				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
-				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
-				atc.ScheduleJob:             authorized(inputHandlers[atc.ScheduleJob]),
-				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
-				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
-				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
-				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
-				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
-				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
-				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.CheckResource:               authorized(inputHandlers[atc.CheckResource]),
+				atc.CheckResourceType:           authorized(inputHandlers[atc.CheckResourceType]),
+				atc.CreateJobBuild:              authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:               authorized(inputHandlers[atc.RerunJobBuild]),
+				atc.DeletePipeline:              authorized(inputHandlers[atc.DeletePipeline]),
+				atc.DisableResourceVersion:      authorized(inputHandlers[atc.DisableResourceVersion]),
+				atc.EnableResourceVersion:       authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:          authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:               authorized(inputHandlers[atc.UnpinResource]),
+				atc.SetPinCommentOnResource:     authorized(inputHandlers[atc.SetPinCommentOnResource]),
+				atc.GetConfig:                   authorized(inputHandlers[atc.GetConfig]),
+				atc.GetCC:                       authorized(inputHandlers[atc.GetCC]),
+				atc.GetVersionsDB:               authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListJobInputs:               authorized(inputHandlers[atc.ListJobInputs]),
+				atc.GetJobSchedulingExplanation: authorized(inputHandlers[atc.GetJobSchedulingExplanation]),
+				atc.GetJobTestHistory:           authorized(inputHandlers[atc.GetJobTestHistory]),
+				atc.OrderPipelines:              authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PauseJob:                    authorized(inputHandlers[atc.PauseJob]),
+				atc.PausePipeline:               authorized(inputHandlers[atc.PausePipeline]),
+				atc.RenamePipeline:              authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SaveConfig:                  authorized(inputHandlers[atc.SaveConfig]),
+				atc.UnpauseJob:                  authorized(inputHandlers[atc.UnpauseJob]),
+				atc.ScheduleJob:                 authorized(inputHandlers[atc.ScheduleJob]),
+				atc.UnpausePipeline:             authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ExposePipeline:              authorized(inputHandlers[atc.ExposePipeline]),
+				atc.HidePipeline:                authorized(inputHandlers[atc.HidePipeline]),
+				atc.CreatePipelineBuild:         authorized(inputHandlers[atc.CreatePipelineBuild]),
+				atc.ClearTaskCache:              authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.CreateArtifact:              authorized(inputHandlers[atc.CreateArtifact]),
+				atc.GetArtifact:                 authorized(inputHandlers[atc.GetArtifact]),
+				atc.ListStepTemplates:           authorized(inputHandlers[atc.ListStepTemplates]),
+				atc.GetStepTemplate:             authorized(inputHandlers[atc.GetStepTemplate]),
+				atc.SaveStepTemplate:            authorized(inputHandlers[atc.SaveStepTemplate]),
+				atc.DestroyStepTemplate:         authorized(inputHandlers[atc.DestroyStepTemplate]),
+				atc.ListNotifications:           authorized(inputHandlers[atc.ListNotifications]),
+				atc.SaveNotification:            authorized(inputHandlers[atc.SaveNotification]),
+				atc.DestroyNotification:         authorized(inputHandlers[atc.DestroyNotification]),
+				atc.ListNotificationDeliveries:  authorized(inputHandlers[atc.ListNotificationDeliveries]),
+				atc.ListAPITokens:               authorized(inputHandlers[atc.ListAPITokens]),
+				atc.CreateAPIToken:              authorized(inputHandlers[atc.CreateAPIToken]),
+				atc.RevokeAPIToken:              authorized(inputHandlers[atc.RevokeAPIToken]),
+				atc.DestroyServiceAccount:       authorized(inputHandlers[atc.DestroyServiceAccount]),
 			}
 		})
 
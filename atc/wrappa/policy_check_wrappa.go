@@ -0,0 +1,135 @@
+package wrappa
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/tedsuo/rata"
+)
+
+func NewPolicyCheckWrappa(logger lager.Logger, checker policy.Checker) *PolicyCheckWrappa {
+	return &PolicyCheckWrappa{logger, checker}
+}
+
+type PolicyCheckWrappa struct {
+	logger  lager.Logger
+	checker policy.Checker
+}
+
+func (w *PolicyCheckWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
+	if w.checker == nil {
+		return handlers
+	}
+
+	wrapped := rata.Handlers{}
+
+	for name, handler := range handlers {
+		switch name {
+		// the pipeline config is checked by the handler itself once it has
+		// been parsed
+		case atc.SaveConfig:
+			wrapped[name] = handler
+		default:
+			wrapped[name] = policyCheckHandler{
+				logger:  w.logger.Session("policy-check"),
+				action:  name,
+				checker: w.checker,
+				handler: handler,
+			}
+		}
+	}
+
+	return wrapped
+}
+
+type policyCheckHandler struct {
+	logger  lager.Logger
+	action  string
+	checker policy.Checker
+	handler http.Handler
+}
+
+func (h policyCheckHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.shouldCheck(r) {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	input, err := h.input(r)
+	if err != nil {
+		h.logger.Error("failed-to-build-input", err, lager.Data{"action": h.action})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "failed to read request: %s", err)
+		return
+	}
+
+	result, err := h.checker.Check(input)
+	if err != nil {
+		h.logger.Error("failed-to-check", err, lager.Data{"action": h.action})
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "policy check error: %s", err)
+		return
+	}
+
+	if !result.Allowed {
+		h.logger.Info("denied", lager.Data{"action": h.action, "reasons": result.Reasons})
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, policy.PolicyCheckNotPassedError{Reasons: result.Reasons}.Error())
+		return
+	}
+
+	h.handler.ServeHTTP(w, r)
+}
+
+func (h policyCheckHandler) shouldCheck(r *http.Request) bool {
+	if h.checker.ShouldCheckAction(h.action) {
+		return true
+	}
+
+	return h.checker.ShouldCheckHttpMethod(r.Method) && !h.checker.ShouldSkipAction(h.action)
+}
+
+func (h policyCheckHandler) input(r *http.Request) (policy.PolicyCheckInput, error) {
+	acc := accessor.GetAccessor(r)
+
+	input := policy.PolicyCheckInput{
+		HttpMethod: r.Method,
+		Action:     h.action,
+		User:       acc.UserName(),
+		Team:       r.FormValue(":team_name"),
+		Pipeline:   r.FormValue(":pipeline_name"),
+	}
+
+	if input.Pipeline != "" {
+		// malformed instance vars are left for the handler to reject
+		input.InstanceVars, _ = atc.InstanceVarsFromQueryParams(r.URL.Query())
+	}
+
+	if r.Body == nil || r.Header.Get("Content-Type") != "application/json" {
+		return input, nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return policy.PolicyCheckInput{}, err
+	}
+
+	// the wrapped handler still needs to read the body
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+	if len(body) == 0 {
+		return input, nil
+	}
+
+	// malformed payloads are left for the handler to reject
+	_ = json.Unmarshal(body, &input.Data)
+
+	return input, nil
+}
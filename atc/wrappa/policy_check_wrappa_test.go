@@ -0,0 +1,162 @@
+package wrappa_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+	"github.com/concourse/concourse/atc/wrappa"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type bodyRecordingHandler struct {
+	body []byte
+}
+
+func (h *bodyRecordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.body, _ = ioutil.ReadAll(r.Body)
+}
+
+var _ = Describe("PolicyCheckWrappa", func() {
+	var (
+		fakeChecker  *policyfakes.FakeChecker
+		inner        *bodyRecordingHandler
+		wrapped      rata.Handlers
+		request      *http.Request
+		recorder     *httptest.ResponseRecorder
+		requestedFor string
+	)
+
+	BeforeEach(func() {
+		fakeChecker = new(policyfakes.FakeChecker)
+		fakeChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+
+		inner = &bodyRecordingHandler{}
+		requestedFor = atc.PausePipeline
+
+		request = httptest.NewRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/pause?:team_name=main&:pipeline_name=some-pipeline", nil)
+		recorder = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		wrapped = wrappa.NewPolicyCheckWrappa(lagertest.NewTestLogger("test"), fakeChecker).Wrap(rata.Handlers{
+			requestedFor: inner,
+		})
+
+		wrapped[requestedFor].ServeHTTP(recorder, request)
+	})
+
+	Context("when the checker is not configured", func() {
+		It("does not wrap the handlers", func() {
+			handlers := rata.Handlers{atc.PausePipeline: inner}
+			Expect(wrappa.NewPolicyCheckWrappa(lagertest.NewTestLogger("test"), nil).Wrap(handlers)).To(Equal(handlers))
+		})
+	})
+
+	Context("when neither the action nor the http method is filtered", func() {
+		It("does not check", func() {
+			Expect(fakeChecker.CheckCallCount()).To(Equal(0))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("when the http method is filtered", func() {
+		BeforeEach(func() {
+			fakeChecker.ShouldCheckHttpMethodReturns(true)
+		})
+
+		It("checks the request", func() {
+			Expect(fakeChecker.CheckCallCount()).To(Equal(1))
+			input := fakeChecker.CheckArgsForCall(0)
+			Expect(input.Action).To(Equal(atc.PausePipeline))
+			Expect(input.HttpMethod).To(Equal("PUT"))
+			Expect(input.Team).To(Equal("main"))
+			Expect(input.Pipeline).To(Equal("some-pipeline"))
+			Expect(input.InstanceVars).To(BeNil())
+		})
+
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest("PUT", `/api/v1/teams/main/pipelines/some-pipeline/pause?:team_name=main&:pipeline_name=some-pipeline&instance_vars=%7B%22branch%22%3A%22feature%22%7D`, nil)
+			})
+
+			It("includes the instance vars", func() {
+				input := fakeChecker.CheckArgsForCall(0)
+				Expect(input.Pipeline).To(Equal("some-pipeline"))
+				Expect(input.InstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+			})
+		})
+
+		Context("when the action is skipped", func() {
+			BeforeEach(func() {
+				fakeChecker.ShouldSkipActionReturns(true)
+			})
+
+			It("does not check", func() {
+				Expect(fakeChecker.CheckCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the action is filtered", func() {
+		BeforeEach(func() {
+			fakeChecker.ShouldCheckActionReturns(true)
+		})
+
+		Context("with a json body", func() {
+			BeforeEach(func() {
+				request.Body = ioutil.NopCloser(bytes.NewBufferString(`{"some":"data"}`))
+				request.Header.Set("Content-Type", "application/json")
+			})
+
+			It("passes the body to the checker and the handler", func() {
+				input := fakeChecker.CheckArgsForCall(0)
+				Expect(input.Data).To(Equal(map[string]interface{}{"some": "data"}))
+				Expect(string(inner.body)).To(Equal(`{"some":"data"}`))
+			})
+		})
+
+		Context("when the policy denies the request", func() {
+			BeforeEach(func() {
+				fakeChecker.CheckReturns(policy.PolicyCheckOutput{
+					Allowed: false,
+					Reasons: []string{"a", "b"},
+				}, nil)
+			})
+
+			It("returns 403 with the reasons", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(recorder.Body.String()).To(Equal("policy check failed: a, b"))
+			})
+		})
+
+		Context("when the check errors", func() {
+			BeforeEach(func() {
+				fakeChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when the action is SaveConfig", func() {
+			BeforeEach(func() {
+				requestedFor = atc.SaveConfig
+			})
+
+			It("leaves the check to the handler", func() {
+				Expect(fakeChecker.CheckCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ApproveBuildCommand struct {
+	Job     flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of the job whose build is waiting"`
+	Build   string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to decide. If job not specified: build id"`
+	Step    string              `short:"s" long:"step" value-name:"NAME" description:"Name of the approve step to decide. Required when the build is waiting on more than one"`
+	Reject  bool                `long:"reject" description:"Reject the step instead of approving it, failing the build"`
+	Comment string              `short:"m" long:"comment" description:"Comment to record with the decision"`
+}
+
+func (command *ApproveBuildCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	buildID := strconv.Itoa(build.ID)
+
+	approvals, _, err := target.Client().BuildStepApprovals(buildID)
+	if err != nil {
+		return err
+	}
+
+	var pending []atc.BuildStepApproval
+	for _, approval := range approvals {
+		if !approval.Pending() {
+			continue
+		}
+
+		if command.Step != "" && approval.Name != command.Step {
+			continue
+		}
+
+		pending = append(pending, approval)
+	}
+
+	switch {
+	case len(pending) == 0 && command.Step != "":
+		return fmt.Errorf("build is not waiting for approval of step '%s'", command.Step)
+	case len(pending) == 0:
+		return fmt.Errorf("build is not waiting for approval")
+	case len(pending) > 1:
+		names := make([]string, len(pending))
+		for i, approval := range pending {
+			names[i] = approval.Name
+		}
+
+		return fmt.Errorf("build is waiting for more than one approval, specify one with --step: %s", strings.Join(names, ", "))
+	}
+
+	approval := pending[0]
+
+	found, err := target.Client().DecideBuildStep(buildID, approval.PlanID, !command.Reject, command.Comment)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build is not waiting for approval of step '%s'", approval.Name)
+	}
+
+	if command.Reject {
+		fmt.Printf("rejected step '%s'\n", approval.Name)
+	} else {
+		fmt.Printf("approved step '%s'\n", approval.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditEventsCommand struct {
+	Count int    `short:"c" long:"count" default:"50" description:"Number of audit events you want to limit the return to"`
+	Json  bool   `long:"json" description:"Print command result as JSON"`
+	Team  string `long:"team" description:"Name of the team to list audit events for, if different from the target default"`
+}
+
+func (command *AuditEventsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	auditEvents, _, err := team.AuditEvents(concourse.Page{Limit: command.Count})
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(auditEvents)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "actor", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+			{Contents: "targets", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "source ip", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, e := range auditEvents {
+		var targets []string
+		for name, value := range e.Targets {
+			targets = append(targets, name+"="+value)
+		}
+		sort.Strings(targets)
+
+		statusCell := ui.TableCell{Contents: strconv.Itoa(e.Status)}
+		if e.Status >= 400 {
+			statusCell.Color = ui.FailedColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(e.ID)},
+			{Contents: time.Unix(e.Time, 0).Format(timeDateLayout)},
+			{Contents: e.Actor},
+			{Contents: e.Action},
+			{Contents: strings.Join(targets, ",")},
+			statusCell,
+			{Contents: e.SourceIP},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
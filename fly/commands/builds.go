@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -21,6 +22,7 @@ const inputTimeLayout = "2006-01-02 15:04:05"
 
 type BuildsCommand struct {
 	AllTeams    bool                     `short:"a" long:"all-teams" description:"Show builds for the all teams that user has access to"`
+	Annotations []string                 `long:"annotation" value-name:"KEY:VALUE" description:"Only show builds with the given annotation (can be specified multiple times)"`
 	Count       int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
 	CurrentTeam bool                     `long:"current-team" description:"Show builds for the currently targeted team"`
 	Job         flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
@@ -136,7 +138,7 @@ func (command *BuildsCommand) validateJobBuilds(builds []atc.Build, currentTeam
 	)
 
 	builds, _, found, err = currentTeam.JobBuilds(
-		command.Job.PipelineName,
+		command.Job.PipelineRef,
 		command.Job.JobName,
 		page,
 	)
@@ -157,7 +159,7 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 
 	var found bool
 	builds, _, found, err = currentTeam.PipelineBuilds(
-		string(command.Pipeline),
+		command.Pipeline.Ref(),
 		page,
 	)
 
@@ -271,6 +273,21 @@ func (command *BuildsCommand) validateBuildArguments(timeSince time.Time, page c
 	if len(command.Teams) > 0 && command.AllTeams {
 		return page, errors.New("Cannot specify both --all-teams and --team")
 	}
+	if len(command.Annotations) > 0 {
+		if command.pipelineFlag() || command.jobFlag() || command.AllTeams || command.CurrentTeam || len(command.Teams) > 0 {
+			return page, errors.New("Cannot specify --annotation with --pipeline, --job, --team, --current-team or --all-teams")
+		}
+
+		page.Annotations = map[string]string{}
+		for _, annotation := range command.Annotations {
+			segs := strings.SplitN(annotation, ":", 2)
+			if len(segs) != 2 || segs[0] == "" {
+				return page, fmt.Errorf("invalid annotation '%s': must be of the form 'key:value'", annotation)
+			}
+
+			page.Annotations[segs[0]] = segs[1]
+		}
+	}
 	return page, err
 }
 
@@ -309,11 +326,11 @@ func roundSecondsOffDuration(d time.Duration) time.Duration {
 }
 
 func (command *BuildsCommand) jobFlag() bool {
-	return command.Job.PipelineName != "" && command.Job.JobName != ""
+	return command.Job.PipelineRef.Name != "" && command.Job.JobName != ""
 }
 
 func (command *BuildsCommand) pipelineFlag() bool {
-	return command.Pipeline != ""
+	return command.Pipeline.Name != ""
 }
 
 func (command *BuildsCommand) buildCap(builds []atc.Build) int {
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type CreateTokenCommand struct {
+	ServiceAccount string        `short:"s" long:"service-account" required:"true" description:"Service account which the token authenticates, created if it does not exist"`
+	Role           string        `long:"role"                                      description:"Role of the service account within the team (default: viewer for new accounts)"`
+	ExpiresIn      time.Duration `long:"expires-in" default:"2160h"                description:"How long the token is valid for; 0 for a token which never expires"`
+	Actions        []string      `long:"action"                                    description:"Only allow the token to perform this API action, e.g. CreateJobBuild (can be specified multiple times)"`
+	Team           string        `long:"team" description:"Name of the team to which the service account belongs, if different from the target default"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	if command.ExpiresIn < 0 {
+		return fmt.Errorf("--expires-in must not be negative")
+	}
+
+	request := atc.APITokenRequest{
+		Role:    command.Role,
+		Actions: command.Actions,
+	}
+
+	if command.ExpiresIn != 0 {
+		request.ExpiresAt = time.Now().Add(command.ExpiresIn).Unix()
+	}
+
+	err := request.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	token, err := team.CreateAPIToken(command.ServiceAccount, request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("token %d created for service account %s\n\n", token.ID, ui.Embolden("%s", token.ServiceAccount))
+	fmt.Printf("  %s\n\n", token.Token)
+	fmt.Println("this token will not be shown again, so store it somewhere safe")
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/vito/go-interact/interact"
+)
+
+type DestroyNotificationCommand struct {
+	Name            string `short:"n" long:"name" required:"true" description:"Notification to destroy"`
+	SkipInteractive bool   `long:"non-interactive" description:"Destroy the notification without confirmation"`
+	Team            string `long:"team" description:"Name of the team to which the notification belongs, if different from the target default"`
+}
+
+func (command *DestroyNotificationCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	fmt.Printf("!!! this will remove notification `%s` along with its deliveries\n\n", command.Name)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := team.DestroyNotification(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("`%s` does not exist\n", command.Name)
+	} else {
+		fmt.Printf("`%s` deleted\n", command.Name)
+	}
+
+	return nil
+}
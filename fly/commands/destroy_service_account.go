@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/vito/go-interact/interact"
+)
+
+type DestroyServiceAccountCommand struct {
+	ServiceAccount  string `short:"s" long:"service-account" required:"true" description:"Service account to destroy"`
+	SkipInteractive bool   `long:"non-interactive" description:"Destroy the service account without confirmation"`
+	Team            string `long:"team" description:"Name of the team to which the service account belongs, if different from the target default"`
+}
+
+func (command *DestroyServiceAccountCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	fmt.Printf("!!! this will remove service account `%s` and revoke all of its tokens\n\n", command.ServiceAccount)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := team.DestroyServiceAccount(command.ServiceAccount)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("`%s` does not exist\n", command.ServiceAccount)
+	} else {
+		fmt.Printf("`%s` deleted\n", command.ServiceAccount)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/vito/go-interact/interact"
+)
+
+type DestroyStepTemplateCommand struct {
+	Name            string `short:"n" long:"name" required:"true" description:"Step template to destroy"`
+	SkipInteractive bool   `long:"non-interactive" description:"Destroy the step template without confirmation"`
+	Team            string `long:"team" description:"Name of the team to which the step template belongs, if different from the target default"`
+}
+
+func (command *DestroyStepTemplateCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	fmt.Printf("!!! this will remove every version of step template `%s`\n", command.Name)
+	fmt.Printf("pipelines which were set with it will keep their steps\n\n")
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := team.DestroyStepTemplate(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("`%s` does not exist\n", command.Name)
+	} else {
+		fmt.Printf("`%s` deleted\n", command.Name)
+	}
+
+	return nil
+}
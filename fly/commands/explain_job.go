@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type ExplainJobCommand struct {
+	Job  flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to explain"`
+	Json bool                `long:"json" description:"Print command result as JSON"`
+	Team string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+}
+
+func (command *ExplainJobCommand) Execute(args []string) error {
+	pipelineName, jobName := command.Job.PipelineRef, command.Job.JobName
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	explanation, found, err := team.JobSchedulingExplanation(pipelineName, jobName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found on team %s\n", pipelineName, jobName, team.Name())
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(explanation)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if explanation.PipelinePaused {
+		fmt.Println(ui.PausedColor.Sprint("pipeline is paused"))
+	}
+
+	if explanation.JobPaused {
+		fmt.Println(ui.PausedColor.Sprint("job is paused"))
+	}
+
+	if explanation.PendingBuild != nil {
+		prep := explanation.PendingBuild
+
+		fmt.Printf("build %d is pending\n", prep.BuildID)
+
+		if prep.MaxRunningBuilds == atc.BuildPreparationStatusBlocking {
+			fmt.Println(ui.PausedColor.Sprint("max running builds reached"))
+		}
+
+		if prep.QueuePosition > 0 {
+			fmt.Println(ui.PausedColor.Sprintf("queued at position %d", prep.QueuePosition))
+		}
+	}
+
+	if len(explanation.Inputs) == 0 {
+		fmt.Println("job has no inputs")
+		return nil
+	}
+
+	headers := []string{"name", "resource", "trigger", "passed", "version", "reason"}
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, input := range explanation.Inputs {
+		row := ui.TableRow{
+			{Contents: input.Name},
+			{Contents: input.Resource},
+		}
+
+		if input.Trigger {
+			row = append(row, ui.TableCell{Contents: "yes"})
+		} else {
+			row = append(row, ui.TableCell{Contents: "no", Color: ui.OffColor})
+		}
+
+		if len(input.Passed) > 0 {
+			row = append(row, ui.TableCell{Contents: strings.Join(input.Passed, ",")})
+		} else {
+			row = append(row, ui.TableCell{Contents: "none", Color: ui.OffColor})
+		}
+
+		if input.Version != nil {
+			row = append(row, ui.TableCell{Contents: ui.PresentVersion(input.Version)})
+		} else {
+			row = append(row, ui.TableCell{Contents: "n/a", Color: ui.OffColor})
+		}
+
+		if input.Reason != "" {
+			reason := string(input.Reason)
+			if input.Message != "" {
+				reason += ": " + input.Message
+			}
+
+			row = append(row, ui.TableCell{Contents: reason, Color: ui.FailedColor})
+		} else {
+			row = append(row, ui.TableCell{Contents: "none", Color: ui.OffColor})
+		}
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -44,6 +44,7 @@ type FlyCommand struct {
 	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	ExplainJob  ExplainJobCommand  `command:"explain-job" alias:"ej" description:"Explain why the scheduler does or does not start a build of a job"`
 
 	Pipelines        PipelinesCommand        `command:"pipelines"           alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
@@ -58,6 +59,21 @@ type FlyCommand struct {
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
 
+	StepTemplates       StepTemplatesCommand       `command:"step-templates"        alias:"sts" description:"List the step templates of a team"`
+	GetStepTemplate     GetStepTemplateCommand     `command:"get-step-template"     alias:"gst" description:"Get a step template"`
+	SetStepTemplate     SetStepTemplateCommand     `command:"set-step-template"     alias:"sst" description:"Save a new version of a step template"`
+	DestroyStepTemplate DestroyStepTemplateCommand `command:"destroy-step-template" alias:"dst" description:"Destroy every version of a step template"`
+
+	Notifications          NotificationsCommand          `command:"notifications"           alias:"ns"  description:"List the build notifications of a team"`
+	SetNotification        SetNotificationCommand        `command:"set-notification"        alias:"sn"  description:"Create or update a build notification"`
+	DestroyNotification    DestroyNotificationCommand    `command:"destroy-notification"    alias:"dn"  description:"Destroy a build notification"`
+	NotificationDeliveries NotificationDeliveriesCommand `command:"notification-deliveries" alias:"nds" description:"List the deliveries of a build notification"`
+
+	CreateToken           CreateTokenCommand           `command:"create-token"            alias:"ctk" description:"Create an API token for a service account of a team"`
+	Tokens                TokensCommand                `command:"tokens"                  alias:"tks" description:"List the API tokens of a team's service accounts"`
+	RevokeToken           RevokeTokenCommand           `command:"revoke-token"            alias:"rtk" description:"Revoke an API token"`
+	DestroyServiceAccount DestroyServiceAccountCommand `command:"destroy-service-account" alias:"dsa" description:"Destroy a service account along with its API tokens"`
+
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource          CheckResourceCommand          `command:"check-resource"             alias:"cr"   description:"Check a resource"`
@@ -70,14 +86,19 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds       BuildsCommand       `command:"builds"      alias:"bs" description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build" alias:"ab" description:"Abort a build"`
+	ApproveBuild ApproveBuildCommand `command:"approve-build" alias:"apb" description:"Approve or reject a build waiting on an approve step"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	SearchLogs   SearchLogsCommand   `command:"search-logs" alias:"sl" description:"Search the logs of builds"`
+	TestResults  TestResultsCommand  `command:"test-results" alias:"tr" description:"Show the test results of a build or the test history of a job"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
+	AuditEvents AuditEventsCommand `command:"audit-events" alias:"ae" description:"List the audit events of a team"`
+
 	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
 	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
 	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type GetStepTemplateCommand struct {
+	Name    string `short:"n" long:"name"    required:"true" description:"Name of the step template"`
+	Version int    `short:"v" long:"version" description:"Version of the step template, if not the latest"`
+	JSON    bool   `short:"j" long:"json"    description:"Print the step template as json instead of yaml"`
+	Team    string `long:"team" description:"Name of the team to which the step template belongs, if different from the target default"`
+}
+
+func (command *GetStepTemplateCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	template, found, err := team.StepTemplate(command.Name, command.Version)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("step template not found")
+	}
+
+	if command.JSON {
+		payload, err := json.Marshal(template)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Printf("%s", payload)
+		return err
+	}
+
+	// print the template in the format accepted by set-step-template
+	var step interface{}
+	err = yaml.Unmarshal([]byte(template.Step), &step)
+	if err != nil {
+		return err
+	}
+
+	payload, err := yaml.Marshal(stepTemplateFile{
+		Params: template.Params,
+		Step:   step,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Printf("%s", payload)
+	return err
+}
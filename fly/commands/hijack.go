@@ -280,16 +280,20 @@ func (command *HijackCommand) getContainerFingerprint(target rc.Target, team con
 		}
 	}
 
-	pipelineName := command.Check.PipelineName
-	if command.Job.PipelineName != "" {
-		pipelineName = command.Job.PipelineName
+	pipelineRef := command.Check.PipelineRef
+	if command.Job.PipelineRef.Name != "" {
+		pipelineRef = command.Job.PipelineRef
+	}
+
+	if pipelineRef.InstanceVars != nil {
+		fingerprint.instanceVars = pipelineRef.InstanceVars
 	}
 
 	for _, field := range []struct {
 		fp  *string
 		cmd string
 	}{
-		{fp: &fingerprint.pipelineName, cmd: pipelineName},
+		{fp: &fingerprint.pipelineName, cmd: pipelineRef.Name},
 		{fp: &fingerprint.buildNameOrID, cmd: command.Build},
 		{fp: &fingerprint.stepName, cmd: command.StepName},
 		{fp: &fingerprint.stepType, cmd: command.StepType},
@@ -362,6 +366,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 
 	if fingerprint.jobName != "" {
 		reqValues["pipeline_name"] = fingerprint.pipelineName
+		fingerprint.addInstanceVars(reqValues)
 		reqValues["job_name"] = fingerprint.jobName
 		if fingerprint.buildNameOrID != "" {
 			reqValues["build_name"] = fingerprint.buildNameOrID
@@ -369,7 +374,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 	} else if fingerprint.buildNameOrID != "" {
 		reqValues["build_id"] = fingerprint.buildNameOrID
 	} else {
-		build, err := GetBuild(locator.client, nil, "", "", "")
+		build, err := GetBuild(locator.client, nil, "", "", atc.PipelineRef{})
 		if err != nil {
 			return reqValues, err
 		}
@@ -390,6 +395,7 @@ func (locator checkContainerLocator) locate(fingerprint *containerFingerprint) (
 	}
 	if fingerprint.pipelineName != "" {
 		reqValues["pipeline_name"] = fingerprint.pipelineName
+		fingerprint.addInstanceVars(reqValues)
 	}
 
 	return reqValues, nil
@@ -397,6 +403,7 @@ func (locator checkContainerLocator) locate(fingerprint *containerFingerprint) (
 
 type containerFingerprint struct {
 	pipelineName  string
+	instanceVars  atc.InstanceVars
 	jobName       string
 	buildNameOrID string
 
@@ -407,6 +414,15 @@ type containerFingerprint struct {
 	attempt   string
 }
 
+// addInstanceVars narrows the container search down to the pipeline instance
+// the fingerprint refers to, if any.
+func (fingerprint *containerFingerprint) addInstanceVars(reqValues map[string]string) {
+	ref := atc.PipelineRef{Name: fingerprint.pipelineName, InstanceVars: fingerprint.instanceVars}
+	if instanceVars := ref.QueryParams().Get(atc.InstanceVarsQueryParam); instanceVars != "" {
+		reqValues[atc.InstanceVarsQueryParam] = instanceVars
+	}
+}
+
 func locateContainer(client concourse.Client, fingerprint *containerFingerprint) (map[string]string, error) {
 	var locator containerLocator
 
@@ -7,27 +7,56 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
 type JobFlag struct {
-	PipelineName string
-	JobName      string
+	PipelineRef atc.PipelineRef
+	JobName     string
 }
 
+// UnmarshalFlag parses <pipeline>/<job>, or <pipeline>/<key:value,...>/<job>
+// to address a job of a pipeline instance.
 func (job *JobFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", -1)
-
-	if len(vs) != 2 {
-		return errors.New("argument format should be <pipeline>/<job>")
+	ref, jobName, err := parsePipelineScopedFlag(value)
+	if err != nil || strings.Contains(jobName, "/") {
+		return errors.New("argument format should be <pipeline>/<job>, or <pipeline>/<key:value>/<job> for a pipeline instance")
 	}
 
-	job.PipelineName = vs[0]
-	job.JobName = vs[1]
+	job.PipelineRef = ref
+	job.JobName = jobName
 
 	return nil
 }
 
+// parsePipelineScopedFlag splits a flag value into a pipeline ref and the name
+// of an object within the pipeline. Instance vars are recognized by the ':'
+// separating their keys and values, so that the name itself may contain '/'.
+func parsePipelineScopedFlag(value string) (atc.PipelineRef, string, error) {
+	vs := strings.SplitN(value, "/", 2)
+	if len(vs) != 2 || vs[0] == "" || vs[1] == "" {
+		return atc.PipelineRef{}, "", fmt.Errorf("missing name in '%s'", value)
+	}
+
+	rest := vs[1]
+	i := strings.LastIndex(rest, "/")
+	if i == -1 || !strings.Contains(rest[:i], ":") {
+		return atc.PipelineRef{Name: vs[0]}, rest, nil
+	}
+
+	if rest[i+1:] == "" {
+		return atc.PipelineRef{}, "", fmt.Errorf("missing name in '%s'", value)
+	}
+
+	ref, err := atc.ParsePipelineRef(value[:len(vs[0])+1+i])
+	if err != nil {
+		return atc.PipelineRef{}, "", err
+	}
+
+	return ref, rest[i+1:], nil
+}
+
 func (flag *JobFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
@@ -57,7 +86,7 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 			}
 		}
 	} else if len(vs) == 2 {
-		jobs, err := team.ListJobs(vs[0])
+		jobs, err := team.ListJobs(atc.PipelineRef{Name: vs[0]})
 		if err != nil {
 			return comps
 		}
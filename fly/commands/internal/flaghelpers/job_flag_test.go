@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -13,7 +14,44 @@ var _ = Describe("JobFlag", func() {
 			jobFlag := &JobFlag{}
 
 			err := jobFlag.UnmarshalFlag("pipeline")
-			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
+			Expect(err).To(MatchError("argument format should be <pipeline>/<job>, or <pipeline>/<key:value>/<job> for a pipeline instance"))
+		})
+	})
+
+	Context("when a pipeline and job are specified", func() {
+		It("parses them", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jobFlag.PipelineRef).To(Equal(atc.PipelineRef{Name: "pipeline"}))
+			Expect(jobFlag.JobName).To(Equal("job"))
+		})
+	})
+
+	Context("when the pipeline is an instance", func() {
+		It("parses its instance vars", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/branch:feature/foo,replicas:2/job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jobFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name: "pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch":   "feature/foo",
+					"replicas": float64(2),
+				},
+			}))
+			Expect(jobFlag.JobName).To(Equal("job"))
+		})
+	})
+
+	Context("when the job name contains '/'", func() {
+		It("displays an error message", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("pipeline/some/job")
+			Expect(err).To(HaveOccurred())
 		})
 	})
 })
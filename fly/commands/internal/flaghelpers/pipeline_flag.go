@@ -6,18 +6,52 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
-type PipelineFlag string
+type PipelineFlag struct {
+	Name         string
+	InstanceVars atc.InstanceVars
+}
 
 func (flag *PipelineFlag) Validate() error {
-	if strings.Contains(string(*flag), "/") {
+	if strings.Contains(flag.Name, "/") {
 		return errors.New("pipeline name cannot contain '/'")
 	}
 	return nil
 }
 
+func (flag *PipelineFlag) UnmarshalFlag(value string) error {
+	// a '/' which isn't followed by instance vars is most likely a typo in the
+	// name, which Validate explains better than the parse error would
+	if i := strings.Index(value, "/"); i != -1 && !strings.Contains(value[i+1:], ":") {
+		flag.Name = value
+		return nil
+	}
+
+	ref, err := atc.ParsePipelineRef(value)
+	if err != nil {
+		return err
+	}
+
+	flag.Name = ref.Name
+	flag.InstanceVars = ref.InstanceVars
+
+	return nil
+}
+
+func (flag PipelineFlag) Ref() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         flag.Name,
+		InstanceVars: flag.InstanceVars,
+	}
+}
+
+func (flag PipelineFlag) String() string {
+	return flag.Ref().String()
+}
+
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
@@ -38,8 +72,9 @@ func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 
 	comps := []flags.Completion{}
 	for _, pipeline := range pipelines {
-		if strings.HasPrefix(pipeline.Name, match) {
-			comps = append(comps, flags.Completion{Item: pipeline.Name})
+		ref := pipeline.Ref().String()
+		if strings.HasPrefix(ref, match) {
+			comps = append(comps, flags.Completion{Item: ref})
 		}
 	}
 
@@ -2,23 +2,26 @@ package flaghelpers
 
 import (
 	"errors"
-	"strings"
+
+	"github.com/concourse/concourse/atc"
 )
 
 type ResourceFlag struct {
-	PipelineName string
+	PipelineRef  atc.PipelineRef
 	ResourceName string
 }
 
+// UnmarshalFlag parses <pipeline>/<resource>, or
+// <pipeline>/<key:value,...>/<resource> to address a resource of a pipeline
+// instance.
 func (resource *ResourceFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", 2)
-
-	if len(vs) != 2 {
-		return errors.New("argument format should be <pipeline>/<resource>")
+	ref, resourceName, err := parsePipelineScopedFlag(value)
+	if err != nil {
+		return errors.New("argument format should be <pipeline>/<resource>, or <pipeline>/<key:value>/<resource> for a pipeline instance")
 	}
 
-	resource.PipelineName = vs[0]
-	resource.ResourceName = vs[1]
+	resource.PipelineRef = ref
+	resource.ResourceName = resourceName
 
 	return nil
 }
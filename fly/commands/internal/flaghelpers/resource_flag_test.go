@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -13,7 +14,32 @@ var _ = Describe("ResourceFlag", func() {
 			resourceFlag := &ResourceFlag{}
 
 			err := resourceFlag.UnmarshalFlag("pipeline")
-			Expect(err).To(MatchError("argument format should be <pipeline>/<resource>"))
+			Expect(err).To(MatchError("argument format should be <pipeline>/<resource>, or <pipeline>/<key:value>/<resource> for a pipeline instance"))
+		})
+	})
+
+	Context("when the resource name contains '/'", func() {
+		It("keeps it in the name", func() {
+			resourceFlag := &ResourceFlag{}
+
+			err := resourceFlag.UnmarshalFlag("pipeline/some/resource")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceFlag.PipelineRef).To(Equal(atc.PipelineRef{Name: "pipeline"}))
+			Expect(resourceFlag.ResourceName).To(Equal("some/resource"))
+		})
+	})
+
+	Context("when the pipeline is an instance", func() {
+		It("parses its instance vars", func() {
+			resourceFlag := &ResourceFlag{}
+
+			err := resourceFlag.UnmarshalFlag("pipeline/branch:main/resource")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name:         "pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "main"},
+			}))
+			Expect(resourceFlag.ResourceName).To(Equal("resource"))
 		})
 	})
 })
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type NotificationDeliveriesCommand struct {
+	Name  string `short:"n" long:"name" required:"true" description:"Notification to list the deliveries of"`
+	Count int    `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json  bool   `long:"json" description:"Print command result as JSON"`
+	Team  string `long:"team" description:"Name of the team to which the notification belongs, if different from the target default"`
+}
+
+func (command *NotificationDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	deliveries, found, err := team.NotificationDeliveries(command.Name, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("notification not found")
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(deliveries)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "build status", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "last attempt", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, d := range deliveries {
+		lastAttemptCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if d.LastAttemptAt != 0 {
+			lastAttemptCell = ui.TableCell{Contents: time.Unix(d.LastAttemptAt, 0).Format(timeDateLayout)}
+		}
+
+		buildStatusCell := ui.TableCell{Contents: string(d.BuildStatus)}
+		switch d.BuildStatus {
+		case atc.StatusSucceeded:
+			buildStatusCell.Color = ui.SucceededColor
+		case atc.StatusFailed:
+			buildStatusCell.Color = ui.FailedColor
+		case atc.StatusErrored:
+			buildStatusCell.Color = ui.ErroredColor
+		case atc.StatusAborted:
+			buildStatusCell.Color = ui.AbortedColor
+		}
+
+		statusCell := ui.TableCell{Contents: string(d.Status)}
+		switch d.Status {
+		case atc.NotificationDeliveryDelivered:
+			statusCell.Color = ui.SucceededColor
+		case atc.NotificationDeliveryFailed:
+			statusCell.Color = ui.FailedColor
+		case atc.NotificationDeliveryPending:
+			statusCell.Color = ui.PendingColor
+		}
+
+		errorCell := ui.TableCell{Contents: d.Error}
+		if d.Error == "" {
+			errorCell = ui.TableCell{Contents: "none", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(d.ID)},
+			{Contents: strconv.Itoa(d.BuildID)},
+			buildStatusCell,
+			statusCell,
+			{Contents: strconv.Itoa(d.Attempts)},
+			lastAttemptCell,
+			errorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
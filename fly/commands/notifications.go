@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type NotificationsCommand struct {
+	Json bool   `long:"json" description:"Print command result as JSON"`
+	Team string `long:"team" description:"Name of the team to list notifications for, if different from the target default"`
+}
+
+func (command *NotificationsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	subscriptions, err := team.Notifications()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(subscriptions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "url", Color: color.New(color.Bold)},
+			{Contents: "statuses", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, s := range subscriptions {
+		statuses := []string{}
+		for _, status := range s.Statuses {
+			statuses = append(statuses, string(status))
+		}
+
+		statusesCell := ui.TableCell{Contents: strings.Join(statuses, ",")}
+		if len(statuses) == 0 {
+			statusesCell.Contents = "all"
+			statusesCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: s.Name},
+			{Contents: s.URL},
+			statusesCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
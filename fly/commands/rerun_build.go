@@ -13,13 +13,14 @@ import (
 )
 
 type RerunBuildCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
-	Build string              `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the rerun build output"`
+	Job        flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
+	Build      string              `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
+	FromFailed bool                `long:"from-failed" description:"Skip the steps which succeeded in the build, reusing their outputs, and rerun from the failed step onward"`
+	Watch      bool                `short:"w" long:"watch" description:"Start watching the rerun build output"`
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
-	pipelineName, jobName, buildName := command.Job.PipelineName, command.Job.JobName, command.Build
+	pipelineName, jobName, buildName := command.Job.PipelineRef, command.Job.JobName, command.Build
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -31,7 +32,7 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().RerunJobBuild(pipelineName, jobName, buildName)
+	build, err := target.Team().RerunJobBuild(pipelineName, jobName, buildName, command.FromFailed)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type RevokeTokenCommand struct {
+	ID   int    `long:"id" required:"true" description:"ID of the API token to revoke"`
+	Team string `long:"team" description:"Name of the team to which the token belongs, if different from the target default"`
+}
+
+func (command *RevokeTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	found, err := team.RevokeAPIToken(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("token %d does not exist\n", command.ID)
+	} else {
+		fmt.Printf("token %d revoked\n", command.ID)
+	}
+
+	return nil
+}
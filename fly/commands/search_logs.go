@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Query    string                   `short:"q" long:"query" required:"true" description:"Words to search the build logs for"`
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Only search the builds of this pipeline"`
+	Job      flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Only search the builds of this job"`
+	Since    string                   `long:"since" description:"Only search builds started after this time"`
+	Until    string                   `long:"until" description:"Only search builds started before this time"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     string                   `long:"team" description:"Name of the team to search the build logs of, if different from the target default"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	search := concourse.BuildLogSearch{
+		Query:    command.Query,
+		Pipeline: command.Pipeline.Ref(),
+		Limit:    command.Count,
+	}
+
+	if command.Job.JobName != "" {
+		search.Pipeline = command.Job.PipelineRef
+		search.JobName = command.Job.JobName
+	}
+
+	if command.Since != "" {
+		search.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		search.Until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	results, err := team.SearchBuildLogs(search)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(results)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "start", Color: color.New(color.Bold)},
+			{Contents: "line", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, result := range results {
+		b := result.Build
+
+		pipelineJobCell := ui.TableCell{Contents: "one-off"}
+		buildCell := ui.TableCell{Contents: "n/a"}
+		if b.PipelineName != "" {
+			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", b.PipelineName, b.JobName)
+			buildCell.Contents = b.Name
+		}
+
+		startTimeCell, _, _ := populateTimeCells(time.Unix(b.StartTime, 0), time.Unix(b.EndTime, 0))
+
+		for _, line := range result.Lines {
+			table.Data = append(table.Data, []ui.TableCell{
+				{Contents: strconv.Itoa(b.ID)},
+				pipelineJobCell,
+				buildCell,
+				startTimeCell,
+				{Contents: line.Line},
+			})
+		}
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type SetNotificationCommand struct {
+	Name     string   `short:"n" long:"name"   required:"true" description:"Name of the notification"`
+	URL      string   `short:"u" long:"url"    required:"true" description:"URL to which finished builds are POSTed"`
+	Secret   string   `long:"secret"                           description:"Secret used to sign the payloads with HMAC-SHA256"`
+	Statuses []string `long:"status"                           description:"Only notify builds finishing with this status (can be specified multiple times)"`
+	Team     string   `long:"team" description:"Name of the team to which the notification belongs, if different from the target default"`
+}
+
+func (command *SetNotificationCommand) Execute([]string) error {
+	subscription := atc.NotificationSubscription{
+		Name:   command.Name,
+		URL:    command.URL,
+		Secret: command.Secret,
+	}
+
+	for _, status := range command.Statuses {
+		subscription.Statuses = append(subscription.Statuses, atc.BuildStatus(status))
+	}
+
+	err := subscription.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	err = team.SetNotification(subscription)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("notification %s saved\n", ui.Embolden("%s", subscription.Name))
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+// stepTemplateFile is the format of the files read by set-step-template and
+// printed by get-step-template.
+type stepTemplateFile struct {
+	Params []string    `json:"params,omitempty"`
+	Step   interface{} `json:"step"`
+}
+
+type SetStepTemplateCommand struct {
+	Name   string       `short:"n" long:"name"   required:"true" description:"Name of the step template"`
+	Config atc.PathFlag `short:"c" long:"config" required:"true" description:"Step template file, containing its params and step"`
+	Team   string       `long:"team" description:"Name of the team to which the step template belongs, if different from the target default"`
+}
+
+func (command *SetStepTemplateCommand) Execute([]string) error {
+	payload, err := ioutil.ReadFile(string(command.Config))
+	if err != nil {
+		return err
+	}
+
+	var file stepTemplateFile
+	err = yaml.UnmarshalStrict(payload, &file)
+	if err != nil {
+		return fmt.Errorf("malformed step template file: %s", err)
+	}
+
+	step, err := yaml.Marshal(file.Step)
+	if err != nil {
+		return err
+	}
+
+	if file.Step == nil {
+		step = nil
+	}
+
+	template := atc.StepTemplate{
+		Name:   command.Name,
+		Params: file.Params,
+		Step:   string(step),
+	}
+
+	err = template.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	saved, err := team.SetStepTemplate(template)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("step template %s saved as version %d\n", ui.Embolden("%s", saved.Name), saved.Version)
+
+	return nil
+}
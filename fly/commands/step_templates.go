@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type StepTemplatesCommand struct {
+	Json bool   `long:"json" description:"Print command result as JSON"`
+	Team string `long:"team" description:"Name of the team to list step templates for, if different from the target default"`
+}
+
+func (command *StepTemplatesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	templates, err := team.StepTemplates()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(templates)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "params", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, t := range templates {
+		paramsCell := ui.TableCell{Contents: strings.Join(t.Params, ",")}
+		if len(t.Params) == 0 {
+			paramsCell.Contents = "none"
+			paramsCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: t.Name},
+			{Contents: strconv.Itoa(t.Version)},
+			paramsCell,
+			{Contents: time.Unix(t.CreatedAt, 0).Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
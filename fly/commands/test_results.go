@@ -0,0 +1,202 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type TestResultsCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job"   value-name:"PIPELINE/JOB" description:"Name of a job whose test history to show"`
+	Build string              `short:"b" long:"build"                           description:"If job is specified: build number to show. If job not specified: build id"`
+	Count int                 `short:"c" long:"count" default:"10"              description:"Number of recent builds of the job whose results to show"`
+	Json  bool                `long:"json" description:"Print command result as JSON"`
+	Team  string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+}
+
+func (command *TestResultsCommand) Execute([]string) error {
+	if command.Build == "" && command.Job.JobName == "" {
+		return errors.New("either a build or a job must be specified")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	if command.Build != "" {
+		return command.showBuild(target.Client(), team)
+	}
+
+	return command.showJob(team)
+}
+
+func (command *TestResultsCommand) showBuild(client concourse.Client, team concourse.Team) error {
+	buildID := command.Build
+	if command.Job.JobName != "" {
+		build, found, err := team.JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("build does not exist")
+		}
+
+		buildID = strconv.Itoa(build.ID)
+	}
+
+	summary, found, err := client.BuildTestResults(buildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("build does not exist")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(summary)
+	}
+
+	fmt.Printf("%d passed, %d failed, %d errored, %d skipped\n", summary.Passed, summary.Failed, summary.Errored, summary.Skipped)
+
+	if len(summary.Tests) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+
+	table := ui.Table{Headers: testResultsHeaders("task", "suite", "name", "status", "duration", "message")}
+
+	for _, test := range summary.Tests {
+		message := strings.SplitN(test.Message, "\n", 2)[0]
+
+		row := ui.TableRow{
+			{Contents: test.Task},
+			{Contents: test.Suite},
+			{Contents: test.Name},
+			testStatusCell(test.Status),
+			{Contents: fmt.Sprintf("%.3fs", test.Duration)},
+		}
+
+		if message != "" {
+			row = append(row, ui.TableCell{Contents: message})
+		} else {
+			row = append(row, ui.TableCell{Contents: "n/a", Color: ui.OffColor})
+		}
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *TestResultsCommand) showJob(team concourse.Team) error {
+	pipelineName, jobName := command.Job.PipelineRef, command.Job.JobName
+
+	history, found, err := team.JobTestHistory(pipelineName, jobName, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found on team %s", pipelineName, jobName, team.Name())
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(history)
+	}
+
+	table := ui.Table{Headers: testResultsHeaders("task", "suite", "name", "last status", "passed", "failed", "flaky")}
+
+	for _, test := range history {
+		var passed, failed int
+		for _, run := range test.Runs {
+			switch run.Status {
+			case atc.TestStatusPassed:
+				passed++
+			case atc.TestStatusFailed, atc.TestStatusErrored:
+				failed++
+			}
+		}
+
+		row := ui.TableRow{
+			{Contents: test.Task},
+			{Contents: test.Suite},
+			{Contents: test.Name},
+		}
+
+		if len(test.Runs) > 0 {
+			row = append(row, testStatusCell(test.Runs[0].Status))
+		} else {
+			row = append(row, ui.TableCell{Contents: "n/a", Color: ui.OffColor})
+		}
+
+		row = append(row,
+			ui.TableCell{Contents: strconv.Itoa(passed)},
+			ui.TableCell{Contents: strconv.Itoa(failed)},
+		)
+
+		if test.Flaky {
+			row = append(row, ui.TableCell{Contents: "yes", Color: ui.FailedColor})
+		} else {
+			row = append(row, ui.TableCell{Contents: "no", Color: ui.OffColor})
+		}
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func testResultsHeaders(headers ...string) ui.TableRow {
+	row := ui.TableRow{}
+	for _, h := range headers {
+		row = append(row, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	return row
+}
+
+func testStatusCell(status atc.TestStatus) ui.TableCell {
+	cell := ui.TableCell{Contents: string(status)}
+
+	switch status {
+	case atc.TestStatusPassed:
+		cell.Color = ui.SucceededColor
+	case atc.TestStatusFailed:
+		cell.Color = ui.FailedColor
+	case atc.TestStatusErrored:
+		cell.Color = ui.ErroredColor
+	case atc.TestStatusSkipped:
+		cell.Color = ui.OffColor
+	}
+
+	return cell
+}
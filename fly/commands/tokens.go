@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	Json bool   `long:"json" description:"Print command result as JSON"`
+	Team string `long:"team" description:"Name of the team to list API tokens for, if different from the target default"`
+}
+
+func (command *TokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	tokens, err := team.APITokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(tokens)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "service account", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "actions", Color: color.New(color.Bold)},
+			{Contents: "expires", Color: color.New(color.Bold)},
+			{Contents: "last used", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, t := range tokens {
+		actionsCell := ui.TableCell{Contents: strings.Join(t.Actions, ",")}
+		if len(t.Actions) == 0 {
+			actionsCell = ui.TableCell{Contents: "all", Color: ui.OffColor}
+		}
+
+		expiresCell := ui.TableCell{Contents: "never", Color: ui.OffColor}
+		if t.ExpiresAt != 0 {
+			expiresCell = ui.TableCell{Contents: time.Unix(t.ExpiresAt, 0).Format(timeDateLayout)}
+		}
+
+		lastUsedCell := ui.TableCell{Contents: "never", Color: ui.OffColor}
+		if t.LastUsedAt != 0 {
+			lastUsedCell = ui.TableCell{Contents: time.Unix(t.LastUsedAt, 0).Format(timeDateLayout)}
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(t.ID)},
+			{Contents: t.ServiceAccount},
+			{Contents: t.Role},
+			actionsCell,
+			expiresCell,
+			lastUsedCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -3,6 +3,7 @@ package eventstream
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/concourse/concourse/atc/event"
@@ -51,6 +52,54 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.MemoizedTask:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mskipped, reusing the outputs of build #%s\x1b[0m\n", e.BuildName)
+
+		case event.ResumedStep:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mskipped, reusing the outputs of build #%s\x1b[0m\n", e.BuildName)
+
+		case event.AcrossIteration:
+			names := make([]string, 0, len(e.Vars))
+			for name := range e.Vars {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			labels := make([]string, len(names))
+			for i, name := range names {
+				labels[i] = fmt.Sprintf("%s: %v", name, e.Vars[name])
+			}
+
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1macross %s\x1b[0m\n", strings.Join(labels, ", "))
+
+		case event.WaitingForApproval:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mwaiting for approval of %s\x1b[0m\n", e.Name)
+
+			if e.Message != "" {
+				fmt.Fprintf(dstImpl, "%s\n", e.Message)
+			}
+
+		case event.ApprovalDecided:
+			decision := "rejected"
+			if e.Approved {
+				decision = "approved"
+			}
+
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1m%s by %s\x1b[0m\n", decision, e.DecidedBy)
+
+			if e.Comment != "" {
+				fmt.Fprintf(dstImpl, "%s\n", e.Comment)
+			}
+
+		case event.Superseded:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1msuperseded by build #%s\x1b[0m\n", e.BuildName)
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
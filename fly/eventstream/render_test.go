@@ -119,6 +119,109 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when an AcrossIteration event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.AcrossIteration{
+				Time: time.Now().Unix(),
+				Vars: map[string]interface{}{
+					"os": "linux",
+					"go": "1.14",
+				},
+			}
+		})
+
+		It("labels the iteration with its vars", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1macross go: 1.14, os: linux\x1b[0m\n"))
+		})
+	})
+
+	Context("when a WaitingForApproval event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForApproval{
+				Time:    time.Now().Unix(),
+				Name:    "ship-it",
+				Message: "ready to ship?",
+			}
+		})
+
+		It("prints the step awaiting approval and its message", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mwaiting for approval of ship-it\x1b[0m\nready to ship?\n"))
+		})
+	})
+
+	Context("when an ApprovalDecided event is received", func() {
+		Context("when it was approved", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.ApprovalDecided{
+					Time:      time.Now().Unix(),
+					Approved:  true,
+					DecidedBy: "some-user",
+					Comment:   "looks good",
+				}
+			})
+
+			It("prints who approved it and their comment", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mapproved by some-user\x1b[0m\nlooks good\n"))
+			})
+		})
+
+		Context("when it was rejected", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.ApprovalDecided{
+					Time:      time.Now().Unix(),
+					Approved:  false,
+					DecidedBy: "some-user",
+				}
+			})
+
+			It("prints who rejected it", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mrejected by some-user\x1b[0m\n"))
+			})
+		})
+	})
+
+	Context("when a Superseded event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Superseded{
+				Time:      time.Now().Unix(),
+				BuildID:   42,
+				BuildName: "7",
+			}
+		})
+
+		It("prints the build which superseded it", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1msuperseded by build #7\x1b[0m\n"))
+		})
+	})
+
+	Context("when a MemoizedTask event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.MemoizedTask{
+				Time:      time.Now().Unix(),
+				BuildID:   42,
+				BuildName: "7",
+			}
+		})
+
+		It("prints the build whose outputs are reused", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mskipped, reusing the outputs of build #7\x1b[0m\n"))
+		})
+	})
+
+	Context("when a ResumedStep event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.ResumedStep{
+				Time:      time.Now().Unix(),
+				BuildID:   42,
+				BuildName: "7",
+			}
+		})
+
+		It("prints the build whose outputs are reused", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mskipped, reusing the outputs of build #7\x1b[0m\n"))
+		})
+	})
+
 	Context("and a StartTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.StartTask{
@@ -0,0 +1,219 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("create-token", func() {
+		createdToken := atc.APIToken{
+			ID:             3,
+			TeamName:       "main",
+			ServiceAccount: "deployer",
+			Role:           "member",
+			Token:          "cst_some-secret-token",
+		}
+
+		Context("when the token never expires", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/service_accounts/deployer/api_tokens"),
+						ghttp.VerifyJSONRepresenting(atc.APITokenRequest{
+							Role:    "member",
+							Actions: []string{atc.CreateJobBuild, atc.GetBuild},
+						}),
+						ghttp.RespondWithJSONEncoded(201, createdToken),
+					),
+				)
+			})
+
+			It("prints the token once", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token",
+					"-s", "deployer",
+					"--role", "member",
+					"--action", "CreateJobBuild",
+					"--action", "GetBuild",
+					"--expires-in", "0",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("token 3 created for service account deployer"))
+				Expect(sess.Out).To(gbytes.Say("cst_some-secret-token"))
+				Expect(sess.Out).To(gbytes.Say("this token will not be shown again"))
+			})
+		})
+
+		Context("when no expiry is given", func() {
+			var expiresAt int64
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/service_accounts/deployer/api_tokens"),
+						func(w http.ResponseWriter, r *http.Request) {
+							var request atc.APITokenRequest
+							err := json.NewDecoder(r.Body).Decode(&request)
+							Expect(err).NotTo(HaveOccurred())
+
+							expiresAt = request.ExpiresAt
+						},
+						ghttp.RespondWithJSONEncoded(201, createdToken),
+					),
+				)
+			})
+
+			It("expires the token in 90 days", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token", "-s", "deployer")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(time.Unix(expiresAt, 0)).To(BeTemporally("~", time.Now().Add(90*24*time.Hour), time.Minute))
+			})
+		})
+
+		Context("when the action is unknown", func() {
+			It("fails without creating a token", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token",
+					"-s", "deployer",
+					"--action", "DoEverything",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("unknown action 'DoEverything'"))
+			})
+		})
+	})
+
+	Describe("tokens", func() {
+		var expiresAt, lastUsedAt time.Time
+
+		BeforeEach(func() {
+			expiresAt = time.Unix(1592400000, 0)
+			lastUsedAt = time.Unix(1584600000, 0)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/api_tokens"),
+					ghttp.RespondWithJSONEncoded(200, []atc.APIToken{
+						{
+							ID:             1,
+							TeamName:       "main",
+							ServiceAccount: "deployer",
+							Role:           "member",
+							Actions:        []string{atc.CreateJobBuild, atc.GetBuild},
+							ExpiresAt:      expiresAt.Unix(),
+							LastUsedAt:     lastUsedAt.Unix(),
+						},
+						{
+							ID:             2,
+							TeamName:       "main",
+							ServiceAccount: "monitor",
+							Role:           "viewer",
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them to the user", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "service account", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "actions", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "1"},
+						{Contents: "deployer"},
+						{Contents: "member"},
+						{Contents: "CreateJobBuild,GetBuild"},
+						{Contents: expiresAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: lastUsedAt.Format("2006-01-02@15:04:05-0700")},
+					},
+					{
+						{Contents: "2"},
+						{Contents: "monitor"},
+						{Contents: "viewer"},
+						{Contents: "all", Color: ui.OffColor},
+						{Contents: "never", Color: ui.OffColor},
+						{Contents: "never", Color: ui.OffColor},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("revoke-token", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/api_tokens/3"),
+					ghttp.RespondWith(204, ""),
+				),
+			)
+		})
+
+		It("revokes it", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "--id", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("token 3 revoked"))
+		})
+	})
+
+	Describe("destroy-service-account", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/service_accounts/deployer"),
+					ghttp.RespondWith(204, ""),
+				),
+			)
+		})
+
+		It("destroys it", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-service-account", "-s", "deployer", "--non-interactive")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("`deployer` deleted"))
+		})
+	})
+})
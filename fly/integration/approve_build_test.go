@@ -0,0 +1,169 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("ApproveBuild", func() {
+	var (
+		approved = true
+
+		expectedBuild = atc.Build{
+			ID:      23,
+			Name:    "42",
+			Status:  "started",
+			JobName: "myjob",
+			APIURL:  "api/v1/builds/23",
+		}
+
+		approvals []atc.BuildStepApproval
+		args      []string
+	)
+
+	BeforeEach(func() {
+		approvals = []atc.BuildStepApproval{
+			{PlanID: "decided-plan-id", Name: "qa", Approved: &approved},
+			{PlanID: "some-plan-id", Name: "ship-it"},
+		}
+
+		args = []string{"-t", targetName, "approve-build", "-b", "23"}
+	})
+
+	JustBeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/builds/23/approvals"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, approvals),
+			),
+		)
+	})
+
+	Context("when the build is waiting on one approval", func() {
+		BeforeEach(func() {
+			atcServer.RouteToHandler("PUT", "/api/v1/builds/23/steps/some-plan-id/approve",
+				ghttp.CombineHandlers(
+					ghttp.VerifyJSONRepresenting(atc.BuildStepDecision{Comment: "lgtm"}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+			atcServer.RouteToHandler("PUT", "/api/v1/builds/23/steps/some-plan-id/reject",
+				ghttp.CombineHandlers(
+					ghttp.VerifyJSONRepresenting(atc.BuildStepDecision{Comment: "lgtm"}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+
+			args = append(args, "-m", "lgtm")
+		})
+
+		It("approves the pending step", func() {
+			flyCmd := exec.Command(flyPath, args...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("approved step 'ship-it'"))
+		})
+
+		Context("when rejecting", func() {
+			BeforeEach(func() {
+				args = append(args, "--reject")
+			})
+
+			It("rejects the pending step", func() {
+				flyCmd := exec.Command(flyPath, args...)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("rejected step 'ship-it'"))
+			})
+		})
+
+		Context("when the approval was decided in the meantime", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("PUT", "/api/v1/builds/23/steps/some-plan-id/approve",
+					ghttp.RespondWith(http.StatusConflict, ""),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, args...)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("approval-already-decided"))
+			})
+		})
+	})
+
+	Context("when the build is waiting on more than one approval", func() {
+		BeforeEach(func() {
+			approvals = append(approvals, atc.BuildStepApproval{PlanID: "other-plan-id", Name: "ship-it-again"})
+		})
+
+		It("asks the user to specify a step", func() {
+			flyCmd := exec.Command(flyPath, args...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("specify one with --step: ship-it, ship-it-again"))
+		})
+
+		Context("when a step is specified", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("PUT", "/api/v1/builds/23/steps/other-plan-id/approve",
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				)
+
+				args = append(args, "-s", "ship-it-again")
+			})
+
+			It("approves that step", func() {
+				flyCmd := exec.Command(flyPath, args...)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("approved step 'ship-it-again'"))
+			})
+		})
+	})
+
+	Context("when the build is not waiting for approval", func() {
+		BeforeEach(func() {
+			approvals = approvals[:1]
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, args...)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("build is not waiting for approval"))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit-events", func() {
+		var (
+			flyCmd *exec.Cmd
+			now    time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Unix(1584100000, 0)
+			flyCmd = exec.Command(flyPath, "-t", targetName, "audit-events", "--count", "2")
+		})
+
+		Context("when audit events are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/audit_events", "limit=2"),
+						ghttp.RespondWithJSONEncoded(200, []atc.AuditEvent{
+							{
+								ID:       2,
+								Time:     now.Unix(),
+								Action:   atc.PausePipeline,
+								Actor:    "some-user",
+								SourceIP: "1.2.3.4",
+								TeamName: "main",
+								Targets:  map[string]string{"pipeline_name": "some-pipeline", "team_name": "main"},
+								Status:   200,
+							},
+							{
+								ID:       1,
+								Time:     now.Unix(),
+								Action:   atc.SetTeam,
+								Actor:    "some-admin",
+								SourceIP: "5.6.7.8",
+								TeamName: "main",
+								Status:   403,
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists them to the user", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "actor", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "targets", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "source ip", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: now.Format("2006-01-02@15:04:05-0700")},
+							{Contents: "some-user"},
+							{Contents: "PausePipeline"},
+							{Contents: "pipeline_name=some-pipeline,team_name=main"},
+							{Contents: "200"},
+							{Contents: "1.2.3.4"},
+						},
+						{
+							{Contents: "1"},
+							{Contents: now.Format("2006-01-02@15:04:05-0700")},
+							{Contents: "some-admin"},
+							{Contents: "SetTeam"},
+							{Contents: ""},
+							{Contents: "403", Color: ui.FailedColor},
+							{Contents: "5.6.7.8"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the api returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/audit_events"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
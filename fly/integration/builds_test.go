@@ -320,6 +320,61 @@ var _ = Describe("Fly CLI", func() {
 					Eventually(session).Should(gexec.Exit(1))
 				})
 			})
+
+			Context("when specifying --annotation and --pipeline", func() {
+				BeforeEach(func() {
+					cmdArgs = append(cmdArgs, "--annotation", "env:prod",
+						"-p", "some-pipeline")
+				})
+
+				It("instructs the user that the filter is not supported", func() {
+					Eventually(session.Err).Should(gbytes.Say("Cannot specify --annotation with --pipeline, --job, --team, --current-team or --all-teams"))
+					Eventually(session).Should(gexec.Exit(1))
+				})
+			})
+
+			Context("when specifying a malformed --annotation", func() {
+				BeforeEach(func() {
+					cmdArgs = append(cmdArgs, "--annotation", "env")
+				})
+
+				It("instructs the user to use the key:value form", func() {
+					Eventually(session.Err).Should(gbytes.Say("invalid annotation 'env': must be of the form 'key:value'"))
+					Eventually(session).Should(gexec.Exit(1))
+				})
+			})
+		})
+
+		Context("when passing the annotation argument", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--annotation", "env:prod", "--annotation", "branch:main", "--json")
+
+				expectedURL = "/api/v1/builds"
+				queryParams = "limit=50&annotation=branch%3Amain&annotation=env%3Aprod"
+
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:          39,
+						Status:      "succeeded",
+						Annotations: map[string]string{"env": "prod", "branch": "main"},
+					},
+				}
+			})
+
+			It("filters builds by the annotations and prints them", func() {
+				Eventually(session).Should(gexec.Exit(0))
+				Expect(session.Out.Contents()).To(MatchJSON(`[
+					{
+						"id": 39,
+						"team_name": "",
+						"name": "",
+						"status": "succeeded",
+						"api_url": "",
+						"annotations": {"env": "prod", "branch": "main"}
+					}
+				]`))
+			})
 		})
 
 		Context("when passing the limit argument", func() {
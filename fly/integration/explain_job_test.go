@@ -0,0 +1,174 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("explain-job", func() {
+		var (
+			path        string
+			explanation atc.SchedulingExplanation
+		)
+
+		BeforeEach(func() {
+			var err error
+			path, err = atc.Routes.CreatePathForRoute(atc.GetJobSchedulingExplanation, map[string]string{
+				"pipeline_name": "some-pipeline",
+				"job_name":      "some-job",
+				"team_name":     "main",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			explanation = atc.SchedulingExplanation{
+				JobPaused: true,
+				Inputs: []atc.InputSchedulingExplanation{
+					{
+						Name:            "some-input",
+						Resource:        "some-resource",
+						Trigger:         true,
+						Version:         atc.Version{"ref": "abc"},
+						FirstOccurrence: true,
+					},
+					{
+						Name:     "some-passed-input",
+						Resource: "some-other-resource",
+						Passed:   []string{"job-a", "job-b"},
+						Reason:   atc.InputSchedulingNoVersionPassedUpstream,
+						Message:  "no satisfiable builds from passed jobs found for set of inputs",
+					},
+				},
+				PendingBuild: &atc.BuildPreparation{
+					BuildID:          42,
+					MaxRunningBuilds: atc.BuildPreparationStatusBlocking,
+					QueuePosition:    3,
+				},
+			}
+		})
+
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWithJSONEncoded(200, explanation),
+					),
+				)
+			})
+
+			It("explains the scheduling of the job", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("job is paused"))
+				Expect(sess.Out).To(gbytes.Say("build 42 is pending"))
+				Expect(sess.Out).To(gbytes.Say("max running builds reached"))
+				Expect(sess.Out).To(gbytes.Say("queued at position 3"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "resource", Color: color.New(color.Bold)},
+						{Contents: "trigger", Color: color.New(color.Bold)},
+						{Contents: "passed", Color: color.New(color.Bold)},
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "reason", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "some-input"},
+							{Contents: "some-resource"},
+							{Contents: "yes"},
+							{Contents: "none", Color: ui.OffColor},
+							{Contents: "ref:abc"},
+							{Contents: "none", Color: ui.OffColor},
+						},
+						{
+							{Contents: "some-passed-input"},
+							{Contents: "some-other-resource"},
+							{Contents: "no", Color: ui.OffColor},
+							{Contents: "job-a,job-b"},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "no-version-passed-upstream: no satisfiable builds from passed jobs found for set of inputs", Color: ui.FailedColor},
+						},
+					},
+				}))
+			})
+
+			It("prints the explanation as JSON", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "some-pipeline/some-job", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`{
+					"pipeline_paused": false,
+					"job_paused": true,
+					"inputs_determined": false,
+					"inputs": [
+						{
+							"name": "some-input",
+							"resource": "some-resource",
+							"trigger": true,
+							"version": {"ref": "abc"},
+							"first_occurrence": true
+						},
+						{
+							"name": "some-passed-input",
+							"resource": "some-other-resource",
+							"trigger": false,
+							"passed": ["job-a", "job-b"],
+							"first_occurrence": false,
+							"reason": "no-version-passed-upstream",
+							"message": "no satisfiable builds from passed jobs found for set of inputs"
+						}
+					],
+					"pending_build": {
+						"build_id": 42,
+						"paused_pipeline": "",
+						"paused_job": "",
+						"max_running_builds": "blocking",
+						"inputs": null,
+						"inputs_satisfied": "",
+						"missing_input_reasons": null,
+						"queue_position": 3
+					}
+				}`))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("some-pipeline/some-job not found on team main"))
+			})
+		})
+	})
+})
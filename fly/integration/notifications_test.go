@@ -0,0 +1,214 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("notifications", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/notifications"),
+					ghttp.RespondWithJSONEncoded(200, []atc.NotificationSubscription{
+						{
+							Name:     "chat",
+							URL:      "https://chat.example.com/hooks",
+							Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+						},
+						{
+							Name: "deploy-tracker",
+							URL:  "https://deploys.example.com/builds",
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them to the user", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notifications")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "url", Color: color.New(color.Bold)},
+					{Contents: "statuses", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "chat"},
+						{Contents: "https://chat.example.com/hooks"},
+						{Contents: "failed,errored"},
+					},
+					{
+						{Contents: "deploy-tracker"},
+						{Contents: "https://deploys.example.com/builds"},
+						{Contents: "all", Color: ui.OffColor},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("set-notification", func() {
+		Context("when the notification is valid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/notifications/chat"),
+						ghttp.VerifyJSONRepresenting(atc.NotificationSubscription{
+							Name:     "chat",
+							URL:      "https://chat.example.com/hooks",
+							Secret:   "some-secret",
+							Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+						}),
+						ghttp.RespondWith(204, ""),
+					),
+				)
+			})
+
+			It("saves it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-notification",
+					"-n", "chat",
+					"-u", "https://chat.example.com/hooks",
+					"--secret", "some-secret",
+					"--status", "failed",
+					"--status", "errored",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("notification chat saved"))
+			})
+		})
+
+		Context("when the status is unknown", func() {
+			It("fails without saving it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-notification",
+					"-n", "chat",
+					"-u", "https://chat.example.com/hooks",
+					"--status", "bogus",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("notification has unknown build status 'bogus'"))
+			})
+		})
+	})
+
+	Describe("destroy-notification", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/notifications/chat"),
+					ghttp.RespondWith(204, ""),
+				),
+			)
+		})
+
+		It("destroys it", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-notification", "-n", "chat", "--non-interactive")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("`chat` deleted"))
+		})
+	})
+
+	Describe("notification-deliveries", func() {
+		var lastAttempt time.Time
+
+		BeforeEach(func() {
+			lastAttempt = time.Unix(1584600000, 0)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/notifications/chat/deliveries", "limit=50"),
+					ghttp.RespondWithJSONEncoded(200, []atc.NotificationDelivery{
+						{
+							ID:             2,
+							Notification:   "chat",
+							BuildID:        43,
+							BuildStatus:    atc.StatusFailed,
+							Status:         atc.NotificationDeliveryPending,
+							Attempts:       1,
+							ResponseStatus: 503,
+							Error:          "unexpected response status 503",
+							LastAttemptAt:  lastAttempt.Unix(),
+						},
+						{
+							ID:           1,
+							Notification: "chat",
+							BuildID:      42,
+							BuildStatus:  atc.StatusSucceeded,
+							Status:       atc.NotificationDeliveryPending,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them to the user", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notification-deliveries", "-n", "chat")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "build", Color: color.New(color.Bold)},
+					{Contents: "build status", Color: color.New(color.Bold)},
+					{Contents: "status", Color: color.New(color.Bold)},
+					{Contents: "attempts", Color: color.New(color.Bold)},
+					{Contents: "last attempt", Color: color.New(color.Bold)},
+					{Contents: "error", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "2"},
+						{Contents: "43"},
+						{Contents: "failed", Color: ui.FailedColor},
+						{Contents: "pending", Color: ui.PendingColor},
+						{Contents: "1"},
+						{Contents: lastAttempt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "unexpected response status 503"},
+					},
+					{
+						{Contents: "1"},
+						{Contents: "42"},
+						{Contents: "succeeded", Color: ui.SucceededColor},
+						{Contents: "pending", Color: ui.PendingColor},
+						{Contents: "0"},
+						{Contents: "n/a", Color: ui.OffColor},
+						{Contents: "none", Color: ui.OffColor},
+					},
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,139 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("search-logs", func() {
+		var (
+			flyCmd *exec.Cmd
+			start  time.Time
+		)
+
+		BeforeEach(func() {
+			start = time.Unix(1584100000, 0)
+			flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "-q", "connection refused", "-j", "some-pipeline/some-job", "-c", "2")
+		})
+
+		Context("when builds are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search", "job_name=some-job&limit=2&pipeline_name=some-pipeline&q=connection+refused"),
+						ghttp.RespondWithJSONEncoded(200, []atc.BuildLogSearchResult{
+							{
+								Build: atc.Build{
+									ID:           12,
+									Name:         "3",
+									PipelineName: "some-pipeline",
+									JobName:      "some-job",
+									TeamName:     "main",
+									Status:       "failed",
+									StartTime:    start.Unix(),
+								},
+								Lines: []atc.BuildLogLine{
+									{Origin: "some-plan-id", Line: "dial tcp: connection refused"},
+									{Origin: "some-plan-id", Line: "retrying: connection refused"},
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the matching lines of each build", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "pipeline/job", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "start", Color: color.New(color.Bold)},
+						{Contents: "line", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "12"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "3"},
+							{Contents: start.Format("2006-01-02@15:04:05-0700")},
+							{Contents: "dial tcp: connection refused"},
+						},
+						{
+							{Contents: "12"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "3"},
+							{Contents: start.Format("2006-01-02@15:04:05-0700")},
+							{Contents: "retrying: connection refused"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when searching the builds of a pipeline instance", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "-q", "connection refused", "-p", "some-pipeline/branch:feature")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search", `instance_vars=%7B%22branch%22%3A%22feature%22%7D&limit=50&pipeline_name=some-pipeline&q=connection+refused`),
+						ghttp.RespondWithJSONEncoded(200, []atc.BuildLogSearchResult{}),
+					),
+				)
+			})
+
+			It("passes the instance vars along", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the API returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/builds/search"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("exits 1", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+
+		Context("when no query is given", func() {
+			It("fails with an error", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("query"))
+			})
+		})
+	})
+})
@@ -0,0 +1,227 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("step-templates", func() {
+		var (
+			flyCmd *exec.Cmd
+			now    time.Time
+		)
+
+		BeforeEach(func() {
+			now = time.Unix(1584300000, 0)
+			flyCmd = exec.Command(flyPath, "-t", targetName, "step-templates")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/step_templates"),
+					ghttp.RespondWithJSONEncoded(200, []atc.StepTemplate{
+						{
+							Name:      "deploy",
+							Version:   3,
+							Params:    []string{"env", "version"},
+							Step:      "put: ((env))",
+							CreatedAt: now.Unix(),
+						},
+						{
+							Name:      "smoke-test",
+							Version:   1,
+							Step:      "task: smoke-test",
+							CreatedAt: now.Unix(),
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists them to the user", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "version", Color: color.New(color.Bold)},
+					{Contents: "params", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "deploy"},
+						{Contents: "3"},
+						{Contents: "env,version"},
+						{Contents: now.Format("2006-01-02@15:04:05-0700")},
+					},
+					{
+						{Contents: "smoke-test"},
+						{Contents: "1"},
+						{Contents: "none", Color: ui.OffColor},
+						{Contents: now.Format("2006-01-02@15:04:05-0700")},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("set-step-template", func() {
+		var (
+			tmpdir     string
+			configFile string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "fly-step-template")
+			Expect(err).NotTo(HaveOccurred())
+
+			configFile = filepath.Join(tmpdir, "template.yml")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		Context("when the file is valid", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(configFile, []byte(`
+params: [env]
+step:
+  put: ((env))
+  params:
+    token: ((deploy-token))
+`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/step_templates/deploy"),
+						ghttp.VerifyJSONRepresenting(atc.StepTemplate{
+							Name:   "deploy",
+							Params: []string{"env"},
+							Step:   "params:\n  token: ((deploy-token))\nput: ((env))\n",
+						}),
+						ghttp.RespondWithJSONEncoded(201, atc.StepTemplate{
+							Name:    "deploy",
+							Version: 2,
+						}),
+					),
+				)
+			})
+
+			It("saves a new version of the template", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-step-template", "-n", "deploy", "-c", configFile)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("step template deploy saved as version 2"))
+			})
+		})
+
+		Context("when the file has no step", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(configFile, []byte("params: [env]\n"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails without saving it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-step-template", "-n", "deploy", "-c", configFile)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("step template has no step"))
+			})
+		})
+	})
+
+	Describe("get-step-template", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/step_templates/deploy", "version=1"),
+					ghttp.RespondWithJSONEncoded(200, atc.StepTemplate{
+						Name:    "deploy",
+						Version: 1,
+						Params:  []string{"env"},
+						Step:    "put: ((env))\n",
+					}),
+				),
+			)
+		})
+
+		It("prints the template in the format of set-step-template", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "get-step-template", "-n", "deploy", "-v", "1")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(string(sess.Out.Contents())).To(Equal("params:\n- env\nstep:\n  put: ((env))\n"))
+		})
+	})
+
+	Describe("destroy-step-template", func() {
+		Context("when the template exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/step_templates/deploy"),
+						ghttp.RespondWith(204, ""),
+					),
+				)
+			})
+
+			It("destroys it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-step-template", "-n", "deploy", "--non-interactive")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("`deploy` deleted"))
+			})
+		})
+
+		Context("when the template does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/step_templates/deploy"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-step-template", "-n", "deploy", "--non-interactive")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("`deploy` does not exist"))
+			})
+		})
+	})
+})
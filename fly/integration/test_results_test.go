@@ -0,0 +1,145 @@
+package integration_test
+
+import (
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("test-results", func() {
+		var flyCmd *exec.Cmd
+
+		Context("when a build is specified", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "test-results", "-b", "12")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/12/test_results"),
+						ghttp.RespondWithJSONEncoded(200, atc.TestSummary{
+							Passed: 1,
+							Failed: 1,
+							Tests: []atc.TestResult{
+								{Task: "unit", Suite: "api", Name: "lists builds", Status: atc.TestStatusPassed, Duration: 0.5},
+								{Task: "unit", Suite: "api", Name: "creates builds", Status: atc.TestStatusFailed, Message: "expected 201\nstack trace"},
+							},
+						}),
+					),
+				)
+			})
+
+			It("prints the summary and the tests of the build", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("1 passed, 1 failed, 0 errored, 0 skipped"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "task", Color: color.New(color.Bold)},
+						{Contents: "suite", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "message", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "unit"},
+							{Contents: "api"},
+							{Contents: "lists builds"},
+							{Contents: "passed", Color: ui.SucceededColor},
+							{Contents: "0.500s"},
+							{Contents: "n/a", Color: ui.OffColor},
+						},
+						{
+							{Contents: "unit"},
+							{Contents: "api"},
+							{Contents: "creates builds"},
+							{Contents: "failed", Color: ui.FailedColor},
+							{Contents: "0.000s"},
+							{Contents: "expected 201"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when a job is specified", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "test-results", "-j", "some-pipeline/some-job", "-c", "5")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/test_history", "limit=5"),
+						ghttp.RespondWithJSONEncoded(200, []atc.TestHistory{
+							{
+								Task:  "unit",
+								Suite: "api",
+								Name:  "creates builds",
+								Flaky: true,
+								Runs: []atc.TestRun{
+									{BuildID: 13, BuildName: "4", Status: atc.TestStatusPassed},
+									{BuildID: 12, BuildName: "3", Status: atc.TestStatusFailed},
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("prints the test history of the job", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "task", Color: color.New(color.Bold)},
+						{Contents: "suite", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "last status", Color: color.New(color.Bold)},
+						{Contents: "passed", Color: color.New(color.Bold)},
+						{Contents: "failed", Color: color.New(color.Bold)},
+						{Contents: "flaky", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "unit"},
+							{Contents: "api"},
+							{Contents: "creates builds"},
+							{Contents: "passed", Color: ui.SucceededColor},
+							{Contents: "1"},
+							{Contents: "1"},
+							{Contents: "yes", Color: ui.FailedColor},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when neither a build nor a job is specified", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "test-results")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("either a build or a job must be specified"))
+			})
+		})
+	})
+})
@@ -238,5 +238,26 @@ var _ = Describe("Watching", func() {
 				watch("--url", atcServer.URL()+"/teams/main/pipelines/main/jobs/some-job/builds/3")
 			})
 		})
+
+		Context("with a specific build of a job of a pipeline instance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/main/jobs/some-job/builds/3", `instance_vars=%7B%22branch%22%3A%22feature%22%7D`),
+						ghttp.RespondWithJSONEncoded(200, atc.Build{
+							ID:      3,
+							Name:    "3",
+							Status:  "failed",
+							JobName: "some-job",
+						}),
+					),
+					eventsHandler(),
+				)
+			})
+
+			It("watches the given build URL of the instance", func() {
+				watch("--url", atcServer.URL()+`/teams/main/pipelines/main/jobs/some-job/builds/3?instance_vars=%7B%22branch%22%3A%22feature%22%7D`)
+			})
+		})
 	})
 })
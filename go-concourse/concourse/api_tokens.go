@@ -0,0 +1,97 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) APITokens() ([]atc.APIToken, error) {
+	var tokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+		Params:      rata.Params{"team_name": team.name},
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+// CreateAPIToken creates a token for the service account, creating the
+// account if it does not exist yet. The returned token includes the token
+// itself, which cannot be retrieved again.
+func (team *team) CreateAPIToken(serviceAccount string, request atc.APITokenRequest) (atc.APIToken, error) {
+	params := rata.Params{
+		"team_name":            team.name,
+		"service_account_name": serviceAccount,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(request)
+	if err != nil {
+		return atc.APIToken{}, fmt.Errorf("Unable to marshal api token request: %s", err)
+	}
+
+	var token atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &token,
+	})
+
+	return token, err
+}
+
+func (team *team) RevokeAPIToken(id int) (bool, error) {
+	params := rata.Params{
+		"team_name":    team.name,
+		"api_token_id": strconv.Itoa(id),
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeAPIToken,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) DestroyServiceAccount(name string) (bool, error) {
+	params := rata.Params{
+		"team_name":            team.name,
+		"service_account_name": name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyServiceAccount,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
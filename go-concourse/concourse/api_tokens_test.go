@@ -0,0 +1,153 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler API Tokens", func() {
+	Describe("team.APITokens", func() {
+		expectedURL := "/api/v1/teams/some-team/api_tokens"
+
+		var expectedTokens []atc.APIToken
+
+		BeforeEach(func() {
+			expectedTokens = []atc.APIToken{
+				{
+					ID:             1,
+					TeamName:       "some-team",
+					ServiceAccount: "deployer",
+					Role:           "member",
+					Actions:        []string{atc.CreateJobBuild},
+					CreatedAt:      1000,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTokens),
+				),
+			)
+		})
+
+		It("returns the team's tokens", func() {
+			tokens, err := team.APITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(expectedTokens))
+		})
+	})
+
+	Describe("team.CreateAPIToken", func() {
+		expectedURL := "/api/v1/teams/some-team/service_accounts/deployer/api_tokens"
+
+		request := atc.APITokenRequest{
+			Role:      "member",
+			Actions:   []string{atc.CreateJobBuild},
+			ExpiresAt: 2000,
+		}
+
+		It("creates the token", func() {
+			expectedToken := atc.APIToken{
+				ID:             1,
+				TeamName:       "some-team",
+				ServiceAccount: "deployer",
+				Role:           "member",
+				Actions:        []string{atc.CreateJobBuild},
+				ExpiresAt:      2000,
+				CreatedAt:      1000,
+				Token:          "cst_some-token",
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyJSONRepresenting(request),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedToken),
+				),
+			)
+
+			token, err := team.CreateAPIToken("deployer", request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal(expectedToken))
+		})
+
+		It("returns the error of an invalid request", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWith(http.StatusBadRequest, "unknown role 'admin'"),
+				),
+			)
+
+			_, err := team.CreateAPIToken("deployer", request)
+			Expect(err).To(MatchError(ContainSubstring("unknown role 'admin'")))
+		})
+	})
+
+	Describe("team.RevokeAPIToken", func() {
+		expectedURL := "/api/v1/teams/some-team/api_tokens/1"
+
+		It("returns true when the token is revoked", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			revoked, err := team.RevokeAPIToken(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+		})
+
+		It("returns false when the token does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			revoked, err := team.RevokeAPIToken(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+		})
+	})
+
+	Describe("team.DestroyServiceAccount", func() {
+		expectedURL := "/api/v1/teams/some-team/service_accounts/deployer"
+
+		It("returns true when the service account is destroyed", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			destroyed, err := team.DestroyServiceAccount("deployer")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeTrue())
+		})
+
+		It("returns false when the service account does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			destroyed, err := team.DestroyServiceAccount("deployer")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package concourse
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) AuditEvents(page Page) ([]atc.AuditEvent, Pagination, error) {
+	var auditEvents []atc.AuditEvent
+
+	headers := http.Header{}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamAuditEvents,
+		Params:      rata.Params{"team_name": team.name},
+		Query:       page.QueryParams(),
+	}, &internal.Response{
+		Result:  &auditEvents,
+		Headers: &headers,
+	})
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	pagination, err := paginationFromHeaders(headers)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return auditEvents, pagination, nil
+}
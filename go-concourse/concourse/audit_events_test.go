@@ -0,0 +1,95 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Audit Events", func() {
+	Describe("team.AuditEvents", func() {
+		expectedURL := "/api/v1/teams/some-team/audit_events"
+
+		var (
+			expectedEvents []atc.AuditEvent
+			page           concourse.Page
+
+			auditEvents []atc.AuditEvent
+			pagination  concourse.Pagination
+			teamErr     error
+		)
+
+		BeforeEach(func() {
+			page = concourse.Page{}
+
+			expectedEvents = []atc.AuditEvent{
+				{
+					ID:       2,
+					Time:     100,
+					Action:   atc.PausePipeline,
+					Actor:    "some-user",
+					TeamName: "some-team",
+					Targets:  map[string]string{"pipeline_name": "some-pipeline"},
+					Status:   200,
+				},
+				{
+					ID:       1,
+					Time:     50,
+					Action:   atc.SetTeam,
+					Actor:    "some-admin",
+					TeamName: "some-team",
+					Status:   403,
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			auditEvents, pagination, teamErr = team.AuditEvents(page)
+		})
+
+		Context("when a page is specified", func() {
+			BeforeEach(func() {
+				page = concourse.Page{Since: 24, Limit: 5}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "since=24&limit=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents, http.Header{
+							"Link": []string{
+								`<http://some-url.com/api/v1/teams/some-team/audit_events?until=452&limit=5>; rel="previous"`,
+								`<http://some-url.com/api/v1/teams/some-team/audit_events?since=1&limit=5>; rel="next"`,
+							},
+						}),
+					),
+				)
+			})
+
+			It("returns the audit events and the pagination data", func() {
+				Expect(teamErr).NotTo(HaveOccurred())
+				Expect(auditEvents).To(Equal(expectedEvents))
+				Expect(pagination.Previous).To(Equal(&concourse.Page{Until: 452, Limit: 5}))
+				Expect(pagination.Next).To(Equal(&concourse.Page{Since: 1, Limit: 5}))
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				Expect(teamErr).To(HaveOccurred())
+			})
+		})
+	})
+})
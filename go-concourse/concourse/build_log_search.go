@@ -0,0 +1,75 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// BuildLogSearch filters the builds whose logs are searched. Only Query is
+// required.
+type BuildLogSearch struct {
+	Query string
+
+	// Pipeline restricts the search to a single pipeline instance when its
+	// name is set.
+	Pipeline atc.PipelineRef
+	JobName  string
+
+	// Since and Until bound the start time of the builds.
+	Since time.Time
+	Until time.Time
+
+	Limit int
+}
+
+func (search BuildLogSearch) QueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Add(atc.SearchBuildLogsQuery, search.Query)
+
+	if search.Pipeline.Name != "" {
+		queryParams.Add(atc.SearchBuildLogsQueryPipeline, search.Pipeline.Name)
+
+		for k, v := range search.Pipeline.QueryParams() {
+			queryParams[k] = v
+		}
+	}
+
+	if search.JobName != "" {
+		queryParams.Add(atc.SearchBuildLogsQueryJob, search.JobName)
+	}
+
+	if !search.Since.IsZero() {
+		queryParams.Add(atc.PaginationQuerySince, strconv.FormatInt(search.Since.Unix(), 10))
+	}
+
+	if !search.Until.IsZero() {
+		queryParams.Add(atc.PaginationQueryUntil, strconv.FormatInt(search.Until.Unix(), 10))
+	}
+
+	if search.Limit > 0 {
+		queryParams.Add(atc.PaginationQueryLimit, strconv.Itoa(search.Limit))
+	}
+
+	return queryParams
+}
+
+func (team *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogSearchResult, error) {
+	var results []atc.BuildLogSearchResult
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.SearchBuildLogs,
+		Params: rata.Params{
+			"team_name": team.name,
+		},
+		Query: search.QueryParams(),
+	}, &internal.Response{
+		Result: &results,
+	})
+
+	return results, err
+}
@@ -0,0 +1,124 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Log Search", func() {
+	Describe("team.SearchBuildLogs", func() {
+		expectedURL := "/api/v1/teams/some-team/builds/search"
+
+		var (
+			search concourse.BuildLogSearch
+
+			expectedResults []atc.BuildLogSearchResult
+
+			results []atc.BuildLogSearchResult
+			err     error
+		)
+
+		BeforeEach(func() {
+			search = concourse.BuildLogSearch{Query: "some error"}
+
+			expectedResults = []atc.BuildLogSearchResult{
+				{
+					Build: atc.Build{
+						ID:       123,
+						Name:     "1",
+						TeamName: "some-team",
+						Status:   "failed",
+						JobName:  "some-job",
+					},
+					Lines: []atc.BuildLogLine{
+						{Origin: "some-plan-id", Line: "some error happened"},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			results, err = team.SearchBuildLogs(search)
+		})
+
+		Context("when only the query is specified", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "q=some+error"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("returns the matching builds", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when filters are specified", func() {
+			BeforeEach(func() {
+				search.Pipeline = atc.PipelineRef{Name: "some-pipeline"}
+				search.JobName = "some-job"
+				search.Since = time.Unix(100, 0)
+				search.Until = time.Unix(200, 0)
+				search.Limit = 5
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "job_name=some-job&limit=5&pipeline_name=some-pipeline&q=some+error&since=100&until=200"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("passes them along", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when a pipeline instance is specified", func() {
+			BeforeEach(func() {
+				search.Pipeline = atc.PipelineRef{
+					Name:         "some-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, `instance_vars=%7B%22branch%22%3A%22feature%22%7D&pipeline_name=some-pipeline&q=some+error`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("passes the instance vars along", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ErrApprovalAlreadyDecided is returned when approving or rejecting a step
+// which was already decided, or whose build is no longer running.
+var ErrApprovalAlreadyDecided = errors.New("approval-already-decided")
+
+func (client *client) BuildStepApprovals(buildID string) ([]atc.BuildStepApproval, bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var approvals []atc.BuildStepApproval
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildStepApprovals,
+		Params:      params,
+	}, &internal.Response{
+		Result: &approvals,
+	})
+
+	switch err.(type) {
+	case nil:
+		return approvals, true, nil
+	case internal.ResourceNotFoundError:
+		return approvals, false, nil
+	default:
+		return approvals, false, err
+	}
+}
+
+func (client *client) DecideBuildStep(buildID string, planID atc.PlanID, approved bool, comment string) (bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+		"plan_id":  string(planID),
+	}
+
+	requestName := atc.RejectBuildStep
+	if approved {
+		requestName = atc.ApproveBuildStep
+	}
+
+	jsonBytes, err := json.Marshal(atc.BuildStepDecision{Comment: comment})
+	if err != nil {
+		return false, err
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return false, ErrApprovalAlreadyDecided
+		}
+		return false, err
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,135 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Step Approvals", func() {
+	Describe("BuildStepApprovals", func() {
+		expectedURL := "/api/v1/builds/1234/approvals"
+
+		Context("when the build exists", func() {
+			expectedApprovals := []atc.BuildStepApproval{
+				{PlanID: "some-plan-id", Name: "ship-it", Roles: []string{"owner"}},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedApprovals),
+					),
+				)
+			})
+
+			It("returns the build's approvals", func() {
+				approvals, found, err := client.BuildStepApprovals("1234")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approvals).To(Equal(expectedApprovals))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildStepApprovals("1234")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("DecideBuildStep", func() {
+		var (
+			approved bool
+			status   int
+			found    bool
+			err      error
+		)
+
+		BeforeEach(func() {
+			approved = true
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			action := "reject"
+			if approved {
+				action = "approve"
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/1234/steps/some-plan-id/"+action),
+					ghttp.VerifyJSONRepresenting(atc.BuildStepDecision{Comment: "some-comment"}),
+					ghttp.RespondWith(status, ""),
+				),
+			)
+
+			found, err = client.DecideBuildStep("1234", "some-plan-id", approved, "some-comment")
+		})
+
+		It("approves the step", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		Context("when rejecting", func() {
+			BeforeEach(func() {
+				approved = false
+			})
+
+			It("rejects the step", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the approval does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false and no error", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the approval was already decided", func() {
+			BeforeEach(func() {
+				status = http.StatusConflict
+			})
+
+			It("returns ErrApprovalAlreadyDecided", func() {
+				Expect(err).To(Equal(concourse.ErrApprovalAlreadyDecided))
+			})
+		})
+
+		Context("when not permitted to decide", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("returns ErrForbidden", func() {
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+})
@@ -90,7 +90,7 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 
 		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.CreateJobBuild(pipelineName, jobName)
+			build, err := team.CreateJobBuild(atc.PipelineRef{Name: pipelineName}, jobName)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -116,18 +116,30 @@ var _ = Describe("ATC Handler Builds", func() {
 				JobName: "myjob",
 				APIURL:  "api/v1/builds/123",
 			}
-			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild"
+		})
 
+		It("takes a pipeline and a job and creates the build", func() {
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
-					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild", ""),
 					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
 				),
 			)
+
+			build, err := team.RerunJobBuild(atc.PipelineRef{Name: pipelineName}, jobName, buildName, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
 		})
 
-		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.RerunJobBuild(pipelineName, jobName, buildName)
+		It("reruns the build from the failed step", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild", "from_failed=true"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+
+			build, err := team.RerunJobBuild(atc.PipelineRef{Name: pipelineName}, jobName, buildName, true)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -160,7 +172,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("returns the given build", func() {
-				build, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				build, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(build).To(Equal(expectedBuild))
@@ -180,7 +192,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("return false and no error", func() {
-				_, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				_, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -377,6 +389,24 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 		})
 
+		Context("when annotations are specified", func() {
+			BeforeEach(func() {
+				page = concourse.Page{Annotations: map[string]string{"env": "prod", "branch": "main"}}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "annotation=branch%3Amain&annotation=env%3Aprod"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuilds),
+					),
+				)
+			})
+
+			It("sends each annotation as a filter", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(builds).To(Equal(expectedBuilds))
+			})
+		})
+
 		Context("when the server returns an error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
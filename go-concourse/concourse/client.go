@@ -21,6 +21,9 @@ type Client interface {
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
+	BuildStepApprovals(buildID string) ([]atc.BuildStepApproval, bool, error)
+	DecideBuildStep(buildID string, planID atc.PlanID, approved bool, comment string) (bool, error)
+	BuildTestResults(buildID string) (atc.TestSummary, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
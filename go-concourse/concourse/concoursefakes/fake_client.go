@@ -81,6 +81,36 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildStepApprovalsStub        func(string) ([]atc.BuildStepApproval, bool, error)
+	buildStepApprovalsMutex       sync.RWMutex
+	buildStepApprovalsArgsForCall []struct {
+		arg1 string
+	}
+	buildStepApprovalsReturns struct {
+		result1 []atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}
+	buildStepApprovalsReturnsOnCall map[int]struct {
+		result1 []atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}
+	BuildTestResultsStub        func(string) (atc.TestSummary, bool, error)
+	buildTestResultsMutex       sync.RWMutex
+	buildTestResultsArgsForCall []struct {
+		arg1 string
+	}
+	buildTestResultsReturns struct {
+		result1 atc.TestSummary
+		result2 bool
+		result3 error
+	}
+	buildTestResultsReturnsOnCall map[int]struct {
+		result1 atc.TestSummary
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -111,6 +141,22 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	DecideBuildStepStub        func(string, atc.PlanID, bool, string) (bool, error)
+	decideBuildStepMutex       sync.RWMutex
+	decideBuildStepArgsForCall []struct {
+		arg1 string
+		arg2 atc.PlanID
+		arg3 bool
+		arg4 string
+	}
+	decideBuildStepReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideBuildStepReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -618,6 +664,138 @@ func (fake *FakeClient) BuildResourcesReturnsOnCall(i int, result1 atc.BuildInpu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildStepApprovals(arg1 string) ([]atc.BuildStepApproval, bool, error) {
+	fake.buildStepApprovalsMutex.Lock()
+	ret, specificReturn := fake.buildStepApprovalsReturnsOnCall[len(fake.buildStepApprovalsArgsForCall)]
+	fake.buildStepApprovalsArgsForCall = append(fake.buildStepApprovalsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("BuildStepApprovals", []interface{}{arg1})
+	fake.buildStepApprovalsMutex.Unlock()
+	if fake.BuildStepApprovalsStub != nil {
+		return fake.BuildStepApprovalsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildStepApprovalsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildStepApprovalsCallCount() int {
+	fake.buildStepApprovalsMutex.RLock()
+	defer fake.buildStepApprovalsMutex.RUnlock()
+	return len(fake.buildStepApprovalsArgsForCall)
+}
+
+func (fake *FakeClient) BuildStepApprovalsCalls(stub func(string) ([]atc.BuildStepApproval, bool, error)) {
+	fake.buildStepApprovalsMutex.Lock()
+	defer fake.buildStepApprovalsMutex.Unlock()
+	fake.BuildStepApprovalsStub = stub
+}
+
+func (fake *FakeClient) BuildStepApprovalsArgsForCall(i int) string {
+	fake.buildStepApprovalsMutex.RLock()
+	defer fake.buildStepApprovalsMutex.RUnlock()
+	argsForCall := fake.buildStepApprovalsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildStepApprovalsReturns(result1 []atc.BuildStepApproval, result2 bool, result3 error) {
+	fake.buildStepApprovalsMutex.Lock()
+	defer fake.buildStepApprovalsMutex.Unlock()
+	fake.BuildStepApprovalsStub = nil
+	fake.buildStepApprovalsReturns = struct {
+		result1 []atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildStepApprovalsReturnsOnCall(i int, result1 []atc.BuildStepApproval, result2 bool, result3 error) {
+	fake.buildStepApprovalsMutex.Lock()
+	defer fake.buildStepApprovalsMutex.Unlock()
+	fake.BuildStepApprovalsStub = nil
+	if fake.buildStepApprovalsReturnsOnCall == nil {
+		fake.buildStepApprovalsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildStepApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildStepApprovalsReturnsOnCall[i] = struct {
+		result1 []atc.BuildStepApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTestResults(arg1 string) (atc.TestSummary, bool, error) {
+	fake.buildTestResultsMutex.Lock()
+	ret, specificReturn := fake.buildTestResultsReturnsOnCall[len(fake.buildTestResultsArgsForCall)]
+	fake.buildTestResultsArgsForCall = append(fake.buildTestResultsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("BuildTestResults", []interface{}{arg1})
+	fake.buildTestResultsMutex.Unlock()
+	if fake.BuildTestResultsStub != nil {
+		return fake.BuildTestResultsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildTestResultsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildTestResultsCallCount() int {
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
+	return len(fake.buildTestResultsArgsForCall)
+}
+
+func (fake *FakeClient) BuildTestResultsCalls(stub func(string) (atc.TestSummary, bool, error)) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = stub
+}
+
+func (fake *FakeClient) BuildTestResultsArgsForCall(i int) string {
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
+	argsForCall := fake.buildTestResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildTestResultsReturns(result1 atc.TestSummary, result2 bool, result3 error) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = nil
+	fake.buildTestResultsReturns = struct {
+		result1 atc.TestSummary
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTestResultsReturnsOnCall(i int, result1 atc.TestSummary, result2 bool, result3 error) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = nil
+	if fake.buildTestResultsReturnsOnCall == nil {
+		fake.buildTestResultsReturnsOnCall = make(map[int]struct {
+			result1 atc.TestSummary
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildTestResultsReturnsOnCall[i] = struct {
+		result1 atc.TestSummary
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -750,6 +928,72 @@ func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) DecideBuildStep(arg1 string, arg2 atc.PlanID, arg3 bool, arg4 string) (bool, error) {
+	fake.decideBuildStepMutex.Lock()
+	ret, specificReturn := fake.decideBuildStepReturnsOnCall[len(fake.decideBuildStepArgsForCall)]
+	fake.decideBuildStepArgsForCall = append(fake.decideBuildStepArgsForCall, struct {
+		arg1 string
+		arg2 atc.PlanID
+		arg3 bool
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("DecideBuildStep", []interface{}{arg1, arg2, arg3, arg4})
+	fake.decideBuildStepMutex.Unlock()
+	if fake.DecideBuildStepStub != nil {
+		return fake.DecideBuildStepStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.decideBuildStepReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DecideBuildStepCallCount() int {
+	fake.decideBuildStepMutex.RLock()
+	defer fake.decideBuildStepMutex.RUnlock()
+	return len(fake.decideBuildStepArgsForCall)
+}
+
+func (fake *FakeClient) DecideBuildStepCalls(stub func(string, atc.PlanID, bool, string) (bool, error)) {
+	fake.decideBuildStepMutex.Lock()
+	defer fake.decideBuildStepMutex.Unlock()
+	fake.DecideBuildStepStub = stub
+}
+
+func (fake *FakeClient) DecideBuildStepArgsForCall(i int) (string, atc.PlanID, bool, string) {
+	fake.decideBuildStepMutex.RLock()
+	defer fake.decideBuildStepMutex.RUnlock()
+	argsForCall := fake.decideBuildStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) DecideBuildStepReturns(result1 bool, result2 error) {
+	fake.decideBuildStepMutex.Lock()
+	defer fake.decideBuildStepMutex.Unlock()
+	fake.DecideBuildStepStub = nil
+	fake.decideBuildStepReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DecideBuildStepReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.decideBuildStepMutex.Lock()
+	defer fake.decideBuildStepMutex.Unlock()
+	fake.DecideBuildStepStub = nil
+	if fake.decideBuildStepReturnsOnCall == nil {
+		fake.decideBuildStepReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideBuildStepReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1642,10 +1886,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
+	fake.buildStepApprovalsMutex.RLock()
+	defer fake.buildStepApprovalsMutex.RUnlock()
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.decideBuildStepMutex.RLock()
+	defer fake.decideBuildStepMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
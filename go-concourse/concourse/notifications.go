@@ -0,0 +1,104 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) Notifications() ([]atc.NotificationSubscription, error) {
+	var subscriptions []atc.NotificationSubscription
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListNotifications,
+		Params:      rata.Params{"team_name": team.name},
+	}, &internal.Response{
+		Result: &subscriptions,
+	})
+
+	return subscriptions, err
+}
+
+// SetNotification creates a notification subscription, or replaces the one
+// with the same name.
+func (team *team) SetNotification(subscription atc.NotificationSubscription) error {
+	params := rata.Params{
+		"team_name":         team.name,
+		"notification_name": subscription.Name,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(subscription)
+	if err != nil {
+		return fmt.Errorf("Unable to marshal notification: %s", err)
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SaveNotification,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (team *team) DestroyNotification(name string) (bool, error) {
+	params := rata.Params{
+		"team_name":         team.name,
+		"notification_name": name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyNotification,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// NotificationDeliveries returns the latest deliveries of a notification,
+// most recent first. All of the deliveries the API returns by default are
+// returned if the limit is 0.
+func (team *team) NotificationDeliveries(name string, limit int) ([]atc.NotificationDelivery, bool, error) {
+	params := rata.Params{
+		"team_name":         team.name,
+		"notification_name": name,
+	}
+
+	query := url.Values{}
+	if limit != 0 {
+		query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.NotificationDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListNotificationDeliveries,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
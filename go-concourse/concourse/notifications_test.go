@@ -0,0 +1,161 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notifications", func() {
+	Describe("team.Notifications", func() {
+		expectedURL := "/api/v1/teams/some-team/notifications"
+
+		var expectedSubscriptions []atc.NotificationSubscription
+
+		BeforeEach(func() {
+			expectedSubscriptions = []atc.NotificationSubscription{
+				{
+					Name:     "some-notification",
+					URL:      "https://example.com/hooks",
+					Statuses: []atc.BuildStatus{atc.StatusFailed},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSubscriptions),
+				),
+			)
+		})
+
+		It("returns the team's notifications", func() {
+			subscriptions, err := team.Notifications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subscriptions).To(Equal(expectedSubscriptions))
+		})
+	})
+
+	Describe("team.SetNotification", func() {
+		expectedURL := "/api/v1/teams/some-team/notifications/some-notification"
+
+		subscription := atc.NotificationSubscription{
+			Name:   "some-notification",
+			URL:    "https://example.com/hooks",
+			Secret: "some-secret",
+		}
+
+		It("saves the notification", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyJSONRepresenting(subscription),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			Expect(team.SetNotification(subscription)).To(Succeed())
+		})
+
+		It("returns the error of an invalid notification", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.RespondWith(http.StatusBadRequest, "notification has no url"),
+				),
+			)
+
+			err := team.SetNotification(subscription)
+			Expect(err).To(MatchError(ContainSubstring("notification has no url")))
+		})
+	})
+
+	Describe("team.DestroyNotification", func() {
+		expectedURL := "/api/v1/teams/some-team/notifications/some-notification"
+
+		It("returns true when the notification is destroyed", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			destroyed, err := team.DestroyNotification("some-notification")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeTrue())
+		})
+
+		It("returns false when the notification does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			destroyed, err := team.DestroyNotification("some-notification")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(destroyed).To(BeFalse())
+		})
+	})
+
+	Describe("team.NotificationDeliveries", func() {
+		expectedURL := "/api/v1/teams/some-team/notifications/some-notification/deliveries"
+
+		expectedDeliveries := []atc.NotificationDelivery{
+			{
+				ID:           1,
+				Notification: "some-notification",
+				BuildID:      2,
+				BuildStatus:  atc.StatusFailed,
+				Status:       atc.NotificationDeliveryDelivered,
+				Attempts:     1,
+			},
+		}
+
+		It("returns the deliveries", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+				),
+			)
+
+			deliveries, found, err := team.NotificationDeliveries("some-notification", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(deliveries).To(Equal(expectedDeliveries))
+		})
+
+		It("limits the deliveries", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "limit=5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+				),
+			)
+
+			_, _, err := team.NotificationDeliveries("some-notification", 5)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns false when the notification does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			_, found, err := team.NotificationDeliveries("some-notification", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
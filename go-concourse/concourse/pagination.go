@@ -3,7 +3,9 @@ package concourse
 import (
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/peterhellberg/link"
 )
@@ -47,6 +49,10 @@ type Page struct {
 	Until      int
 	Limit      int
 	Timestamps bool
+
+	// Annotations filters builds by their annotations. It is only supported
+	// when listing builds across teams and pipelines.
+	Annotations map[string]string
 }
 
 func pageFromURI(uri string) (Page, error) {
@@ -61,6 +67,19 @@ func pageFromURI(uri string) (Page, error) {
 	page.Until, _ = strconv.Atoi(params.Get("until"))
 	page.Limit, _ = strconv.Atoi(params.Get("limit"))
 
+	for _, annotation := range params["annotation"] {
+		segs := strings.SplitN(annotation, ":", 2)
+		if len(segs) != 2 {
+			continue
+		}
+
+		if page.Annotations == nil {
+			page.Annotations = map[string]string{}
+		}
+
+		page.Annotations[segs[0]] = segs[1]
+	}
+
 	return page, nil
 }
 
@@ -82,5 +101,16 @@ func (p Page) QueryParams() url.Values {
 		queryParams.Add("timestamps", "true")
 	}
 
+	keys := make([]string, 0, len(p.Annotations))
+	for key := range p.Annotations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		queryParams.Add("annotation", key+":"+p.Annotations[key])
+	}
+
 	return queryParams
 }
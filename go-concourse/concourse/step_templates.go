@@ -0,0 +1,111 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) StepTemplates() ([]atc.StepTemplate, error) {
+	var templates []atc.StepTemplate
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListStepTemplates,
+		Params:      rata.Params{"team_name": team.name},
+	}, &internal.Response{
+		Result: &templates,
+	})
+
+	return templates, err
+}
+
+// StepTemplate returns the given version of a step template, or its latest
+// version if the version is 0.
+func (team *team) StepTemplate(name string, version int) (atc.StepTemplate, bool, error) {
+	params := rata.Params{
+		"team_name":          team.name,
+		"step_template_name": name,
+	}
+
+	query := url.Values{}
+	if version != 0 {
+		query.Set(atc.StepTemplateQueryVersion, strconv.Itoa(version))
+	}
+
+	var template atc.StepTemplate
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetStepTemplate,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &template,
+	})
+
+	switch err.(type) {
+	case nil:
+		return template, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.StepTemplate{}, false, nil
+	default:
+		return atc.StepTemplate{}, false, err
+	}
+}
+
+// SetStepTemplate saves a new version of a step template, returning it with
+// its version number.
+func (team *team) SetStepTemplate(template atc.StepTemplate) (atc.StepTemplate, error) {
+	params := rata.Params{
+		"team_name":          team.name,
+		"step_template_name": template.Name,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(template)
+	if err != nil {
+		return atc.StepTemplate{}, fmt.Errorf("Unable to marshal step template: %s", err)
+	}
+
+	var saved atc.StepTemplate
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SaveStepTemplate,
+		Params:      params,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &saved,
+	})
+	if err != nil {
+		return atc.StepTemplate{}, err
+	}
+
+	return saved, nil
+}
+
+func (team *team) DestroyStepTemplate(name string) (bool, error) {
+	params := rata.Params{
+		"team_name":          team.name,
+		"step_template_name": name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyStepTemplate,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
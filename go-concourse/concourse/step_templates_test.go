@@ -0,0 +1,185 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Step Templates", func() {
+	Describe("team.StepTemplates", func() {
+		expectedURL := "/api/v1/teams/some-team/step_templates"
+
+		var expectedTemplates []atc.StepTemplate
+
+		BeforeEach(func() {
+			expectedTemplates = []atc.StepTemplate{
+				{
+					Name:    "some-template",
+					Version: 2,
+					Params:  []string{"some-param"},
+					Step:    "task: ((some-param))",
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTemplates),
+				),
+			)
+		})
+
+		It("returns the team's step templates", func() {
+			templates, err := team.StepTemplates()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templates).To(Equal(expectedTemplates))
+		})
+	})
+
+	Describe("team.StepTemplate", func() {
+		expectedURL := "/api/v1/teams/some-team/step_templates/some-template"
+
+		Context("when the template exists", func() {
+			expectedTemplate := atc.StepTemplate{
+				Name:    "some-template",
+				Version: 2,
+				Step:    "task: some-task",
+			}
+
+			It("requests the latest version when no version is given", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTemplate),
+					),
+				)
+
+				template, found, err := team.StepTemplate("some-template", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(template).To(Equal(expectedTemplate))
+			})
+
+			It("requests the given version", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "version=2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTemplate),
+					),
+				)
+
+				_, found, err := team.StepTemplate("some-template", 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the template does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.StepTemplate("some-template", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("team.SetStepTemplate", func() {
+		expectedURL := "/api/v1/teams/some-team/step_templates/some-template"
+
+		template := atc.StepTemplate{
+			Name:   "some-template",
+			Params: []string{"some-param"},
+			Step:   "task: ((some-param))",
+		}
+
+		Context("when the template is saved", func() {
+			BeforeEach(func() {
+				saved := template
+				saved.Version = 3
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(template),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, saved),
+					),
+				)
+			})
+
+			It("returns the saved version", func() {
+				saved, err := team.SetStepTemplate(template)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(saved.Version).To(Equal(3))
+			})
+		})
+
+		Context("when the template is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusBadRequest, "step template has no step"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.SetStepTemplate(template)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("step template has no step"))
+			})
+		})
+	})
+
+	Describe("team.DestroyStepTemplate", func() {
+		expectedURL := "/api/v1/teams/some-team/step_templates/some-template"
+
+		Context("when the template exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				destroyed, err := team.DestroyStepTemplate("some-template")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destroyed).To(BeTrue())
+			})
+		})
+
+		Context("when the template does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				destroyed, err := team.DestroyStepTemplate("some-template")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destroyed).To(BeFalse())
+			})
+		})
+	})
+})
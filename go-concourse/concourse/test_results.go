@@ -0,0 +1,63 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildTestResults(buildID string) (atc.TestSummary, bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var summary atc.TestSummary
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetBuildTestResults,
+		Params:      params,
+	}, &internal.Response{
+		Result: &summary,
+	})
+
+	switch err.(type) {
+	case nil:
+		return summary, true, nil
+	case internal.ResourceNotFoundError:
+		return summary, false, nil
+	default:
+		return summary, false, err
+	}
+}
+
+func (team *team) JobTestHistory(pipelineRef atc.PipelineRef, jobName string, limit int) ([]atc.TestHistory, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	queryParams := pipelineRef.QueryParams()
+	if limit > 0 {
+		queryParams.Add(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var history []atc.TestHistory
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobTestHistory,
+		Params:      params,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &history,
+	})
+
+	switch err.(type) {
+	case nil:
+		return history, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,110 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Test Results", func() {
+	Describe("BuildTestResults", func() {
+		expectedURL := "/api/v1/builds/1234/test_results"
+
+		Context("when the build exists", func() {
+			expectedSummary := atc.TestSummary{
+				Passed: 1,
+				Tests: []atc.TestResult{
+					{Task: "unit", Suite: "api", Name: "some-test", Status: atc.TestStatusPassed},
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSummary),
+					),
+				)
+			})
+
+			It("returns the test summary of the build", func() {
+				summary, found, err := client.BuildTestResults("1234")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(summary).To(Equal(expectedSummary))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildTestResults("1234")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("JobTestHistory", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/test_history"
+
+		Context("when the job exists", func() {
+			expectedHistory := []atc.TestHistory{
+				{
+					Task:  "unit",
+					Suite: "api",
+					Name:  "some-test",
+					Flaky: true,
+					Runs: []atc.TestRun{
+						{BuildID: 2, BuildName: "2", Status: atc.TestStatusPassed},
+						{BuildID: 1, BuildName: "1", Status: atc.TestStatusFailed},
+					},
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedHistory),
+					),
+				)
+			})
+
+			It("returns the test history of the job", func() {
+				history, found, err := team.JobTestHistory(atc.PipelineRef{Name: "mypipeline"}, "myjob", 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(history).To(Equal(expectedHistory))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.JobTestHistory(atc.PipelineRef{Name: "mypipeline"}, "myjob", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// APITokenPrefix tells the API tokens of service accounts apart from the JWTs
+// issued to users.
+const APITokenPrefix = "cst_"
+
+// GenerateAPIToken returns a new random API token along with the hash which
+// is stored in its place.
+func GenerateAPIToken() (string, string, error) {
+	secret := make([]byte, 32)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	apiToken := APITokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	return apiToken, HashAPIToken(apiToken), nil
+}
+
+func HashAPIToken(apiToken string) string {
+	hash := sha256.Sum256([]byte(apiToken))
+	return hex.EncodeToString(hash[:])
+}
+
+func IsAPIToken(bearer string) bool {
+	return strings.HasPrefix(bearer, APITokenPrefix)
+}
@@ -0,0 +1,33 @@
+package token_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+var _ = Describe("API Tokens", func() {
+	Describe("GenerateAPIToken", func() {
+		It("generates a random prefixed token along with its hash", func() {
+			apiToken, hash, err := token.GenerateAPIToken()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(token.IsAPIToken(apiToken)).To(BeTrue())
+			Expect(hash).To(Equal(token.HashAPIToken(apiToken)))
+			Expect(hash).NotTo(ContainSubstring(apiToken))
+
+			otherToken, otherHash, err := token.GenerateAPIToken()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(otherToken).NotTo(Equal(apiToken))
+			Expect(otherHash).NotTo(Equal(hash))
+		})
+	})
+
+	Describe("IsAPIToken", func() {
+		It("does not consider JWTs to be API tokens", func() {
+			Expect(token.IsAPIToken("eyJhbGciOiJSUzI1NiJ9.e30.c2ln")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,197 @@
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	coltracepb "github.com/open-telemetry/opentelemetry-proto/gen/go/collector/trace/v1"
+	commonpb "github.com/open-telemetry/opentelemetry-proto/gen/go/common/v1"
+	resourcepb "github.com/open-telemetry/opentelemetry-proto/gen/go/resource/v1"
+	tracepb "github.com/open-telemetry/opentelemetry-proto/gen/go/trace/v1"
+	"go.opentelemetry.io/otel/api/core"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	otlpBufferSize    = 1000
+	otlpMaxBatchSize  = 100
+	otlpExportTimeout = 10 * time.Second
+)
+
+type OTLP struct {
+	Address string            `long:"otlp-address" description:"otlp/grpc collector address to send traces to"`
+	Headers map[string]string `long:"otlp-header"  description:"headers to attach to each export request"`
+	UseTLS  bool              `long:"otlp-use-tls" description:"whether to use tls when connecting to the collector"`
+	Service string            `long:"otlp-service" description:"service name to report traces under" default:"web"`
+}
+
+func (o OTLP) IsConfigured() bool {
+	return o.Address != ""
+}
+
+func (o OTLP) Exporter() (export.SpanSyncer, error) {
+	transport := grpc.WithInsecure()
+	if o.UseTLS {
+		transport = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	}
+
+	conn, err := grpc.Dial(o.Address, transport)
+	if err != nil {
+		err = fmt.Errorf("failed to create otlp exporter: %w", err)
+		return nil, err
+	}
+
+	exporter := &otlpExporter{
+		client:  coltracepb.NewTraceServiceClient(conn),
+		headers: metadata.New(o.Headers),
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.AttributeKeyValue{
+				{Key: "service.name", StringValue: o.Service},
+			},
+		},
+		spans: make(chan *tracepb.Span, otlpBufferSize),
+	}
+
+	go exporter.run()
+
+	return exporter, nil
+}
+
+// otlpExporter sends spans to an OpenTelemetry collector over OTLP/gRPC.
+//
+// Spans are exported in the background so that ending a span never waits on
+// the collector; if the collector can't keep up, spans are dropped.
+//
+type otlpExporter struct {
+	client   coltracepb.TraceServiceClient
+	headers  metadata.MD
+	resource *resourcepb.Resource
+
+	spans chan *tracepb.Span
+}
+
+func (e *otlpExporter) ExportSpan(_ context.Context, data *export.SpanData) {
+	select {
+	case e.spans <- otlpSpan(data):
+	default:
+	}
+}
+
+func (e *otlpExporter) run() {
+	for span := range e.spans {
+		batch := []*tracepb.Span{span}
+
+	collect:
+		for len(batch) < otlpMaxBatchSize {
+			select {
+			case span := <-e.spans:
+				batch = append(batch, span)
+			default:
+				break collect
+			}
+		}
+
+		e.export(batch)
+	}
+}
+
+func (e *otlpExporter) export(spans []*tracepb.Span) {
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, e.headers)
+
+	// errors are dropped, as there's nowhere to report them to but the
+	// collector itself
+	_, _ = e.client.Export(ctx, &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{
+			{
+				Resource: e.resource,
+				InstrumentationLibrarySpans: []*tracepb.InstrumentationLibrarySpans{
+					{
+						InstrumentationLibrary: &commonpb.InstrumentationLibrary{Name: "concourse"},
+						Spans:                  spans,
+					},
+				},
+			},
+		},
+	})
+}
+
+// otlpSpan converts the SDK's representation of a span to OTLP's.
+//
+func otlpSpan(data *export.SpanData) *tracepb.Span {
+	span := &tracepb.Span{
+		TraceId:                data.SpanContext.TraceID[:],
+		SpanId:                 data.SpanContext.SpanID[:],
+		Name:                   data.Name,
+		Kind:                   tracepb.Span_SpanKind(data.SpanKind),
+		StartTimeUnixNano:      uint64(data.StartTime.UnixNano()),
+		EndTimeUnixNano:        uint64(data.EndTime.UnixNano()),
+		Attributes:             otlpAttributes(data.Attributes),
+		DroppedAttributesCount: uint32(data.DroppedAttributeCount),
+		DroppedEventsCount:     uint32(data.DroppedMessageEventCount),
+		DroppedLinksCount:      uint32(data.DroppedLinkCount),
+		Status: &tracepb.Status{
+			// OTLP status codes mirror gRPC's
+			Code: tracepb.Status_StatusCode(data.Status),
+		},
+	}
+
+	if data.ParentSpanID.IsValid() {
+		span.ParentSpanId = data.ParentSpanID[:]
+	}
+
+	for _, event := range data.MessageEvents {
+		span.Events = append(span.Events, &tracepb.Span_Event{
+			TimeUnixNano: uint64(event.Time.UnixNano()),
+			Name:         event.Name,
+			Attributes:   otlpAttributes(event.Attributes),
+		})
+	}
+
+	for _, link := range data.Links {
+		span.Links = append(span.Links, &tracepb.Span_Link{
+			TraceId:    link.TraceID[:],
+			SpanId:     link.SpanID[:],
+			Attributes: otlpAttributes(link.Attributes),
+		})
+	}
+
+	return span
+}
+
+func otlpAttributes(kvs []core.KeyValue) []*commonpb.AttributeKeyValue {
+	attrs := make([]*commonpb.AttributeKeyValue, 0, len(kvs))
+
+	for _, kv := range kvs {
+		attr := &commonpb.AttributeKeyValue{Key: string(kv.Key)}
+
+		switch kv.Value.Type() {
+		case core.BOOL:
+			attr.Type = commonpb.AttributeKeyValue_BOOL
+			attr.BoolValue = kv.Value.AsBool()
+		case core.INT32, core.INT64:
+			attr.Type = commonpb.AttributeKeyValue_INT
+			attr.IntValue = kv.Value.AsInt64()
+		case core.UINT32, core.UINT64:
+			attr.Type = commonpb.AttributeKeyValue_INT
+			attr.IntValue = int64(kv.Value.AsUint64())
+		case core.FLOAT32, core.FLOAT64:
+			attr.Type = commonpb.AttributeKeyValue_DOUBLE
+			attr.DoubleValue = kv.Value.AsFloat64()
+		default:
+			attr.Type = commonpb.AttributeKeyValue_STRING
+			attr.StringValue = kv.Value.Emit()
+		}
+
+		attrs = append(attrs, attr)
+	}
+
+	return attrs
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/api/core"
+	"go.opentelemetry.io/otel/api/propagators"
+	"go.opentelemetry.io/otel/api/trace"
+)
+
+// propagator encodes span contexts using the W3C Trace Context format, i.e.
+// the `traceparent` header.
+//
+var propagator = propagators.TraceContext{}
+
+// remoteSpan carries a span context received from another process so that
+// spans started from it become its children.
+//
+type remoteSpan struct {
+	trace.NoopSpan
+
+	spanContext core.SpanContext
+}
+
+func (s remoteSpan) SpanContext() core.SpanContext {
+	return s.spanContext
+}
+
+// Inject encodes the context of the span in ctx into the carrier.
+//
+func Inject(ctx context.Context, supplier propagators.Supplier) {
+	propagator.Inject(ctx, supplier)
+}
+
+// Extract decodes a span context from the carrier, giving back a context that
+// has it as the parent of the next span started with StartSpan.
+//
+// If the carrier has no valid span context, ctx is returned as is.
+//
+func Extract(ctx context.Context, supplier propagators.Supplier) context.Context {
+	spanContext, _ := propagator.Extract(ctx, supplier)
+	if !spanContext.IsValid() {
+		return ctx
+	}
+
+	return trace.ContextWithSpan(ctx, remoteSpan{spanContext: spanContext})
+}
+
+// Env returns the context of the span in ctx as environment variables (e.g.
+// `TRACEPARENT=...`), for passing it on to processes run in containers.
+//
+func Env(ctx context.Context) []string {
+	carrier := envSupplier{}
+	Inject(ctx, carrier)
+
+	var env []string
+	for _, key := range propagator.GetAllKeys() {
+		if value, found := carrier[key]; found {
+			env = append(env, envName(key)+"="+value)
+		}
+	}
+
+	return env
+}
+
+func envName(key string) string {
+	return strings.ToUpper(strings.Replace(key, "-", "_", -1))
+}
+
+type envSupplier map[string]string
+
+func (s envSupplier) Get(key string) string        { return s[key] }
+func (s envSupplier) Set(key string, value string) { s[key] = value }
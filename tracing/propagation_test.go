@@ -0,0 +1,78 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/concourse/concourse/tracing"
+	"go.opentelemetry.io/otel/api/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Propagation", func() {
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	var header http.Header
+
+	BeforeEach(func() {
+		header = http.Header{}
+	})
+
+	Describe("Extract", func() {
+		Context("with a traceparent", func() {
+			BeforeEach(func() {
+				header.Set("traceparent", traceparent)
+			})
+
+			It("makes the remote span the current one", func() {
+				ctx := tracing.Extract(context.Background(), header)
+
+				spanContext := trace.SpanFromContext(ctx).SpanContext()
+				Expect(spanContext.TraceIDString()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+				Expect(spanContext.SpanIDString()).To(Equal("00f067aa0ba902b7"))
+			})
+
+			It("can be injected back", func() {
+				ctx := tracing.Extract(context.Background(), header)
+
+				injected := http.Header{}
+				tracing.Inject(ctx, injected)
+				Expect(injected.Get("traceparent")).To(Equal(traceparent))
+			})
+
+			It("can be turned into environment variables", func() {
+				ctx := tracing.Extract(context.Background(), header)
+
+				Expect(tracing.Env(ctx)).To(ConsistOf("TRACEPARENT=" + traceparent))
+			})
+		})
+
+		Context("without a traceparent", func() {
+			It("leaves the context as is", func() {
+				ctx := context.Background()
+				Expect(tracing.Extract(ctx, header)).To(Equal(ctx))
+			})
+		})
+
+		Context("with a malformed traceparent", func() {
+			BeforeEach(func() {
+				header.Set("traceparent", "bogus")
+			})
+
+			It("leaves the context as is", func() {
+				ctx := context.Background()
+				Expect(tracing.Extract(ctx, header)).To(Equal(ctx))
+			})
+		})
+	})
+
+	Describe("Env", func() {
+		Context("without a span", func() {
+			It("is empty", func() {
+				Expect(tracing.Env(context.Background())).To(BeEmpty())
+			})
+		})
+	})
+})
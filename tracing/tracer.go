@@ -57,6 +57,9 @@ var Configured bool
 // finalization happening for the root span (3) given how `defer` statements
 // stack.
 //
+// If ctx carries a span context extracted from another process (see
+// Extract), the span is started as a child of it.
+//
 func StartSpan(
 	ctx context.Context,
 	component string,
@@ -66,9 +69,15 @@ func StartSpan(
 		return ctx, trace.NoopSpan{}
 	}
 
+	var opts []trace.StartOption
+	if remote, ok := trace.SpanFromContext(ctx).(remoteSpan); ok {
+		opts = append(opts, trace.ChildOf(remote.spanContext))
+	}
+
 	ctx, span := global.TraceProvider().Tracer("concourse").Start(
 		ctx,
 		component,
+		opts...,
 	)
 
 	if len(attrs) != 0 {
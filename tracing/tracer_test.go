@@ -2,6 +2,7 @@ package tracing_test
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/tracing/tracingfakes"
@@ -17,11 +18,12 @@ import (
 var _ = Describe("Tracer", func() {
 
 	var (
-		fakeSpan *tracingfakes.FakeSpan
+		fakeTracer *tracingfakes.FakeTracer
+		fakeSpan   *tracingfakes.FakeSpan
 	)
 
 	BeforeEach(func() {
-		fakeTracer := new(tracingfakes.FakeTracer)
+		fakeTracer = new(tracingfakes.FakeTracer)
 		fakeProvider := new(tracingfakes.FakeProvider)
 		fakeSpan = new(tracingfakes.FakeSpan)
 
@@ -46,6 +48,10 @@ var _ = Describe("Tracer", func() {
 			attrs     = tracing.Attrs{}
 		)
 
+		BeforeEach(func() {
+			ctx = context.Background()
+		})
+
 		JustBeforeEach(func() {
 			_, span = tracing.StartSpan(ctx, component, attrs)
 		})
@@ -74,6 +80,27 @@ var _ = Describe("Tracer", func() {
 			})
 		})
 
+		Context("with a span context extracted from another process", func() {
+
+			BeforeEach(func() {
+				ctx = tracing.Extract(context.Background(), http.Header{
+					"Traceparent": {"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+				})
+			})
+
+			It("starts the span as a child of it", func() {
+				Expect(fakeTracer.StartCallCount()).To(Equal(1))
+
+				_, _, opts := fakeTracer.StartArgsForCall(0)
+				Expect(opts).To(HaveLen(1))
+
+				startOpts := trace.StartConfig{}
+				opts[0](&startOpts)
+				Expect(startOpts.Relation.RelationshipType).To(Equal(trace.ChildOfRelationship))
+				Expect(startOpts.Relation.SpanContext.SpanIDString()).To(Equal("00f067aa0ba902b7"))
+			})
+		})
+
 	})
 
 })
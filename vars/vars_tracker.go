@@ -22,6 +22,11 @@ type CredVarsTracker interface {
 	Enabled() bool
 
 	AddLocalVar(string, interface{}, bool)
+
+	// NewLocalScope returns a tracker whose local vars shadow those of this
+	// tracker without modifying them. Interpolated creds are still tracked by
+	// the outermost tracker so that they are redacted everywhere.
+	NewLocalScope() CredVarsTracker
 }
 
 func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
@@ -36,6 +41,8 @@ func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
 }
 
 type credVarsTracker struct {
+	parent *credVarsTracker
+
 	credVars  Variables
 	localVars StaticVariables
 
@@ -60,26 +67,49 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	parts := strings.Split(varDef.Name, ":")
 	if len(parts) == 2 && parts[0] == "." {
 		varDef.Name = parts[1]
-		val, found, err = t.localVars.Get(varDef)
-		if found {
-			parts = strings.Split(varDef.Name, ".")
-			if _, ok := t.noRedactVarNames[parts[0]]; ok {
-				redact = false
-			}
-		}
+		val, found, redact, err = t.getLocalVar(varDef)
 	} else {
 		val, found, err = t.credVars.Get(varDef)
 	}
 
 	if t.enabled && found && redact {
-		t.lock.Lock()
-		t.track(varDef.Name, val)
-		t.lock.Unlock()
+		root := t.root()
+		root.lock.Lock()
+		root.track(varDef.Name, val)
+		root.lock.Unlock()
 	}
 
 	return val, found, err
 }
 
+func (t *credVarsTracker) getLocalVar(varDef VariableDefinition) (interface{}, bool, bool, error) {
+	val, found, err := t.localVars.Get(varDef)
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	if !found {
+		if t.parent != nil {
+			return t.parent.getLocalVar(varDef)
+		}
+
+		return nil, false, false, nil
+	}
+
+	parts := strings.Split(varDef.Name, ".")
+	_, noRedact := t.noRedactVarNames[parts[0]]
+
+	return val, true, !noRedact, nil
+}
+
+func (t *credVarsTracker) root() *credVarsTracker {
+	if t.parent != nil {
+		return t.parent.root()
+	}
+
+	return t
+}
+
 func (t *credVarsTracker) track(name string, val interface{}) {
 	switch v := val.(type) {
 	case map[interface{}]interface{}:
@@ -104,11 +134,12 @@ func (t *credVarsTracker) List() ([]VariableDefinition, error) {
 }
 
 func (t *credVarsTracker) IterateInterpolatedCreds(iter CredVarsTrackerIterator) {
-	t.lock.RLock()
-	for k, v := range t.interpolatedCreds {
+	root := t.root()
+	root.lock.RLock()
+	for k, v := range root.interpolatedCreds {
 		iter.YieldCred(k, v)
 	}
-	t.lock.RUnlock()
+	root.lock.RUnlock()
 }
 
 func (t *credVarsTracker) Enabled() bool {
@@ -122,6 +153,16 @@ func (t *credVarsTracker) AddLocalVar(name string, value interface{}, redact boo
 	}
 }
 
+func (t *credVarsTracker) NewLocalScope() CredVarsTracker {
+	return &credVarsTracker{
+		parent:           t,
+		localVars:        StaticVariables{},
+		credVars:         t.credVars,
+		enabled:          t.enabled,
+		noRedactVarNames: map[string]bool{},
+	}
+}
+
 // MapCredVarsTrackerIterator implements a simple CredVarsTrackerIterator which just
 // populate interpolated secrets into a map. This could be useful in unit test.
 
@@ -101,6 +101,48 @@ var _ = Describe("vars_tracker", func() {
 		})
 	})
 
+	Describe("NewLocalScope", func() {
+		var scope CredVarsTracker
+
+		BeforeEach(func() {
+			v := StaticVariables{"k1": "v1"}
+			tracker = NewCredVarsTracker(v, true)
+			tracker.AddLocalVar("foo", "bar", true)
+			tracker.AddLocalVar("baz", "qux", true)
+
+			scope = tracker.NewLocalScope()
+			scope.AddLocalVar("foo", "shadowed", true)
+		})
+
+		It("shadows local vars of the parent", func() {
+			val, found, err := scope.Get(VariableDefinition{Name: ".:foo"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("shadowed"))
+
+			val, found, err = tracker.Get(VariableDefinition{Name: ".:foo"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("bar"))
+		})
+
+		It("falls back to local vars of the parent", func() {
+			val, found, err := scope.Get(VariableDefinition{Name: ".:baz"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("qux"))
+		})
+
+		It("tracks fetched variables in the parent", func() {
+			scope.Get(VariableDefinition{Name: "k1"})
+			scope.Get(VariableDefinition{Name: ".:foo"})
+			mapit := NewMapCredVarsTrackerIterator()
+			tracker.IterateInterpolatedCreds(mapit)
+			Expect(mapit.Data["k1"]).To(Equal("v1"))
+			Expect(mapit.Data["foo"]).To(Equal("shadowed"))
+		})
+	})
+
 	Describe("turn off track", func() {
 		BeforeEach(func() {
 			v := StaticVariables{"k1": "v1", "k2": "v2", "k3": "v3"}